@@ -176,6 +176,10 @@ controllers:
   {{- if .Values.config.controllers.controllerInstallation }}
   controllerInstallation:
     concurrentSyncs: {{ required ".Values.config.controllers.controllerInstallation.concurrentSyncs is required" .Values.config.controllers.controllerInstallation.concurrentSyncs }}
+    {{- if .Values.config.controllers.controllerInstallation.signatureVerification }}
+    signatureVerification:
+{{ toYaml .Values.config.controllers.controllerInstallation.signatureVerification | indent 6 }}
+    {{- end }}
   {{- end }}
   {{- if .Values.config.controllers.controllerInstallationCare }}
   controllerInstallationCare:
@@ -245,6 +249,17 @@ controllers:
     concurrentSyncs: {{ required ".Values.config.controllers.shootState.concurrentSyncs is required" .Values.config.controllers.shootState.concurrentSyncs }}
     syncPeriod: {{ required ".Values.config.controllers.shootState.syncPeriod is required" .Values.config.controllers.shootState.syncPeriod }}
   {{- end }}
+  {{- if .Values.config.controllers.shootActivity }}
+  shootActivity:
+    concurrentSyncs: {{ required ".Values.config.controllers.shootActivity.concurrentSyncs is required" .Values.config.controllers.shootActivity.concurrentSyncs }}
+    syncPeriod: {{ required ".Values.config.controllers.shootActivity.syncPeriod is required" .Values.config.controllers.shootActivity.syncPeriod }}
+    {{- if .Values.config.controllers.shootActivity.apiRequestsQuery }}
+    apiRequestsQuery: {{ .Values.config.controllers.shootActivity.apiRequestsQuery | quote }}
+    {{- end }}
+    {{- if .Values.config.controllers.shootActivity.workloadsQuery }}
+    workloadsQuery: {{ .Values.config.controllers.shootActivity.workloadsQuery | quote }}
+    {{- end }}
+  {{- end }}
   {{- if .Values.config.controllers.managedSeed }}
   managedSeed:
     concurrentSyncs: {{ required ".Values.config.controllers.managedSeed.concurrentSyncs is required" .Values.config.controllers.managedSeed.concurrentSyncs }}
@@ -344,6 +359,10 @@ exposureClassHandlers:
 nodeToleration:
 {{ toYaml .Values.nodeToleration | indent 2 }}
 {{- end}}
+{{- if .Values.config.ociChartCache }}
+ociChartCache:
+{{ toYaml .Values.config.ociChartCache | indent 2 }}
+{{- end }}
 {{- end -}}
 
 {{- define "gardenlet.config.name" -}}
@@ -740,6 +740,12 @@ func ComputeExpectedGardenletConfiguration(
 				ConcurrentSyncs: &five,
 				SyncPeriod:      &metav1.Duration{Duration: 6 * time.Hour},
 			},
+			ShootActivity: &gardenletv1alpha1.ShootActivityControllerConfiguration{
+				ConcurrentSyncs:  &five,
+				SyncPeriod:       &metav1.Duration{Duration: 5 * time.Minute},
+				APIRequestsQuery: ptr.To(gardenletv1alpha1.DefaultShootActivityAPIRequestsQuery),
+				WorkloadsQuery:   ptr.To(gardenletv1alpha1.DefaultShootActivityWorkloadsQuery),
+			},
 			TokenRequestorServiceAccount: &gardenletv1alpha1.TokenRequestorServiceAccountControllerConfiguration{
 				ConcurrentSyncs: &five,
 			},
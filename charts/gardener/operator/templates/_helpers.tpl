@@ -142,6 +142,10 @@ config.yaml: |
   nodeToleration:
 {{ toYaml .Values.nodeToleration | indent 4 }}
   {{- end }}
+  {{- if .Values.config.ociChartCache }}
+  ociChartCache:
+{{ toYaml .Values.config.ociChartCache | indent 4 }}
+  {{- end }}
 {{- end -}}
 
 {{- define "operator.config.name" -}}
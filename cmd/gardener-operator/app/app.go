@@ -43,6 +43,7 @@ import (
 	operatorclient "github.com/gardener/gardener/pkg/operator/client"
 	"github.com/gardener/gardener/pkg/operator/controller"
 	"github.com/gardener/gardener/pkg/operator/webhook"
+	"github.com/gardener/gardener/pkg/utils/oci"
 )
 
 // Name is a const for the name of this component.
@@ -85,6 +86,17 @@ func run(ctx context.Context, log logr.Logger, cfg *config.OperatorConfiguration
 		return err
 	}
 
+	if cfg.OCIChartCache != nil {
+		log.Info("Configuring cache for OCI Helm charts")
+		opts := oci.CacheOptions{Directory: ptr.Deref(cfg.OCIChartCache.Directory, "")}
+		if cfg.OCIChartCache.MaxSize != nil {
+			opts.MaxSize = cfg.OCIChartCache.MaxSize.Value()
+		}
+		if err := oci.ConfigureDefaultCache(opts); err != nil {
+			return fmt.Errorf("failed configuring cache for OCI Helm charts: %w", err)
+		}
+	}
+
 	var extraHandlers map[string]http.Handler
 	if cfg.Debugging != nil && cfg.Debugging.EnableProfiling {
 		extraHandlers = routes.ProfilingHandlers
@@ -0,0 +1,200 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/go-logr/logr"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"k8s.io/component-base/version/verflag"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	"github.com/gardener/gardener/cmd/utils/initrun"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/scheduler/apis/config"
+	"github.com/gardener/gardener/pkg/scheduler/controller/shoot"
+)
+
+const (
+	outputText = "text"
+	outputYAML = "yaml"
+	outputJSON = "json"
+)
+
+type explainOptions struct {
+	shoot    string
+	filename string
+	output   string
+}
+
+func (o *explainOptions) addFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&o.shoot, "shoot", o.shoot, "Namespace and name of an existing shoot to explain the seed determination for, in the format <namespace>/<name>.")
+	fs.StringVarP(&o.filename, "filename", "f", o.filename, "Path to a manifest of a (not yet existing) shoot to explain the seed determination for, or '-' to read it from stdin.")
+	fs.StringVar(&o.output, "output", outputText, fmt.Sprintf("Output format, one of %q, %q or %q.", outputText, outputYAML, outputJSON))
+}
+
+func (o *explainOptions) validate() error {
+	switch {
+	case o.shoot == "" && o.filename == "":
+		return fmt.Errorf("one of the flags --shoot or --filename must be set")
+	case o.shoot != "" && o.filename != "":
+		return fmt.Errorf("the flags --shoot and --filename are mutually exclusive")
+	case o.shoot != "":
+		if namespace, name, ok := strings.Cut(o.shoot, "/"); !ok || namespace == "" || name == "" {
+			return fmt.Errorf("flag --shoot must be in the format <namespace>/<name>, got %q", o.shoot)
+		}
+	}
+
+	switch o.output {
+	case outputText, outputYAML, outputJSON:
+		return nil
+	default:
+		return fmt.Errorf("unsupported output format %q, must be one of %q, %q or %q", o.output, outputText, outputYAML, outputJSON)
+	}
+}
+
+func getExplainCommand(opts *options) *cobra.Command {
+	explainOpts := &explainOptions{}
+
+	explainCmd := &cobra.Command{
+		Use:   "explain",
+		Short: "Explain which seed the " + Name + " would choose for a shoot, without scheduling it",
+		Long: `Runs the seed determination of the ` + Name + ` for the given shoot in dry-run mode. It reports for every seed
+the filter which rejected it together with the reason, the final ranking of the remaining candidates and the seed that
+would be chosen. The shoot is either read from the garden cluster (--shoot) or from a manifest (--filename), so that the
+placement of a shoot can be explained before it is created. The shoot is not modified.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if err := explainOpts.validate(); err != nil {
+				return err
+			}
+
+			log, err := initrun.InitRun(cmd, opts, Name)
+			if err != nil {
+				return err
+			}
+			return explain(cmd.Context(), log, cmd.InOrStdin(), cmd.OutOrStdout(), opts.config, explainOpts)
+		},
+	}
+
+	flags := explainCmd.Flags()
+	verflag.AddFlags(flags)
+	opts.addFlags(flags)
+	explainOpts.addFlags(flags)
+
+	return explainCmd
+}
+
+func explain(ctx context.Context, log logr.Logger, in io.Reader, out io.Writer, cfg *config.SchedulerConfiguration, opts *explainOptions) error {
+	if kubeconfig := os.Getenv("KUBECONFIG"); kubeconfig != "" {
+		cfg.ClientConnection.Kubeconfig = kubeconfig
+	}
+
+	restCfg, err := kubernetes.RESTConfigFromClientConnectionConfiguration(&cfg.ClientConnection, nil, kubernetes.AuthTokenFile)
+	if err != nil {
+		return err
+	}
+
+	c, err := client.New(restCfg, client.Options{Scheme: kubernetes.GardenScheme})
+	if err != nil {
+		return fmt.Errorf("failed creating client: %w", err)
+	}
+
+	s, err := readShoot(ctx, c, in, opts)
+	if err != nil {
+		return err
+	}
+
+	reconciler := &shoot.Reconciler{Client: c, Config: cfg.Schedulers.Shoot, GardenNamespace: v1beta1constants.GardenNamespace}
+	return printExplanation(out, reconciler.ExplainSeedDetermination(ctx, log, s), opts.output)
+}
+
+func readShoot(ctx context.Context, c client.Reader, in io.Reader, opts *explainOptions) (*gardencorev1beta1.Shoot, error) {
+	shoot := &gardencorev1beta1.Shoot{}
+
+	if opts.shoot != "" {
+		namespace, name, _ := strings.Cut(opts.shoot, "/")
+		if err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, shoot); err != nil {
+			return nil, fmt.Errorf("failed reading shoot %s: %w", opts.shoot, err)
+		}
+		return shoot, nil
+	}
+
+	var (
+		data []byte
+		err  error
+	)
+	if opts.filename == "-" {
+		data, err = io.ReadAll(in)
+	} else {
+		data, err = os.ReadFile(opts.filename)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed reading shoot manifest %s: %w", opts.filename, err)
+	}
+
+	// The universal decoder applies the defaults which are usually applied by the API server when the shoot is created.
+	if _, _, err := kubernetes.GardenCodec.UniversalDecoder(gardencorev1beta1.SchemeGroupVersion).Decode(data, nil, shoot); err != nil {
+		return nil, fmt.Errorf("failed decoding shoot manifest %s: %w", opts.filename, err)
+	}
+	if shoot.Namespace == "" {
+		return nil, fmt.Errorf("shoot manifest %s does not specify a namespace", opts.filename)
+	}
+	return shoot, nil
+}
+
+func printExplanation(out io.Writer, explanation *shoot.Explanation, output string) error {
+	switch output {
+	case outputYAML:
+		data, err := yaml.Marshal(explanation)
+		if err != nil {
+			return err
+		}
+		_, err = out.Write(data)
+		return err
+
+	case outputJSON:
+		encoder := json.NewEncoder(out)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(explanation)
+	}
+
+	fmt.Fprintf(out, "Shoot:    %s\nStrategy: %s\n\n", explanation.Shoot, explanation.Strategy)
+
+	w := tabwriter.NewWriter(out, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "SEED\tSHOOTS\tRANK\tSCORE\tREJECTED BY\tREASON")
+	for _, seed := range explanation.Seeds {
+		rank, score := "-", "-"
+		if seed.Rank > 0 {
+			rank = strconv.Itoa(seed.Rank)
+		}
+		if seed.Score != nil {
+			score = strconv.FormatInt(*seed.Score, 10)
+		}
+		fmt.Fprintf(w, "%s\t%d\t%s\t%s\t%s\t%s\n", seed.Name, seed.Shoots, rank, score, seed.RejectedBy, seed.Reason)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	if explanation.Error != "" {
+		_, err := fmt.Fprintf(out, "\nNo seed could be determined: %s\n", explanation.Error)
+		return err
+	}
+	_, err := fmt.Fprintf(out, "\nChosen seed: %s\n", explanation.SeedName)
+	return err
+}
@@ -66,6 +66,7 @@ import (
 	"github.com/gardener/gardener/pkg/utils"
 	"github.com/gardener/gardener/pkg/utils/flow"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	"github.com/gardener/gardener/pkg/utils/oci"
 )
 
 // Name is a const for the name of this component.
@@ -112,6 +113,17 @@ func run(ctx context.Context, cancel context.CancelFunc, log logr.Logger, cfg *c
 		return err
 	}
 
+	if cfg.OCIChartCache != nil {
+		log.Info("Configuring cache for OCI Helm charts")
+		opts := oci.CacheOptions{Directory: ptr.Deref(cfg.OCIChartCache.Directory, "")}
+		if cfg.OCIChartCache.MaxSize != nil {
+			opts.MaxSize = cfg.OCIChartCache.MaxSize.Value()
+		}
+		if err := oci.ConfigureDefaultCache(opts); err != nil {
+			return fmt.Errorf("failed configuring cache for OCI Helm charts: %w", err)
+		}
+	}
+
 	var extraHandlers map[string]http.Handler
 	if cfg.Debugging != nil && cfg.Debugging.EnableProfiling {
 		extraHandlers = routes.ProfilingHandlers
@@ -51,6 +51,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&ExposureClassList{},
 		&InternalSecret{},
 		&InternalSecretList{},
+		&MaintenancePolicy{},
+		&MaintenancePolicyList{},
 		&NamespacedCloudProfile{},
 		&NamespacedCloudProfileList{},
 		&Project{},
@@ -7,6 +7,7 @@ package core
 import (
 	"strings"
 
+	corev1 "k8s.io/api/core/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -69,6 +70,10 @@ type OCIRepository struct {
 	// Digest of the image to pull, takes precedence over tag.
 	// The value should be in the format 'sha256:<HASH>'.
 	Digest *string
+	// PullSecretRef is a reference to a secret containing the pull secret.
+	// The secret must be of type `kubernetes.io/dockerconfigjson` and must be located in the `garden` namespace.
+	// For usage in the gardenlet, the secret must have the label `gardener.cloud/role=helm-pull-secret`.
+	PullSecretRef *corev1.LocalObjectReference
 }
 
 // GetURL returns the fully-qualified OCIRepository URL of the artifact.
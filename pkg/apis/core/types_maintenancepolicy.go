@@ -0,0 +1,109 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package core
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// MaintenancePolicy stages the rollout of automatic Kubernetes and machine image version updates across Shoots.
+// Shoots are grouped into waves, and a version is only rolled out to a wave once the Shoots of all previous waves which
+// already use it have been healthy for the soak duration of their wave.
+type MaintenancePolicy struct {
+	metav1.TypeMeta
+	// Standard object metadata.
+	metav1.ObjectMeta
+	// Spec contains the specification of this maintenance policy.
+	Spec MaintenancePolicySpec
+	// Status contains the most recently observed rollout progress of this maintenance policy.
+	Status MaintenancePolicyStatus
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// MaintenancePolicyList is a collection of MaintenancePolicies.
+type MaintenancePolicyList struct {
+	metav1.TypeMeta
+	// Standard list object metadata.
+	metav1.ListMeta
+	// Items is the list of MaintenancePolicies.
+	Items []MaintenancePolicy
+}
+
+// MaintenancePolicySpec is the specification of a MaintenancePolicy.
+type MaintenancePolicySpec struct {
+	// Waves is the ordered list of rollout waves. A Shoot belongs to the first wave it matches. Shoots which do not
+	// match any wave are not affected by this policy.
+	Waves []MaintenanceRolloutWave
+}
+
+// MaintenanceRolloutWave is a group of Shoots which receive automatic version updates in the same stage of a rollout.
+// A Shoot matches the wave if it matches all of the specified criteria.
+type MaintenanceRolloutWave struct {
+	// Name is the name of the wave.
+	Name string
+	// ShootSelector selects the Shoots of this wave by their labels.
+	ShootSelector *metav1.LabelSelector
+	// ProjectPurposes selects the Shoots of this wave by the purpose of their project, e.g. `evaluation` or `production`.
+	ProjectPurposes []string
+	// SoakDuration is the duration for which the Shoots of this wave must be healthy after they were updated to a
+	// version before this version is rolled out to the next wave.
+	SoakDuration *metav1.Duration
+}
+
+// MaintenancePolicyStatus holds the most recently observed rollout progress of a MaintenancePolicy.
+type MaintenancePolicyStatus struct {
+	// ObservedGeneration is the most recent generation observed for this MaintenancePolicy.
+	ObservedGeneration int64
+	// Waves contains the number of Shoots per wave.
+	Waves []MaintenanceRolloutWaveStatus
+	// Rollouts contains the progress of the versions which are used by the Shoots of this policy.
+	Rollouts []MaintenanceRollout
+}
+
+// MaintenanceRolloutWaveStatus contains the number of Shoots of a wave.
+type MaintenanceRolloutWaveStatus struct {
+	// Name is the name of the wave.
+	Name string
+	// Shoots is the number of Shoots which belong to the wave.
+	Shoots int32
+}
+
+// MaintenanceRolloutKind is the kind of version which is rolled out.
+type MaintenanceRolloutKind string
+
+const (
+	// MaintenanceRolloutKindKubernetes is the kind for Kubernetes versions.
+	MaintenanceRolloutKindKubernetes MaintenanceRolloutKind = "Kubernetes"
+	// MaintenanceRolloutKindMachineImage is the kind for machine image versions.
+	MaintenanceRolloutKindMachineImage MaintenanceRolloutKind = "MachineImage"
+)
+
+// MaintenanceRollout contains the rollout progress of a version.
+type MaintenanceRollout struct {
+	// Kind is the kind of the version.
+	Kind MaintenanceRolloutKind
+	// Name is the name of the machine image. It is empty for Kubernetes versions.
+	Name string
+	// Version is the version which is rolled out.
+	Version string
+	// Waves contains the progress of the rollout in the waves which have Shoots using the version.
+	Waves []MaintenanceRolloutWaveProgress
+}
+
+// MaintenanceRolloutWaveProgress contains the progress of a rollout in a wave.
+type MaintenanceRolloutWaveProgress struct {
+	// Name is the name of the wave.
+	Name string
+	// UpdatedShoots is the number of Shoots of the wave which use the version.
+	UpdatedShoots int32
+	// HealthySince is the time since when all Shoots of the wave which use the version have been healthy. It is reset
+	// when one of them becomes unhealthy or when further Shoots of the wave start using the version.
+	HealthySince *metav1.Time
+}
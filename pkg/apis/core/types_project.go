@@ -6,6 +6,7 @@ package core
 
 import (
 	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -58,6 +59,8 @@ type ProjectSpec struct {
 	Tolerations *ProjectTolerations
 	// DualApprovalForDeletion contains configuration for the dual approval concept for resource deletion.
 	DualApprovalForDeletion []DualApprovalForDeletion
+	// Budget defines limits for the resources consumed by the Shoots of the project per calendar month.
+	Budget *ProjectBudget
 }
 
 // ProjectStatus holds the most recently observed status of the project.
@@ -73,6 +76,9 @@ type ProjectStatus struct {
 	StaleAutoDeleteTimestamp *metav1.Time
 	// LastActivityTimestamp contains the timestamp from the last activity performed in this project.
 	LastActivityTimestamp *metav1.Time
+	// Usage contains the resources consumed by the Shoots of the project in the current calendar month. It is only
+	// maintained if a budget is configured for the project.
+	Usage *ProjectUsage
 }
 
 // ProjectMember is a member of a project.
@@ -111,6 +117,76 @@ type DualApprovalForDeletion struct {
 	IncludeServiceAccounts *bool
 }
 
+// ProjectBudget defines limits for the resources consumed by the Shoots of a project per calendar month (UTC). The
+// consumption is computed based on the machines observed in the worker pools of the Shoots.
+type ProjectBudget struct {
+	// MachineHours is the maximum number of machine hours the Shoots of the project may consume per month.
+	MachineHours *resource.Quantity
+	// StorageGibibyteHours is the maximum number of gibibyte hours of the volumes of the machines the Shoots of the
+	// project may consume per month.
+	StorageGibibyteHours *resource.Quantity
+	// Cost is the maximum cost the Shoots of the project may cause per month. The cost is computed based on the prices.
+	Cost *resource.Quantity
+	// Prices is the price table used to compute the cost of the machines and volumes. Machine and volume types without
+	// a price do not cause any cost.
+	Prices *BudgetPrices
+	// WarningThresholdPercentage is the percentage of a limit at which a warning is issued. Defaults to 80.
+	WarningThresholdPercentage *int32
+}
+
+// BudgetPrices is a price table for machine and volume types.
+type BudgetPrices struct {
+	// MachineTypes contains the prices of machine types.
+	MachineTypes []MachineTypePrice
+	// VolumeTypes contains the prices of volume types.
+	VolumeTypes []VolumeTypePrice
+}
+
+// MachineTypePrice is the price of a machine type.
+type MachineTypePrice struct {
+	// Name is the name of the machine type as defined in the CloudProfile.
+	Name string
+	// PerHour is the price of a machine of this type per hour.
+	PerHour resource.Quantity
+}
+
+// VolumeTypePrice is the price of a volume type.
+type VolumeTypePrice struct {
+	// Name is the name of the volume type as defined in the CloudProfile.
+	Name string
+	// PerGibibyteHour is the price of a gibibyte of a volume of this type per hour.
+	PerGibibyteHour resource.Quantity
+}
+
+// ProjectUsage contains the resources consumed by the Shoots of a project in the current calendar month.
+type ProjectUsage struct {
+	// PeriodStart is the start of the current accounting period.
+	PeriodStart metav1.Time
+	// LastUpdateTime is the last time at which the usage was updated.
+	LastUpdateTime metav1.Time
+	// MachineHours is the number of machine hours consumed in the current period.
+	MachineHours resource.Quantity
+	// StorageGibibyteHours is the number of gibibyte hours of the volumes of the machines consumed in the current period.
+	StorageGibibyteHours resource.Quantity
+	// Cost is the cost caused in the current period. It is only computed if prices are configured.
+	Cost *resource.Quantity
+	// BudgetState is the state of the usage compared to the budget of the project.
+	BudgetState ProjectBudgetState
+}
+
+// ProjectBudgetState is the state of the usage of a project compared to its budget.
+type ProjectBudgetState string
+
+const (
+	// ProjectBudgetStateWithinBudget indicates that the usage is below the warning threshold of all limits.
+	ProjectBudgetStateWithinBudget ProjectBudgetState = "WithinBudget"
+	// ProjectBudgetStateWarning indicates that the usage reached the warning threshold of at least one limit.
+	ProjectBudgetStateWarning ProjectBudgetState = "Warning"
+	// ProjectBudgetStateExceeded indicates that the usage reached at least one limit. New Shoots and scale-ups are
+	// rejected.
+	ProjectBudgetStateExceeded ProjectBudgetState = "Exceeded"
+)
+
 const (
 	// ProjectMemberAdmin is a const for a role that provides full admin access.
 	ProjectMemberAdmin = "admin"
@@ -149,4 +225,8 @@ const (
 	ProjectEventNamespaceDeletionFailed = "NamespaceDeletionFailed"
 	// ProjectEventNamespaceMarkedForDeletion indicates that the namespace has been successfully marked for deletion.
 	ProjectEventNamespaceMarkedForDeletion = "NamespaceMarkedForDeletion"
+	// ProjectEventBudgetWarning indicates that the usage of the project reached the warning threshold of its budget.
+	ProjectEventBudgetWarning = "BudgetWarning"
+	// ProjectEventBudgetExceeded indicates that the usage of the project exceeded its budget.
+	ProjectEventBudgetExceeded = "BudgetExceeded"
 )
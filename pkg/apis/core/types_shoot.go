@@ -160,6 +160,34 @@ type ShootStatus struct {
 	EncryptedResources []string
 	// Networking contains information about cluster networking such as CIDRs.
 	Networking *NetworkingStatus
+	// MaintenancePreview holds information about the operations which will be performed in the next maintenance of the Shoot.
+	MaintenancePreview *MaintenancePreview
+	// HibernationSchedule holds the next times at which the Shoot will be hibernated and woken up according to its
+	// hibernation schedules, taking exceptions and overrides into account.
+	HibernationSchedule *HibernationScheduleStatus
+	// LastActivityTime is the last time at which activity was observed in the Shoot cluster. It is only maintained if
+	// the Shoot is configured to be hibernated when idle.
+	LastActivityTime *metav1.Time
+	// WorkerPools contains the machines observed in the worker pools of the Shoot cluster. It is maintained by the
+	// gardenlet while the Shoot is awake.
+	WorkerPools []WorkerPoolStatus
+}
+
+// HibernationScheduleStatus holds the next times at which the Shoot will be hibernated and woken up according to its
+// hibernation schedules.
+type HibernationScheduleStatus struct {
+	// NextHibernationTime is the next time at which the Shoot will be hibernated.
+	NextHibernationTime *metav1.Time
+	// NextWakeUpTime is the next time at which the Shoot will be woken up.
+	NextWakeUpTime *metav1.Time
+}
+
+// WorkerPoolStatus contains the machines observed in a worker pool of a Shoot cluster.
+type WorkerPoolStatus struct {
+	// Name is the name of the worker pool.
+	Name string
+	// Machines is the number of machines observed in the worker pool.
+	Machines int32
 }
 
 // LastMaintenance holds information about a maintenance operation on the Shoot.
@@ -174,6 +202,49 @@ type LastMaintenance struct {
 	FailureReason *string
 }
 
+// MaintenancePreview holds information about the operations which will be performed in the next maintenance of the Shoot.
+type MaintenancePreview struct {
+	// A human-readable message containing details about the operations which will be performed in the next maintenance.
+	Description string
+	// FailureReason holds the information about maintenance operations which are expected to fail.
+	FailureReason *string
+	// LastUpdateTime is the time when the preview was computed.
+	LastUpdateTime metav1.Time
+	// NextMaintenanceTime is the beginning of the next maintenance time window.
+	NextMaintenanceTime *metav1.Time
+	// KubernetesVersion holds information about the planned update of the control plane Kubernetes version.
+	KubernetesVersion *MaintenanceVersionUpdate
+	// Workers holds information about the planned updates of the worker pools.
+	Workers []WorkerMaintenancePreview
+	// SpecChanges is a list of further changes to the Shoot specification which will be performed in the next maintenance.
+	SpecChanges []string
+}
+
+// WorkerMaintenancePreview holds information about the planned updates of a worker pool.
+type WorkerMaintenancePreview struct {
+	// Name is the name of the worker pool.
+	Name string
+	// KubernetesVersion holds information about the planned update of the worker pool Kubernetes version.
+	KubernetesVersion *MaintenanceVersionUpdate
+	// MachineImageVersion holds information about the planned update of the worker pool machine image version.
+	MachineImageVersion *MaintenanceVersionUpdate
+}
+
+// MaintenanceVersionUpdate holds information about a planned version update.
+type MaintenanceVersionUpdate struct {
+	// Version is the currently used version.
+	Version string
+	// TargetVersion is the version which will be used after the maintenance. It is empty if no suitable version could
+	// be determined.
+	TargetVersion *string
+	// Reason is the reason for the update.
+	Reason string
+	// Forced indicates whether the update is enforced, e.g. because the current version is expired.
+	Forced bool
+	// FailureReason holds the information why the update is expected to fail.
+	FailureReason *string
+}
+
 // NetworkingStatus contains information about cluster networking such as CIDRs.
 type NetworkingStatus struct {
 	// Pods are the CIDRs of the pod network.
@@ -432,6 +503,33 @@ type Hibernation struct {
 	Enabled *bool
 	// Schedules determine the hibernation schedules.
 	Schedules []HibernationSchedule
+	// Exceptions contains the dates on which the hibernation schedules are not applied.
+	Exceptions *HibernationExceptions
+	// AwakeUntil is a one-off override which keeps the Shoot awake until the given time, i.e., the Shoot is woken up
+	// and the hibernation schedules are not applied before this time. The override is removed once it has expired and
+	// the Shoot is hibernated or woken up according to its hibernation schedules.
+	AwakeUntil *metav1.Time
+	// Idle configures the hibernation of the Shoot after it has been idle for a certain period of time.
+	Idle *HibernationIdle
+}
+
+// HibernationIdle configures the hibernation of a Shoot after it has been idle for a certain period of time. A Shoot
+// is considered idle if neither API requests of non-system users nor running non-system workloads are observed.
+type HibernationIdle struct {
+	// Timeout is the period of time after which an idle Shoot is hibernated.
+	Timeout metav1.Duration
+}
+
+// HibernationExceptions contains the dates on which the hibernation schedules of a Shoot are not applied, i.e., the
+// Shoot is neither hibernated nor woken up at the start or end times which fall on these dates (evaluated in the
+// location of the respective schedule).
+type HibernationExceptions struct {
+	// Dates is a list of dates in the format `YYYY-MM-DD`.
+	Dates []string
+	// CalendarRefs is a list of references to ConfigMaps in the namespace of the Shoot which contain a calendar in the
+	// iCalendar format (RFC 5545) in their `calendar.ics` data key, e.g., holiday calendars. The days of all events of
+	// these calendars are treated as exception dates.
+	CalendarRefs []corev1.LocalObjectReference
 }
 
 // HibernationSchedule determines the hibernation schedule of a Shoot.
@@ -1410,6 +1508,10 @@ const (
 	ShootEventHibernationEnabled = "Hibernated"
 	// ShootEventHibernationDisabled indicates that hibernation ended.
 	ShootEventHibernationDisabled = "WokenUp"
+	// ShootEventHibernationCalendarInvalid indicates that a hibernation calendar referenced by the shoot is missing or invalid.
+	ShootEventHibernationCalendarInvalid = "HibernationCalendarInvalid"
+	// ShootEventCertificatesExpiring indicates that certificates of the shoot's control plane expire soon.
+	ShootEventCertificatesExpiring = "CertificatesExpiring"
 	// ShootEventSchedulingSuccessful indicates that a scheduling decision was taken successfully.
 	ShootEventSchedulingSuccessful = "SchedulingSuccessful"
 	// ShootEventSchedulingFailed indicates that a scheduling decision failed.
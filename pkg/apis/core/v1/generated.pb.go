@@ -13,6 +13,7 @@ import (
 	io "io"
 
 	proto "github.com/gogo/protobuf/proto"
+	v12 "k8s.io/api/core/v1"
 	v11 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 
 	math "math"
@@ -156,44 +157,47 @@ func init() {
 }
 
 var fileDescriptor_9b216bec51effd5c = []byte{
-	// 587 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x41, 0x6f, 0xd3, 0x4c,
-	0x10, 0x8d, 0x93, 0xb6, 0x5f, 0xbe, 0x6d, 0x8b, 0xc0, 0xe2, 0x60, 0x7a, 0xb0, 0xab, 0x9c, 0x7a,
-	0xc9, 0x9a, 0x54, 0x08, 0x71, 0x00, 0x0e, 0x4e, 0x25, 0x5a, 0x04, 0x44, 0xda, 0x22, 0x0e, 0x08,
-	0x09, 0x36, 0xce, 0xc4, 0x36, 0xb1, 0xbd, 0xd6, 0x7a, 0x13, 0xc8, 0x8d, 0x9f, 0xc0, 0x95, 0x13,
-	0x7f, 0x27, 0xc7, 0x1e, 0x7b, 0x32, 0xc4, 0xfc, 0x11, 0xb4, 0x1b, 0x37, 0x76, 0x68, 0x2a, 0xc8,
-	0x6d, 0xfd, 0x66, 0xde, 0x9b, 0xf7, 0x66, 0x37, 0x41, 0x4f, 0xbc, 0x40, 0xf8, 0xe3, 0x3e, 0x76,
-	0x59, 0x64, 0x7b, 0x94, 0x0f, 0x20, 0x06, 0x5e, 0x1e, 0x92, 0x91, 0x67, 0xd3, 0x24, 0x48, 0x6d,
-	0x97, 0x71, 0xb0, 0x27, 0x1d, 0xdb, 0x93, 0x30, 0x15, 0x30, 0xc0, 0x09, 0x67, 0x82, 0xe9, 0xed,
-	0x92, 0x8e, 0xaf, 0x58, 0xe5, 0x21, 0x19, 0x79, 0x58, 0xd2, 0xb1, 0xa4, 0xe3, 0x49, 0xe7, 0xa0,
-	0x5d, 0x9d, 0xc6, 0x3c, 0x66, 0x2b, 0x95, 0xfe, 0x78, 0xa8, 0xbe, 0xd4, 0x87, 0x3a, 0x2d, 0xd4,
-	0x0f, 0x4e, 0x47, 0x8f, 0x52, 0x1c, 0x30, 0x69, 0x01, 0x3e, 0x0b, 0x88, 0xd3, 0x80, 0xc5, 0x69,
-	0x5b, 0x2a, 0x02, 0x9f, 0x54, 0xed, 0xad, 0x34, 0xac, 0xf1, 0x79, 0xf0, 0xa0, 0x54, 0x8a, 0xa8,
-	0xeb, 0x07, 0x31, 0xf0, 0x69, 0x49, 0x8f, 0x40, 0xd0, 0x75, 0x2c, 0xfb, 0x26, 0x16, 0x1f, 0xc7,
-	0x22, 0x88, 0xe0, 0x1a, 0xe1, 0xe1, 0xdf, 0x08, 0xa9, 0xeb, 0x43, 0x44, 0xff, 0xe4, 0xb5, 0x7e,
-	0x68, 0xe8, 0x6e, 0x97, 0xc5, 0x82, 0xb3, 0x30, 0x04, 0x7e, 0x02, 0x49, 0xc8, 0xa6, 0x11, 0xc4,
-	0x42, 0xff, 0x80, 0x9a, 0xd2, 0xdc, 0x80, 0x0a, 0x6a, 0x68, 0x87, 0xda, 0xd1, 0xee, 0xf1, 0x7d,
-	0xbc, 0x98, 0x81, 0xab, 0x33, 0xca, 0x4d, 0xcb, 0x6e, 0x3c, 0xe9, 0xe0, 0x5e, 0xff, 0x23, 0xb8,
-	0xe2, 0x25, 0x08, 0xea, 0xe8, 0xb3, 0xcc, 0xaa, 0xe5, 0x99, 0x85, 0x4a, 0x8c, 0x2c, 0x55, 0x75,
-	0x40, 0x5b, 0x3e, 0x84, 0x91, 0x51, 0x57, 0xea, 0xcf, 0xf0, 0x46, 0x17, 0x8a, 0x4f, 0x21, 0x8c,
-	0xd6, 0x19, 0x77, 0x9a, 0x79, 0x66, 0x6d, 0xc9, 0x2a, 0x51, 0xf2, 0xad, 0x5c, 0x43, 0xc6, 0xba,
-	0xc6, 0x17, 0x41, 0x2a, 0xf4, 0x77, 0xd7, 0x52, 0xe2, 0x7f, 0x4b, 0x29, 0xd9, 0x2a, 0xe3, 0xed,
-	0x22, 0x63, 0xf3, 0x0a, 0xa9, 0x24, 0xf4, 0xd1, 0x76, 0x20, 0x20, 0x4a, 0x8d, 0xfa, 0x61, 0xe3,
-	0x68, 0xf7, 0xb8, 0xbb, 0x61, 0xc4, 0xb5, 0xf1, 0xf6, 0x8b, 0x79, 0xdb, 0x67, 0x52, 0x99, 0x2c,
-	0x06, 0xb4, 0xbe, 0xd7, 0x91, 0x71, 0xd3, 0x46, 0xf4, 0x23, 0xd4, 0xe4, 0xf4, 0x53, 0xd7, 0xa7,
-	0x5c, 0xa8, 0x90, 0x7b, 0xce, 0x9e, 0x34, 0x4c, 0x0a, 0x8c, 0x2c, 0xab, 0x7a, 0x1f, 0xed, 0x4c,
-	0x68, 0x38, 0x86, 0xb4, 0xb8, 0x94, 0xa7, 0x95, 0x65, 0x94, 0xcf, 0xfc, 0xfd, 0xf2, 0x77, 0x50,
-	0x7a, 0x5e, 0x69, 0x90, 0xe6, 0x9f, 0x9f, 0xf7, 0x5e, 0x39, 0x28, 0xcf, 0xac, 0x9d, 0x37, 0x4a,
-	0x91, 0x14, 0xca, 0xfa, 0x18, 0xed, 0x33, 0x37, 0x20, 0x90, 0xb0, 0x34, 0x10, 0x8c, 0x4f, 0x8d,
-	0x86, 0x1a, 0xf5, 0x78, 0xc3, 0xe5, 0xf4, 0xba, 0x67, 0xa5, 0x86, 0x73, 0x27, 0xcf, 0xac, 0xfd,
-	0x15, 0x88, 0xac, 0x4e, 0x69, 0x7d, 0xd3, 0xd0, 0x6a, 0x83, 0x7e, 0x0f, 0x35, 0x38, 0x0c, 0xd5,
-	0x46, 0xfe, 0x77, 0xfe, 0xcb, 0x33, 0xab, 0x41, 0x60, 0x48, 0x24, 0xa6, 0x63, 0x84, 0x78, 0x69,
-	0xb0, 0xae, 0x3a, 0x6e, 0xc9, 0x87, 0x5c, 0xd1, 0xaf, 0x74, 0x48, 0x29, 0x41, 0x3d, 0x95, 0xa4,
-	0x90, 0x7a, 0x4d, 0x3d, 0x22, 0x31, 0xbd, 0x85, 0x76, 0x06, 0x81, 0x07, 0xa9, 0x30, 0xb6, 0x54,
-	0x55, 0xad, 0xe4, 0x44, 0x21, 0xa4, 0xa8, 0x38, 0xe7, 0xb3, 0xb9, 0x59, 0xbb, 0x98, 0x9b, 0xb5,
-	0xcb, 0xb9, 0x59, 0xfb, 0x92, 0x9b, 0xda, 0x2c, 0x37, 0xb5, 0x8b, 0xdc, 0xd4, 0x2e, 0x73, 0x53,
-	0xfb, 0x99, 0x9b, 0xda, 0xd7, 0x5f, 0x66, 0xed, 0x6d, 0x7b, 0xa3, 0x3f, 0xcc, 0xdf, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0xd9, 0x86, 0x04, 0xdb, 0x60, 0x05, 0x00, 0x00,
+	// 631 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xc1, 0x6e, 0xd3, 0x4c,
+	0x10, 0xc7, 0xe3, 0xa4, 0xed, 0x97, 0x6f, 0xdb, 0x20, 0x58, 0x71, 0x30, 0x3d, 0x38, 0x55, 0x4e,
+	0xb9, 0x64, 0x4d, 0x2b, 0x84, 0x38, 0x00, 0x07, 0xa7, 0x12, 0x2d, 0x2a, 0x14, 0x6d, 0x11, 0x07,
+	0x84, 0x04, 0x1b, 0x67, 0xe2, 0x98, 0xda, 0x5e, 0x6b, 0xbd, 0x09, 0xf4, 0xc6, 0x23, 0xf0, 0x06,
+	0xbc, 0x4e, 0x8f, 0x3d, 0xf6, 0x14, 0xa8, 0x79, 0x0d, 0x0e, 0x68, 0x37, 0x69, 0xd6, 0xa6, 0x89,
+	0x20, 0xb7, 0xf5, 0xcc, 0xfc, 0x7f, 0x33, 0xff, 0x59, 0xdb, 0xe8, 0x49, 0x10, 0xca, 0xe1, 0xa8,
+	0x47, 0x7c, 0x1e, 0xbb, 0x01, 0x13, 0x7d, 0x48, 0x40, 0x98, 0x43, 0x7a, 0x1a, 0xb8, 0x2c, 0x0d,
+	0x33, 0xd7, 0xe7, 0x02, 0xdc, 0xf1, 0xae, 0x1b, 0xa8, 0x30, 0x93, 0xd0, 0x27, 0xa9, 0xe0, 0x92,
+	0xe3, 0x8e, 0x91, 0x93, 0x6b, 0x95, 0x39, 0xa4, 0xa7, 0x01, 0x51, 0x72, 0xa2, 0xe4, 0x64, 0xbc,
+	0xbb, 0xdd, 0x29, 0x76, 0xe3, 0x01, 0x77, 0x35, 0xa5, 0x37, 0x1a, 0xe8, 0x27, 0xfd, 0xa0, 0x4f,
+	0x53, 0xfa, 0x76, 0xeb, 0xf4, 0x51, 0x46, 0x42, 0xae, 0x46, 0x58, 0x36, 0xc1, 0xf6, 0x81, 0xa9,
+	0x81, 0xcf, 0x12, 0x92, 0x2c, 0xe4, 0x49, 0xd6, 0x51, 0x5d, 0x41, 0x8c, 0x8b, 0x16, 0x4a, 0x05,
+	0x8b, 0x48, 0x0f, 0x0c, 0x29, 0x66, 0xfe, 0x30, 0x4c, 0x40, 0x9c, 0x19, 0x79, 0x0c, 0x92, 0x2d,
+	0x52, 0xb9, 0xcb, 0x54, 0x62, 0x94, 0xc8, 0x30, 0x86, 0x1b, 0x82, 0x87, 0x7f, 0x13, 0x64, 0xfe,
+	0x10, 0x62, 0xf6, 0xa7, 0xae, 0xf5, 0xdd, 0x42, 0x77, 0xbb, 0x3c, 0x91, 0x82, 0x47, 0x11, 0x88,
+	0x7d, 0x48, 0x23, 0x7e, 0x16, 0x43, 0x22, 0xf1, 0x07, 0x54, 0x57, 0xc3, 0xf5, 0x99, 0x64, 0xb6,
+	0xb5, 0x63, 0xb5, 0x37, 0xf7, 0xee, 0x93, 0x69, 0x0f, 0x52, 0xec, 0x61, 0x6e, 0x43, 0x55, 0x93,
+	0xf1, 0x2e, 0x39, 0xee, 0x7d, 0x04, 0x5f, 0xbe, 0x00, 0xc9, 0x3c, 0x7c, 0x3e, 0x69, 0x56, 0xf2,
+	0x49, 0x13, 0x99, 0x18, 0x9d, 0x53, 0x31, 0xa0, 0xb5, 0x21, 0x44, 0xb1, 0x5d, 0xd5, 0xf4, 0x67,
+	0x64, 0xa5, 0x4b, 0x27, 0x07, 0x10, 0xc5, 0x8b, 0x06, 0xf7, 0xea, 0xf9, 0xa4, 0xb9, 0xa6, 0xb2,
+	0x54, 0xe3, 0x5b, 0xb9, 0x85, 0xec, 0x45, 0x85, 0x47, 0x61, 0x26, 0xf1, 0xbb, 0x1b, 0x2e, 0xc9,
+	0xbf, 0xb9, 0x54, 0x6a, 0xed, 0xf1, 0xf6, 0xcc, 0x63, 0xfd, 0x3a, 0x52, 0x70, 0x38, 0x44, 0xeb,
+	0xa1, 0x84, 0x38, 0xb3, 0xab, 0x3b, 0xb5, 0xf6, 0xe6, 0x5e, 0x77, 0x45, 0x8b, 0x0b, 0xed, 0x35,
+	0x66, 0xfd, 0xd6, 0x0f, 0x15, 0x99, 0x4e, 0x1b, 0xb4, 0xbe, 0x55, 0x91, 0xbd, 0x6c, 0x23, 0xb8,
+	0x8d, 0xea, 0x82, 0x7d, 0xea, 0x0e, 0x99, 0x90, 0xda, 0xe4, 0x96, 0xb7, 0xa5, 0x06, 0xa6, 0xb3,
+	0x18, 0x9d, 0x67, 0x71, 0x0f, 0x6d, 0x8c, 0x59, 0x34, 0x82, 0x6c, 0x76, 0x29, 0x4f, 0x0b, 0xcb,
+	0x30, 0xaf, 0xf9, 0xfb, 0xf9, 0x77, 0x60, 0x66, 0x2e, 0x15, 0xa8, 0xe1, 0x9f, 0x9f, 0x1c, 0xbf,
+	0xf4, 0x50, 0x3e, 0x69, 0x6e, 0xbc, 0xd1, 0x44, 0x3a, 0x23, 0xe3, 0x11, 0x6a, 0x70, 0x3f, 0xa4,
+	0x90, 0xf2, 0x2c, 0x94, 0x5c, 0x9c, 0xd9, 0x35, 0xdd, 0xea, 0xf1, 0x8a, 0xcb, 0x39, 0xee, 0x1e,
+	0x1a, 0x86, 0x77, 0x27, 0x9f, 0x34, 0x1b, 0xa5, 0x10, 0x2d, 0x77, 0x69, 0xfd, 0xb2, 0x50, 0xb9,
+	0x00, 0xdf, 0x43, 0x35, 0x01, 0x03, 0xbd, 0x91, 0xff, 0xbd, 0xff, 0xf2, 0x49, 0xb3, 0x46, 0x61,
+	0x40, 0x55, 0x0c, 0x13, 0x84, 0x84, 0x19, 0xb0, 0xaa, 0x2b, 0x6e, 0xa9, 0x17, 0xb9, 0xc0, 0x47,
+	0xa2, 0x84, 0x92, 0x2c, 0xb0, 0x6b, 0x06, 0xf5, 0x9a, 0x05, 0x54, 0xc5, 0x70, 0x0b, 0x6d, 0xf4,
+	0xc3, 0x00, 0x32, 0x69, 0xaf, 0xe9, 0xac, 0x5e, 0xc9, 0xbe, 0x8e, 0xd0, 0x59, 0x06, 0x33, 0xd4,
+	0x48, 0x47, 0x51, 0x74, 0x02, 0xbe, 0x00, 0x49, 0x61, 0x60, 0xaf, 0xeb, 0x95, 0xb4, 0x0b, 0xdb,
+	0x9f, 0xfb, 0x3e, 0xe2, 0x3e, 0x8b, 0xa6, 0xdf, 0x13, 0x85, 0x01, 0x08, 0x48, 0x7c, 0x98, 0xda,
+	0x7f, 0x55, 0x44, 0xd0, 0x32, 0xd1, 0x3b, 0x79, 0xdb, 0x59, 0xe9, 0x9f, 0x7c, 0x7e, 0xe5, 0x54,
+	0x2e, 0xae, 0x9c, 0xca, 0xe5, 0x95, 0x53, 0xf9, 0x92, 0x3b, 0xd6, 0x79, 0xee, 0x58, 0x17, 0xb9,
+	0x63, 0x5d, 0xe6, 0x8e, 0xf5, 0x23, 0x77, 0xac, 0xaf, 0x3f, 0x9d, 0xca, 0xef, 0x01, 0x00, 0xe7,
+	0xf6, 0xe8, 0xb1, 0xe7, 0x05, 0x00, 0x00,
 }
 
 func (m *ControllerDeployment) Marshal() (dAtA []byte, err error) {
@@ -362,6 +366,18 @@ func (m *OCIRepository) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PullSecretRef != nil {
+		{
+			size, err := m.PullSecretRef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.Digest != nil {
 		i -= len(*m.Digest)
 		copy(dAtA[i:], *m.Digest)
@@ -479,6 +495,10 @@ func (m *OCIRepository) Size() (n int) {
 		l = len(*m.Digest)
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.PullSecretRef != nil {
+		l = m.PullSecretRef.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -536,6 +556,7 @@ func (this *OCIRepository) String() string {
 		`Repository:` + valueToStringGenerated(this.Repository) + `,`,
 		`Tag:` + valueToStringGenerated(this.Tag) + `,`,
 		`Digest:` + valueToStringGenerated(this.Digest) + `,`,
+		`PullSecretRef:` + strings.Replace(fmt.Sprintf("%v", this.PullSecretRef), "LocalObjectReference", "v12.LocalObjectReference", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1101,6 +1122,42 @@ func (m *OCIRepository) Unmarshal(dAtA []byte) error {
 			s := string(dAtA[iNdEx:postIndex])
 			m.Digest = &s
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PullSecretRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PullSecretRef == nil {
+				m.PullSecretRef = &v12.LocalObjectReference{}
+			}
+			if err := m.PullSecretRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -7,6 +7,7 @@ package v1
 import (
 	"strings"
 
+	corev1 "k8s.io/api/core/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -65,6 +66,11 @@ type OCIRepository struct {
 	// The value should be in the format 'sha256:<HASH>'.
 	// +optional
 	Digest *string `json:"digest,omitempty" protobuf:"bytes,4,opt,name=digest"`
+	// PullSecretRef is a reference to a secret containing the pull secret.
+	// The secret must be of type `kubernetes.io/dockerconfigjson` and must be located in the `garden` namespace.
+	// For usage in the gardenlet, the secret must have the label `gardener.cloud/role=helm-pull-secret`.
+	// +optional
+	PullSecretRef *corev1.LocalObjectReference `json:"pullSecretRef,omitempty" protobuf:"bytes,5,opt,name=pullSecretRef"`
 }
 
 // GetURL returns the fully-qualified OCIRepository URL of the artifact.
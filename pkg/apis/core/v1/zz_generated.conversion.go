@@ -13,6 +13,7 @@ import (
 	unsafe "unsafe"
 
 	core "github.com/gardener/gardener/pkg/apis/core"
+	corev1 "k8s.io/api/core/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
@@ -153,6 +154,7 @@ func autoConvert_v1_OCIRepository_To_core_OCIRepository(in *OCIRepository, out *
 	out.Repository = (*string)(unsafe.Pointer(in.Repository))
 	out.Tag = (*string)(unsafe.Pointer(in.Tag))
 	out.Digest = (*string)(unsafe.Pointer(in.Digest))
+	out.PullSecretRef = (*corev1.LocalObjectReference)(unsafe.Pointer(in.PullSecretRef))
 	return nil
 }
 
@@ -166,6 +168,7 @@ func autoConvert_core_OCIRepository_To_v1_OCIRepository(in *core.OCIRepository,
 	out.Repository = (*string)(unsafe.Pointer(in.Repository))
 	out.Tag = (*string)(unsafe.Pointer(in.Tag))
 	out.Digest = (*string)(unsafe.Pointer(in.Digest))
+	out.PullSecretRef = (*corev1.LocalObjectReference)(unsafe.Pointer(in.PullSecretRef))
 	return nil
 }
 
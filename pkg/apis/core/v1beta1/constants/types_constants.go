@@ -263,6 +263,8 @@ const (
 	GardenRoleCACluster = "ca-cluster"
 	// GardenRoleCAClient is the value of the GardenRole key indicating type 'ca-client'.
 	GardenRoleCAClient = "ca-client"
+	// GardenRoleSecretsInventory is the value of the GardenRole key indicating type 'secrets-inventory'.
+	GardenRoleSecretsInventory = "secrets-inventory"
 	// GardenRoleSSHKeyPair is the value of the GardenRole key indicating type 'ssh-keypair'.
 	GardenRoleSSHKeyPair = "ssh-keypair"
 	// GardenRoleDefaultDomain is the value of the GardenRole key indicating type 'default-domain'.
@@ -282,6 +284,8 @@ const (
 	GardenRoleExposureClassHandler = "exposureclass-handler"
 	// GardenRoleShootServiceAccountIssuer is the value of the GardenRole key indicating type 'shoot-service-account-issuer'.
 	GardenRoleShootServiceAccountIssuer = "shoot-service-account-issuer"
+	// GardenRoleHelmPullSecret is the value of the GardenRole key indicating type 'helm-pull-secret'.
+	GardenRoleHelmPullSecret = "helm-pull-secret"
 
 	// ShootUID is an annotation key for the shoot namespace in the seed cluster,
 	// which value will be the value of `shoot.status.uid`
@@ -326,6 +330,9 @@ const (
 	ShootExpirationTimestamp = "shoot.gardener.cloud/expiration-timestamp"
 	// ShootStatus is a constant for a label on a Shoot resource indicating that the Shoot's health.
 	ShootStatus = "shoot.gardener.cloud/status"
+	// ShootCertificatesExpiring is a constant for a label on a Shoot resource indicating that certificates of the Shoot's
+	// control plane expire soon.
+	ShootCertificatesExpiring = "shoot.gardener.cloud/certificates-expiring"
 	// FailedShootNeedsRetryOperation is a constant for an annotation on a Shoot in a failed state indicating that a retry operation should be triggered during the next maintenance time window.
 	FailedShootNeedsRetryOperation = "maintenance.shoot.gardener.cloud/needs-retry-operation"
 	// LabelExcludeWebhookFromRemediation is a constant for a label on a webhook in the shoot which makes it being
@@ -634,6 +641,12 @@ const (
 	// AnnotationSchedulingCloudProfiles is a constant for an annotation key on a configmap which denotes
 	// the linked cloudprofiles containing the region distances.
 	AnnotationSchedulingCloudProfiles = "scheduling.gardener.cloud/cloudprofiles"
+	// AnnotationSchedulingDrain is a constant for an annotation key on a seed which instructs the seed rebalancing
+	// controller of the gardener-scheduler to move all shoot control planes away from the seed if set to "true".
+	AnnotationSchedulingDrain = "scheduling.gardener.cloud/drain"
+	// AnnotationSchedulingProposedSeed is a constant for an annotation key on a shoot which contains the name of the seed
+	// the seed rebalancing controller of the gardener-scheduler proposes to migrate the shoot control plane to.
+	AnnotationSchedulingProposedSeed = "scheduling.gardener.cloud/proposed-seed"
 
 	// AnnotationConfirmationForceDeletion is a constant for an annotation on a Shoot resource whose value must be set to "true" in order to
 	// trigger force-deletion of the cluster. It can only be set if the Shoot has a deletion timestamp and contains an ErrorCode in the Shoot Status.
@@ -6,6 +6,7 @@ package v1beta1
 
 import (
 	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/utils/ptr"
 
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 )
@@ -49,3 +50,10 @@ func SetDefaults_ProjectMember(obj *ProjectMember) {
 		obj.Role = ProjectMemberViewer
 	}
 }
+
+// SetDefaults_ProjectBudget sets default values for ProjectBudget objects.
+func SetDefaults_ProjectBudget(obj *ProjectBudget) {
+	if obj.WarningThresholdPercentage == nil {
+		obj.WarningThresholdPercentage = ptr.To[int32](80)
+	}
+}
@@ -7,6 +7,7 @@ package v1beta1_test
 import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
 	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/utils/ptr"
 
@@ -160,4 +161,22 @@ var _ = Describe("Project defaulting", func() {
 			}
 		})
 	})
+
+	Describe("budget defaulting", func() {
+		It("should default the warning threshold", func() {
+			obj.Spec.Budget = &ProjectBudget{}
+
+			SetObjectDefaults_Project(obj)
+
+			Expect(obj.Spec.Budget.WarningThresholdPercentage).To(PointTo(Equal(int32(80))))
+		})
+
+		It("should not overwrite the warning threshold", func() {
+			obj.Spec.Budget = &ProjectBudget{WarningThresholdPercentage: ptr.To[int32](90)}
+
+			SetObjectDefaults_Project(obj)
+
+			Expect(obj.Spec.Budget.WarningThresholdPercentage).To(PointTo(Equal(int32(90))))
+		})
+	})
 })
@@ -0,0 +1,129 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package helper
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/utils/ptr"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+)
+
+// MaintenanceRolloutWaveIndex returns the index of the first wave of the given MaintenancePolicy which the given Shoot
+// matches. The project of the Shoot is used to match the project purposes and might be nil. It returns -1 if the Shoot
+// does not match any wave.
+func MaintenanceRolloutWaveIndex(policy *gardencorev1beta1.MaintenancePolicy, shoot *gardencorev1beta1.Shoot, project *gardencorev1beta1.Project) (int, error) {
+	for i, wave := range policy.Spec.Waves {
+		if wave.ShootSelector != nil {
+			selector, err := metav1.LabelSelectorAsSelector(wave.ShootSelector)
+			if err != nil {
+				return -1, fmt.Errorf("failed parsing shoot selector of wave %q of MaintenancePolicy %q: %w", wave.Name, policy.Name, err)
+			}
+			if !selector.Matches(labels.Set(shoot.Labels)) {
+				continue
+			}
+		}
+
+		if len(wave.ProjectPurposes) > 0 && (project == nil || !slices.Contains(wave.ProjectPurposes, ptr.Deref(project.Spec.Purpose, ""))) {
+			continue
+		}
+
+		return i, nil
+	}
+
+	return -1, nil
+}
+
+// FindMaintenanceRollout returns the rollout of the given version from the status of the given MaintenancePolicy.
+func FindMaintenanceRollout(policy *gardencorev1beta1.MaintenancePolicy, kind gardencorev1beta1.MaintenanceRolloutKind, name, version string) *gardencorev1beta1.MaintenanceRollout {
+	for i, rollout := range policy.Status.Rollouts {
+		if rollout.Kind == kind && rollout.Name == name && rollout.Version == version {
+			return &policy.Status.Rollouts[i]
+		}
+	}
+	return nil
+}
+
+// MaintenanceRolloutReleased checks whether the given version may be rolled out to the wave with the given index of
+// the given MaintenancePolicy. This is the case if the Shoots of all previous waves which use the version have been
+// healthy for the soak duration of their wave. Previous waves are skipped if none of their Shoots uses the same machine
+// image resp. the same Kubernetes minor version. If the version is not released yet, a reason is returned.
+func MaintenanceRolloutReleased(policy *gardencorev1beta1.MaintenancePolicy, waveIndex int, kind gardencorev1beta1.MaintenanceRolloutKind, name, version string, now time.Time) (bool, string) {
+	if policy.Status.ObservedGeneration != policy.Generation {
+		return false, fmt.Sprintf("status of MaintenancePolicy %q is outdated", policy.Name)
+	}
+
+	rollout := FindMaintenanceRollout(policy, kind, name, version)
+
+	for i := 0; i < waveIndex && i < len(policy.Spec.Waves); i++ {
+		wave := policy.Spec.Waves[i]
+
+		if !maintenanceRolloutWaveUsesLine(policy, wave.Name, kind, name, version) {
+			continue
+		}
+
+		var progress *gardencorev1beta1.MaintenanceRolloutWaveProgress
+		if rollout != nil {
+			progress = findMaintenanceRolloutWaveProgress(rollout, wave.Name)
+		}
+
+		if progress == nil || progress.UpdatedShoots == 0 {
+			return false, fmt.Sprintf("version %q is not yet used by any Shoot of wave %q of MaintenancePolicy %q", version, wave.Name, policy.Name)
+		}
+		if progress.HealthySince == nil {
+			return false, fmt.Sprintf("Shoots of wave %q of MaintenancePolicy %q using version %q are not healthy", wave.Name, policy.Name, version)
+		}
+
+		if soakedAt := progress.HealthySince.Add(ptr.Deref(wave.SoakDuration, metav1.Duration{}).Duration); now.Before(soakedAt) {
+			return false, fmt.Sprintf("version %q is soaking in wave %q of MaintenancePolicy %q until %s", version, wave.Name, policy.Name, soakedAt.UTC().Format(time.RFC3339))
+		}
+	}
+
+	return true, ""
+}
+
+// maintenanceRolloutWaveUsesLine returns true if any Shoot of the given wave uses a version of the same machine image
+// resp. of the same Kubernetes minor version as the given version.
+func maintenanceRolloutWaveUsesLine(policy *gardencorev1beta1.MaintenancePolicy, waveName string, kind gardencorev1beta1.MaintenanceRolloutKind, name, version string) bool {
+	for _, rollout := range policy.Status.Rollouts {
+		if rollout.Kind != kind || rollout.Name != name {
+			continue
+		}
+		if kind == gardencorev1beta1.MaintenanceRolloutKindKubernetes && !sameMinorVersion(rollout.Version, version) {
+			continue
+		}
+		if progress := findMaintenanceRolloutWaveProgress(&rollout, waveName); progress != nil && progress.UpdatedShoots > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func findMaintenanceRolloutWaveProgress(rollout *gardencorev1beta1.MaintenanceRollout, waveName string) *gardencorev1beta1.MaintenanceRolloutWaveProgress {
+	for i, progress := range rollout.Waves {
+		if progress.Name == waveName {
+			return &rollout.Waves[i]
+		}
+	}
+	return nil
+}
+
+func sameMinorVersion(a, b string) bool {
+	versionA, err := semver.NewVersion(a)
+	if err != nil {
+		return false
+	}
+	versionB, err := semver.NewVersion(b)
+	if err != nil {
+		return false
+	}
+	return versionA.Major() == versionB.Major() && versionA.Minor() == versionB.Minor()
+}
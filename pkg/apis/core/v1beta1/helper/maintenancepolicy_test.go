@@ -0,0 +1,151 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package helper_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	. "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+)
+
+var _ = Describe("MaintenancePolicy", func() {
+	var (
+		now    = time.Date(2024, 6, 1, 10, 0, 0, 0, time.UTC)
+		policy *gardencorev1beta1.MaintenancePolicy
+	)
+
+	BeforeEach(func() {
+		policy = &gardencorev1beta1.MaintenancePolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "default", Generation: 1},
+			Spec: gardencorev1beta1.MaintenancePolicySpec{
+				Waves: []gardencorev1beta1.MaintenanceRolloutWave{
+					{Name: "dev", ProjectPurposes: []string{"dev"}, SoakDuration: &metav1.Duration{Duration: 24 * time.Hour}},
+					{Name: "canary", ShootSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"canary": "true"}}, SoakDuration: &metav1.Duration{Duration: time.Hour}},
+					{Name: "prod", ProjectPurposes: []string{"prod"}, ShootSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "1"}}},
+				},
+			},
+			Status: gardencorev1beta1.MaintenancePolicyStatus{ObservedGeneration: 1},
+		}
+	})
+
+	Describe("#MaintenanceRolloutWaveIndex", func() {
+		var (
+			shoot   *gardencorev1beta1.Shoot
+			project *gardencorev1beta1.Project
+		)
+
+		BeforeEach(func() {
+			shoot = &gardencorev1beta1.Shoot{}
+			project = &gardencorev1beta1.Project{}
+		})
+
+		It("should match the project purpose", func() {
+			project.Spec.Purpose = ptr.To("dev")
+			Expect(MaintenanceRolloutWaveIndex(policy, shoot, project)).To(Equal(0))
+		})
+
+		It("should match the shoot selector", func() {
+			shoot.Labels = map[string]string{"canary": "true"}
+			Expect(MaintenanceRolloutWaveIndex(policy, shoot, nil)).To(Equal(1))
+		})
+
+		It("should return the first matching wave", func() {
+			shoot.Labels = map[string]string{"canary": "true"}
+			project.Spec.Purpose = ptr.To("dev")
+			Expect(MaintenanceRolloutWaveIndex(policy, shoot, project)).To(Equal(0))
+		})
+
+		It("should require all criteria of a wave to match", func() {
+			project.Spec.Purpose = ptr.To("prod")
+			Expect(MaintenanceRolloutWaveIndex(policy, shoot, project)).To(Equal(-1))
+
+			shoot.Labels = map[string]string{"tier": "1"}
+			Expect(MaintenanceRolloutWaveIndex(policy, shoot, project)).To(Equal(2))
+		})
+
+		It("should return an error for invalid selectors", func() {
+			policy.Spec.Waves[0].ShootSelector = &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "foo", Operator: "invalid"}}}
+
+			_, err := MaintenanceRolloutWaveIndex(policy, shoot, project)
+			Expect(err).To(MatchError(ContainSubstring(`failed parsing shoot selector of wave "dev"`)))
+		})
+	})
+
+	Describe("#MaintenanceRolloutReleased", func() {
+		var rollout func(kind gardencorev1beta1.MaintenanceRolloutKind, name, version string, waves ...gardencorev1beta1.MaintenanceRolloutWaveProgress) gardencorev1beta1.MaintenanceRollout
+
+		BeforeEach(func() {
+			rollout = func(kind gardencorev1beta1.MaintenanceRolloutKind, name, version string, waves ...gardencorev1beta1.MaintenanceRolloutWaveProgress) gardencorev1beta1.MaintenanceRollout {
+				return gardencorev1beta1.MaintenanceRollout{Kind: kind, Name: name, Version: version, Waves: waves}
+			}
+
+			policy.Status.Rollouts = []gardencorev1beta1.MaintenanceRollout{
+				rollout(gardencorev1beta1.MaintenanceRolloutKindKubernetes, "", "1.30.1",
+					gardencorev1beta1.MaintenanceRolloutWaveProgress{Name: "canary", UpdatedShoots: 1, HealthySince: &metav1.Time{Time: now.Add(-48 * time.Hour)}},
+					gardencorev1beta1.MaintenanceRolloutWaveProgress{Name: "prod", UpdatedShoots: 2, HealthySince: &metav1.Time{Time: now.Add(-48 * time.Hour)}},
+				),
+				rollout(gardencorev1beta1.MaintenanceRolloutKindKubernetes, "", "1.30.5",
+					gardencorev1beta1.MaintenanceRolloutWaveProgress{Name: "dev", UpdatedShoots: 2, HealthySince: &metav1.Time{Time: now.Add(-25 * time.Hour)}},
+				),
+				rollout(gardencorev1beta1.MaintenanceRolloutKindMachineImage, "gardenlinux", "1.0.0",
+					gardencorev1beta1.MaintenanceRolloutWaveProgress{Name: "dev", UpdatedShoots: 1, HealthySince: &metav1.Time{Time: now.Add(-time.Hour)}},
+				),
+				rollout(gardencorev1beta1.MaintenanceRolloutKindMachineImage, "gardenlinux", "1.1.0",
+					gardencorev1beta1.MaintenanceRolloutWaveProgress{Name: "dev", UpdatedShoots: 1, HealthySince: &metav1.Time{Time: now.Add(-time.Hour)}},
+				),
+			}
+		})
+
+		It("should always release versions to the first wave", func() {
+			Expect(MaintenanceRolloutReleased(policy, 0, gardencorev1beta1.MaintenanceRolloutKindKubernetes, "", "1.30.9", now)).To(BeTrue())
+		})
+
+		It("should not release versions if the status is outdated", func() {
+			policy.Generation = 2
+
+			released, reason := MaintenanceRolloutReleased(policy, 0, gardencorev1beta1.MaintenanceRolloutKindKubernetes, "", "1.30.5", now)
+			Expect(released).To(BeFalse())
+			Expect(reason).To(Equal(`status of MaintenancePolicy "default" is outdated`))
+		})
+
+		It("should release versions which have been soaked in previous waves", func() {
+			Expect(MaintenanceRolloutReleased(policy, 1, gardencorev1beta1.MaintenanceRolloutKindKubernetes, "", "1.30.5", now)).To(BeTrue())
+		})
+
+		It("should not release versions which are not used in previous waves", func() {
+			released, reason := MaintenanceRolloutReleased(policy, 2, gardencorev1beta1.MaintenanceRolloutKindKubernetes, "", "1.30.5", now)
+			Expect(released).To(BeFalse())
+			Expect(reason).To(Equal(`version "1.30.5" is not yet used by any Shoot of wave "canary" of MaintenancePolicy "default"`))
+		})
+
+		It("should not release versions which are still soaking in previous waves", func() {
+			released, reason := MaintenanceRolloutReleased(policy, 1, gardencorev1beta1.MaintenanceRolloutKindMachineImage, "gardenlinux", "1.1.0", now)
+			Expect(released).To(BeFalse())
+			Expect(reason).To(Equal(`version "1.1.0" is soaking in wave "dev" of MaintenancePolicy "default" until 2024-06-02T09:00:00Z`))
+		})
+
+		It("should not release versions which are unhealthy in previous waves", func() {
+			policy.Status.Rollouts[1].Waves[0].HealthySince = nil
+
+			released, reason := MaintenanceRolloutReleased(policy, 1, gardencorev1beta1.MaintenanceRolloutKindKubernetes, "", "1.30.5", now)
+			Expect(released).To(BeFalse())
+			Expect(reason).To(Equal(`Shoots of wave "dev" of MaintenancePolicy "default" using version "1.30.5" are not healthy`))
+		})
+
+		It("should skip previous waves without Shoots using the same Kubernetes minor version", func() {
+			Expect(MaintenanceRolloutReleased(policy, 1, gardencorev1beta1.MaintenanceRolloutKindKubernetes, "", "1.31.1", now)).To(BeTrue())
+		})
+
+		It("should skip previous waves without Shoots using the same machine image", func() {
+			Expect(MaintenanceRolloutReleased(policy, 2, gardencorev1beta1.MaintenanceRolloutKindMachineImage, "suse-chost", "15.5.0", now)).To(BeTrue())
+		})
+	})
+})
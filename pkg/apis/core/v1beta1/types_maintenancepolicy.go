@@ -0,0 +1,121 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// MaintenancePolicy stages the rollout of automatic Kubernetes and machine image version updates across Shoots.
+// Shoots are grouped into waves, and a version is only rolled out to a wave once the Shoots of all previous waves which
+// already use it have been healthy for the soak duration of their wave.
+type MaintenancePolicy struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object metadata.
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	// Spec contains the specification of this maintenance policy.
+	Spec MaintenancePolicySpec `json:"spec" protobuf:"bytes,2,opt,name=spec"`
+	// Status contains the most recently observed rollout progress of this maintenance policy.
+	// +optional
+	Status MaintenancePolicyStatus `json:"status" protobuf:"bytes,3,opt,name=status"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// MaintenancePolicyList is a collection of MaintenancePolicies.
+type MaintenancePolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard list object metadata.
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	// Items is the list of MaintenancePolicies.
+	Items []MaintenancePolicy `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+// MaintenancePolicySpec is the specification of a MaintenancePolicy.
+type MaintenancePolicySpec struct {
+	// Waves is the ordered list of rollout waves. A Shoot belongs to the first wave it matches. Shoots which do not
+	// match any wave are not affected by this policy.
+	Waves []MaintenanceRolloutWave `json:"waves" protobuf:"bytes,1,rep,name=waves"`
+}
+
+// MaintenanceRolloutWave is a group of Shoots which receive automatic version updates in the same stage of a rollout.
+// A Shoot matches the wave if it matches all of the specified criteria.
+type MaintenanceRolloutWave struct {
+	// Name is the name of the wave.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// ShootSelector selects the Shoots of this wave by their labels.
+	// +optional
+	ShootSelector *metav1.LabelSelector `json:"shootSelector,omitempty" protobuf:"bytes,2,opt,name=shootSelector"`
+	// ProjectPurposes selects the Shoots of this wave by the purpose of their project, e.g. `evaluation` or `production`.
+	// +optional
+	ProjectPurposes []string `json:"projectPurposes,omitempty" protobuf:"bytes,3,rep,name=projectPurposes"`
+	// SoakDuration is the duration for which the Shoots of this wave must be healthy after they were updated to a
+	// version before this version is rolled out to the next wave.
+	// +optional
+	SoakDuration *metav1.Duration `json:"soakDuration,omitempty" protobuf:"bytes,4,opt,name=soakDuration"`
+}
+
+// MaintenancePolicyStatus holds the most recently observed rollout progress of a MaintenancePolicy.
+type MaintenancePolicyStatus struct {
+	// ObservedGeneration is the most recent generation observed for this MaintenancePolicy.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty" protobuf:"varint,1,opt,name=observedGeneration"`
+	// Waves contains the number of Shoots per wave.
+	// +optional
+	Waves []MaintenanceRolloutWaveStatus `json:"waves,omitempty" protobuf:"bytes,2,rep,name=waves"`
+	// Rollouts contains the progress of the versions which are used by the Shoots of this policy.
+	// +optional
+	Rollouts []MaintenanceRollout `json:"rollouts,omitempty" protobuf:"bytes,3,rep,name=rollouts"`
+}
+
+// MaintenanceRolloutWaveStatus contains the number of Shoots of a wave.
+type MaintenanceRolloutWaveStatus struct {
+	// Name is the name of the wave.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Shoots is the number of Shoots which belong to the wave.
+	Shoots int32 `json:"shoots" protobuf:"varint,2,opt,name=shoots"`
+}
+
+// MaintenanceRolloutKind is the kind of version which is rolled out.
+type MaintenanceRolloutKind string
+
+const (
+	// MaintenanceRolloutKindKubernetes is the kind for Kubernetes versions.
+	MaintenanceRolloutKindKubernetes MaintenanceRolloutKind = "Kubernetes"
+	// MaintenanceRolloutKindMachineImage is the kind for machine image versions.
+	MaintenanceRolloutKindMachineImage MaintenanceRolloutKind = "MachineImage"
+)
+
+// MaintenanceRollout contains the rollout progress of a version.
+type MaintenanceRollout struct {
+	// Kind is the kind of the version.
+	Kind MaintenanceRolloutKind `json:"kind" protobuf:"bytes,1,opt,name=kind,casttype=MaintenanceRolloutKind"`
+	// Name is the name of the machine image. It is empty for Kubernetes versions.
+	// +optional
+	Name string `json:"name,omitempty" protobuf:"bytes,2,opt,name=name"`
+	// Version is the version which is rolled out.
+	Version string `json:"version" protobuf:"bytes,3,opt,name=version"`
+	// Waves contains the progress of the rollout in the waves which have Shoots using the version.
+	// +optional
+	Waves []MaintenanceRolloutWaveProgress `json:"waves,omitempty" protobuf:"bytes,4,rep,name=waves"`
+}
+
+// MaintenanceRolloutWaveProgress contains the progress of a rollout in a wave.
+type MaintenanceRolloutWaveProgress struct {
+	// Name is the name of the wave.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// UpdatedShoots is the number of Shoots of the wave which use the version.
+	UpdatedShoots int32 `json:"updatedShoots" protobuf:"varint,2,opt,name=updatedShoots"`
+	// HealthySince is the time since when all Shoots of the wave which use the version have been healthy. It is reset
+	// when one of them becomes unhealthy or when further Shoots of the wave start using the version.
+	// +optional
+	HealthySince *metav1.Time `json:"healthySince,omitempty" protobuf:"bytes,3,opt,name=healthySince"`
+}
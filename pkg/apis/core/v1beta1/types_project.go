@@ -6,6 +6,7 @@ package v1beta1
 
 import (
 	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -74,6 +75,9 @@ type ProjectSpec struct {
 	// DualApprovalForDeletion contains configuration for the dual approval concept for resource deletion.
 	// +optional
 	DualApprovalForDeletion []DualApprovalForDeletion `json:"dualApprovalForDeletion,omitempty" protobuf:"bytes,8,opt,name=dualApprovalForDeletion"`
+	// Budget defines limits for the resources consumed by the Shoots of the project per calendar month.
+	// +optional
+	Budget *ProjectBudget `json:"budget,omitempty" protobuf:"bytes,9,opt,name=budget"`
 }
 
 // ProjectStatus holds the most recently observed status of the project.
@@ -93,6 +97,10 @@ type ProjectStatus struct {
 	// LastActivityTimestamp contains the timestamp from the last activity performed in this project.
 	// +optional
 	LastActivityTimestamp *metav1.Time `json:"lastActivityTimestamp,omitempty" protobuf:"bytes,5,opt,name=lastActivityTimestamp"`
+	// Usage contains the resources consumed by the Shoots of the project in the current calendar month. It is only
+	// maintained if a budget is configured for the project.
+	// +optional
+	Usage *ProjectUsage `json:"usage,omitempty" protobuf:"bytes,6,opt,name=usage"`
 }
 
 // ProjectMember is a member of a project.
@@ -146,6 +154,84 @@ type DualApprovalForDeletion struct {
 	IncludeServiceAccounts *bool `json:"includeServiceAccounts,omitempty" protobuf:"varint,3,opt,name=includeServiceAccounts"`
 }
 
+// ProjectBudget defines limits for the resources consumed by the Shoots of a project per calendar month (UTC). The
+// consumption is computed based on the machines observed in the worker pools of the Shoots.
+type ProjectBudget struct {
+	// MachineHours is the maximum number of machine hours the Shoots of the project may consume per month.
+	// +optional
+	MachineHours *resource.Quantity `json:"machineHours,omitempty" protobuf:"bytes,1,opt,name=machineHours"`
+	// StorageGibibyteHours is the maximum number of gibibyte hours of the volumes of the machines the Shoots of the
+	// project may consume per month.
+	// +optional
+	StorageGibibyteHours *resource.Quantity `json:"storageGibibyteHours,omitempty" protobuf:"bytes,2,opt,name=storageGibibyteHours"`
+	// Cost is the maximum cost the Shoots of the project may cause per month. The cost is computed based on the prices.
+	// +optional
+	Cost *resource.Quantity `json:"cost,omitempty" protobuf:"bytes,3,opt,name=cost"`
+	// Prices is the price table used to compute the cost of the machines and volumes. Machine and volume types without
+	// a price do not cause any cost.
+	// +optional
+	Prices *BudgetPrices `json:"prices,omitempty" protobuf:"bytes,4,opt,name=prices"`
+	// WarningThresholdPercentage is the percentage of a limit at which a warning is issued. Defaults to 80.
+	// +optional
+	WarningThresholdPercentage *int32 `json:"warningThresholdPercentage,omitempty" protobuf:"varint,5,opt,name=warningThresholdPercentage"`
+}
+
+// BudgetPrices is a price table for machine and volume types.
+type BudgetPrices struct {
+	// MachineTypes contains the prices of machine types.
+	// +optional
+	MachineTypes []MachineTypePrice `json:"machineTypes,omitempty" protobuf:"bytes,1,rep,name=machineTypes"`
+	// VolumeTypes contains the prices of volume types.
+	// +optional
+	VolumeTypes []VolumeTypePrice `json:"volumeTypes,omitempty" protobuf:"bytes,2,rep,name=volumeTypes"`
+}
+
+// MachineTypePrice is the price of a machine type.
+type MachineTypePrice struct {
+	// Name is the name of the machine type as defined in the CloudProfile.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// PerHour is the price of a machine of this type per hour.
+	PerHour resource.Quantity `json:"perHour" protobuf:"bytes,2,opt,name=perHour"`
+}
+
+// VolumeTypePrice is the price of a volume type.
+type VolumeTypePrice struct {
+	// Name is the name of the volume type as defined in the CloudProfile.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// PerGibibyteHour is the price of a gibibyte of a volume of this type per hour.
+	PerGibibyteHour resource.Quantity `json:"perGibibyteHour" protobuf:"bytes,2,opt,name=perGibibyteHour"`
+}
+
+// ProjectUsage contains the resources consumed by the Shoots of a project in the current calendar month.
+type ProjectUsage struct {
+	// PeriodStart is the start of the current accounting period.
+	PeriodStart metav1.Time `json:"periodStart" protobuf:"bytes,1,opt,name=periodStart"`
+	// LastUpdateTime is the last time at which the usage was updated.
+	LastUpdateTime metav1.Time `json:"lastUpdateTime" protobuf:"bytes,2,opt,name=lastUpdateTime"`
+	// MachineHours is the number of machine hours consumed in the current period.
+	MachineHours resource.Quantity `json:"machineHours" protobuf:"bytes,3,opt,name=machineHours"`
+	// StorageGibibyteHours is the number of gibibyte hours of the volumes of the machines consumed in the current period.
+	StorageGibibyteHours resource.Quantity `json:"storageGibibyteHours" protobuf:"bytes,4,opt,name=storageGibibyteHours"`
+	// Cost is the cost caused in the current period. It is only computed if prices are configured.
+	// +optional
+	Cost *resource.Quantity `json:"cost,omitempty" protobuf:"bytes,5,opt,name=cost"`
+	// BudgetState is the state of the usage compared to the budget of the project.
+	BudgetState ProjectBudgetState `json:"budgetState" protobuf:"bytes,6,opt,name=budgetState,casttype=ProjectBudgetState"`
+}
+
+// ProjectBudgetState is the state of the usage of a project compared to its budget.
+type ProjectBudgetState string
+
+const (
+	// ProjectBudgetStateWithinBudget indicates that the usage is below the warning threshold of all limits.
+	ProjectBudgetStateWithinBudget ProjectBudgetState = "WithinBudget"
+	// ProjectBudgetStateWarning indicates that the usage reached the warning threshold of at least one limit.
+	ProjectBudgetStateWarning ProjectBudgetState = "Warning"
+	// ProjectBudgetStateExceeded indicates that the usage reached at least one limit. New Shoots and scale-ups are
+	// rejected.
+	ProjectBudgetStateExceeded ProjectBudgetState = "Exceeded"
+)
+
 const (
 	// ProjectMemberAdmin is a const for a role that provides full admin access.
 	ProjectMemberAdmin = "admin"
@@ -184,4 +270,8 @@ const (
 	ProjectEventNamespaceDeletionFailed = "NamespaceDeletionFailed"
 	// ProjectEventNamespaceMarkedForDeletion indicates that the namespace has been successfully marked for deletion.
 	ProjectEventNamespaceMarkedForDeletion = "NamespaceMarkedForDeletion"
+	// ProjectEventBudgetWarning indicates that the usage of the project reached the warning threshold of its budget.
+	ProjectEventBudgetWarning = "BudgetWarning"
+	// ProjectEventBudgetExceeded indicates that the usage of the project exceeded its budget.
+	ProjectEventBudgetExceeded = "BudgetExceeded"
 )
@@ -212,6 +212,40 @@ type ShootStatus struct {
 	// Networking contains information about cluster networking such as CIDRs.
 	// +optional
 	Networking *NetworkingStatus `json:"networking,omitempty" protobuf:"bytes,19,opt,name=networking"`
+	// MaintenancePreview holds information about the operations which will be performed in the next maintenance of the Shoot.
+	// +optional
+	MaintenancePreview *MaintenancePreview `json:"maintenancePreview,omitempty" protobuf:"bytes,20,opt,name=maintenancePreview"`
+	// HibernationSchedule holds the next times at which the Shoot will be hibernated and woken up according to its
+	// hibernation schedules, taking exceptions and overrides into account.
+	// +optional
+	HibernationSchedule *HibernationScheduleStatus `json:"hibernationSchedule,omitempty" protobuf:"bytes,21,opt,name=hibernationSchedule"`
+	// LastActivityTime is the last time at which activity was observed in the Shoot cluster. It is only maintained if
+	// the Shoot is configured to be hibernated when idle.
+	// +optional
+	LastActivityTime *metav1.Time `json:"lastActivityTime,omitempty" protobuf:"bytes,22,opt,name=lastActivityTime"`
+	// WorkerPools contains the machines observed in the worker pools of the Shoot cluster. It is maintained by the
+	// gardenlet while the Shoot is awake.
+	// +optional
+	WorkerPools []WorkerPoolStatus `json:"workerPools,omitempty" protobuf:"bytes,23,rep,name=workerPools"`
+}
+
+// HibernationScheduleStatus holds the next times at which the Shoot will be hibernated and woken up according to its
+// hibernation schedules.
+type HibernationScheduleStatus struct {
+	// NextHibernationTime is the next time at which the Shoot will be hibernated.
+	// +optional
+	NextHibernationTime *metav1.Time `json:"nextHibernationTime,omitempty" protobuf:"bytes,1,opt,name=nextHibernationTime"`
+	// NextWakeUpTime is the next time at which the Shoot will be woken up.
+	// +optional
+	NextWakeUpTime *metav1.Time `json:"nextWakeUpTime,omitempty" protobuf:"bytes,2,opt,name=nextWakeUpTime"`
+}
+
+// WorkerPoolStatus contains the machines observed in a worker pool of a Shoot cluster.
+type WorkerPoolStatus struct {
+	// Name is the name of the worker pool.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Machines is the number of machines observed in the worker pool.
+	Machines int32 `json:"machines" protobuf:"varint,2,opt,name=machines"`
 }
 
 // LastMaintenance holds information about a maintenance operation on the Shoot.
@@ -227,6 +261,59 @@ type LastMaintenance struct {
 	FailureReason *string `json:"failureReason,omitempty" protobuf:"bytes,4,opt,name=failureReason"`
 }
 
+// MaintenancePreview holds information about the operations which will be performed in the next maintenance of the Shoot.
+type MaintenancePreview struct {
+	// A human-readable message containing details about the operations which will be performed in the next maintenance.
+	Description string `json:"description" protobuf:"bytes,1,opt,name=description"`
+	// FailureReason holds the information about maintenance operations which are expected to fail.
+	// +optional
+	FailureReason *string `json:"failureReason,omitempty" protobuf:"bytes,2,opt,name=failureReason"`
+	// LastUpdateTime is the time when the preview was computed.
+	LastUpdateTime metav1.Time `json:"lastUpdateTime" protobuf:"bytes,3,opt,name=lastUpdateTime"`
+	// NextMaintenanceTime is the beginning of the next maintenance time window.
+	// +optional
+	NextMaintenanceTime *metav1.Time `json:"nextMaintenanceTime,omitempty" protobuf:"bytes,4,opt,name=nextMaintenanceTime"`
+	// KubernetesVersion holds information about the planned update of the control plane Kubernetes version.
+	// +optional
+	KubernetesVersion *MaintenanceVersionUpdate `json:"kubernetesVersion,omitempty" protobuf:"bytes,5,opt,name=kubernetesVersion"`
+	// Workers holds information about the planned updates of the worker pools.
+	// +optional
+	Workers []WorkerMaintenancePreview `json:"workers,omitempty" protobuf:"bytes,6,rep,name=workers"`
+	// SpecChanges is a list of further changes to the Shoot specification which will be performed in the next maintenance.
+	// +optional
+	SpecChanges []string `json:"specChanges,omitempty" protobuf:"bytes,7,rep,name=specChanges"`
+}
+
+// WorkerMaintenancePreview holds information about the planned updates of a worker pool.
+type WorkerMaintenancePreview struct {
+	// Name is the name of the worker pool.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// KubernetesVersion holds information about the planned update of the worker pool Kubernetes version.
+	// +optional
+	KubernetesVersion *MaintenanceVersionUpdate `json:"kubernetesVersion,omitempty" protobuf:"bytes,2,opt,name=kubernetesVersion"`
+	// MachineImageVersion holds information about the planned update of the worker pool machine image version.
+	// +optional
+	MachineImageVersion *MaintenanceVersionUpdate `json:"machineImageVersion,omitempty" protobuf:"bytes,3,opt,name=machineImageVersion"`
+}
+
+// MaintenanceVersionUpdate holds information about a planned version update.
+type MaintenanceVersionUpdate struct {
+	// Version is the currently used version.
+	Version string `json:"version" protobuf:"bytes,1,opt,name=version"`
+	// TargetVersion is the version which will be used after the maintenance. It is empty if no suitable version could
+	// be determined.
+	// +optional
+	TargetVersion *string `json:"targetVersion,omitempty" protobuf:"bytes,2,opt,name=targetVersion"`
+	// Reason is the reason for the update.
+	Reason string `json:"reason" protobuf:"bytes,3,opt,name=reason"`
+	// Forced indicates whether the update is enforced, e.g. because the current version is expired.
+	// +optional
+	Forced bool `json:"forced,omitempty" protobuf:"varint,4,opt,name=forced"`
+	// FailureReason holds the information why the update is expected to fail.
+	// +optional
+	FailureReason *string `json:"failureReason,omitempty" protobuf:"bytes,5,opt,name=failureReason"`
+}
+
 // NetworkingStatus contains information about cluster networking such as CIDRs.
 type NetworkingStatus struct {
 	// Pods are the CIDRs of the pod network.
@@ -536,6 +623,38 @@ type Hibernation struct {
 	// Schedules determine the hibernation schedules.
 	// +optional
 	Schedules []HibernationSchedule `json:"schedules,omitempty" protobuf:"bytes,2,rep,name=schedules"`
+	// Exceptions contains the dates on which the hibernation schedules are not applied.
+	// +optional
+	Exceptions *HibernationExceptions `json:"exceptions,omitempty" protobuf:"bytes,3,opt,name=exceptions"`
+	// AwakeUntil is a one-off override which keeps the Shoot awake until the given time, i.e., the Shoot is woken up
+	// and the hibernation schedules are not applied before this time. The override is removed once it has expired and
+	// the Shoot is hibernated or woken up according to its hibernation schedules.
+	// +optional
+	AwakeUntil *metav1.Time `json:"awakeUntil,omitempty" protobuf:"bytes,4,opt,name=awakeUntil"`
+	// Idle configures the hibernation of the Shoot after it has been idle for a certain period of time.
+	// +optional
+	Idle *HibernationIdle `json:"idle,omitempty" protobuf:"bytes,5,opt,name=idle"`
+}
+
+// HibernationIdle configures the hibernation of a Shoot after it has been idle for a certain period of time. A Shoot
+// is considered idle if neither API requests of non-system users nor running non-system workloads are observed.
+type HibernationIdle struct {
+	// Timeout is the period of time after which an idle Shoot is hibernated.
+	Timeout metav1.Duration `json:"timeout" protobuf:"bytes,1,opt,name=timeout"`
+}
+
+// HibernationExceptions contains the dates on which the hibernation schedules of a Shoot are not applied, i.e., the
+// Shoot is neither hibernated nor woken up at the start or end times which fall on these dates (evaluated in the
+// location of the respective schedule).
+type HibernationExceptions struct {
+	// Dates is a list of dates in the format `YYYY-MM-DD`.
+	// +optional
+	Dates []string `json:"dates,omitempty" protobuf:"bytes,1,rep,name=dates"`
+	// CalendarRefs is a list of references to ConfigMaps in the namespace of the Shoot which contain a calendar in the
+	// iCalendar format (RFC 5545) in their `calendar.ics` data key, e.g., holiday calendars. The days of all events of
+	// these calendars are treated as exception dates.
+	// +optional
+	CalendarRefs []corev1.LocalObjectReference `json:"calendarRefs,omitempty" protobuf:"bytes,2,rep,name=calendarRefs"`
 }
 
 // HibernationSchedule determines the hibernation schedule of a Shoot.
@@ -1807,6 +1926,10 @@ const (
 	ShootEventHibernationEnabled = "Hibernated"
 	// ShootEventHibernationDisabled indicates that hibernation ended.
 	ShootEventHibernationDisabled = "WokenUp"
+	// ShootEventHibernationCalendarInvalid indicates that a hibernation calendar referenced by the shoot is missing or invalid.
+	ShootEventHibernationCalendarInvalid = "HibernationCalendarInvalid"
+	// ShootEventCertificatesExpiring indicates that certificates of the shoot's control plane expire soon.
+	ShootEventCertificatesExpiring = "CertificatesExpiring"
 	// ShootEventSchedulingSuccessful indicates that a scheduling decision was taken successfully.
 	ShootEventSchedulingSuccessful = "SchedulingSuccessful"
 	// ShootEventSchedulingFailed indicates that a scheduling decision failed.
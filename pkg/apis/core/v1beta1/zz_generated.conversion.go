@@ -271,6 +271,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*BudgetPrices)(nil), (*core.BudgetPrices)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_BudgetPrices_To_core_BudgetPrices(a.(*BudgetPrices), b.(*core.BudgetPrices), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.BudgetPrices)(nil), (*BudgetPrices)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_BudgetPrices_To_v1beta1_BudgetPrices(a.(*core.BudgetPrices), b.(*BudgetPrices), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*CARotation)(nil), (*core.CARotation)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_CARotation_To_core_CARotation(a.(*CARotation), b.(*core.CARotation), scope)
 	}); err != nil {
@@ -701,6 +711,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*HibernationExceptions)(nil), (*core.HibernationExceptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_HibernationExceptions_To_core_HibernationExceptions(a.(*HibernationExceptions), b.(*core.HibernationExceptions), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.HibernationExceptions)(nil), (*HibernationExceptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_HibernationExceptions_To_v1beta1_HibernationExceptions(a.(*core.HibernationExceptions), b.(*HibernationExceptions), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*HibernationIdle)(nil), (*core.HibernationIdle)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_HibernationIdle_To_core_HibernationIdle(a.(*HibernationIdle), b.(*core.HibernationIdle), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.HibernationIdle)(nil), (*HibernationIdle)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_HibernationIdle_To_v1beta1_HibernationIdle(a.(*core.HibernationIdle), b.(*HibernationIdle), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*HibernationSchedule)(nil), (*core.HibernationSchedule)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_HibernationSchedule_To_core_HibernationSchedule(a.(*HibernationSchedule), b.(*core.HibernationSchedule), scope)
 	}); err != nil {
@@ -711,6 +741,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*HibernationScheduleStatus)(nil), (*core.HibernationScheduleStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_HibernationScheduleStatus_To_core_HibernationScheduleStatus(a.(*HibernationScheduleStatus), b.(*core.HibernationScheduleStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.HibernationScheduleStatus)(nil), (*HibernationScheduleStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_HibernationScheduleStatus_To_v1beta1_HibernationScheduleStatus(a.(*core.HibernationScheduleStatus), b.(*HibernationScheduleStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*HighAvailability)(nil), (*core.HighAvailability)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_HighAvailability_To_core_HighAvailability(a.(*HighAvailability), b.(*core.HighAvailability), scope)
 	}); err != nil {
@@ -986,6 +1026,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*MachineTypePrice)(nil), (*core.MachineTypePrice)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MachineTypePrice_To_core_MachineTypePrice(a.(*MachineTypePrice), b.(*core.MachineTypePrice), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.MachineTypePrice)(nil), (*MachineTypePrice)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_MachineTypePrice_To_v1beta1_MachineTypePrice(a.(*core.MachineTypePrice), b.(*MachineTypePrice), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*MachineTypeStorage)(nil), (*core.MachineTypeStorage)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_MachineTypeStorage_To_core_MachineTypeStorage(a.(*MachineTypeStorage), b.(*core.MachineTypeStorage), scope)
 	}); err != nil {
@@ -1016,6 +1066,96 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*MaintenancePolicy)(nil), (*core.MaintenancePolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MaintenancePolicy_To_core_MaintenancePolicy(a.(*MaintenancePolicy), b.(*core.MaintenancePolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.MaintenancePolicy)(nil), (*MaintenancePolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_MaintenancePolicy_To_v1beta1_MaintenancePolicy(a.(*core.MaintenancePolicy), b.(*MaintenancePolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MaintenancePolicyList)(nil), (*core.MaintenancePolicyList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MaintenancePolicyList_To_core_MaintenancePolicyList(a.(*MaintenancePolicyList), b.(*core.MaintenancePolicyList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.MaintenancePolicyList)(nil), (*MaintenancePolicyList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_MaintenancePolicyList_To_v1beta1_MaintenancePolicyList(a.(*core.MaintenancePolicyList), b.(*MaintenancePolicyList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MaintenancePolicySpec)(nil), (*core.MaintenancePolicySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MaintenancePolicySpec_To_core_MaintenancePolicySpec(a.(*MaintenancePolicySpec), b.(*core.MaintenancePolicySpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.MaintenancePolicySpec)(nil), (*MaintenancePolicySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_MaintenancePolicySpec_To_v1beta1_MaintenancePolicySpec(a.(*core.MaintenancePolicySpec), b.(*MaintenancePolicySpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MaintenancePolicyStatus)(nil), (*core.MaintenancePolicyStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MaintenancePolicyStatus_To_core_MaintenancePolicyStatus(a.(*MaintenancePolicyStatus), b.(*core.MaintenancePolicyStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.MaintenancePolicyStatus)(nil), (*MaintenancePolicyStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_MaintenancePolicyStatus_To_v1beta1_MaintenancePolicyStatus(a.(*core.MaintenancePolicyStatus), b.(*MaintenancePolicyStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MaintenancePreview)(nil), (*core.MaintenancePreview)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MaintenancePreview_To_core_MaintenancePreview(a.(*MaintenancePreview), b.(*core.MaintenancePreview), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.MaintenancePreview)(nil), (*MaintenancePreview)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_MaintenancePreview_To_v1beta1_MaintenancePreview(a.(*core.MaintenancePreview), b.(*MaintenancePreview), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MaintenanceRollout)(nil), (*core.MaintenanceRollout)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MaintenanceRollout_To_core_MaintenanceRollout(a.(*MaintenanceRollout), b.(*core.MaintenanceRollout), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.MaintenanceRollout)(nil), (*MaintenanceRollout)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_MaintenanceRollout_To_v1beta1_MaintenanceRollout(a.(*core.MaintenanceRollout), b.(*MaintenanceRollout), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MaintenanceRolloutWave)(nil), (*core.MaintenanceRolloutWave)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MaintenanceRolloutWave_To_core_MaintenanceRolloutWave(a.(*MaintenanceRolloutWave), b.(*core.MaintenanceRolloutWave), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.MaintenanceRolloutWave)(nil), (*MaintenanceRolloutWave)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_MaintenanceRolloutWave_To_v1beta1_MaintenanceRolloutWave(a.(*core.MaintenanceRolloutWave), b.(*MaintenanceRolloutWave), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MaintenanceRolloutWaveProgress)(nil), (*core.MaintenanceRolloutWaveProgress)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MaintenanceRolloutWaveProgress_To_core_MaintenanceRolloutWaveProgress(a.(*MaintenanceRolloutWaveProgress), b.(*core.MaintenanceRolloutWaveProgress), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.MaintenanceRolloutWaveProgress)(nil), (*MaintenanceRolloutWaveProgress)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_MaintenanceRolloutWaveProgress_To_v1beta1_MaintenanceRolloutWaveProgress(a.(*core.MaintenanceRolloutWaveProgress), b.(*MaintenanceRolloutWaveProgress), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MaintenanceRolloutWaveStatus)(nil), (*core.MaintenanceRolloutWaveStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MaintenanceRolloutWaveStatus_To_core_MaintenanceRolloutWaveStatus(a.(*MaintenanceRolloutWaveStatus), b.(*core.MaintenanceRolloutWaveStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.MaintenanceRolloutWaveStatus)(nil), (*MaintenanceRolloutWaveStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_MaintenanceRolloutWaveStatus_To_v1beta1_MaintenanceRolloutWaveStatus(a.(*core.MaintenanceRolloutWaveStatus), b.(*MaintenanceRolloutWaveStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*MaintenanceTimeWindow)(nil), (*core.MaintenanceTimeWindow)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_MaintenanceTimeWindow_To_core_MaintenanceTimeWindow(a.(*MaintenanceTimeWindow), b.(*core.MaintenanceTimeWindow), scope)
 	}); err != nil {
@@ -1026,6 +1166,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*MaintenanceVersionUpdate)(nil), (*core.MaintenanceVersionUpdate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MaintenanceVersionUpdate_To_core_MaintenanceVersionUpdate(a.(*MaintenanceVersionUpdate), b.(*core.MaintenanceVersionUpdate), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.MaintenanceVersionUpdate)(nil), (*MaintenanceVersionUpdate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_MaintenanceVersionUpdate_To_v1beta1_MaintenanceVersionUpdate(a.(*core.MaintenanceVersionUpdate), b.(*MaintenanceVersionUpdate), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*MemorySwapConfiguration)(nil), (*core.MemorySwapConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_MemorySwapConfiguration_To_core_MemorySwapConfiguration(a.(*MemorySwapConfiguration), b.(*core.MemorySwapConfiguration), scope)
 	}); err != nil {
@@ -1186,6 +1336,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ProjectBudget)(nil), (*core.ProjectBudget)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ProjectBudget_To_core_ProjectBudget(a.(*ProjectBudget), b.(*core.ProjectBudget), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ProjectBudget)(nil), (*ProjectBudget)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ProjectBudget_To_v1beta1_ProjectBudget(a.(*core.ProjectBudget), b.(*ProjectBudget), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ProjectList)(nil), (*core.ProjectList)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ProjectList_To_core_ProjectList(a.(*ProjectList), b.(*core.ProjectList), scope)
 	}); err != nil {
@@ -1216,6 +1376,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ProjectUsage)(nil), (*core.ProjectUsage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ProjectUsage_To_core_ProjectUsage(a.(*ProjectUsage), b.(*core.ProjectUsage), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ProjectUsage)(nil), (*ProjectUsage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ProjectUsage_To_v1beta1_ProjectUsage(a.(*core.ProjectUsage), b.(*ProjectUsage), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Provider)(nil), (*core.Provider)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_Provider_To_core_Provider(a.(*Provider), b.(*core.Provider), scope)
 	}); err != nil {
@@ -1816,6 +1986,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*VolumeTypePrice)(nil), (*core.VolumeTypePrice)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_VolumeTypePrice_To_core_VolumeTypePrice(a.(*VolumeTypePrice), b.(*core.VolumeTypePrice), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.VolumeTypePrice)(nil), (*VolumeTypePrice)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_VolumeTypePrice_To_v1beta1_VolumeTypePrice(a.(*core.VolumeTypePrice), b.(*VolumeTypePrice), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*WatchCacheSizes)(nil), (*core.WatchCacheSizes)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_WatchCacheSizes_To_core_WatchCacheSizes(a.(*WatchCacheSizes), b.(*core.WatchCacheSizes), scope)
 	}); err != nil {
@@ -1846,6 +2026,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*WorkerMaintenancePreview)(nil), (*core.WorkerMaintenancePreview)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_WorkerMaintenancePreview_To_core_WorkerMaintenancePreview(a.(*WorkerMaintenancePreview), b.(*core.WorkerMaintenancePreview), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.WorkerMaintenancePreview)(nil), (*WorkerMaintenancePreview)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_WorkerMaintenancePreview_To_v1beta1_WorkerMaintenancePreview(a.(*core.WorkerMaintenancePreview), b.(*WorkerMaintenancePreview), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*WorkerPoolStatus)(nil), (*core.WorkerPoolStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_WorkerPoolStatus_To_core_WorkerPoolStatus(a.(*WorkerPoolStatus), b.(*core.WorkerPoolStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.WorkerPoolStatus)(nil), (*WorkerPoolStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_WorkerPoolStatus_To_v1beta1_WorkerPoolStatus(a.(*core.WorkerPoolStatus), b.(*WorkerPoolStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*WorkerSystemComponents)(nil), (*core.WorkerSystemComponents)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_WorkerSystemComponents_To_core_WorkerSystemComponents(a.(*WorkerSystemComponents), b.(*core.WorkerSystemComponents), scope)
 	}); err != nil {
@@ -2480,6 +2680,28 @@ func Convert_core_BastionMachineType_To_v1beta1_BastionMachineType(in *core.Bast
 	return autoConvert_core_BastionMachineType_To_v1beta1_BastionMachineType(in, out, s)
 }
 
+func autoConvert_v1beta1_BudgetPrices_To_core_BudgetPrices(in *BudgetPrices, out *core.BudgetPrices, s conversion.Scope) error {
+	out.MachineTypes = *(*[]core.MachineTypePrice)(unsafe.Pointer(&in.MachineTypes))
+	out.VolumeTypes = *(*[]core.VolumeTypePrice)(unsafe.Pointer(&in.VolumeTypes))
+	return nil
+}
+
+// Convert_v1beta1_BudgetPrices_To_core_BudgetPrices is an autogenerated conversion function.
+func Convert_v1beta1_BudgetPrices_To_core_BudgetPrices(in *BudgetPrices, out *core.BudgetPrices, s conversion.Scope) error {
+	return autoConvert_v1beta1_BudgetPrices_To_core_BudgetPrices(in, out, s)
+}
+
+func autoConvert_core_BudgetPrices_To_v1beta1_BudgetPrices(in *core.BudgetPrices, out *BudgetPrices, s conversion.Scope) error {
+	out.MachineTypes = *(*[]MachineTypePrice)(unsafe.Pointer(&in.MachineTypes))
+	out.VolumeTypes = *(*[]VolumeTypePrice)(unsafe.Pointer(&in.VolumeTypes))
+	return nil
+}
+
+// Convert_core_BudgetPrices_To_v1beta1_BudgetPrices is an autogenerated conversion function.
+func Convert_core_BudgetPrices_To_v1beta1_BudgetPrices(in *core.BudgetPrices, out *BudgetPrices, s conversion.Scope) error {
+	return autoConvert_core_BudgetPrices_To_v1beta1_BudgetPrices(in, out, s)
+}
+
 func autoConvert_v1beta1_CARotation_To_core_CARotation(in *CARotation, out *core.CARotation, s conversion.Scope) error {
 	out.Phase = core.CredentialsRotationPhase(in.Phase)
 	out.LastCompletionTime = (*metav1.Time)(unsafe.Pointer(in.LastCompletionTime))
@@ -3588,6 +3810,9 @@ func autoConvert_core_HelmControllerDeployment_To_v1beta1_HelmControllerDeployme
 func autoConvert_v1beta1_Hibernation_To_core_Hibernation(in *Hibernation, out *core.Hibernation, s conversion.Scope) error {
 	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
 	out.Schedules = *(*[]core.HibernationSchedule)(unsafe.Pointer(&in.Schedules))
+	out.Exceptions = (*core.HibernationExceptions)(unsafe.Pointer(in.Exceptions))
+	out.AwakeUntil = (*metav1.Time)(unsafe.Pointer(in.AwakeUntil))
+	out.Idle = (*core.HibernationIdle)(unsafe.Pointer(in.Idle))
 	return nil
 }
 
@@ -3599,6 +3824,9 @@ func Convert_v1beta1_Hibernation_To_core_Hibernation(in *Hibernation, out *core.
 func autoConvert_core_Hibernation_To_v1beta1_Hibernation(in *core.Hibernation, out *Hibernation, s conversion.Scope) error {
 	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
 	out.Schedules = *(*[]HibernationSchedule)(unsafe.Pointer(&in.Schedules))
+	out.Exceptions = (*HibernationExceptions)(unsafe.Pointer(in.Exceptions))
+	out.AwakeUntil = (*metav1.Time)(unsafe.Pointer(in.AwakeUntil))
+	out.Idle = (*HibernationIdle)(unsafe.Pointer(in.Idle))
 	return nil
 }
 
@@ -3607,6 +3835,48 @@ func Convert_core_Hibernation_To_v1beta1_Hibernation(in *core.Hibernation, out *
 	return autoConvert_core_Hibernation_To_v1beta1_Hibernation(in, out, s)
 }
 
+func autoConvert_v1beta1_HibernationExceptions_To_core_HibernationExceptions(in *HibernationExceptions, out *core.HibernationExceptions, s conversion.Scope) error {
+	out.Dates = *(*[]string)(unsafe.Pointer(&in.Dates))
+	out.CalendarRefs = *(*[]v1.LocalObjectReference)(unsafe.Pointer(&in.CalendarRefs))
+	return nil
+}
+
+// Convert_v1beta1_HibernationExceptions_To_core_HibernationExceptions is an autogenerated conversion function.
+func Convert_v1beta1_HibernationExceptions_To_core_HibernationExceptions(in *HibernationExceptions, out *core.HibernationExceptions, s conversion.Scope) error {
+	return autoConvert_v1beta1_HibernationExceptions_To_core_HibernationExceptions(in, out, s)
+}
+
+func autoConvert_core_HibernationExceptions_To_v1beta1_HibernationExceptions(in *core.HibernationExceptions, out *HibernationExceptions, s conversion.Scope) error {
+	out.Dates = *(*[]string)(unsafe.Pointer(&in.Dates))
+	out.CalendarRefs = *(*[]v1.LocalObjectReference)(unsafe.Pointer(&in.CalendarRefs))
+	return nil
+}
+
+// Convert_core_HibernationExceptions_To_v1beta1_HibernationExceptions is an autogenerated conversion function.
+func Convert_core_HibernationExceptions_To_v1beta1_HibernationExceptions(in *core.HibernationExceptions, out *HibernationExceptions, s conversion.Scope) error {
+	return autoConvert_core_HibernationExceptions_To_v1beta1_HibernationExceptions(in, out, s)
+}
+
+func autoConvert_v1beta1_HibernationIdle_To_core_HibernationIdle(in *HibernationIdle, out *core.HibernationIdle, s conversion.Scope) error {
+	out.Timeout = in.Timeout
+	return nil
+}
+
+// Convert_v1beta1_HibernationIdle_To_core_HibernationIdle is an autogenerated conversion function.
+func Convert_v1beta1_HibernationIdle_To_core_HibernationIdle(in *HibernationIdle, out *core.HibernationIdle, s conversion.Scope) error {
+	return autoConvert_v1beta1_HibernationIdle_To_core_HibernationIdle(in, out, s)
+}
+
+func autoConvert_core_HibernationIdle_To_v1beta1_HibernationIdle(in *core.HibernationIdle, out *HibernationIdle, s conversion.Scope) error {
+	out.Timeout = in.Timeout
+	return nil
+}
+
+// Convert_core_HibernationIdle_To_v1beta1_HibernationIdle is an autogenerated conversion function.
+func Convert_core_HibernationIdle_To_v1beta1_HibernationIdle(in *core.HibernationIdle, out *HibernationIdle, s conversion.Scope) error {
+	return autoConvert_core_HibernationIdle_To_v1beta1_HibernationIdle(in, out, s)
+}
+
 func autoConvert_v1beta1_HibernationSchedule_To_core_HibernationSchedule(in *HibernationSchedule, out *core.HibernationSchedule, s conversion.Scope) error {
 	out.Start = (*string)(unsafe.Pointer(in.Start))
 	out.End = (*string)(unsafe.Pointer(in.End))
@@ -3631,6 +3901,28 @@ func Convert_core_HibernationSchedule_To_v1beta1_HibernationSchedule(in *core.Hi
 	return autoConvert_core_HibernationSchedule_To_v1beta1_HibernationSchedule(in, out, s)
 }
 
+func autoConvert_v1beta1_HibernationScheduleStatus_To_core_HibernationScheduleStatus(in *HibernationScheduleStatus, out *core.HibernationScheduleStatus, s conversion.Scope) error {
+	out.NextHibernationTime = (*metav1.Time)(unsafe.Pointer(in.NextHibernationTime))
+	out.NextWakeUpTime = (*metav1.Time)(unsafe.Pointer(in.NextWakeUpTime))
+	return nil
+}
+
+// Convert_v1beta1_HibernationScheduleStatus_To_core_HibernationScheduleStatus is an autogenerated conversion function.
+func Convert_v1beta1_HibernationScheduleStatus_To_core_HibernationScheduleStatus(in *HibernationScheduleStatus, out *core.HibernationScheduleStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_HibernationScheduleStatus_To_core_HibernationScheduleStatus(in, out, s)
+}
+
+func autoConvert_core_HibernationScheduleStatus_To_v1beta1_HibernationScheduleStatus(in *core.HibernationScheduleStatus, out *HibernationScheduleStatus, s conversion.Scope) error {
+	out.NextHibernationTime = (*metav1.Time)(unsafe.Pointer(in.NextHibernationTime))
+	out.NextWakeUpTime = (*metav1.Time)(unsafe.Pointer(in.NextWakeUpTime))
+	return nil
+}
+
+// Convert_core_HibernationScheduleStatus_To_v1beta1_HibernationScheduleStatus is an autogenerated conversion function.
+func Convert_core_HibernationScheduleStatus_To_v1beta1_HibernationScheduleStatus(in *core.HibernationScheduleStatus, out *HibernationScheduleStatus, s conversion.Scope) error {
+	return autoConvert_core_HibernationScheduleStatus_To_v1beta1_HibernationScheduleStatus(in, out, s)
+}
+
 func autoConvert_v1beta1_HighAvailability_To_core_HighAvailability(in *HighAvailability, out *core.HighAvailability, s conversion.Scope) error {
 	if err := Convert_v1beta1_FailureTolerance_To_core_FailureTolerance(&in.FailureTolerance, &out.FailureTolerance, s); err != nil {
 		return err
@@ -4545,6 +4837,28 @@ func Convert_core_MachineType_To_v1beta1_MachineType(in *core.MachineType, out *
 	return autoConvert_core_MachineType_To_v1beta1_MachineType(in, out, s)
 }
 
+func autoConvert_v1beta1_MachineTypePrice_To_core_MachineTypePrice(in *MachineTypePrice, out *core.MachineTypePrice, s conversion.Scope) error {
+	out.Name = in.Name
+	out.PerHour = in.PerHour
+	return nil
+}
+
+// Convert_v1beta1_MachineTypePrice_To_core_MachineTypePrice is an autogenerated conversion function.
+func Convert_v1beta1_MachineTypePrice_To_core_MachineTypePrice(in *MachineTypePrice, out *core.MachineTypePrice, s conversion.Scope) error {
+	return autoConvert_v1beta1_MachineTypePrice_To_core_MachineTypePrice(in, out, s)
+}
+
+func autoConvert_core_MachineTypePrice_To_v1beta1_MachineTypePrice(in *core.MachineTypePrice, out *MachineTypePrice, s conversion.Scope) error {
+	out.Name = in.Name
+	out.PerHour = in.PerHour
+	return nil
+}
+
+// Convert_core_MachineTypePrice_To_v1beta1_MachineTypePrice is an autogenerated conversion function.
+func Convert_core_MachineTypePrice_To_v1beta1_MachineTypePrice(in *core.MachineTypePrice, out *MachineTypePrice, s conversion.Scope) error {
+	return autoConvert_core_MachineTypePrice_To_v1beta1_MachineTypePrice(in, out, s)
+}
+
 func autoConvert_v1beta1_MachineTypeStorage_To_core_MachineTypeStorage(in *MachineTypeStorage, out *core.MachineTypeStorage, s conversion.Scope) error {
 	out.Class = in.Class
 	out.StorageSize = (*resource.Quantity)(unsafe.Pointer(in.StorageSize))
@@ -4617,6 +4931,234 @@ func Convert_core_MaintenanceAutoUpdate_To_v1beta1_MaintenanceAutoUpdate(in *cor
 	return autoConvert_core_MaintenanceAutoUpdate_To_v1beta1_MaintenanceAutoUpdate(in, out, s)
 }
 
+func autoConvert_v1beta1_MaintenancePolicy_To_core_MaintenancePolicy(in *MaintenancePolicy, out *core.MaintenancePolicy, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_MaintenancePolicySpec_To_core_MaintenancePolicySpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta1_MaintenancePolicyStatus_To_core_MaintenancePolicyStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_MaintenancePolicy_To_core_MaintenancePolicy is an autogenerated conversion function.
+func Convert_v1beta1_MaintenancePolicy_To_core_MaintenancePolicy(in *MaintenancePolicy, out *core.MaintenancePolicy, s conversion.Scope) error {
+	return autoConvert_v1beta1_MaintenancePolicy_To_core_MaintenancePolicy(in, out, s)
+}
+
+func autoConvert_core_MaintenancePolicy_To_v1beta1_MaintenancePolicy(in *core.MaintenancePolicy, out *MaintenancePolicy, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_core_MaintenancePolicySpec_To_v1beta1_MaintenancePolicySpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_core_MaintenancePolicyStatus_To_v1beta1_MaintenancePolicyStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_core_MaintenancePolicy_To_v1beta1_MaintenancePolicy is an autogenerated conversion function.
+func Convert_core_MaintenancePolicy_To_v1beta1_MaintenancePolicy(in *core.MaintenancePolicy, out *MaintenancePolicy, s conversion.Scope) error {
+	return autoConvert_core_MaintenancePolicy_To_v1beta1_MaintenancePolicy(in, out, s)
+}
+
+func autoConvert_v1beta1_MaintenancePolicyList_To_core_MaintenancePolicyList(in *MaintenancePolicyList, out *core.MaintenancePolicyList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]core.MaintenancePolicy)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1beta1_MaintenancePolicyList_To_core_MaintenancePolicyList is an autogenerated conversion function.
+func Convert_v1beta1_MaintenancePolicyList_To_core_MaintenancePolicyList(in *MaintenancePolicyList, out *core.MaintenancePolicyList, s conversion.Scope) error {
+	return autoConvert_v1beta1_MaintenancePolicyList_To_core_MaintenancePolicyList(in, out, s)
+}
+
+func autoConvert_core_MaintenancePolicyList_To_v1beta1_MaintenancePolicyList(in *core.MaintenancePolicyList, out *MaintenancePolicyList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]MaintenancePolicy)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_core_MaintenancePolicyList_To_v1beta1_MaintenancePolicyList is an autogenerated conversion function.
+func Convert_core_MaintenancePolicyList_To_v1beta1_MaintenancePolicyList(in *core.MaintenancePolicyList, out *MaintenancePolicyList, s conversion.Scope) error {
+	return autoConvert_core_MaintenancePolicyList_To_v1beta1_MaintenancePolicyList(in, out, s)
+}
+
+func autoConvert_v1beta1_MaintenancePolicySpec_To_core_MaintenancePolicySpec(in *MaintenancePolicySpec, out *core.MaintenancePolicySpec, s conversion.Scope) error {
+	out.Waves = *(*[]core.MaintenanceRolloutWave)(unsafe.Pointer(&in.Waves))
+	return nil
+}
+
+// Convert_v1beta1_MaintenancePolicySpec_To_core_MaintenancePolicySpec is an autogenerated conversion function.
+func Convert_v1beta1_MaintenancePolicySpec_To_core_MaintenancePolicySpec(in *MaintenancePolicySpec, out *core.MaintenancePolicySpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_MaintenancePolicySpec_To_core_MaintenancePolicySpec(in, out, s)
+}
+
+func autoConvert_core_MaintenancePolicySpec_To_v1beta1_MaintenancePolicySpec(in *core.MaintenancePolicySpec, out *MaintenancePolicySpec, s conversion.Scope) error {
+	out.Waves = *(*[]MaintenanceRolloutWave)(unsafe.Pointer(&in.Waves))
+	return nil
+}
+
+// Convert_core_MaintenancePolicySpec_To_v1beta1_MaintenancePolicySpec is an autogenerated conversion function.
+func Convert_core_MaintenancePolicySpec_To_v1beta1_MaintenancePolicySpec(in *core.MaintenancePolicySpec, out *MaintenancePolicySpec, s conversion.Scope) error {
+	return autoConvert_core_MaintenancePolicySpec_To_v1beta1_MaintenancePolicySpec(in, out, s)
+}
+
+func autoConvert_v1beta1_MaintenancePolicyStatus_To_core_MaintenancePolicyStatus(in *MaintenancePolicyStatus, out *core.MaintenancePolicyStatus, s conversion.Scope) error {
+	out.ObservedGeneration = in.ObservedGeneration
+	out.Waves = *(*[]core.MaintenanceRolloutWaveStatus)(unsafe.Pointer(&in.Waves))
+	out.Rollouts = *(*[]core.MaintenanceRollout)(unsafe.Pointer(&in.Rollouts))
+	return nil
+}
+
+// Convert_v1beta1_MaintenancePolicyStatus_To_core_MaintenancePolicyStatus is an autogenerated conversion function.
+func Convert_v1beta1_MaintenancePolicyStatus_To_core_MaintenancePolicyStatus(in *MaintenancePolicyStatus, out *core.MaintenancePolicyStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_MaintenancePolicyStatus_To_core_MaintenancePolicyStatus(in, out, s)
+}
+
+func autoConvert_core_MaintenancePolicyStatus_To_v1beta1_MaintenancePolicyStatus(in *core.MaintenancePolicyStatus, out *MaintenancePolicyStatus, s conversion.Scope) error {
+	out.ObservedGeneration = in.ObservedGeneration
+	out.Waves = *(*[]MaintenanceRolloutWaveStatus)(unsafe.Pointer(&in.Waves))
+	out.Rollouts = *(*[]MaintenanceRollout)(unsafe.Pointer(&in.Rollouts))
+	return nil
+}
+
+// Convert_core_MaintenancePolicyStatus_To_v1beta1_MaintenancePolicyStatus is an autogenerated conversion function.
+func Convert_core_MaintenancePolicyStatus_To_v1beta1_MaintenancePolicyStatus(in *core.MaintenancePolicyStatus, out *MaintenancePolicyStatus, s conversion.Scope) error {
+	return autoConvert_core_MaintenancePolicyStatus_To_v1beta1_MaintenancePolicyStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_MaintenancePreview_To_core_MaintenancePreview(in *MaintenancePreview, out *core.MaintenancePreview, s conversion.Scope) error {
+	out.Description = in.Description
+	out.FailureReason = (*string)(unsafe.Pointer(in.FailureReason))
+	out.LastUpdateTime = in.LastUpdateTime
+	out.NextMaintenanceTime = (*metav1.Time)(unsafe.Pointer(in.NextMaintenanceTime))
+	out.KubernetesVersion = (*core.MaintenanceVersionUpdate)(unsafe.Pointer(in.KubernetesVersion))
+	out.Workers = *(*[]core.WorkerMaintenancePreview)(unsafe.Pointer(&in.Workers))
+	out.SpecChanges = *(*[]string)(unsafe.Pointer(&in.SpecChanges))
+	return nil
+}
+
+// Convert_v1beta1_MaintenancePreview_To_core_MaintenancePreview is an autogenerated conversion function.
+func Convert_v1beta1_MaintenancePreview_To_core_MaintenancePreview(in *MaintenancePreview, out *core.MaintenancePreview, s conversion.Scope) error {
+	return autoConvert_v1beta1_MaintenancePreview_To_core_MaintenancePreview(in, out, s)
+}
+
+func autoConvert_core_MaintenancePreview_To_v1beta1_MaintenancePreview(in *core.MaintenancePreview, out *MaintenancePreview, s conversion.Scope) error {
+	out.Description = in.Description
+	out.FailureReason = (*string)(unsafe.Pointer(in.FailureReason))
+	out.LastUpdateTime = in.LastUpdateTime
+	out.NextMaintenanceTime = (*metav1.Time)(unsafe.Pointer(in.NextMaintenanceTime))
+	out.KubernetesVersion = (*MaintenanceVersionUpdate)(unsafe.Pointer(in.KubernetesVersion))
+	out.Workers = *(*[]WorkerMaintenancePreview)(unsafe.Pointer(&in.Workers))
+	out.SpecChanges = *(*[]string)(unsafe.Pointer(&in.SpecChanges))
+	return nil
+}
+
+// Convert_core_MaintenancePreview_To_v1beta1_MaintenancePreview is an autogenerated conversion function.
+func Convert_core_MaintenancePreview_To_v1beta1_MaintenancePreview(in *core.MaintenancePreview, out *MaintenancePreview, s conversion.Scope) error {
+	return autoConvert_core_MaintenancePreview_To_v1beta1_MaintenancePreview(in, out, s)
+}
+
+func autoConvert_v1beta1_MaintenanceRollout_To_core_MaintenanceRollout(in *MaintenanceRollout, out *core.MaintenanceRollout, s conversion.Scope) error {
+	out.Kind = core.MaintenanceRolloutKind(in.Kind)
+	out.Name = in.Name
+	out.Version = in.Version
+	out.Waves = *(*[]core.MaintenanceRolloutWaveProgress)(unsafe.Pointer(&in.Waves))
+	return nil
+}
+
+// Convert_v1beta1_MaintenanceRollout_To_core_MaintenanceRollout is an autogenerated conversion function.
+func Convert_v1beta1_MaintenanceRollout_To_core_MaintenanceRollout(in *MaintenanceRollout, out *core.MaintenanceRollout, s conversion.Scope) error {
+	return autoConvert_v1beta1_MaintenanceRollout_To_core_MaintenanceRollout(in, out, s)
+}
+
+func autoConvert_core_MaintenanceRollout_To_v1beta1_MaintenanceRollout(in *core.MaintenanceRollout, out *MaintenanceRollout, s conversion.Scope) error {
+	out.Kind = MaintenanceRolloutKind(in.Kind)
+	out.Name = in.Name
+	out.Version = in.Version
+	out.Waves = *(*[]MaintenanceRolloutWaveProgress)(unsafe.Pointer(&in.Waves))
+	return nil
+}
+
+// Convert_core_MaintenanceRollout_To_v1beta1_MaintenanceRollout is an autogenerated conversion function.
+func Convert_core_MaintenanceRollout_To_v1beta1_MaintenanceRollout(in *core.MaintenanceRollout, out *MaintenanceRollout, s conversion.Scope) error {
+	return autoConvert_core_MaintenanceRollout_To_v1beta1_MaintenanceRollout(in, out, s)
+}
+
+func autoConvert_v1beta1_MaintenanceRolloutWave_To_core_MaintenanceRolloutWave(in *MaintenanceRolloutWave, out *core.MaintenanceRolloutWave, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ShootSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.ShootSelector))
+	out.ProjectPurposes = *(*[]string)(unsafe.Pointer(&in.ProjectPurposes))
+	out.SoakDuration = (*metav1.Duration)(unsafe.Pointer(in.SoakDuration))
+	return nil
+}
+
+// Convert_v1beta1_MaintenanceRolloutWave_To_core_MaintenanceRolloutWave is an autogenerated conversion function.
+func Convert_v1beta1_MaintenanceRolloutWave_To_core_MaintenanceRolloutWave(in *MaintenanceRolloutWave, out *core.MaintenanceRolloutWave, s conversion.Scope) error {
+	return autoConvert_v1beta1_MaintenanceRolloutWave_To_core_MaintenanceRolloutWave(in, out, s)
+}
+
+func autoConvert_core_MaintenanceRolloutWave_To_v1beta1_MaintenanceRolloutWave(in *core.MaintenanceRolloutWave, out *MaintenanceRolloutWave, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ShootSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.ShootSelector))
+	out.ProjectPurposes = *(*[]string)(unsafe.Pointer(&in.ProjectPurposes))
+	out.SoakDuration = (*metav1.Duration)(unsafe.Pointer(in.SoakDuration))
+	return nil
+}
+
+// Convert_core_MaintenanceRolloutWave_To_v1beta1_MaintenanceRolloutWave is an autogenerated conversion function.
+func Convert_core_MaintenanceRolloutWave_To_v1beta1_MaintenanceRolloutWave(in *core.MaintenanceRolloutWave, out *MaintenanceRolloutWave, s conversion.Scope) error {
+	return autoConvert_core_MaintenanceRolloutWave_To_v1beta1_MaintenanceRolloutWave(in, out, s)
+}
+
+func autoConvert_v1beta1_MaintenanceRolloutWaveProgress_To_core_MaintenanceRolloutWaveProgress(in *MaintenanceRolloutWaveProgress, out *core.MaintenanceRolloutWaveProgress, s conversion.Scope) error {
+	out.Name = in.Name
+	out.UpdatedShoots = in.UpdatedShoots
+	out.HealthySince = (*metav1.Time)(unsafe.Pointer(in.HealthySince))
+	return nil
+}
+
+// Convert_v1beta1_MaintenanceRolloutWaveProgress_To_core_MaintenanceRolloutWaveProgress is an autogenerated conversion function.
+func Convert_v1beta1_MaintenanceRolloutWaveProgress_To_core_MaintenanceRolloutWaveProgress(in *MaintenanceRolloutWaveProgress, out *core.MaintenanceRolloutWaveProgress, s conversion.Scope) error {
+	return autoConvert_v1beta1_MaintenanceRolloutWaveProgress_To_core_MaintenanceRolloutWaveProgress(in, out, s)
+}
+
+func autoConvert_core_MaintenanceRolloutWaveProgress_To_v1beta1_MaintenanceRolloutWaveProgress(in *core.MaintenanceRolloutWaveProgress, out *MaintenanceRolloutWaveProgress, s conversion.Scope) error {
+	out.Name = in.Name
+	out.UpdatedShoots = in.UpdatedShoots
+	out.HealthySince = (*metav1.Time)(unsafe.Pointer(in.HealthySince))
+	return nil
+}
+
+// Convert_core_MaintenanceRolloutWaveProgress_To_v1beta1_MaintenanceRolloutWaveProgress is an autogenerated conversion function.
+func Convert_core_MaintenanceRolloutWaveProgress_To_v1beta1_MaintenanceRolloutWaveProgress(in *core.MaintenanceRolloutWaveProgress, out *MaintenanceRolloutWaveProgress, s conversion.Scope) error {
+	return autoConvert_core_MaintenanceRolloutWaveProgress_To_v1beta1_MaintenanceRolloutWaveProgress(in, out, s)
+}
+
+func autoConvert_v1beta1_MaintenanceRolloutWaveStatus_To_core_MaintenanceRolloutWaveStatus(in *MaintenanceRolloutWaveStatus, out *core.MaintenanceRolloutWaveStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Shoots = in.Shoots
+	return nil
+}
+
+// Convert_v1beta1_MaintenanceRolloutWaveStatus_To_core_MaintenanceRolloutWaveStatus is an autogenerated conversion function.
+func Convert_v1beta1_MaintenanceRolloutWaveStatus_To_core_MaintenanceRolloutWaveStatus(in *MaintenanceRolloutWaveStatus, out *core.MaintenanceRolloutWaveStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_MaintenanceRolloutWaveStatus_To_core_MaintenanceRolloutWaveStatus(in, out, s)
+}
+
+func autoConvert_core_MaintenanceRolloutWaveStatus_To_v1beta1_MaintenanceRolloutWaveStatus(in *core.MaintenanceRolloutWaveStatus, out *MaintenanceRolloutWaveStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Shoots = in.Shoots
+	return nil
+}
+
+// Convert_core_MaintenanceRolloutWaveStatus_To_v1beta1_MaintenanceRolloutWaveStatus is an autogenerated conversion function.
+func Convert_core_MaintenanceRolloutWaveStatus_To_v1beta1_MaintenanceRolloutWaveStatus(in *core.MaintenanceRolloutWaveStatus, out *MaintenanceRolloutWaveStatus, s conversion.Scope) error {
+	return autoConvert_core_MaintenanceRolloutWaveStatus_To_v1beta1_MaintenanceRolloutWaveStatus(in, out, s)
+}
+
 func autoConvert_v1beta1_MaintenanceTimeWindow_To_core_MaintenanceTimeWindow(in *MaintenanceTimeWindow, out *core.MaintenanceTimeWindow, s conversion.Scope) error {
 	out.Begin = in.Begin
 	out.End = in.End
@@ -4639,6 +5181,34 @@ func Convert_core_MaintenanceTimeWindow_To_v1beta1_MaintenanceTimeWindow(in *cor
 	return autoConvert_core_MaintenanceTimeWindow_To_v1beta1_MaintenanceTimeWindow(in, out, s)
 }
 
+func autoConvert_v1beta1_MaintenanceVersionUpdate_To_core_MaintenanceVersionUpdate(in *MaintenanceVersionUpdate, out *core.MaintenanceVersionUpdate, s conversion.Scope) error {
+	out.Version = in.Version
+	out.TargetVersion = (*string)(unsafe.Pointer(in.TargetVersion))
+	out.Reason = in.Reason
+	out.Forced = in.Forced
+	out.FailureReason = (*string)(unsafe.Pointer(in.FailureReason))
+	return nil
+}
+
+// Convert_v1beta1_MaintenanceVersionUpdate_To_core_MaintenanceVersionUpdate is an autogenerated conversion function.
+func Convert_v1beta1_MaintenanceVersionUpdate_To_core_MaintenanceVersionUpdate(in *MaintenanceVersionUpdate, out *core.MaintenanceVersionUpdate, s conversion.Scope) error {
+	return autoConvert_v1beta1_MaintenanceVersionUpdate_To_core_MaintenanceVersionUpdate(in, out, s)
+}
+
+func autoConvert_core_MaintenanceVersionUpdate_To_v1beta1_MaintenanceVersionUpdate(in *core.MaintenanceVersionUpdate, out *MaintenanceVersionUpdate, s conversion.Scope) error {
+	out.Version = in.Version
+	out.TargetVersion = (*string)(unsafe.Pointer(in.TargetVersion))
+	out.Reason = in.Reason
+	out.Forced = in.Forced
+	out.FailureReason = (*string)(unsafe.Pointer(in.FailureReason))
+	return nil
+}
+
+// Convert_core_MaintenanceVersionUpdate_To_v1beta1_MaintenanceVersionUpdate is an autogenerated conversion function.
+func Convert_core_MaintenanceVersionUpdate_To_v1beta1_MaintenanceVersionUpdate(in *core.MaintenanceVersionUpdate, out *MaintenanceVersionUpdate, s conversion.Scope) error {
+	return autoConvert_core_MaintenanceVersionUpdate_To_v1beta1_MaintenanceVersionUpdate(in, out, s)
+}
+
 func autoConvert_v1beta1_MemorySwapConfiguration_To_core_MemorySwapConfiguration(in *MemorySwapConfiguration, out *core.MemorySwapConfiguration, s conversion.Scope) error {
 	out.SwapBehavior = (*core.SwapBehavior)(unsafe.Pointer(in.SwapBehavior))
 	return nil
@@ -4934,6 +5504,7 @@ func autoConvert_v1beta1_OCIRepository_To_core_OCIRepository(in *OCIRepository,
 	out.Repository = (*string)(unsafe.Pointer(in.Repository))
 	out.Tag = (*string)(unsafe.Pointer(in.Tag))
 	out.Digest = (*string)(unsafe.Pointer(in.Digest))
+	out.PullSecretRef = (*v1.LocalObjectReference)(unsafe.Pointer(in.PullSecretRef))
 	return nil
 }
 
@@ -4947,6 +5518,7 @@ func autoConvert_core_OCIRepository_To_v1beta1_OCIRepository(in *core.OCIReposit
 	out.Repository = (*string)(unsafe.Pointer(in.Repository))
 	out.Tag = (*string)(unsafe.Pointer(in.Tag))
 	out.Digest = (*string)(unsafe.Pointer(in.Digest))
+	out.PullSecretRef = (*v1.LocalObjectReference)(unsafe.Pointer(in.PullSecretRef))
 	return nil
 }
 
@@ -5069,6 +5641,34 @@ func Convert_core_Project_To_v1beta1_Project(in *core.Project, out *Project, s c
 	return autoConvert_core_Project_To_v1beta1_Project(in, out, s)
 }
 
+func autoConvert_v1beta1_ProjectBudget_To_core_ProjectBudget(in *ProjectBudget, out *core.ProjectBudget, s conversion.Scope) error {
+	out.MachineHours = (*resource.Quantity)(unsafe.Pointer(in.MachineHours))
+	out.StorageGibibyteHours = (*resource.Quantity)(unsafe.Pointer(in.StorageGibibyteHours))
+	out.Cost = (*resource.Quantity)(unsafe.Pointer(in.Cost))
+	out.Prices = (*core.BudgetPrices)(unsafe.Pointer(in.Prices))
+	out.WarningThresholdPercentage = (*int32)(unsafe.Pointer(in.WarningThresholdPercentage))
+	return nil
+}
+
+// Convert_v1beta1_ProjectBudget_To_core_ProjectBudget is an autogenerated conversion function.
+func Convert_v1beta1_ProjectBudget_To_core_ProjectBudget(in *ProjectBudget, out *core.ProjectBudget, s conversion.Scope) error {
+	return autoConvert_v1beta1_ProjectBudget_To_core_ProjectBudget(in, out, s)
+}
+
+func autoConvert_core_ProjectBudget_To_v1beta1_ProjectBudget(in *core.ProjectBudget, out *ProjectBudget, s conversion.Scope) error {
+	out.MachineHours = (*resource.Quantity)(unsafe.Pointer(in.MachineHours))
+	out.StorageGibibyteHours = (*resource.Quantity)(unsafe.Pointer(in.StorageGibibyteHours))
+	out.Cost = (*resource.Quantity)(unsafe.Pointer(in.Cost))
+	out.Prices = (*BudgetPrices)(unsafe.Pointer(in.Prices))
+	out.WarningThresholdPercentage = (*int32)(unsafe.Pointer(in.WarningThresholdPercentage))
+	return nil
+}
+
+// Convert_core_ProjectBudget_To_v1beta1_ProjectBudget is an autogenerated conversion function.
+func Convert_core_ProjectBudget_To_v1beta1_ProjectBudget(in *core.ProjectBudget, out *ProjectBudget, s conversion.Scope) error {
+	return autoConvert_core_ProjectBudget_To_v1beta1_ProjectBudget(in, out, s)
+}
+
 func autoConvert_v1beta1_ProjectList_To_core_ProjectList(in *ProjectList, out *core.ProjectList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	if in.Items != nil {
@@ -5143,6 +5743,7 @@ func autoConvert_v1beta1_ProjectSpec_To_core_ProjectSpec(in *ProjectSpec, out *c
 	out.Namespace = (*string)(unsafe.Pointer(in.Namespace))
 	out.Tolerations = (*core.ProjectTolerations)(unsafe.Pointer(in.Tolerations))
 	out.DualApprovalForDeletion = *(*[]core.DualApprovalForDeletion)(unsafe.Pointer(&in.DualApprovalForDeletion))
+	out.Budget = (*core.ProjectBudget)(unsafe.Pointer(in.Budget))
 	return nil
 }
 
@@ -5165,6 +5766,7 @@ func autoConvert_core_ProjectSpec_To_v1beta1_ProjectSpec(in *core.ProjectSpec, o
 	out.Namespace = (*string)(unsafe.Pointer(in.Namespace))
 	out.Tolerations = (*ProjectTolerations)(unsafe.Pointer(in.Tolerations))
 	out.DualApprovalForDeletion = *(*[]DualApprovalForDeletion)(unsafe.Pointer(&in.DualApprovalForDeletion))
+	out.Budget = (*ProjectBudget)(unsafe.Pointer(in.Budget))
 	return nil
 }
 
@@ -5174,6 +5776,7 @@ func autoConvert_v1beta1_ProjectStatus_To_core_ProjectStatus(in *ProjectStatus,
 	out.StaleSinceTimestamp = (*metav1.Time)(unsafe.Pointer(in.StaleSinceTimestamp))
 	out.StaleAutoDeleteTimestamp = (*metav1.Time)(unsafe.Pointer(in.StaleAutoDeleteTimestamp))
 	out.LastActivityTimestamp = (*metav1.Time)(unsafe.Pointer(in.LastActivityTimestamp))
+	out.Usage = (*core.ProjectUsage)(unsafe.Pointer(in.Usage))
 	return nil
 }
 
@@ -5188,6 +5791,7 @@ func autoConvert_core_ProjectStatus_To_v1beta1_ProjectStatus(in *core.ProjectSta
 	out.StaleSinceTimestamp = (*metav1.Time)(unsafe.Pointer(in.StaleSinceTimestamp))
 	out.StaleAutoDeleteTimestamp = (*metav1.Time)(unsafe.Pointer(in.StaleAutoDeleteTimestamp))
 	out.LastActivityTimestamp = (*metav1.Time)(unsafe.Pointer(in.LastActivityTimestamp))
+	out.Usage = (*ProjectUsage)(unsafe.Pointer(in.Usage))
 	return nil
 }
 
@@ -5218,6 +5822,36 @@ func Convert_core_ProjectTolerations_To_v1beta1_ProjectTolerations(in *core.Proj
 	return autoConvert_core_ProjectTolerations_To_v1beta1_ProjectTolerations(in, out, s)
 }
 
+func autoConvert_v1beta1_ProjectUsage_To_core_ProjectUsage(in *ProjectUsage, out *core.ProjectUsage, s conversion.Scope) error {
+	out.PeriodStart = in.PeriodStart
+	out.LastUpdateTime = in.LastUpdateTime
+	out.MachineHours = in.MachineHours
+	out.StorageGibibyteHours = in.StorageGibibyteHours
+	out.Cost = (*resource.Quantity)(unsafe.Pointer(in.Cost))
+	out.BudgetState = core.ProjectBudgetState(in.BudgetState)
+	return nil
+}
+
+// Convert_v1beta1_ProjectUsage_To_core_ProjectUsage is an autogenerated conversion function.
+func Convert_v1beta1_ProjectUsage_To_core_ProjectUsage(in *ProjectUsage, out *core.ProjectUsage, s conversion.Scope) error {
+	return autoConvert_v1beta1_ProjectUsage_To_core_ProjectUsage(in, out, s)
+}
+
+func autoConvert_core_ProjectUsage_To_v1beta1_ProjectUsage(in *core.ProjectUsage, out *ProjectUsage, s conversion.Scope) error {
+	out.PeriodStart = in.PeriodStart
+	out.LastUpdateTime = in.LastUpdateTime
+	out.MachineHours = in.MachineHours
+	out.StorageGibibyteHours = in.StorageGibibyteHours
+	out.Cost = (*resource.Quantity)(unsafe.Pointer(in.Cost))
+	out.BudgetState = ProjectBudgetState(in.BudgetState)
+	return nil
+}
+
+// Convert_core_ProjectUsage_To_v1beta1_ProjectUsage is an autogenerated conversion function.
+func Convert_core_ProjectUsage_To_v1beta1_ProjectUsage(in *core.ProjectUsage, out *ProjectUsage, s conversion.Scope) error {
+	return autoConvert_core_ProjectUsage_To_v1beta1_ProjectUsage(in, out, s)
+}
+
 func autoConvert_v1beta1_Provider_To_core_Provider(in *Provider, out *core.Provider, s conversion.Scope) error {
 	out.Type = in.Type
 	out.ControlPlaneConfig = (*runtime.RawExtension)(unsafe.Pointer(in.ControlPlaneConfig))
@@ -6606,6 +7240,10 @@ func autoConvert_v1beta1_ShootStatus_To_core_ShootStatus(in *ShootStatus, out *c
 	out.LastMaintenance = (*core.LastMaintenance)(unsafe.Pointer(in.LastMaintenance))
 	out.EncryptedResources = *(*[]string)(unsafe.Pointer(&in.EncryptedResources))
 	out.Networking = (*core.NetworkingStatus)(unsafe.Pointer(in.Networking))
+	out.MaintenancePreview = (*core.MaintenancePreview)(unsafe.Pointer(in.MaintenancePreview))
+	out.HibernationSchedule = (*core.HibernationScheduleStatus)(unsafe.Pointer(in.HibernationSchedule))
+	out.LastActivityTime = (*metav1.Time)(unsafe.Pointer(in.LastActivityTime))
+	out.WorkerPools = *(*[]core.WorkerPoolStatus)(unsafe.Pointer(&in.WorkerPools))
 	return nil
 }
 
@@ -6636,6 +7274,10 @@ func autoConvert_core_ShootStatus_To_v1beta1_ShootStatus(in *core.ShootStatus, o
 	out.LastMaintenance = (*LastMaintenance)(unsafe.Pointer(in.LastMaintenance))
 	out.EncryptedResources = *(*[]string)(unsafe.Pointer(&in.EncryptedResources))
 	out.Networking = (*NetworkingStatus)(unsafe.Pointer(in.Networking))
+	out.MaintenancePreview = (*MaintenancePreview)(unsafe.Pointer(in.MaintenancePreview))
+	out.HibernationSchedule = (*HibernationScheduleStatus)(unsafe.Pointer(in.HibernationSchedule))
+	out.LastActivityTime = (*metav1.Time)(unsafe.Pointer(in.LastActivityTime))
+	out.WorkerPools = *(*[]WorkerPoolStatus)(unsafe.Pointer(&in.WorkerPools))
 	return nil
 }
 
@@ -6854,6 +7496,28 @@ func Convert_core_VolumeType_To_v1beta1_VolumeType(in *core.VolumeType, out *Vol
 	return autoConvert_core_VolumeType_To_v1beta1_VolumeType(in, out, s)
 }
 
+func autoConvert_v1beta1_VolumeTypePrice_To_core_VolumeTypePrice(in *VolumeTypePrice, out *core.VolumeTypePrice, s conversion.Scope) error {
+	out.Name = in.Name
+	out.PerGibibyteHour = in.PerGibibyteHour
+	return nil
+}
+
+// Convert_v1beta1_VolumeTypePrice_To_core_VolumeTypePrice is an autogenerated conversion function.
+func Convert_v1beta1_VolumeTypePrice_To_core_VolumeTypePrice(in *VolumeTypePrice, out *core.VolumeTypePrice, s conversion.Scope) error {
+	return autoConvert_v1beta1_VolumeTypePrice_To_core_VolumeTypePrice(in, out, s)
+}
+
+func autoConvert_core_VolumeTypePrice_To_v1beta1_VolumeTypePrice(in *core.VolumeTypePrice, out *VolumeTypePrice, s conversion.Scope) error {
+	out.Name = in.Name
+	out.PerGibibyteHour = in.PerGibibyteHour
+	return nil
+}
+
+// Convert_core_VolumeTypePrice_To_v1beta1_VolumeTypePrice is an autogenerated conversion function.
+func Convert_core_VolumeTypePrice_To_v1beta1_VolumeTypePrice(in *core.VolumeTypePrice, out *VolumeTypePrice, s conversion.Scope) error {
+	return autoConvert_core_VolumeTypePrice_To_v1beta1_VolumeTypePrice(in, out, s)
+}
+
 func autoConvert_v1beta1_WatchCacheSizes_To_core_WatchCacheSizes(in *WatchCacheSizes, out *core.WatchCacheSizes, s conversion.Scope) error {
 	out.Default = (*int32)(unsafe.Pointer(in.Default))
 	out.Resources = *(*[]core.ResourceWatchCacheSize)(unsafe.Pointer(&in.Resources))
@@ -6994,6 +7658,52 @@ func Convert_core_WorkerKubernetes_To_v1beta1_WorkerKubernetes(in *core.WorkerKu
 	return autoConvert_core_WorkerKubernetes_To_v1beta1_WorkerKubernetes(in, out, s)
 }
 
+func autoConvert_v1beta1_WorkerMaintenancePreview_To_core_WorkerMaintenancePreview(in *WorkerMaintenancePreview, out *core.WorkerMaintenancePreview, s conversion.Scope) error {
+	out.Name = in.Name
+	out.KubernetesVersion = (*core.MaintenanceVersionUpdate)(unsafe.Pointer(in.KubernetesVersion))
+	out.MachineImageVersion = (*core.MaintenanceVersionUpdate)(unsafe.Pointer(in.MachineImageVersion))
+	return nil
+}
+
+// Convert_v1beta1_WorkerMaintenancePreview_To_core_WorkerMaintenancePreview is an autogenerated conversion function.
+func Convert_v1beta1_WorkerMaintenancePreview_To_core_WorkerMaintenancePreview(in *WorkerMaintenancePreview, out *core.WorkerMaintenancePreview, s conversion.Scope) error {
+	return autoConvert_v1beta1_WorkerMaintenancePreview_To_core_WorkerMaintenancePreview(in, out, s)
+}
+
+func autoConvert_core_WorkerMaintenancePreview_To_v1beta1_WorkerMaintenancePreview(in *core.WorkerMaintenancePreview, out *WorkerMaintenancePreview, s conversion.Scope) error {
+	out.Name = in.Name
+	out.KubernetesVersion = (*MaintenanceVersionUpdate)(unsafe.Pointer(in.KubernetesVersion))
+	out.MachineImageVersion = (*MaintenanceVersionUpdate)(unsafe.Pointer(in.MachineImageVersion))
+	return nil
+}
+
+// Convert_core_WorkerMaintenancePreview_To_v1beta1_WorkerMaintenancePreview is an autogenerated conversion function.
+func Convert_core_WorkerMaintenancePreview_To_v1beta1_WorkerMaintenancePreview(in *core.WorkerMaintenancePreview, out *WorkerMaintenancePreview, s conversion.Scope) error {
+	return autoConvert_core_WorkerMaintenancePreview_To_v1beta1_WorkerMaintenancePreview(in, out, s)
+}
+
+func autoConvert_v1beta1_WorkerPoolStatus_To_core_WorkerPoolStatus(in *WorkerPoolStatus, out *core.WorkerPoolStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Machines = in.Machines
+	return nil
+}
+
+// Convert_v1beta1_WorkerPoolStatus_To_core_WorkerPoolStatus is an autogenerated conversion function.
+func Convert_v1beta1_WorkerPoolStatus_To_core_WorkerPoolStatus(in *WorkerPoolStatus, out *core.WorkerPoolStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_WorkerPoolStatus_To_core_WorkerPoolStatus(in, out, s)
+}
+
+func autoConvert_core_WorkerPoolStatus_To_v1beta1_WorkerPoolStatus(in *core.WorkerPoolStatus, out *WorkerPoolStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Machines = in.Machines
+	return nil
+}
+
+// Convert_core_WorkerPoolStatus_To_v1beta1_WorkerPoolStatus is an autogenerated conversion function.
+func Convert_core_WorkerPoolStatus_To_v1beta1_WorkerPoolStatus(in *core.WorkerPoolStatus, out *WorkerPoolStatus, s conversion.Scope) error {
+	return autoConvert_core_WorkerPoolStatus_To_v1beta1_WorkerPoolStatus(in, out, s)
+}
+
 func autoConvert_v1beta1_WorkerSystemComponents_To_core_WorkerSystemComponents(in *WorkerSystemComponents, out *core.WorkerSystemComponents, s conversion.Scope) error {
 	out.Allow = in.Allow
 	return nil
@@ -98,6 +98,9 @@ func SetObjectDefaults_Project(in *Project) {
 		a := &in.Spec.Members[i]
 		SetDefaults_ProjectMember(a)
 	}
+	if in.Spec.Budget != nil {
+		SetDefaults_ProjectBudget(in.Spec.Budget)
+	}
 }
 
 func SetObjectDefaults_ProjectList(in *ProjectList) {
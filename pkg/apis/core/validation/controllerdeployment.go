@@ -79,6 +79,12 @@ func ValidateOCIRepository(oci *core.OCIRepository, fldPath *field.Path) field.E
 		return allErrs
 	}
 
+	if oci.PullSecretRef != nil {
+		for _, msg := range apivalidation.NameIsDNSSubdomain(oci.PullSecretRef.Name, false) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("pullSecretRef", "name"), oci.PullSecretRef.Name, msg))
+		}
+	}
+
 	if ptr.Deref(oci.Ref, "") == "" && ptr.Deref(oci.Repository, "") == "" {
 		allErrs = append(allErrs, field.Required(fldPath, "must provide either ref or repository"))
 		return allErrs
@@ -9,6 +9,7 @@ import (
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
 	gomegatypes "github.com/onsi/gomega/types"
+	corev1 "k8s.io/api/core/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -184,6 +185,21 @@ var _ = Describe("#ValidateControllerDeployment", func() {
 				}))))
 			})
 
+			It("should allow a pull secret reference", func() {
+				controllerDeployment.Helm.OCIRepository.PullSecretRef = &corev1.LocalObjectReference{Name: "pull-secret"}
+
+				Expect(ValidateControllerDeployment(controllerDeployment)).To(BeEmpty())
+			})
+
+			It("should forbid an invalid pull secret reference", func() {
+				controllerDeployment.Helm.OCIRepository.PullSecretRef = &corev1.LocalObjectReference{}
+
+				Expect(ValidateControllerDeployment(controllerDeployment)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("helm.ociRepository.pullSecretRef.name"),
+				}))))
+			})
+
 			It("should require setting ociRepository", func() {
 				controllerDeployment.Helm.OCIRepository = nil
 
@@ -0,0 +1,100 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+)
+
+// ValidateMaintenancePolicy validates a MaintenancePolicy object.
+func ValidateMaintenancePolicy(maintenancePolicy *core.MaintenancePolicy) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMeta(&maintenancePolicy.ObjectMeta, false, apivalidation.NameIsDNSLabel, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, ValidateMaintenancePolicySpec(&maintenancePolicy.Spec, field.NewPath("spec"))...)
+
+	return allErrs
+}
+
+// ValidateMaintenancePolicyUpdate validates a MaintenancePolicy object before an update.
+func ValidateMaintenancePolicyUpdate(newMaintenancePolicy, oldMaintenancePolicy *core.MaintenancePolicy) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMetaUpdate(&newMaintenancePolicy.ObjectMeta, &oldMaintenancePolicy.ObjectMeta, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, ValidateMaintenancePolicy(newMaintenancePolicy)...)
+
+	return allErrs
+}
+
+// ValidateMaintenancePolicySpec validates the specification of a MaintenancePolicy object.
+func ValidateMaintenancePolicySpec(spec *core.MaintenancePolicySpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(spec.Waves) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("waves"), "at least one wave must be specified"))
+	}
+
+	names := sets.New[string]()
+	for i, wave := range spec.Waves {
+		idxPath := fldPath.Child("waves").Index(i)
+
+		if len(wave.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "wave name must not be empty"))
+		} else if names.Has(wave.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), wave.Name))
+		}
+		names.Insert(wave.Name)
+
+		if wave.ShootSelector == nil && len(wave.ProjectPurposes) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath, "either shootSelector or projectPurposes must be specified"))
+		}
+		if wave.ShootSelector != nil {
+			allErrs = append(allErrs, metav1validation.ValidateLabelSelector(wave.ShootSelector, metav1validation.LabelSelectorValidationOptions{}, idxPath.Child("shootSelector"))...)
+		}
+
+		purposes := sets.New[string]()
+		for j, purpose := range wave.ProjectPurposes {
+			if len(purpose) == 0 {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("projectPurposes").Index(j), purpose, "project purpose must not be empty"))
+			} else if purposes.Has(purpose) {
+				allErrs = append(allErrs, field.Duplicate(idxPath.Child("projectPurposes").Index(j), purpose))
+			}
+			purposes.Insert(purpose)
+		}
+
+		if wave.SoakDuration != nil && wave.SoakDuration.Duration < 0 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("soakDuration"), wave.SoakDuration.Duration.String(), "soak duration must not be negative"))
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateMaintenancePolicyStatusUpdate validates the status field of a MaintenancePolicy object.
+func ValidateMaintenancePolicyStatusUpdate(newMaintenancePolicy, oldMaintenancePolicy *core.MaintenancePolicy) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMetaUpdate(&newMaintenancePolicy.ObjectMeta, &oldMaintenancePolicy.ObjectMeta, field.NewPath("metadata"))...)
+
+	fldPath := field.NewPath("status")
+	if newMaintenancePolicy.Status.ObservedGeneration < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("observedGeneration"), newMaintenancePolicy.Status.ObservedGeneration, "observed generation must not be negative"))
+	}
+	for i, wave := range newMaintenancePolicy.Status.Waves {
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(wave.Shoots), fldPath.Child("waves").Index(i).Child("shoots"))...)
+	}
+	for i, rollout := range newMaintenancePolicy.Status.Rollouts {
+		for j, wave := range rollout.Waves {
+			allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(wave.UpdatedShoots), fldPath.Child("rollouts").Index(i).Child("waves").Index(j).Child("updatedShoots"))...)
+		}
+	}
+
+	return allErrs
+}
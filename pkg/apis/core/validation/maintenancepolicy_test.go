@@ -0,0 +1,174 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	. "github.com/gardener/gardener/pkg/apis/core/validation"
+)
+
+var _ = Describe("MaintenancePolicy Validation Tests", func() {
+	var maintenancePolicy *core.MaintenancePolicy
+
+	BeforeEach(func() {
+		maintenancePolicy = &core.MaintenancePolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            "default",
+				ResourceVersion: "1",
+			},
+			Spec: core.MaintenancePolicySpec{
+				Waves: []core.MaintenanceRolloutWave{
+					{
+						Name:            "dev",
+						ProjectPurposes: []string{"dev", "evaluation"},
+						SoakDuration:    &metav1.Duration{Duration: 24 * time.Hour},
+					},
+					{
+						Name:          "canary",
+						ShootSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"canary": "true"}},
+					},
+					{
+						Name:            "prod",
+						ProjectPurposes: []string{"prod"},
+					},
+				},
+			},
+		}
+	})
+
+	Describe("#ValidateMaintenancePolicy", func() {
+		It("should pass as maintenance policy is valid", func() {
+			Expect(ValidateMaintenancePolicy(maintenancePolicy)).To(BeEmpty())
+		})
+
+		It("should forbid empty metadata", func() {
+			maintenancePolicy.ObjectMeta = metav1.ObjectMeta{}
+
+			Expect(ValidateMaintenancePolicy(maintenancePolicy)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("metadata.name"),
+			}))))
+		})
+
+		It("should forbid a policy without waves", func() {
+			maintenancePolicy.Spec.Waves = nil
+
+			Expect(ValidateMaintenancePolicy(maintenancePolicy)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.waves"),
+			}))))
+		})
+
+		It("should forbid waves with empty or duplicate names", func() {
+			maintenancePolicy.Spec.Waves[1].Name = ""
+			maintenancePolicy.Spec.Waves[2].Name = "dev"
+
+			Expect(ValidateMaintenancePolicy(maintenancePolicy)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.waves[1].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("spec.waves[2].name"),
+				})),
+			))
+		})
+
+		It("should forbid waves without criteria", func() {
+			maintenancePolicy.Spec.Waves[1].ShootSelector = nil
+
+			Expect(ValidateMaintenancePolicy(maintenancePolicy)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.waves[1]"),
+			}))))
+		})
+
+		It("should forbid invalid shoot selectors", func() {
+			maintenancePolicy.Spec.Waves[1].ShootSelector = &metav1.LabelSelector{MatchLabels: map[string]string{"canary": "no/slash/allowed"}}
+
+			Expect(ValidateMaintenancePolicy(maintenancePolicy)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.waves[1].shootSelector.matchLabels"),
+			}))))
+		})
+
+		It("should forbid empty or duplicate project purposes", func() {
+			maintenancePolicy.Spec.Waves[0].ProjectPurposes = []string{"dev", "", "dev"}
+
+			Expect(ValidateMaintenancePolicy(maintenancePolicy)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.waves[0].projectPurposes[1]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("spec.waves[0].projectPurposes[2]"),
+				})),
+			))
+		})
+
+		It("should forbid negative soak durations", func() {
+			maintenancePolicy.Spec.Waves[0].SoakDuration = &metav1.Duration{Duration: -time.Hour}
+
+			Expect(ValidateMaintenancePolicy(maintenancePolicy)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.waves[0].soakDuration"),
+			}))))
+		})
+	})
+
+	Describe("#ValidateMaintenancePolicyUpdate", func() {
+		It("should allow changing the waves", func() {
+			newMaintenancePolicy := maintenancePolicy.DeepCopy()
+			newMaintenancePolicy.Spec.Waves = newMaintenancePolicy.Spec.Waves[1:]
+
+			Expect(ValidateMaintenancePolicyUpdate(newMaintenancePolicy, maintenancePolicy)).To(BeEmpty())
+		})
+
+		It("should validate the new spec", func() {
+			newMaintenancePolicy := maintenancePolicy.DeepCopy()
+			newMaintenancePolicy.Spec.Waves = nil
+
+			Expect(ValidateMaintenancePolicyUpdate(newMaintenancePolicy, maintenancePolicy)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.waves"),
+			}))))
+		})
+	})
+
+	Describe("#ValidateMaintenancePolicyStatusUpdate", func() {
+		It("should forbid negative numbers of shoots", func() {
+			newMaintenancePolicy := maintenancePolicy.DeepCopy()
+			newMaintenancePolicy.Status = core.MaintenancePolicyStatus{
+				Waves: []core.MaintenanceRolloutWaveStatus{{Name: "dev", Shoots: -1}},
+				Rollouts: []core.MaintenanceRollout{{
+					Kind:    core.MaintenanceRolloutKindKubernetes,
+					Version: "1.30.5",
+					Waves:   []core.MaintenanceRolloutWaveProgress{{Name: "dev", UpdatedShoots: -1}},
+				}},
+			}
+
+			Expect(ValidateMaintenancePolicyStatusUpdate(newMaintenancePolicy, maintenancePolicy)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("status.waves[0].shoots"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("status.rollouts[0].waves[0].updatedShoots"),
+				})),
+			))
+		})
+	})
+})
@@ -19,6 +19,7 @@ import (
 
 	"github.com/gardener/gardener/pkg/apis/core"
 	"github.com/gardener/gardener/pkg/utils"
+	kubernetescorevalidation "github.com/gardener/gardener/pkg/utils/validation/kubernetes/core"
 )
 
 // ValidateProject validates a Project object.
@@ -116,6 +117,10 @@ func ValidateProjectSpec(projectSpec *core.ProjectSpec, fldPath *field.Path) fie
 
 	allErrs = append(allErrs, validateDualApprovalForDeletion(projectSpec.DualApprovalForDeletion, fldPath.Child("dualApprovalForDeletion"))...)
 
+	if projectSpec.Budget != nil {
+		allErrs = append(allErrs, validateProjectBudget(projectSpec.Budget, fldPath.Child("budget"))...)
+	}
+
 	return allErrs
 }
 
@@ -273,6 +278,64 @@ func validateDualApprovalForDeletion(dualApproval []core.DualApprovalForDeletion
 	return allErrs
 }
 
+func validateProjectBudget(budget *core.ProjectBudget, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if budget.MachineHours == nil && budget.StorageGibibyteHours == nil && budget.Cost == nil {
+		allErrs = append(allErrs, field.Required(fldPath, "at least one of machineHours, storageGibibyteHours, or cost must be specified"))
+	}
+
+	if budget.MachineHours != nil {
+		allErrs = append(allErrs, kubernetescorevalidation.ValidateResourceQuantityValue("machineHours", *budget.MachineHours, fldPath.Child("machineHours"))...)
+	}
+	if budget.StorageGibibyteHours != nil {
+		allErrs = append(allErrs, kubernetescorevalidation.ValidateResourceQuantityValue("storageGibibyteHours", *budget.StorageGibibyteHours, fldPath.Child("storageGibibyteHours"))...)
+	}
+	if budget.Cost != nil {
+		allErrs = append(allErrs, kubernetescorevalidation.ValidateResourceQuantityValue("cost", *budget.Cost, fldPath.Child("cost"))...)
+
+		if budget.Prices == nil {
+			allErrs = append(allErrs, field.Required(fldPath.Child("prices"), "prices must be specified when a cost limit is configured"))
+		}
+	}
+
+	if budget.Prices != nil {
+		machineTypes := sets.New[string]()
+		for i, price := range budget.Prices.MachineTypes {
+			idxPath := fldPath.Child("prices", "machineTypes").Index(i)
+
+			if len(price.Name) == 0 {
+				allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must provide a name"))
+			} else if machineTypes.Has(price.Name) {
+				allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), price.Name))
+			}
+			machineTypes.Insert(price.Name)
+
+			allErrs = append(allErrs, kubernetescorevalidation.ValidateResourceQuantityValue("perHour", price.PerHour, idxPath.Child("perHour"))...)
+		}
+
+		volumeTypes := sets.New[string]()
+		for i, price := range budget.Prices.VolumeTypes {
+			idxPath := fldPath.Child("prices", "volumeTypes").Index(i)
+
+			if len(price.Name) == 0 {
+				allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must provide a name"))
+			} else if volumeTypes.Has(price.Name) {
+				allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), price.Name))
+			}
+			volumeTypes.Insert(price.Name)
+
+			allErrs = append(allErrs, kubernetescorevalidation.ValidateResourceQuantityValue("perGibibyteHour", price.PerGibibyteHour, idxPath.Child("perGibibyteHour"))...)
+		}
+	}
+
+	if threshold := budget.WarningThresholdPercentage; threshold != nil && (*threshold < 1 || *threshold > 100) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("warningThresholdPercentage"), *threshold, "must be between 1 and 100"))
+	}
+
+	return allErrs
+}
+
 // ValidateProjectStatusUpdate validates the status field of a Project object.
 func ValidateProjectStatusUpdate(newProject, oldProject *core.Project) field.ErrorList {
 	allErrs := field.ErrorList{}
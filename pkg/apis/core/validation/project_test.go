@@ -12,6 +12,7 @@ import (
 	. "github.com/onsi/gomega/gstruct"
 	gomegatypes "github.com/onsi/gomega/types"
 	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/ptr"
@@ -456,6 +457,105 @@ var _ = Describe("Project Validation Tests", func() {
 			})
 		})
 
+		Context("budget", func() {
+			It("should allow valid budgets", func() {
+				project.Spec.Budget = &core.ProjectBudget{
+					MachineHours:         ptr.To(resource.MustParse("1000")),
+					StorageGibibyteHours: ptr.To(resource.MustParse("50000")),
+					Cost:                 ptr.To(resource.MustParse("500")),
+					Prices: &core.BudgetPrices{
+						MachineTypes: []core.MachineTypePrice{{Name: "m5.large", PerHour: resource.MustParse("0.096")}},
+						VolumeTypes:  []core.VolumeTypePrice{{Name: "gp3", PerGibibyteHour: resource.MustParse("0.0001")}},
+					},
+					WarningThresholdPercentage: ptr.To[int32](80),
+				}
+
+				Expect(ValidateProject(project)).To(BeEmpty())
+			})
+
+			It("should forbid budgets without limits", func() {
+				project.Spec.Budget = &core.ProjectBudget{}
+
+				Expect(ValidateProject(project)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.budget"),
+					})),
+				))
+			})
+
+			It("should forbid negative limits", func() {
+				project.Spec.Budget = &core.ProjectBudget{
+					MachineHours:         ptr.To(resource.MustParse("-1")),
+					StorageGibibyteHours: ptr.To(resource.MustParse("-1")),
+				}
+
+				Expect(ValidateProject(project)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.budget.machineHours"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.budget.storageGibibyteHours"),
+					})),
+				))
+			})
+
+			It("should require prices for cost limits", func() {
+				project.Spec.Budget = &core.ProjectBudget{Cost: ptr.To(resource.MustParse("500"))}
+
+				Expect(ValidateProject(project)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.budget.prices"),
+					})),
+				))
+			})
+
+			It("should forbid invalid prices", func() {
+				project.Spec.Budget = &core.ProjectBudget{
+					MachineHours: ptr.To(resource.MustParse("1000")),
+					Prices: &core.BudgetPrices{
+						MachineTypes: []core.MachineTypePrice{
+							{Name: "m5.large", PerHour: resource.MustParse("0.096")},
+							{Name: "m5.large", PerHour: resource.MustParse("-1")},
+						},
+						VolumeTypes: []core.VolumeTypePrice{{PerGibibyteHour: resource.MustParse("0.0001")}},
+					},
+				}
+
+				Expect(ValidateProject(project)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("spec.budget.prices.machineTypes[1].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.budget.prices.machineTypes[1].perHour"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.budget.prices.volumeTypes[0].name"),
+					})),
+				))
+			})
+
+			It("should forbid invalid warning thresholds", func() {
+				project.Spec.Budget = &core.ProjectBudget{
+					MachineHours:               ptr.To(resource.MustParse("1000")),
+					WarningThresholdPercentage: ptr.To[int32](101),
+				}
+
+				Expect(ValidateProject(project)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.budget.warningThresholdPercentage"),
+					})),
+				))
+			})
+		})
+
 		DescribeTable("namespace immutability",
 			func(old, new *string, matcher gomegatypes.GomegaMatcher) {
 				project.Spec.Namespace = old
@@ -2183,6 +2183,66 @@ func ValidateHibernation(annotations map[string]string, hibernation *core.Hibern
 	}
 
 	allErrs = append(allErrs, ValidateHibernationSchedules(hibernation.Schedules, fldPath.Child("schedules"))...)
+	allErrs = append(allErrs, ValidateHibernationExceptions(hibernation.Exceptions, fldPath.Child("exceptions"))...)
+	allErrs = append(allErrs, ValidateHibernationIdle(hibernation.Idle, fldPath.Child("idle"))...)
+
+	return allErrs
+}
+
+// HibernationIdleTimeoutMinimum is a constant for the minimum value for the idle timeout of a hibernation.
+const HibernationIdleTimeoutMinimum = 30 * time.Minute
+
+// ValidateHibernationIdle validates the idle configuration of a hibernation.
+func ValidateHibernationIdle(idle *core.HibernationIdle, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if idle == nil {
+		return allErrs
+	}
+
+	if idle.Timeout.Duration < HibernationIdleTimeoutMinimum {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("timeout"), idle.Timeout.Duration.String(), fmt.Sprintf("must be at least %s", HibernationIdleTimeoutMinimum)))
+	}
+
+	return allErrs
+}
+
+// ValidateHibernationExceptions validates the exception dates and calendar references of a hibernation.
+func ValidateHibernationExceptions(exceptions *core.HibernationExceptions, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if exceptions == nil {
+		return allErrs
+	}
+
+	seenDates := sets.New[string]()
+	for i, date := range exceptions.Dates {
+		idxPath := fldPath.Child("dates").Index(i)
+
+		if _, err := time.Parse(time.DateOnly, date); err != nil {
+			allErrs = append(allErrs, field.Invalid(idxPath, date, "must be a date in the format YYYY-MM-DD"))
+		} else if seenDates.Has(date) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, date))
+		}
+		seenDates.Insert(date)
+	}
+
+	seenCalendarRefs := sets.New[string]()
+	for i, ref := range exceptions.CalendarRefs {
+		idxPath := fldPath.Child("calendarRefs").Index(i).Child("name")
+
+		if len(ref.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath, "must provide a name"))
+			continue
+		}
+		for _, msg := range apivalidation.NameIsDNSSubdomain(ref.Name, false) {
+			allErrs = append(allErrs, field.Invalid(idxPath, ref.Name, msg))
+		}
+		if seenCalendarRefs.Has(ref.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, ref.Name))
+		}
+		seenCalendarRefs.Insert(ref.Name)
+	}
 
 	return allErrs
 }
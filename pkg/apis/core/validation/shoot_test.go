@@ -6951,6 +6951,61 @@ var _ = Describe("Shoot Validation Tests", func() {
 		)
 	})
 
+	Describe("#ValidateHibernationExceptions", func() {
+		DescribeTable("validate hibernation exceptions",
+			func(exceptions *core.HibernationExceptions, matcher gomegatypes.GomegaMatcher) {
+				Expect(ValidateHibernationExceptions(exceptions, field.NewPath("exceptions"))).To(matcher)
+			},
+			Entry("nil exceptions", nil, BeEmpty()),
+			Entry("valid exceptions",
+				&core.HibernationExceptions{Dates: []string{"2024-12-24", "2024-12-31"}, CalendarRefs: []corev1.LocalObjectReference{{Name: "holidays"}}},
+				BeEmpty()),
+			Entry("invalid and duplicate dates",
+				&core.HibernationExceptions{Dates: []string{"2024-12-24", "24.12.2024", "2024-12-24"}},
+				ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("exceptions.dates[1]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("exceptions.dates[2]"),
+					})),
+				)),
+			Entry("empty, invalid and duplicate calendar references",
+				&core.HibernationExceptions{CalendarRefs: []corev1.LocalObjectReference{{Name: ""}, {Name: "Holidays"}, {Name: "holidays"}, {Name: "holidays"}}},
+				ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("exceptions.calendarRefs[0].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("exceptions.calendarRefs[1].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("exceptions.calendarRefs[3].name"),
+					})),
+				)),
+		)
+	})
+
+	Describe("#ValidateHibernationIdle", func() {
+		DescribeTable("validate hibernation idle configuration",
+			func(idle *core.HibernationIdle, matcher gomegatypes.GomegaMatcher) {
+				Expect(ValidateHibernationIdle(idle, field.NewPath("idle"))).To(matcher)
+			},
+			Entry("nil configuration", nil, BeEmpty()),
+			Entry("valid timeout", &core.HibernationIdle{Timeout: metav1.Duration{Duration: 8 * time.Hour}}, BeEmpty()),
+			Entry("minimum timeout", &core.HibernationIdle{Timeout: metav1.Duration{Duration: 30 * time.Minute}}, BeEmpty()),
+			Entry("too short timeout", &core.HibernationIdle{Timeout: metav1.Duration{Duration: 10 * time.Minute}}, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("idle.timeout"),
+			})))),
+		)
+	})
+
 	Describe("#ValidateHibernationCronSpec", func() {
 		DescribeTable("validate cron spec",
 			func(seenSpecs sets.Set[string], spec string, matcher gomegatypes.GomegaMatcher) {
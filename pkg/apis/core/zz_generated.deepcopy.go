@@ -609,6 +609,36 @@ func (in *BastionMachineType) DeepCopy() *BastionMachineType {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BudgetPrices) DeepCopyInto(out *BudgetPrices) {
+	*out = *in
+	if in.MachineTypes != nil {
+		in, out := &in.MachineTypes, &out.MachineTypes
+		*out = make([]MachineTypePrice, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.VolumeTypes != nil {
+		in, out := &in.VolumeTypes, &out.VolumeTypes
+		*out = make([]VolumeTypePrice, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BudgetPrices.
+func (in *BudgetPrices) DeepCopy() *BudgetPrices {
+	if in == nil {
+		return nil
+	}
+	out := new(BudgetPrices)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CARotation) DeepCopyInto(out *CARotation) {
 	*out = *in
@@ -1933,6 +1963,20 @@ func (in *Hibernation) DeepCopyInto(out *Hibernation) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Exceptions != nil {
+		in, out := &in.Exceptions, &out.Exceptions
+		*out = new(HibernationExceptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AwakeUntil != nil {
+		in, out := &in.AwakeUntil, &out.AwakeUntil
+		*out = (*in).DeepCopy()
+	}
+	if in.Idle != nil {
+		in, out := &in.Idle, &out.Idle
+		*out = new(HibernationIdle)
+		**out = **in
+	}
 	return
 }
 
@@ -1946,6 +1990,49 @@ func (in *Hibernation) DeepCopy() *Hibernation {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HibernationExceptions) DeepCopyInto(out *HibernationExceptions) {
+	*out = *in
+	if in.Dates != nil {
+		in, out := &in.Dates, &out.Dates
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.CalendarRefs != nil {
+		in, out := &in.CalendarRefs, &out.CalendarRefs
+		*out = make([]v1.LocalObjectReference, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HibernationExceptions.
+func (in *HibernationExceptions) DeepCopy() *HibernationExceptions {
+	if in == nil {
+		return nil
+	}
+	out := new(HibernationExceptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HibernationIdle) DeepCopyInto(out *HibernationIdle) {
+	*out = *in
+	out.Timeout = in.Timeout
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HibernationIdle.
+func (in *HibernationIdle) DeepCopy() *HibernationIdle {
+	if in == nil {
+		return nil
+	}
+	out := new(HibernationIdle)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HibernationSchedule) DeepCopyInto(out *HibernationSchedule) {
 	*out = *in
@@ -1977,6 +2064,30 @@ func (in *HibernationSchedule) DeepCopy() *HibernationSchedule {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HibernationScheduleStatus) DeepCopyInto(out *HibernationScheduleStatus) {
+	*out = *in
+	if in.NextHibernationTime != nil {
+		in, out := &in.NextHibernationTime, &out.NextHibernationTime
+		*out = (*in).DeepCopy()
+	}
+	if in.NextWakeUpTime != nil {
+		in, out := &in.NextWakeUpTime, &out.NextWakeUpTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HibernationScheduleStatus.
+func (in *HibernationScheduleStatus) DeepCopy() *HibernationScheduleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(HibernationScheduleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HighAvailability) DeepCopyInto(out *HighAvailability) {
 	*out = *in
@@ -3012,6 +3123,23 @@ func (in *MachineType) DeepCopy() *MachineType {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineTypePrice) DeepCopyInto(out *MachineTypePrice) {
+	*out = *in
+	out.PerHour = in.PerHour.DeepCopy()
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineTypePrice.
+func (in *MachineTypePrice) DeepCopy() *MachineTypePrice {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineTypePrice)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MachineTypeStorage) DeepCopyInto(out *MachineTypeStorage) {
 	*out = *in
@@ -3090,6 +3218,251 @@ func (in *MaintenanceAutoUpdate) DeepCopy() *MaintenanceAutoUpdate {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenancePolicy) DeepCopyInto(out *MaintenancePolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenancePolicy.
+func (in *MaintenancePolicy) DeepCopy() *MaintenancePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenancePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MaintenancePolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenancePolicyList) DeepCopyInto(out *MaintenancePolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MaintenancePolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenancePolicyList.
+func (in *MaintenancePolicyList) DeepCopy() *MaintenancePolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenancePolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MaintenancePolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenancePolicySpec) DeepCopyInto(out *MaintenancePolicySpec) {
+	*out = *in
+	if in.Waves != nil {
+		in, out := &in.Waves, &out.Waves
+		*out = make([]MaintenanceRolloutWave, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenancePolicySpec.
+func (in *MaintenancePolicySpec) DeepCopy() *MaintenancePolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenancePolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenancePolicyStatus) DeepCopyInto(out *MaintenancePolicyStatus) {
+	*out = *in
+	if in.Waves != nil {
+		in, out := &in.Waves, &out.Waves
+		*out = make([]MaintenanceRolloutWaveStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.Rollouts != nil {
+		in, out := &in.Rollouts, &out.Rollouts
+		*out = make([]MaintenanceRollout, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenancePolicyStatus.
+func (in *MaintenancePolicyStatus) DeepCopy() *MaintenancePolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenancePolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenancePreview) DeepCopyInto(out *MaintenancePreview) {
+	*out = *in
+	if in.FailureReason != nil {
+		in, out := &in.FailureReason, &out.FailureReason
+		*out = new(string)
+		**out = **in
+	}
+	in.LastUpdateTime.DeepCopyInto(&out.LastUpdateTime)
+	if in.NextMaintenanceTime != nil {
+		in, out := &in.NextMaintenanceTime, &out.NextMaintenanceTime
+		*out = (*in).DeepCopy()
+	}
+	if in.KubernetesVersion != nil {
+		in, out := &in.KubernetesVersion, &out.KubernetesVersion
+		*out = new(MaintenanceVersionUpdate)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Workers != nil {
+		in, out := &in.Workers, &out.Workers
+		*out = make([]WorkerMaintenancePreview, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SpecChanges != nil {
+		in, out := &in.SpecChanges, &out.SpecChanges
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenancePreview.
+func (in *MaintenancePreview) DeepCopy() *MaintenancePreview {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenancePreview)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceRollout) DeepCopyInto(out *MaintenanceRollout) {
+	*out = *in
+	if in.Waves != nil {
+		in, out := &in.Waves, &out.Waves
+		*out = make([]MaintenanceRolloutWaveProgress, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceRollout.
+func (in *MaintenanceRollout) DeepCopy() *MaintenanceRollout {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceRollout)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceRolloutWave) DeepCopyInto(out *MaintenanceRolloutWave) {
+	*out = *in
+	if in.ShootSelector != nil {
+		in, out := &in.ShootSelector, &out.ShootSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProjectPurposes != nil {
+		in, out := &in.ProjectPurposes, &out.ProjectPurposes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SoakDuration != nil {
+		in, out := &in.SoakDuration, &out.SoakDuration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceRolloutWave.
+func (in *MaintenanceRolloutWave) DeepCopy() *MaintenanceRolloutWave {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceRolloutWave)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceRolloutWaveProgress) DeepCopyInto(out *MaintenanceRolloutWaveProgress) {
+	*out = *in
+	if in.HealthySince != nil {
+		in, out := &in.HealthySince, &out.HealthySince
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceRolloutWaveProgress.
+func (in *MaintenanceRolloutWaveProgress) DeepCopy() *MaintenanceRolloutWaveProgress {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceRolloutWaveProgress)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceRolloutWaveStatus) DeepCopyInto(out *MaintenanceRolloutWaveStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceRolloutWaveStatus.
+func (in *MaintenanceRolloutWaveStatus) DeepCopy() *MaintenanceRolloutWaveStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceRolloutWaveStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MaintenanceTimeWindow) DeepCopyInto(out *MaintenanceTimeWindow) {
 	*out = *in
@@ -3106,6 +3479,32 @@ func (in *MaintenanceTimeWindow) DeepCopy() *MaintenanceTimeWindow {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceVersionUpdate) DeepCopyInto(out *MaintenanceVersionUpdate) {
+	*out = *in
+	if in.TargetVersion != nil {
+		in, out := &in.TargetVersion, &out.TargetVersion
+		*out = new(string)
+		**out = **in
+	}
+	if in.FailureReason != nil {
+		in, out := &in.FailureReason, &out.FailureReason
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceVersionUpdate.
+func (in *MaintenanceVersionUpdate) DeepCopy() *MaintenanceVersionUpdate {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceVersionUpdate)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MemorySwapConfiguration) DeepCopyInto(out *MemorySwapConfiguration) {
 	*out = *in
@@ -3466,6 +3865,11 @@ func (in *OCIRepository) DeepCopyInto(out *OCIRepository) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.PullSecretRef != nil {
+		in, out := &in.PullSecretRef, &out.PullSecretRef
+		*out = new(v1.LocalObjectReference)
+		**out = **in
+	}
 	return
 }
 
@@ -3627,6 +4031,47 @@ func (in *Project) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectBudget) DeepCopyInto(out *ProjectBudget) {
+	*out = *in
+	if in.MachineHours != nil {
+		in, out := &in.MachineHours, &out.MachineHours
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.StorageGibibyteHours != nil {
+		in, out := &in.StorageGibibyteHours, &out.StorageGibibyteHours
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.Cost != nil {
+		in, out := &in.Cost, &out.Cost
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.Prices != nil {
+		in, out := &in.Prices, &out.Prices
+		*out = new(BudgetPrices)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WarningThresholdPercentage != nil {
+		in, out := &in.WarningThresholdPercentage, &out.WarningThresholdPercentage
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectBudget.
+func (in *ProjectBudget) DeepCopy() *ProjectBudget {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectBudget)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProjectList) DeepCopyInto(out *ProjectList) {
 	*out = *in
@@ -3729,6 +4174,11 @@ func (in *ProjectSpec) DeepCopyInto(out *ProjectSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Budget != nil {
+		in, out := &in.Budget, &out.Budget
+		*out = new(ProjectBudget)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -3757,6 +4207,11 @@ func (in *ProjectStatus) DeepCopyInto(out *ProjectStatus) {
 		in, out := &in.LastActivityTimestamp, &out.LastActivityTimestamp
 		*out = (*in).DeepCopy()
 	}
+	if in.Usage != nil {
+		in, out := &in.Usage, &out.Usage
+		*out = new(ProjectUsage)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -3800,6 +4255,31 @@ func (in *ProjectTolerations) DeepCopy() *ProjectTolerations {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectUsage) DeepCopyInto(out *ProjectUsage) {
+	*out = *in
+	in.PeriodStart.DeepCopyInto(&out.PeriodStart)
+	in.LastUpdateTime.DeepCopyInto(&out.LastUpdateTime)
+	out.MachineHours = in.MachineHours.DeepCopy()
+	out.StorageGibibyteHours = in.StorageGibibyteHours.DeepCopy()
+	if in.Cost != nil {
+		in, out := &in.Cost, &out.Cost
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectUsage.
+func (in *ProjectUsage) DeepCopy() *ProjectUsage {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectUsage)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Provider) DeepCopyInto(out *Provider) {
 	*out = *in
@@ -5406,6 +5886,25 @@ func (in *ShootStatus) DeepCopyInto(out *ShootStatus) {
 		*out = new(NetworkingStatus)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.MaintenancePreview != nil {
+		in, out := &in.MaintenancePreview, &out.MaintenancePreview
+		*out = new(MaintenancePreview)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HibernationSchedule != nil {
+		in, out := &in.HibernationSchedule, &out.HibernationSchedule
+		*out = new(HibernationScheduleStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LastActivityTime != nil {
+		in, out := &in.LastActivityTime, &out.LastActivityTime
+		*out = (*in).DeepCopy()
+	}
+	if in.WorkerPools != nil {
+		in, out := &in.WorkerPools, &out.WorkerPools
+		*out = make([]WorkerPoolStatus, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -5659,6 +6158,23 @@ func (in *VolumeType) DeepCopy() *VolumeType {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeTypePrice) DeepCopyInto(out *VolumeTypePrice) {
+	*out = *in
+	out.PerGibibyteHour = in.PerGibibyteHour.DeepCopy()
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeTypePrice.
+func (in *VolumeTypePrice) DeepCopy() *VolumeTypePrice {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeTypePrice)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WatchCacheSizes) DeepCopyInto(out *WatchCacheSizes) {
 	*out = *in
@@ -5825,6 +6341,48 @@ func (in *WorkerKubernetes) DeepCopy() *WorkerKubernetes {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkerMaintenancePreview) DeepCopyInto(out *WorkerMaintenancePreview) {
+	*out = *in
+	if in.KubernetesVersion != nil {
+		in, out := &in.KubernetesVersion, &out.KubernetesVersion
+		*out = new(MaintenanceVersionUpdate)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MachineImageVersion != nil {
+		in, out := &in.MachineImageVersion, &out.MachineImageVersion
+		*out = new(MaintenanceVersionUpdate)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerMaintenancePreview.
+func (in *WorkerMaintenancePreview) DeepCopy() *WorkerMaintenancePreview {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkerMaintenancePreview)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkerPoolStatus) DeepCopyInto(out *WorkerPoolStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerPoolStatus.
+func (in *WorkerPoolStatus) DeepCopy() *WorkerPoolStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkerPoolStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkerSystemComponents) DeepCopyInto(out *WorkerSystemComponents) {
 	*out = *in
@@ -17,6 +17,23 @@ const (
 	Ignore = "resources.gardener.cloud/ignore"
 	// SkipHealthCheck is an annotation that dictates whether a resource should be ignored during health check.
 	SkipHealthCheck = "resources.gardener.cloud/skip-health-check"
+	// HealthCheckExpression is an annotation on a resource managed by a ManagedResource which contains a CEL expression
+	// that must evaluate to true for the resource to be considered healthy. It is only considered for resources which are
+	// not covered by the dedicated health checks.
+	HealthCheckExpression = "resources.gardener.cloud/health-check-expression"
+	// ApplyPhase is an annotation on a resource managed by a ManagedResource which contains the phase (an integer) in
+	// which the resource is applied. Resources of a phase are only applied once all resources of the previous phases are
+	// healthy. Resources without this annotation belong to phase 0.
+	ApplyPhase = "resources.gardener.cloud/apply-phase"
+	// DriftPolicy is an annotation on a resource managed by a ManagedResource which defines how modifications of the
+	// resource by other field managers (drift) are handled if drift detection is enabled. Defaults to `Revert`.
+	DriftPolicy = "resources.gardener.cloud/drift-policy"
+	// DriftPolicyRevert is a constant for the value of the drift-policy annotation. Detected drift is reported and
+	// reverted immediately.
+	DriftPolicyRevert = "Revert"
+	// DriftPolicyReport is a constant for the value of the drift-policy annotation. Detected drift is only reported and
+	// not reverted until the desired state of the resource in the ManagedResource changes.
+	DriftPolicyReport = "Report"
 	// DeleteOnInvalidUpdate is a constant for an annotation on a resource managed by a ManagedResource. If set to
 	// true then the controller will delete the object in case it faces an "Invalid" response during an update operation.
 	DeleteOnInvalidUpdate = "resources.gardener.cloud/delete-on-invalid-update"
@@ -42,6 +59,10 @@ const (
 	// It is set by the ManagedResource controller to the key of the owning ManagedResource, optionally prefixed with the
 	// clusterID.
 	OriginAnnotation = "resources.gardener.cloud/origin"
+	// Preview is a constant for an annotation on a ManagedResource. If set to true then the controller does not apply
+	// the resources but computes the changes that would be made to the target cluster with server-side dry-run requests
+	// and reports them in the `.status.preview` field.
+	Preview = "resources.gardener.cloud/preview"
 	// FinalizeDeletionAfter is an annotation on an object part of a ManagedResource that whose value states the
 	// duration after which a deletion should be finalized (i.e., removal of `.metadata.finalizers[]`).
 	FinalizeDeletionAfter = "resources.gardener.cloud/finalize-deletion-after"
@@ -260,8 +281,24 @@ type ManagedResourceSpec struct {
 	// resource, should also be deleted when the corresponding StatefulSet is deleted (defaults to false).
 	// +optional
 	DeletePersistentVolumeClaims *bool `json:"deletePersistentVolumeClaims,omitempty"`
+	// ApplyMode specifies how the resources are applied to the target cluster (defaults to `Update`). With
+	// `ServerSideApply`, the resources are applied with server-side apply and fields owned by other field managers are
+	// preserved.
+	// +optional
+	ApplyMode *ApplyMode `json:"applyMode,omitempty"`
 }
 
+// ApplyMode is the mode used for applying the resources of a ManagedResource to the target cluster.
+type ApplyMode string
+
+const (
+	// ApplyModeUpdate means that the resources are merged with the existing objects and applied with update requests.
+	ApplyModeUpdate ApplyMode = "Update"
+	// ApplyModeServerSideApply means that the resources are applied with server-side apply. Conflicts with other field
+	// managers are not forced but reported in the `ResourcesApplied` condition.
+	ApplyModeServerSideApply ApplyMode = "ServerSideApply"
+)
+
 // ManagedResourceStatus is the status of a managed resource.
 type ManagedResourceStatus struct {
 	Conditions []gardencorev1beta1.Condition `json:"conditions,omitempty"`
@@ -273,6 +310,56 @@ type ManagedResourceStatus struct {
 	// SecretsDataChecksum is the checksum of referenced secrets data.
 	// +optional
 	SecretsDataChecksum *string `json:"secretsDataChecksum,omitempty"`
+	// Preview contains the changes that would be made to the target cluster when applying the resources. It is only
+	// maintained while the ManagedResource is annotated with `resources.gardener.cloud/preview=true`.
+	// +optional
+	Preview *ManagedResourcePreview `json:"preview,omitempty"`
+}
+
+// ManagedResourcePreview contains the changes that would be made to the target cluster when applying the resources.
+type ManagedResourcePreview struct {
+	// SecretsDataChecksum is the checksum of the referenced secrets data the preview was computed for.
+	SecretsDataChecksum string `json:"secretsDataChecksum"`
+	// LastUpdateTime is the last time the computed changes differed from the previous preview.
+	LastUpdateTime metav1.Time `json:"lastUpdateTime"`
+	// Changes is a list of objects that would be created, updated or deleted.
+	// +optional
+	Changes []ObjectChange `json:"changes,omitempty"`
+}
+
+// ObjectChange describes a change of an object in the target cluster.
+type ObjectChange struct {
+	corev1.ObjectReference `json:",inline"`
+	// Action is the action that would be performed for the object.
+	Action ObjectChangeAction `json:"action"`
+	// Fields is a list of fields that would be changed by an update of the object. It is truncated if too many fields
+	// would be changed.
+	// +optional
+	Fields []FieldChange `json:"fields,omitempty"`
+}
+
+// ObjectChangeAction is the action that would be performed for an object.
+type ObjectChangeAction string
+
+const (
+	// ObjectChangeActionCreate means that the object would be created.
+	ObjectChangeActionCreate ObjectChangeAction = "Create"
+	// ObjectChangeActionUpdate means that the object would be updated.
+	ObjectChangeActionUpdate ObjectChangeAction = "Update"
+	// ObjectChangeActionDelete means that the object would be deleted.
+	ObjectChangeActionDelete ObjectChangeAction = "Delete"
+)
+
+// FieldChange describes a change of a field of an object.
+type FieldChange struct {
+	// Path is the path of the field, e.g. `spec.template.spec.containers[0].image`.
+	Path string `json:"path"`
+	// Old is the JSON encoded current value of the field. It is empty if the field would be added or if the object is a Secret.
+	// +optional
+	Old string `json:"old,omitempty"`
+	// New is the JSON encoded new value of the field. It is empty if the field would be removed or if the object is a Secret.
+	// +optional
+	New string `json:"new,omitempty"`
 }
 
 // ObjectReference is a reference to another object.
@@ -291,6 +378,8 @@ const (
 	ResourcesHealthy gardencorev1beta1.ConditionType = "ResourcesHealthy"
 	// ResourcesProgressing is a condition type that indicates whether some resources are still progressing to be rolled out.
 	ResourcesProgressing gardencorev1beta1.ConditionType = "ResourcesProgressing"
+	// DriftDetected is a condition type that indicates whether resources have been modified by other field managers.
+	DriftDetected gardencorev1beta1.ConditionType = "DriftDetected"
 )
 
 // These are well-known reasons for Conditions.
@@ -319,7 +408,26 @@ const (
 	// ConditionManagedResourceIgnored indicates that the ManagedResource's conditions are not checked,
 	// because the ManagedResource is marked to be ignored.
 	ConditionManagedResourceIgnored = "ManagedResourceIgnored"
+	// ConditionChangesPreviewed indicates that the `ResourcesApplied` condition is `Progressing`,
+	// because the ManagedResource is in preview mode and there are changes which have not been applied yet.
+	ConditionChangesPreviewed = "ChangesPreviewed"
+	// ConditionApplyConflict indicates that the `ResourcesApplied` condition is `False`,
+	// because applying the resources with server-side apply failed due to conflicts with other field managers.
+	ConditionApplyConflict = "ApplyConflict"
+	// ConditionApplyPhasePending indicates that the `ResourcesApplied` condition is `Progressing`,
+	// because resources of an apply phase are not applied yet since resources of a previous phase are not healthy yet.
+	ConditionApplyPhasePending = "ApplyPhasePending"
 	// ConditionChecksPending indicates that the `ResourcesProgressing` condition is `Unknown`,
 	// because the condition checks have not been completely executed yet for the current set of resources.
 	ConditionChecksPending = "ChecksPending"
+	// ConditionNoDriftDetected indicates that the `DriftDetected` condition is `False`,
+	// because no resources have been modified by other field managers.
+	ConditionNoDriftDetected = "NoDriftDetected"
+	// ConditionDriftReported indicates that the `DriftDetected` condition is `True`,
+	// because resources with the `Report` drift policy have been modified by other field managers.
+	ConditionDriftReported = "DriftReported"
+	// ConditionDriftReverting indicates that the `DriftDetected` condition is `True`,
+	// because resources with the `Revert` drift policy have been modified by other field managers and the
+	// modifications are being reverted.
+	ConditionDriftReverting = "DriftReverting"
 )
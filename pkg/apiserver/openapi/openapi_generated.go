@@ -55,6 +55,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Bastion":                                    schema_pkg_apis_core_v1beta1_Bastion(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.BastionMachineImage":                        schema_pkg_apis_core_v1beta1_BastionMachineImage(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.BastionMachineType":                         schema_pkg_apis_core_v1beta1_BastionMachineType(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.BudgetPrices":                               schema_pkg_apis_core_v1beta1_BudgetPrices(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.CARotation":                                 schema_pkg_apis_core_v1beta1_CARotation(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.CRI":                                        schema_pkg_apis_core_v1beta1_CRI(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.CloudProfile":                               schema_pkg_apis_core_v1beta1_CloudProfile(ref),
@@ -100,7 +101,10 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.GardenerResourceData":                       schema_pkg_apis_core_v1beta1_GardenerResourceData(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.HelmControllerDeployment":                   schema_pkg_apis_core_v1beta1_HelmControllerDeployment(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Hibernation":                                schema_pkg_apis_core_v1beta1_Hibernation(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.HibernationExceptions":                      schema_pkg_apis_core_v1beta1_HibernationExceptions(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.HibernationIdle":                            schema_pkg_apis_core_v1beta1_HibernationIdle(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.HibernationSchedule":                        schema_pkg_apis_core_v1beta1_HibernationSchedule(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.HibernationScheduleStatus":                  schema_pkg_apis_core_v1beta1_HibernationScheduleStatus(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.HighAvailability":                           schema_pkg_apis_core_v1beta1_HighAvailability(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.HorizontalPodAutoscalerConfig":              schema_pkg_apis_core_v1beta1_HorizontalPodAutoscalerConfig(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Ingress":                                    schema_pkg_apis_core_v1beta1_Ingress(ref),
@@ -129,10 +133,21 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.MachineImage":                               schema_pkg_apis_core_v1beta1_MachineImage(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.MachineImageVersion":                        schema_pkg_apis_core_v1beta1_MachineImageVersion(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.MachineType":                                schema_pkg_apis_core_v1beta1_MachineType(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.MachineTypePrice":                           schema_pkg_apis_core_v1beta1_MachineTypePrice(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.MachineTypeStorage":                         schema_pkg_apis_core_v1beta1_MachineTypeStorage(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Maintenance":                                schema_pkg_apis_core_v1beta1_Maintenance(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.MaintenanceAutoUpdate":                      schema_pkg_apis_core_v1beta1_MaintenanceAutoUpdate(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.MaintenancePolicy":                          schema_pkg_apis_core_v1beta1_MaintenancePolicy(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.MaintenancePolicyList":                      schema_pkg_apis_core_v1beta1_MaintenancePolicyList(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.MaintenancePolicySpec":                      schema_pkg_apis_core_v1beta1_MaintenancePolicySpec(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.MaintenancePolicyStatus":                    schema_pkg_apis_core_v1beta1_MaintenancePolicyStatus(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.MaintenancePreview":                         schema_pkg_apis_core_v1beta1_MaintenancePreview(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.MaintenanceRollout":                         schema_pkg_apis_core_v1beta1_MaintenanceRollout(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.MaintenanceRolloutWave":                     schema_pkg_apis_core_v1beta1_MaintenanceRolloutWave(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.MaintenanceRolloutWaveProgress":             schema_pkg_apis_core_v1beta1_MaintenanceRolloutWaveProgress(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.MaintenanceRolloutWaveStatus":               schema_pkg_apis_core_v1beta1_MaintenanceRolloutWaveStatus(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.MaintenanceTimeWindow":                      schema_pkg_apis_core_v1beta1_MaintenanceTimeWindow(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.MaintenanceVersionUpdate":                   schema_pkg_apis_core_v1beta1_MaintenanceVersionUpdate(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.MemorySwapConfiguration":                    schema_pkg_apis_core_v1beta1_MemorySwapConfiguration(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Monitoring":                                 schema_pkg_apis_core_v1beta1_Monitoring(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.NamedResourceReference":                     schema_pkg_apis_core_v1beta1_NamedResourceReference(ref),
@@ -149,11 +164,13 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ObservabilityRotation":                      schema_pkg_apis_core_v1beta1_ObservabilityRotation(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.OpenIDConnectClientAuthentication":          schema_pkg_apis_core_v1beta1_OpenIDConnectClientAuthentication(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Project":                                    schema_pkg_apis_core_v1beta1_Project(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectBudget":                              schema_pkg_apis_core_v1beta1_ProjectBudget(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectList":                                schema_pkg_apis_core_v1beta1_ProjectList(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectMember":                              schema_pkg_apis_core_v1beta1_ProjectMember(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectSpec":                                schema_pkg_apis_core_v1beta1_ProjectSpec(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectStatus":                              schema_pkg_apis_core_v1beta1_ProjectStatus(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectTolerations":                         schema_pkg_apis_core_v1beta1_ProjectTolerations(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectUsage":                               schema_pkg_apis_core_v1beta1_ProjectUsage(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Provider":                                   schema_pkg_apis_core_v1beta1_Provider(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Quota":                                      schema_pkg_apis_core_v1beta1_Quota(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.QuotaList":                                  schema_pkg_apis_core_v1beta1_QuotaList(ref),
@@ -214,9 +231,12 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.VerticalPodAutoscaler":                      schema_pkg_apis_core_v1beta1_VerticalPodAutoscaler(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Volume":                                     schema_pkg_apis_core_v1beta1_Volume(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.VolumeType":                                 schema_pkg_apis_core_v1beta1_VolumeType(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.VolumeTypePrice":                            schema_pkg_apis_core_v1beta1_VolumeTypePrice(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.WatchCacheSizes":                            schema_pkg_apis_core_v1beta1_WatchCacheSizes(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Worker":                                     schema_pkg_apis_core_v1beta1_Worker(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerKubernetes":                           schema_pkg_apis_core_v1beta1_WorkerKubernetes(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerMaintenancePreview":                   schema_pkg_apis_core_v1beta1_WorkerMaintenancePreview(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerPoolStatus":                           schema_pkg_apis_core_v1beta1_WorkerPoolStatus(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerSystemComponents":                     schema_pkg_apis_core_v1beta1_WorkerSystemComponents(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkersSettings":                            schema_pkg_apis_core_v1beta1_WorkersSettings(ref),
 		"github.com/gardener/gardener/pkg/apis/operations/v1alpha1.Bastion":                             schema_pkg_apis_operations_v1alpha1_Bastion(ref),
@@ -1003,9 +1023,17 @@ func schema_pkg_apis_core_v1_OCIRepository(ref common.ReferenceCallback) common.
 							Format:      "",
 						},
 					},
+					"pullSecretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PullSecretRef is a reference to a secret containing the pull secret. The secret must be of type `kubernetes.io/dockerconfigjson` and must be located in the `garden` namespace. For usage in the gardenlet, the secret must have the label `gardener.cloud/role=helm-pull-secret`.",
+							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
+						},
+					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.LocalObjectReference"},
 	}
 }
 
@@ -1845,6 +1873,49 @@ func schema_pkg_apis_core_v1beta1_BastionMachineType(ref common.ReferenceCallbac
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_BudgetPrices(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BudgetPrices is a price table for machine and volume types.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"machineTypes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MachineTypes contains the prices of machine types.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.MachineTypePrice"),
+									},
+								},
+							},
+						},
+					},
+					"volumeTypes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VolumeTypes contains the prices of volume types.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.VolumeTypePrice"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.MachineTypePrice", "github.com/gardener/gardener/pkg/apis/core/v1beta1.VolumeTypePrice"},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_CARotation(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -3900,11 +3971,95 @@ func schema_pkg_apis_core_v1beta1_Hibernation(ref common.ReferenceCallback) comm
 							},
 						},
 					},
+					"exceptions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Exceptions contains the dates on which the hibernation schedules are not applied.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.HibernationExceptions"),
+						},
+					},
+					"awakeUntil": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AwakeUntil is a one-off override which keeps the Shoot awake until the given time, i.e., the Shoot is woken up and the hibernation schedules are not applied before this time. The override is removed once it has expired and the Shoot is hibernated or woken up according to its hibernation schedules.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"idle": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Idle configures the hibernation of the Shoot after it has been idle for a certain period of time.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.HibernationIdle"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.HibernationExceptions", "github.com/gardener/gardener/pkg/apis/core/v1beta1.HibernationIdle", "github.com/gardener/gardener/pkg/apis/core/v1beta1.HibernationSchedule", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_HibernationExceptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HibernationExceptions contains the dates on which the hibernation schedules of a Shoot are not applied, i.e., the Shoot is neither hibernated nor woken up at the start or end times which fall on these dates (evaluated in the location of the respective schedule).",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"dates": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Dates is a list of dates in the format `YYYY-MM-DD`.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"calendarRefs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CalendarRefs is a list of references to ConfigMaps in the namespace of the Shoot which contain a calendar in the iCalendar format (RFC 5545) in their `calendar.ics` data key, e.g., holiday calendars. The days of all events of these calendars are treated as exception dates.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.LocalObjectReference"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.LocalObjectReference"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_HibernationIdle(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HibernationIdle configures the hibernation of a Shoot after it has been idle for a certain period of time. A Shoot is considered idle if neither API requests of non-system users nor running non-system workloads are observed.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"timeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Timeout is the period of time after which an idle Shoot is hibernated.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
 				},
+				Required: []string{"timeout"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.HibernationSchedule"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
@@ -3942,6 +4097,33 @@ func schema_pkg_apis_core_v1beta1_HibernationSchedule(ref common.ReferenceCallba
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_HibernationScheduleStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HibernationScheduleStatus holds the next times at which the Shoot will be hibernated and woken up according to its hibernation schedules.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"nextHibernationTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NextHibernationTime is the next time at which the Shoot will be hibernated.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"nextWakeUpTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NextWakeUpTime is the next time at which the Shoot will be woken up.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_HighAvailability(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -5490,6 +5672,36 @@ func schema_pkg_apis_core_v1beta1_MachineType(ref common.ReferenceCallback) comm
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_MachineTypePrice(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MachineTypePrice is the price of a machine type.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the machine type as defined in the CloudProfile.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"perHour": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PerHour is the price of a machine of this type per hour.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+				},
+				Required: []string{"name", "perHour"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_MachineTypeStorage(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -5597,101 +5809,584 @@ func schema_pkg_apis_core_v1beta1_MaintenanceAutoUpdate(ref common.ReferenceCall
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_MaintenanceTimeWindow(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_MaintenancePolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "MaintenanceTimeWindow contains information about the time window for maintenance operations.",
+				Description: "MaintenancePolicy stages the rollout of automatic Kubernetes and machine image version updates across Shoots. Shoots are grouped into waves, and a version is only rolled out to a wave once the Shoots of all previous waves which already use it have been healthy for the soak duration of their wave.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"begin": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Begin is the beginning of the time window in the format HHMMSS+ZONE, e.g. \"220000+0100\". If not present, a random value will be computed.",
-							Default:     "",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"end": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "End is the end of the time window in the format HHMMSS+ZONE, e.g. \"220000+0100\". If not present, the value will be computed based on the \"Begin\" value.",
-							Default:     "",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard object metadata.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec contains the specification of this maintenance policy.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.MaintenancePolicySpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status contains the most recently observed rollout progress of this maintenance policy.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.MaintenancePolicyStatus"),
+						},
+					},
 				},
-				Required: []string{"begin", "end"},
+				Required: []string{"spec"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.MaintenancePolicySpec", "github.com/gardener/gardener/pkg/apis/core/v1beta1.MaintenancePolicyStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_MemorySwapConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_MaintenancePolicyList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "MemorySwapConfiguration contains kubelet swap configuration For more information, please see KEP: 2400-node-swap",
+				Description: "MaintenancePolicyList is a collection of MaintenancePolicies.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"swapBehavior": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SwapBehavior configures swap memory available to container workloads. May be one of {\"LimitedSwap\", \"UnlimitedSwap\"} defaults to: LimitedSwap",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard list object metadata.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Items is the list of MaintenancePolicies.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.MaintenancePolicy"),
+									},
+								},
+							},
+						},
+					},
 				},
+				Required: []string{"items"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.MaintenancePolicy", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_Monitoring(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_MaintenancePolicySpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Monitoring contains information about the monitoring configuration for the shoot.",
+				Description: "MaintenancePolicySpec is the specification of a MaintenancePolicy.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"alerting": {
+					"waves": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Alerting contains information about the alerting configuration for the shoot cluster.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.Alerting"),
+							Description: "Waves is the ordered list of rollout waves. A Shoot belongs to the first wave it matches. Shoots which do not match any wave are not affected by this policy.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.MaintenanceRolloutWave"),
+									},
+								},
+							},
 						},
 					},
 				},
+				Required: []string{"waves"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.Alerting"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.MaintenanceRolloutWave"},
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_NamedResourceReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_MaintenancePolicyStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "NamedResourceReference is a named reference to a resource.",
+				Description: "MaintenancePolicyStatus holds the most recently observed rollout progress of a MaintenancePolicy.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"observedGeneration": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name of the resource reference.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "ObservedGeneration is the most recent generation observed for this MaintenancePolicy.",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-					"resourceRef": {
+					"waves": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ResourceRef is a reference to a resource.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("k8s.io/api/autoscaling/v1.CrossVersionObjectReference"),
+							Description: "Waves contains the number of Shoots per wave.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.MaintenanceRolloutWaveStatus"),
+									},
+								},
+							},
 						},
 					},
-				},
-				Required: []string{"name", "resourceRef"},
+					"rollouts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Rollouts contains the progress of the versions which are used by the Shoots of this policy.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.MaintenanceRollout"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.MaintenanceRollout", "github.com/gardener/gardener/pkg/apis/core/v1beta1.MaintenanceRolloutWaveStatus"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_MaintenancePreview(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MaintenancePreview holds information about the operations which will be performed in the next maintenance of the Shoot.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"description": {
+						SchemaProps: spec.SchemaProps{
+							Description: "A human-readable message containing details about the operations which will be performed in the next maintenance.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"failureReason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FailureReason holds the information about maintenance operations which are expected to fail.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastUpdateTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastUpdateTime is the time when the preview was computed.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"nextMaintenanceTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NextMaintenanceTime is the beginning of the next maintenance time window.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"kubernetesVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KubernetesVersion holds information about the planned update of the control plane Kubernetes version.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.MaintenanceVersionUpdate"),
+						},
+					},
+					"workers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Workers holds information about the planned updates of the worker pools.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerMaintenancePreview"),
+									},
+								},
+							},
+						},
+					},
+					"specChanges": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SpecChanges is a list of further changes to the Shoot specification which will be performed in the next maintenance.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"description", "lastUpdateTime"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.MaintenanceVersionUpdate", "github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerMaintenancePreview", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_MaintenanceRollout(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MaintenanceRollout contains the rollout progress of a version.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is the kind of the version.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the machine image. It is empty for Kubernetes versions.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Version is the version which is rolled out.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"waves": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Waves contains the progress of the rollout in the waves which have Shoots using the version.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.MaintenanceRolloutWaveProgress"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"kind", "version"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.MaintenanceRolloutWaveProgress"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_MaintenanceRolloutWave(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MaintenanceRolloutWave is a group of Shoots which receive automatic version updates in the same stage of a rollout. A Shoot matches the wave if it matches all of the specified criteria.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the wave.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"shootSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ShootSelector selects the Shoots of this wave by their labels.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+					"projectPurposes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ProjectPurposes selects the Shoots of this wave by the purpose of their project, e.g. `evaluation` or `production`.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"soakDuration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SoakDuration is the duration for which the Shoots of this wave must be healthy after they were updated to a version before this version is rolled out to the next wave.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_MaintenanceRolloutWaveProgress(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MaintenanceRolloutWaveProgress contains the progress of a rollout in a wave.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the wave.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"updatedShoots": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UpdatedShoots is the number of Shoots of the wave which use the version.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"healthySince": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HealthySince is the time since when all Shoots of the wave which use the version have been healthy. It is reset when one of them becomes unhealthy or when further Shoots of the wave start using the version.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"name", "updatedShoots"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_MaintenanceRolloutWaveStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MaintenanceRolloutWaveStatus contains the number of Shoots of a wave.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the wave.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"shoots": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Shoots is the number of Shoots which belong to the wave.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"name", "shoots"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_MaintenanceTimeWindow(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MaintenanceTimeWindow contains information about the time window for maintenance operations.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"begin": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Begin is the beginning of the time window in the format HHMMSS+ZONE, e.g. \"220000+0100\". If not present, a random value will be computed.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"end": {
+						SchemaProps: spec.SchemaProps{
+							Description: "End is the end of the time window in the format HHMMSS+ZONE, e.g. \"220000+0100\". If not present, the value will be computed based on the \"Begin\" value.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"begin", "end"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_MaintenanceVersionUpdate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MaintenanceVersionUpdate holds information about a planned version update.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Version is the currently used version.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"targetVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetVersion is the version which will be used after the maintenance. It is empty if no suitable version could be determined.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reason is the reason for the update.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"forced": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Forced indicates whether the update is enforced, e.g. because the current version is expired.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"failureReason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FailureReason holds the information why the update is expected to fail.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"version", "reason"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_MemorySwapConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MemorySwapConfiguration contains kubelet swap configuration For more information, please see KEP: 2400-node-swap",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"swapBehavior": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SwapBehavior configures swap memory available to container workloads. May be one of {\"LimitedSwap\", \"UnlimitedSwap\"} defaults to: LimitedSwap",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_Monitoring(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Monitoring contains information about the monitoring configuration for the shoot.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"alerting": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Alerting contains information about the alerting configuration for the shoot cluster.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.Alerting"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.Alerting"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_NamedResourceReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NamedResourceReference is a named reference to a resource.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the resource reference.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"resourceRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ResourceRef is a reference to a resource.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/api/autoscaling/v1.CrossVersionObjectReference"),
+						},
+					},
+				},
+				Required: []string{"name", "resourceRef"},
 			},
 		},
 		Dependencies: []string{
@@ -6207,9 +6902,17 @@ func schema_pkg_apis_core_v1beta1_OCIRepository(ref common.ReferenceCallback) co
 							Format:      "",
 						},
 					},
+					"pullSecretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PullSecretRef is a reference to a secret containing the pull secret. The secret must be of type `kubernetes.io/dockerconfigjson` and must be located in the `garden` namespace. For usage in the gardenlet, the secret must have the label `gardener.cloud/role=helm-pull-secret`.",
+							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
+						},
+					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.LocalObjectReference"},
 	}
 }
 
@@ -6427,6 +7130,52 @@ func schema_pkg_apis_core_v1beta1_Project(ref common.ReferenceCallback) common.O
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_ProjectBudget(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ProjectBudget defines limits for the resources consumed by the Shoots of a project per calendar month (UTC). The consumption is computed based on the machines observed in the worker pools of the Shoots.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"machineHours": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MachineHours is the maximum number of machine hours the Shoots of the project may consume per month.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"storageGibibyteHours": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StorageGibibyteHours is the maximum number of gibibyte hours of the volumes of the machines the Shoots of the project may consume per month.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"cost": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Cost is the maximum cost the Shoots of the project may cause per month. The cost is computed based on the prices.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"prices": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Prices is the price table used to compute the cost of the machines and volumes. Machine and volume types without a price do not cause any cost.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.BudgetPrices"),
+						},
+					},
+					"warningThresholdPercentage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WarningThresholdPercentage is the percentage of a limit at which a warning is issued. Defaults to 80.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.BudgetPrices", "k8s.io/apimachinery/pkg/api/resource.Quantity"},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_ProjectList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -6619,11 +7368,17 @@ func schema_pkg_apis_core_v1beta1_ProjectSpec(ref common.ReferenceCallback) comm
 							},
 						},
 					},
+					"budget": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Budget defines limits for the resources consumed by the Shoots of the project per calendar month.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectBudget"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.DualApprovalForDeletion", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectMember", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectTolerations", "k8s.io/api/rbac/v1.Subject"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.DualApprovalForDeletion", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectBudget", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectMember", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectTolerations", "k8s.io/api/rbac/v1.Subject"},
 	}
 }
 
@@ -6666,11 +7421,17 @@ func schema_pkg_apis_core_v1beta1_ProjectStatus(ref common.ReferenceCallback) co
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
+					"usage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Usage contains the resources consumed by the Shoots of the project in the current calendar month. It is only maintained if a budget is configured for the project.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectUsage"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectUsage", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -6729,6 +7490,60 @@ func schema_pkg_apis_core_v1beta1_ProjectTolerations(ref common.ReferenceCallbac
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_ProjectUsage(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ProjectUsage contains the resources consumed by the Shoots of a project in the current calendar month.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"periodStart": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PeriodStart is the start of the current accounting period.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"lastUpdateTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastUpdateTime is the last time at which the usage was updated.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"machineHours": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MachineHours is the number of machine hours consumed in the current period.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"storageGibibyteHours": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StorageGibibyteHours is the number of gibibyte hours of the volumes of the machines consumed in the current period.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"cost": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Cost is the cost caused in the current period. It is only computed if prices are configured.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"budgetState": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BudgetState is the state of the usage compared to the budget of the project.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"periodStart", "lastUpdateTime", "machineHours", "storageGibibyteHours", "budgetState"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_Provider(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -9326,12 +10141,44 @@ func schema_pkg_apis_core_v1beta1_ShootStatus(ref common.ReferenceCallback) comm
 							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.NetworkingStatus"),
 						},
 					},
+					"maintenancePreview": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaintenancePreview holds information about the operations which will be performed in the next maintenance of the Shoot.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.MaintenancePreview"),
+						},
+					},
+					"hibernationSchedule": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HibernationSchedule holds the next times at which the Shoot will be hibernated and woken up according to its hibernation schedules, taking exceptions and overrides into account.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.HibernationScheduleStatus"),
+						},
+					},
+					"lastActivityTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastActivityTime is the last time at which activity was observed in the Shoot cluster. It is only maintained if the Shoot is configured to be hibernated when idle.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"workerPools": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WorkerPools contains the machines observed in the worker pools of the Shoot cluster. It is maintained by the gardenlet while the Shoot is awake.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerPoolStatus"),
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"gardener", "hibernated", "technicalID", "uid"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.Condition", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Gardener", "github.com/gardener/gardener/pkg/apis/core/v1beta1.LastError", "github.com/gardener/gardener/pkg/apis/core/v1beta1.LastMaintenance", "github.com/gardener/gardener/pkg/apis/core/v1beta1.LastOperation", "github.com/gardener/gardener/pkg/apis/core/v1beta1.NetworkingStatus", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootAdvertisedAddress", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootCredentials", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.Condition", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Gardener", "github.com/gardener/gardener/pkg/apis/core/v1beta1.HibernationScheduleStatus", "github.com/gardener/gardener/pkg/apis/core/v1beta1.LastError", "github.com/gardener/gardener/pkg/apis/core/v1beta1.LastMaintenance", "github.com/gardener/gardener/pkg/apis/core/v1beta1.LastOperation", "github.com/gardener/gardener/pkg/apis/core/v1beta1.MaintenancePreview", "github.com/gardener/gardener/pkg/apis/core/v1beta1.NetworkingStatus", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootAdvertisedAddress", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootCredentials", "github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerPoolStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -9680,6 +10527,36 @@ func schema_pkg_apis_core_v1beta1_VolumeType(ref common.ReferenceCallback) commo
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_VolumeTypePrice(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VolumeTypePrice is the price of a volume type.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the volume type as defined in the CloudProfile.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"perGibibyteHour": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PerGibibyteHour is the price of a gibibyte of a volume of this type per hour.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+				},
+				Required: []string{"name", "perGibibyteHour"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_WatchCacheSizes(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -9950,6 +10827,72 @@ func schema_pkg_apis_core_v1beta1_WorkerKubernetes(ref common.ReferenceCallback)
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_WorkerMaintenancePreview(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "WorkerMaintenancePreview holds information about the planned updates of a worker pool.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the worker pool.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"kubernetesVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KubernetesVersion holds information about the planned update of the worker pool Kubernetes version.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.MaintenanceVersionUpdate"),
+						},
+					},
+					"machineImageVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MachineImageVersion holds information about the planned update of the worker pool machine image version.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.MaintenanceVersionUpdate"),
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.MaintenanceVersionUpdate"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_WorkerPoolStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "WorkerPoolStatus contains the machines observed in a worker pool of a Shoot cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the worker pool.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"machines": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Machines is the number of machines observed in the worker pool.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"name", "machines"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_WorkerSystemComponents(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
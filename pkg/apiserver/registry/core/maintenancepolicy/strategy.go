@@ -0,0 +1,95 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package maintenancepolicy
+
+import (
+	"context"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/storage/names"
+
+	"github.com/gardener/gardener/pkg/api"
+	"github.com/gardener/gardener/pkg/apis/core"
+	"github.com/gardener/gardener/pkg/apis/core/validation"
+)
+
+type maintenancePolicyStrategy struct {
+	runtime.ObjectTyper
+	names.NameGenerator
+}
+
+// Strategy defines the storage strategy for MaintenancePolicies.
+var Strategy = maintenancePolicyStrategy{api.Scheme, names.SimpleNameGenerator}
+
+func (maintenancePolicyStrategy) NamespaceScoped() bool {
+	return false
+}
+
+func (maintenancePolicyStrategy) PrepareForCreate(_ context.Context, obj runtime.Object) {
+	maintenancePolicy := obj.(*core.MaintenancePolicy)
+
+	maintenancePolicy.Generation = 1
+	maintenancePolicy.Status = core.MaintenancePolicyStatus{}
+}
+
+func (maintenancePolicyStrategy) PrepareForUpdate(_ context.Context, obj, old runtime.Object) {
+	newMaintenancePolicy := obj.(*core.MaintenancePolicy)
+	oldMaintenancePolicy := old.(*core.MaintenancePolicy)
+	newMaintenancePolicy.Status = oldMaintenancePolicy.Status
+
+	if !apiequality.Semantic.DeepEqual(oldMaintenancePolicy.Spec, newMaintenancePolicy.Spec) {
+		newMaintenancePolicy.Generation = oldMaintenancePolicy.Generation + 1
+	}
+}
+
+func (maintenancePolicyStrategy) Validate(_ context.Context, obj runtime.Object) field.ErrorList {
+	maintenancePolicy := obj.(*core.MaintenancePolicy)
+	return validation.ValidateMaintenancePolicy(maintenancePolicy)
+}
+
+func (maintenancePolicyStrategy) Canonicalize(_ runtime.Object) {
+}
+
+func (maintenancePolicyStrategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+func (maintenancePolicyStrategy) ValidateUpdate(_ context.Context, newObj, oldObj runtime.Object) field.ErrorList {
+	oldMaintenancePolicy, newMaintenancePolicy := oldObj.(*core.MaintenancePolicy), newObj.(*core.MaintenancePolicy)
+	return validation.ValidateMaintenancePolicyUpdate(newMaintenancePolicy, oldMaintenancePolicy)
+}
+
+func (maintenancePolicyStrategy) AllowUnconditionalUpdate() bool {
+	return false
+}
+
+// WarningsOnCreate returns warnings to the client performing a create.
+func (maintenancePolicyStrategy) WarningsOnCreate(_ context.Context, _ runtime.Object) []string {
+	return nil
+}
+
+// WarningsOnUpdate returns warnings to the client performing the update.
+func (maintenancePolicyStrategy) WarningsOnUpdate(_ context.Context, _, _ runtime.Object) []string {
+	return nil
+}
+
+type maintenancePolicyStatusStrategy struct {
+	maintenancePolicyStrategy
+}
+
+// StatusStrategy defines the storage strategy for the status subresource of MaintenancePolicies.
+var StatusStrategy = maintenancePolicyStatusStrategy{Strategy}
+
+func (maintenancePolicyStatusStrategy) PrepareForUpdate(_ context.Context, obj, old runtime.Object) {
+	newMaintenancePolicy := obj.(*core.MaintenancePolicy)
+	oldMaintenancePolicy := old.(*core.MaintenancePolicy)
+	newMaintenancePolicy.Spec = oldMaintenancePolicy.Spec
+}
+
+func (maintenancePolicyStatusStrategy) ValidateUpdate(_ context.Context, obj, old runtime.Object) field.ErrorList {
+	return validation.ValidateMaintenancePolicyStatusUpdate(obj.(*core.MaintenancePolicy), old.(*core.MaintenancePolicy))
+}
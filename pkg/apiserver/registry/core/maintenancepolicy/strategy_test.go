@@ -0,0 +1,80 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package maintenancepolicy_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	maintenancepolicyregistry "github.com/gardener/gardener/pkg/apiserver/registry/core/maintenancepolicy"
+)
+
+var _ = Describe("Strategy", func() {
+	var (
+		ctx = context.TODO()
+
+		maintenancePolicy *core.MaintenancePolicy
+	)
+
+	BeforeEach(func() {
+		maintenancePolicy = &core.MaintenancePolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "default", Generation: 1},
+			Spec: core.MaintenancePolicySpec{
+				Waves: []core.MaintenanceRolloutWave{{Name: "dev", ProjectPurposes: []string{"dev"}}},
+			},
+			Status: core.MaintenancePolicyStatus{ObservedGeneration: 1},
+		}
+	})
+
+	Describe("#PrepareForCreate", func() {
+		It("should set the generation and clear the status", func() {
+			maintenancePolicy.Generation = 0
+
+			maintenancepolicyregistry.Strategy.PrepareForCreate(ctx, maintenancePolicy)
+
+			Expect(maintenancePolicy.Generation).To(Equal(int64(1)))
+			Expect(maintenancePolicy.Status).To(Equal(core.MaintenancePolicyStatus{}))
+		})
+	})
+
+	Describe("#PrepareForUpdate", func() {
+		It("should keep the status and the generation if the spec is unchanged", func() {
+			newMaintenancePolicy := maintenancePolicy.DeepCopy()
+			newMaintenancePolicy.Status = core.MaintenancePolicyStatus{}
+			newMaintenancePolicy.Labels = map[string]string{"foo": "bar"}
+
+			maintenancepolicyregistry.Strategy.PrepareForUpdate(ctx, newMaintenancePolicy, maintenancePolicy)
+
+			Expect(newMaintenancePolicy.Generation).To(Equal(int64(1)))
+			Expect(newMaintenancePolicy.Status).To(Equal(maintenancePolicy.Status))
+		})
+
+		It("should increase the generation if the spec changes", func() {
+			newMaintenancePolicy := maintenancePolicy.DeepCopy()
+			newMaintenancePolicy.Spec.Waves[0].ProjectPurposes = []string{"evaluation"}
+
+			maintenancepolicyregistry.Strategy.PrepareForUpdate(ctx, newMaintenancePolicy, maintenancePolicy)
+
+			Expect(newMaintenancePolicy.Generation).To(Equal(int64(2)))
+		})
+	})
+
+	Describe("#StatusStrategy.PrepareForUpdate", func() {
+		It("should keep the spec", func() {
+			newMaintenancePolicy := maintenancePolicy.DeepCopy()
+			newMaintenancePolicy.Spec.Waves = nil
+			newMaintenancePolicy.Status.Waves = []core.MaintenanceRolloutWaveStatus{{Name: "dev", Shoots: 2}}
+
+			maintenancepolicyregistry.StatusStrategy.PrepareForUpdate(ctx, newMaintenancePolicy, maintenancePolicy)
+
+			Expect(newMaintenancePolicy.Spec).To(Equal(maintenancePolicy.Spec))
+			Expect(newMaintenancePolicy.Status.Waves).To(HaveLen(1))
+		})
+	})
+})
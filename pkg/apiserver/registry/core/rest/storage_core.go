@@ -25,6 +25,7 @@ import (
 	controllerregistrationstore "github.com/gardener/gardener/pkg/apiserver/registry/core/controllerregistration/storage"
 	exposureclassstore "github.com/gardener/gardener/pkg/apiserver/registry/core/exposureclass/storage"
 	internalsecretstore "github.com/gardener/gardener/pkg/apiserver/registry/core/internalsecret/storage"
+	maintenancepolicystore "github.com/gardener/gardener/pkg/apiserver/registry/core/maintenancepolicy/storage"
 	namespacedcloudprofilestore "github.com/gardener/gardener/pkg/apiserver/registry/core/namespacedcloudprofile/storage"
 	projectstore "github.com/gardener/gardener/pkg/apiserver/registry/core/project/storage"
 	quotastore "github.com/gardener/gardener/pkg/apiserver/registry/core/quota/storage"
@@ -99,6 +100,10 @@ func (p StorageProvider) v1beta1Storage(restOptionsGetter generic.RESTOptionsGet
 
 	storage["internalsecrets"] = internalsecretstore.NewREST(restOptionsGetter)
 
+	maintenancePolicyStorage := maintenancepolicystore.NewStorage(restOptionsGetter)
+	storage["maintenancepolicies"] = maintenancePolicyStorage.MaintenancePolicy
+	storage["maintenancepolicies/status"] = maintenancePolicyStorage.Status
+
 	projectStorage := projectstore.NewStorage(restOptionsGetter)
 	storage["projects"] = projectStorage.Project
 	storage["projects/status"] = projectStorage.Status
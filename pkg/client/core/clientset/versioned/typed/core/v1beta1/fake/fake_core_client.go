@@ -48,6 +48,10 @@ func (c *FakeCoreV1beta1) InternalSecrets(namespace string) v1beta1.InternalSecr
 	return &FakeInternalSecrets{c, namespace}
 }
 
+func (c *FakeCoreV1beta1) MaintenancePolicies() v1beta1.MaintenancePolicyInterface {
+	return &FakeMaintenancePolicies{c}
+}
+
 func (c *FakeCoreV1beta1) NamespacedCloudProfiles(namespace string) v1beta1.NamespacedCloudProfileInterface {
 	return &FakeNamespacedCloudProfiles{c, namespace}
 }
@@ -46,6 +46,10 @@ type InternalSecretListerExpansion interface{}
 // InternalSecretNamespaceLister.
 type InternalSecretNamespaceListerExpansion interface{}
 
+// MaintenancePolicyListerExpansion allows custom methods to be added to
+// MaintenancePolicyLister.
+type MaintenancePolicyListerExpansion interface{}
+
 // NamespacedCloudProfileListerExpansion allows custom methods to be added to
 // NamespacedCloudProfileLister.
 type NamespacedCloudProfileListerExpansion interface{}
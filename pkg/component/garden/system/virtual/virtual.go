@@ -322,6 +322,7 @@ func (g *gardenSystem) computeResourcesData() (map[string][]byte, error) {
 					Resources: []string{
 						"cloudprofiles",
 						"exposureclasses",
+						"maintenancepolicies",
 						"seeds",
 					},
 					Verbs: []string{"get", "list", "watch"},
@@ -340,6 +340,7 @@ var _ = Describe("Virtual", func() {
 					Resources: []string{
 						"cloudprofiles",
 						"exposureclasses",
+						"maintenancepolicies",
 						"seeds",
 					},
 					Verbs: []string{"get", "list", "watch"},
@@ -53,6 +53,7 @@ func (k *kubeAPIServer) reconcileServiceMonitor(ctx context.Context, serviceMoni
 					"apiserver_crd_webhook_conversion_duration_seconds_.+",
 					"apiserver_current_inflight_requests",
 					"apiserver_current_inqueue_requests",
+					"apiserver_flowcontrol_dispatched_requests_total",
 					"apiserver_init_events_total",
 					"apiserver_latency",
 					"apiserver_latency_seconds",
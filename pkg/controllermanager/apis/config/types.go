@@ -55,6 +55,8 @@ type ControllerManagerControllerConfiguration struct {
 	Event *EventControllerConfiguration
 	// ExposureClass defines the configuration of the ExposureClass controller.
 	ExposureClass *ExposureClassControllerConfiguration
+	// MaintenancePolicy defines the configuration of the MaintenancePolicy controller.
+	MaintenancePolicy *MaintenancePolicyControllerConfiguration
 	// Project defines the configuration of the Project controller.
 	Project *ProjectControllerConfiguration
 	// Quota defines the configuration of the Quota controller.
@@ -83,6 +85,8 @@ type ControllerManagerControllerConfiguration struct {
 	ShootConditions *ShootConditionsControllerConfiguration
 	// ShootStatusLabel defines the configuration of the ShootStatusLabel controller.
 	ShootStatusLabel *ShootStatusLabelControllerConfiguration
+	// ShootCertificateExpiration defines the configuration of the ShootCertificateExpiration controller.
+	ShootCertificateExpiration *ShootCertificateExpirationControllerConfiguration
 	// ManagedSeedSet defines the configuration of the ManagedSeedSet controller.
 	ManagedSeedSet *ManagedSeedSetControllerConfiguration
 }
@@ -155,6 +159,17 @@ type ExposureClassControllerConfiguration struct {
 	ConcurrentSyncs *int
 }
 
+// MaintenancePolicyControllerConfiguration defines the configuration of the
+// MaintenancePolicy controller.
+type MaintenancePolicyControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on
+	// events.
+	ConcurrentSyncs *int
+	// SyncPeriod is the duration how often the rollout progress of MaintenancePolicies is
+	// computed.
+	SyncPeriod *metav1.Duration
+}
+
 // ProjectControllerConfiguration defines the configuration of the
 // Project controller.
 type ProjectControllerConfiguration struct {
@@ -174,6 +189,8 @@ type ProjectControllerConfiguration struct {
 	StaleExpirationTimeDays *int
 	// StaleSyncPeriod is the duration how often the reconciliation loop for stale Projects is executed.
 	StaleSyncPeriod *metav1.Duration
+	// BudgetSyncPeriod is the duration how often the usage of Projects with a budget is accounted.
+	BudgetSyncPeriod *metav1.Duration
 }
 
 // QuotaConfiguration defines quota configurations.
@@ -262,6 +279,9 @@ type ShootMaintenanceControllerConfiguration struct {
 	EnableShootControlPlaneRestarter *bool
 	// EnableShootCoreAddonRestarter configures whether some core addons to be restarted during maintenance.
 	EnableShootCoreAddonRestarter *bool
+	// PreviewSyncPeriod is the duration how often the preview of the next maintenance is refreshed in the Shoot status.
+	// A value of zero disables the maintenance preview.
+	PreviewSyncPeriod *metav1.Duration
 }
 
 // ShootQuotaControllerConfiguration defines the configuration of the
@@ -324,6 +344,19 @@ type ShootStatusLabelControllerConfiguration struct {
 	ConcurrentSyncs *int
 }
 
+// ShootCertificateExpirationControllerConfiguration defines the configuration of the
+// ShootCertificateExpiration controller.
+type ShootCertificateExpirationControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on
+	// events.
+	ConcurrentSyncs *int
+	// SyncPeriod is the duration how often the secrets inventories of the shoots are checked.
+	SyncPeriod *metav1.Duration
+	// ExpirationThreshold is the duration before the end of the validity of a certificate from which on it is
+	// considered as expiring.
+	ExpirationThreshold *metav1.Duration
+}
+
 // ManagedSeedSetControllerConfiguration defines the configuration of the
 // ManagedSeedSet controller.
 type ManagedSeedSetControllerConfiguration struct {
@@ -102,6 +102,11 @@ func SetDefaults_ProjectControllerConfiguration(obj *ProjectControllerConfigurat
 			Duration: 12 * time.Hour,
 		}
 	}
+	if obj.BudgetSyncPeriod == nil {
+		obj.BudgetSyncPeriod = &metav1.Duration{
+			Duration: 10 * time.Minute,
+		}
+	}
 
 	for i, quota := range obj.Quotas {
 		if quota.ProjectSelector == nil {
@@ -179,6 +184,16 @@ func SetDefaults_ExposureClassControllerConfiguration(obj *ExposureClassControll
 	}
 }
 
+// SetDefaults_MaintenancePolicyControllerConfiguration sets defaults for the MaintenancePolicyControllerConfiguration.
+func SetDefaults_MaintenancePolicyControllerConfiguration(obj *MaintenancePolicyControllerConfiguration) {
+	if obj.ConcurrentSyncs == nil {
+		obj.ConcurrentSyncs = ptr.To(DefaultControllerConcurrentSyncs)
+	}
+	if obj.SyncPeriod == nil {
+		obj.SyncPeriod = &metav1.Duration{Duration: 5 * time.Minute}
+	}
+}
+
 // SetDefaults_QuotaControllerConfiguration sets defaults for the QuotaControllerConfiguration.
 func SetDefaults_QuotaControllerConfiguration(obj *QuotaControllerConfiguration) {
 	if obj.ConcurrentSyncs == nil {
@@ -238,6 +253,9 @@ func SetDefaults_ShootMaintenanceControllerConfiguration(obj *ShootMaintenanceCo
 	if obj.EnableShootControlPlaneRestarter == nil {
 		obj.EnableShootControlPlaneRestarter = ptr.To(true)
 	}
+	if obj.PreviewSyncPeriod == nil {
+		obj.PreviewSyncPeriod = &metav1.Duration{Duration: 24 * time.Hour}
+	}
 }
 
 // SetDefaults_ShootQuotaControllerConfiguration sets defaults for the ShootQuotaControllerConfiguration.
@@ -283,6 +301,19 @@ func SetDefaults_ShootStatusLabelControllerConfiguration(obj *ShootStatusLabelCo
 	}
 }
 
+// SetDefaults_ShootCertificateExpirationControllerConfiguration sets defaults for the ShootCertificateExpirationControllerConfiguration.
+func SetDefaults_ShootCertificateExpirationControllerConfiguration(obj *ShootCertificateExpirationControllerConfiguration) {
+	if obj.ConcurrentSyncs == nil {
+		obj.ConcurrentSyncs = ptr.To(DefaultControllerConcurrentSyncs)
+	}
+	if obj.SyncPeriod == nil {
+		obj.SyncPeriod = &metav1.Duration{Duration: time.Hour}
+	}
+	if obj.ExpirationThreshold == nil {
+		obj.ExpirationThreshold = &metav1.Duration{Duration: 30 * 24 * time.Hour}
+	}
+}
+
 // SetDefaults_ManagedSeedSetControllerConfiguration sets defaults for the ManagedSeedSetControllerConfiguration.
 func SetDefaults_ManagedSeedSetControllerConfiguration(obj *ManagedSeedSetControllerConfiguration) {
 	if obj.ConcurrentSyncs == nil {
@@ -316,6 +347,9 @@ func SetDefaults_ControllerManagerControllerConfiguration(obj *ControllerManager
 	if obj.ExposureClass == nil {
 		obj.ExposureClass = &ExposureClassControllerConfiguration{}
 	}
+	if obj.MaintenancePolicy == nil {
+		obj.MaintenancePolicy = &MaintenancePolicyControllerConfiguration{}
+	}
 	if obj.Project == nil {
 		obj.Project = &ProjectControllerConfiguration{}
 	}
@@ -352,6 +386,9 @@ func SetDefaults_ControllerManagerControllerConfiguration(obj *ControllerManager
 	if obj.ShootStatusLabel == nil {
 		obj.ShootStatusLabel = &ShootStatusLabelControllerConfiguration{}
 	}
+	if obj.ShootCertificateExpiration == nil {
+		obj.ShootCertificateExpiration = &ShootCertificateExpirationControllerConfiguration{}
+	}
 
 	if obj.ManagedSeedSet == nil {
 		obj.ManagedSeedSet = &ManagedSeedSetControllerConfiguration{
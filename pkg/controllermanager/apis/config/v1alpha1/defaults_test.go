@@ -183,6 +183,9 @@ var _ = Describe("Defaults", func() {
 				StaleSyncPeriod: &metav1.Duration{
 					Duration: 12 * time.Hour,
 				},
+				BudgetSyncPeriod: &metav1.Duration{
+					Duration: 10 * time.Minute,
+				},
 			}
 			SetObjectDefaults_ControllerManagerConfiguration(obj)
 
@@ -224,6 +227,9 @@ var _ = Describe("Defaults", func() {
 						StaleSyncPeriod: &metav1.Duration{
 							Duration: 12 * time.Hour,
 						},
+						BudgetSyncPeriod: &metav1.Duration{
+							Duration: 5 * time.Minute,
+						},
 					},
 				},
 			}
@@ -419,6 +425,33 @@ var _ = Describe("Defaults", func() {
 		})
 	})
 
+	Describe("MaintenancePolicyControllerConfiguration defaulting", func() {
+		It("should default MaintenancePolicyControllerConfiguration correctly", func() {
+			expected := &MaintenancePolicyControllerConfiguration{
+				ConcurrentSyncs: ptr.To(DefaultControllerConcurrentSyncs),
+				SyncPeriod:      &metav1.Duration{Duration: 5 * time.Minute},
+			}
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.MaintenancePolicy).To(Equal(expected))
+		})
+
+		It("should not default fields that are set", func() {
+			obj = &ControllerManagerConfiguration{
+				Controllers: ControllerManagerControllerConfiguration{
+					MaintenancePolicy: &MaintenancePolicyControllerConfiguration{
+						ConcurrentSyncs: ptr.To(10),
+						SyncPeriod:      &metav1.Duration{Duration: time.Minute},
+					},
+				},
+			}
+			expected := obj.Controllers.MaintenancePolicy.DeepCopy()
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.MaintenancePolicy).To(Equal(expected))
+		})
+	})
+
 	Describe("QuotaControllerConfiguration defaulting", func() {
 		It("should default QuotaControllerConfiguration correctly", func() {
 			expected := &QuotaControllerConfiguration{
@@ -582,6 +615,7 @@ var _ = Describe("Defaults", func() {
 			expected := &ShootMaintenanceControllerConfiguration{
 				ConcurrentSyncs:                  ptr.To(DefaultControllerConcurrentSyncs),
 				EnableShootControlPlaneRestarter: ptr.To(true),
+				PreviewSyncPeriod:                &metav1.Duration{Duration: 24 * time.Hour},
 			}
 			SetObjectDefaults_ControllerManagerConfiguration(obj)
 
@@ -594,6 +628,7 @@ var _ = Describe("Defaults", func() {
 					ShootMaintenance: ShootMaintenanceControllerConfiguration{
 						ConcurrentSyncs:                  ptr.To(10),
 						EnableShootControlPlaneRestarter: ptr.To(false),
+						PreviewSyncPeriod:                &metav1.Duration{Duration: time.Hour},
 					},
 				},
 			}
@@ -749,6 +784,35 @@ var _ = Describe("Defaults", func() {
 		})
 	})
 
+	Describe("ShootCertificateExpirationControllerConfiguration defaulting", func() {
+		It("should default ShootCertificateExpirationControllerConfiguration correctly", func() {
+			expected := &ShootCertificateExpirationControllerConfiguration{
+				ConcurrentSyncs:     ptr.To(DefaultControllerConcurrentSyncs),
+				SyncPeriod:          &metav1.Duration{Duration: time.Hour},
+				ExpirationThreshold: &metav1.Duration{Duration: 720 * time.Hour},
+			}
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.ShootCertificateExpiration).To(Equal(expected))
+		})
+
+		It("should not default fields that are set", func() {
+			obj = &ControllerManagerConfiguration{
+				Controllers: ControllerManagerControllerConfiguration{
+					ShootCertificateExpiration: &ShootCertificateExpirationControllerConfiguration{
+						ConcurrentSyncs:     ptr.To(10),
+						SyncPeriod:          &metav1.Duration{Duration: 5 * time.Minute},
+						ExpirationThreshold: &metav1.Duration{Duration: 24 * time.Hour},
+					},
+				},
+			}
+			expected := obj.Controllers.ShootCertificateExpiration.DeepCopy()
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.ShootCertificateExpiration).To(Equal(expected))
+		})
+	})
+
 	Describe("ManagedSeedSetControllerConfiguration defaulting", func() {
 		It("should default ManagedSeedSetControllerConfiguration correctly if nil", func() {
 			expected := &ManagedSeedSetControllerConfiguration{
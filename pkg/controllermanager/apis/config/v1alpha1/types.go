@@ -66,6 +66,9 @@ type ControllerManagerControllerConfiguration struct {
 	// ExposureClass defines the configuration of the ExposureClass controller.
 	// +optional
 	ExposureClass *ExposureClassControllerConfiguration `json:"exposureClass,omitempty"`
+	// MaintenancePolicy defines the configuration of the MaintenancePolicy controller.
+	// +optional
+	MaintenancePolicy *MaintenancePolicyControllerConfiguration `json:"maintenancePolicy,omitempty"`
 	// Project defines the configuration of the Project controller.
 	// +optional
 	Project *ProjectControllerConfiguration `json:"project,omitempty"`
@@ -106,6 +109,9 @@ type ControllerManagerControllerConfiguration struct {
 	// ShootStatusLabel defines the configuration of the ShootStatusLabel controller.
 	// +optional
 	ShootStatusLabel *ShootStatusLabelControllerConfiguration `json:"shootStatusLabel,omitempty"`
+	// ShootCertificateExpiration defines the configuration of the ShootCertificateExpiration controller.
+	// +optional
+	ShootCertificateExpiration *ShootCertificateExpirationControllerConfiguration `json:"shootCertificateExpiration,omitempty"`
 	// ManagedSeedSet defines the configuration of the ManagedSeedSet controller.
 	// +optional
 	ManagedSeedSet *ManagedSeedSetControllerConfiguration `json:"managedSeedSet,omitempty"`
@@ -189,6 +195,19 @@ type ExposureClassControllerConfiguration struct {
 	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
 }
 
+// MaintenancePolicyControllerConfiguration defines the configuration of the
+// MaintenancePolicy controller.
+type MaintenancePolicyControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on
+	// events.
+	// +optional
+	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
+	// SyncPeriod is the duration how often the rollout progress of MaintenancePolicies is
+	// computed.
+	// +optional
+	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+}
+
 // ProjectControllerConfiguration defines the configuration of the
 // Project controller.
 type ProjectControllerConfiguration struct {
@@ -214,6 +233,9 @@ type ProjectControllerConfiguration struct {
 	// StaleSyncPeriod is the duration how often the reconciliation loop for stale Projects is executed.
 	// +optional
 	StaleSyncPeriod *metav1.Duration `json:"staleSyncPeriod,omitempty"`
+	// BudgetSyncPeriod is the duration how often the usage of Projects with a budget is accounted.
+	// +optional
+	BudgetSyncPeriod *metav1.Duration `json:"budgetSyncPeriod,omitempty"`
 }
 
 // QuotaConfiguration defines quota configurations.
@@ -319,6 +341,10 @@ type ShootMaintenanceControllerConfiguration struct {
 	// EnableShootCoreAddonRestarter configures whether some core addons to be restarted during maintenance.
 	// +optional
 	EnableShootCoreAddonRestarter *bool `json:"enableShootCoreAddonRestarter"`
+	// PreviewSyncPeriod is the duration how often the preview of the next maintenance is refreshed in the Shoot status.
+	// A value of zero disables the maintenance preview.
+	// +optional
+	PreviewSyncPeriod *metav1.Duration `json:"previewSyncPeriod,omitempty"`
 }
 
 // ShootQuotaControllerConfiguration defines the configuration of the
@@ -392,6 +418,24 @@ type ShootStatusLabelControllerConfiguration struct {
 	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
 }
 
+// ShootCertificateExpirationControllerConfiguration defines the configuration of the
+// ShootCertificateExpiration controller.
+type ShootCertificateExpirationControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on
+	// events.
+	// +optional
+	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
+	// SyncPeriod is the duration how often the secrets inventories of the shoots are checked.
+	// Defaults to 1h.
+	// +optional
+	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+	// ExpirationThreshold is the duration before the end of the validity of a certificate from which on it is
+	// considered as expiring.
+	// Defaults to 720h (30d).
+	// +optional
+	ExpirationThreshold *metav1.Duration `json:"expirationThreshold,omitempty"`
+}
+
 // ManagedSeedSetControllerConfiguration defines the configuration of the
 // ManagedSeedSet controller.
 type ManagedSeedSetControllerConfiguration struct {
@@ -137,6 +137,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*MaintenancePolicyControllerConfiguration)(nil), (*config.MaintenancePolicyControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MaintenancePolicyControllerConfiguration_To_config_MaintenancePolicyControllerConfiguration(a.(*MaintenancePolicyControllerConfiguration), b.(*config.MaintenancePolicyControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.MaintenancePolicyControllerConfiguration)(nil), (*MaintenancePolicyControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_MaintenancePolicyControllerConfiguration_To_v1alpha1_MaintenancePolicyControllerConfiguration(a.(*config.MaintenancePolicyControllerConfiguration), b.(*MaintenancePolicyControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ManagedSeedSetControllerConfiguration)(nil), (*config.ManagedSeedSetControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ManagedSeedSetControllerConfiguration_To_config_ManagedSeedSetControllerConfiguration(a.(*ManagedSeedSetControllerConfiguration), b.(*config.ManagedSeedSetControllerConfiguration), scope)
 	}); err != nil {
@@ -242,6 +252,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ShootCertificateExpirationControllerConfiguration)(nil), (*config.ShootCertificateExpirationControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootCertificateExpirationControllerConfiguration_To_config_ShootCertificateExpirationControllerConfiguration(a.(*ShootCertificateExpirationControllerConfiguration), b.(*config.ShootCertificateExpirationControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ShootCertificateExpirationControllerConfiguration)(nil), (*ShootCertificateExpirationControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ShootCertificateExpirationControllerConfiguration_To_v1alpha1_ShootCertificateExpirationControllerConfiguration(a.(*config.ShootCertificateExpirationControllerConfiguration), b.(*ShootCertificateExpirationControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ShootConditionsControllerConfiguration)(nil), (*config.ShootConditionsControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ShootConditionsControllerConfiguration_To_config_ShootConditionsControllerConfiguration(a.(*ShootConditionsControllerConfiguration), b.(*config.ShootConditionsControllerConfiguration), scope)
 	}); err != nil {
@@ -511,6 +531,7 @@ func autoConvert_v1alpha1_ControllerManagerControllerConfiguration_To_config_Con
 	out.ControllerRegistration = (*config.ControllerRegistrationControllerConfiguration)(unsafe.Pointer(in.ControllerRegistration))
 	out.Event = (*config.EventControllerConfiguration)(unsafe.Pointer(in.Event))
 	out.ExposureClass = (*config.ExposureClassControllerConfiguration)(unsafe.Pointer(in.ExposureClass))
+	out.MaintenancePolicy = (*config.MaintenancePolicyControllerConfiguration)(unsafe.Pointer(in.MaintenancePolicy))
 	if in.Project != nil {
 		in, out := &in.Project, &out.Project
 		*out = new(config.ProjectControllerConfiguration)
@@ -537,6 +558,7 @@ func autoConvert_v1alpha1_ControllerManagerControllerConfiguration_To_config_Con
 	out.ShootRetry = (*config.ShootRetryControllerConfiguration)(unsafe.Pointer(in.ShootRetry))
 	out.ShootConditions = (*config.ShootConditionsControllerConfiguration)(unsafe.Pointer(in.ShootConditions))
 	out.ShootStatusLabel = (*config.ShootStatusLabelControllerConfiguration)(unsafe.Pointer(in.ShootStatusLabel))
+	out.ShootCertificateExpiration = (*config.ShootCertificateExpirationControllerConfiguration)(unsafe.Pointer(in.ShootCertificateExpiration))
 	out.ManagedSeedSet = (*config.ManagedSeedSetControllerConfiguration)(unsafe.Pointer(in.ManagedSeedSet))
 	return nil
 }
@@ -555,6 +577,7 @@ func autoConvert_config_ControllerManagerControllerConfiguration_To_v1alpha1_Con
 	out.ControllerRegistration = (*ControllerRegistrationControllerConfiguration)(unsafe.Pointer(in.ControllerRegistration))
 	out.Event = (*EventControllerConfiguration)(unsafe.Pointer(in.Event))
 	out.ExposureClass = (*ExposureClassControllerConfiguration)(unsafe.Pointer(in.ExposureClass))
+	out.MaintenancePolicy = (*MaintenancePolicyControllerConfiguration)(unsafe.Pointer(in.MaintenancePolicy))
 	if in.Project != nil {
 		in, out := &in.Project, &out.Project
 		*out = new(ProjectControllerConfiguration)
@@ -581,6 +604,7 @@ func autoConvert_config_ControllerManagerControllerConfiguration_To_v1alpha1_Con
 	out.ShootRetry = (*ShootRetryControllerConfiguration)(unsafe.Pointer(in.ShootRetry))
 	out.ShootConditions = (*ShootConditionsControllerConfiguration)(unsafe.Pointer(in.ShootConditions))
 	out.ShootStatusLabel = (*ShootStatusLabelControllerConfiguration)(unsafe.Pointer(in.ShootStatusLabel))
+	out.ShootCertificateExpiration = (*ShootCertificateExpirationControllerConfiguration)(unsafe.Pointer(in.ShootCertificateExpiration))
 	out.ManagedSeedSet = (*ManagedSeedSetControllerConfiguration)(unsafe.Pointer(in.ManagedSeedSet))
 	return nil
 }
@@ -672,6 +696,28 @@ func Convert_config_ExposureClassControllerConfiguration_To_v1alpha1_ExposureCla
 	return autoConvert_config_ExposureClassControllerConfiguration_To_v1alpha1_ExposureClassControllerConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_MaintenancePolicyControllerConfiguration_To_config_MaintenancePolicyControllerConfiguration(in *MaintenancePolicyControllerConfiguration, out *config.MaintenancePolicyControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	return nil
+}
+
+// Convert_v1alpha1_MaintenancePolicyControllerConfiguration_To_config_MaintenancePolicyControllerConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_MaintenancePolicyControllerConfiguration_To_config_MaintenancePolicyControllerConfiguration(in *MaintenancePolicyControllerConfiguration, out *config.MaintenancePolicyControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MaintenancePolicyControllerConfiguration_To_config_MaintenancePolicyControllerConfiguration(in, out, s)
+}
+
+func autoConvert_config_MaintenancePolicyControllerConfiguration_To_v1alpha1_MaintenancePolicyControllerConfiguration(in *config.MaintenancePolicyControllerConfiguration, out *MaintenancePolicyControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	return nil
+}
+
+// Convert_config_MaintenancePolicyControllerConfiguration_To_v1alpha1_MaintenancePolicyControllerConfiguration is an autogenerated conversion function.
+func Convert_config_MaintenancePolicyControllerConfiguration_To_v1alpha1_MaintenancePolicyControllerConfiguration(in *config.MaintenancePolicyControllerConfiguration, out *MaintenancePolicyControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_config_MaintenancePolicyControllerConfiguration_To_v1alpha1_MaintenancePolicyControllerConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_ManagedSeedSetControllerConfiguration_To_config_ManagedSeedSetControllerConfiguration(in *ManagedSeedSetControllerConfiguration, out *config.ManagedSeedSetControllerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
 	out.MaxShootRetries = (*int)(unsafe.Pointer(in.MaxShootRetries))
@@ -733,6 +779,7 @@ func autoConvert_v1alpha1_ProjectControllerConfiguration_To_config_ProjectContro
 	out.StaleGracePeriodDays = (*int)(unsafe.Pointer(in.StaleGracePeriodDays))
 	out.StaleExpirationTimeDays = (*int)(unsafe.Pointer(in.StaleExpirationTimeDays))
 	out.StaleSyncPeriod = (*v1.Duration)(unsafe.Pointer(in.StaleSyncPeriod))
+	out.BudgetSyncPeriod = (*v1.Duration)(unsafe.Pointer(in.BudgetSyncPeriod))
 	return nil
 }
 
@@ -758,6 +805,7 @@ func autoConvert_config_ProjectControllerConfiguration_To_v1alpha1_ProjectContro
 	out.StaleGracePeriodDays = (*int)(unsafe.Pointer(in.StaleGracePeriodDays))
 	out.StaleExpirationTimeDays = (*int)(unsafe.Pointer(in.StaleExpirationTimeDays))
 	out.StaleSyncPeriod = (*v1.Duration)(unsafe.Pointer(in.StaleSyncPeriod))
+	out.BudgetSyncPeriod = (*v1.Duration)(unsafe.Pointer(in.BudgetSyncPeriod))
 	return nil
 }
 
@@ -945,6 +993,30 @@ func Convert_config_ServerConfiguration_To_v1alpha1_ServerConfiguration(in *conf
 	return autoConvert_config_ServerConfiguration_To_v1alpha1_ServerConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_ShootCertificateExpirationControllerConfiguration_To_config_ShootCertificateExpirationControllerConfiguration(in *ShootCertificateExpirationControllerConfiguration, out *config.ShootCertificateExpirationControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	out.ExpirationThreshold = (*v1.Duration)(unsafe.Pointer(in.ExpirationThreshold))
+	return nil
+}
+
+// Convert_v1alpha1_ShootCertificateExpirationControllerConfiguration_To_config_ShootCertificateExpirationControllerConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_ShootCertificateExpirationControllerConfiguration_To_config_ShootCertificateExpirationControllerConfiguration(in *ShootCertificateExpirationControllerConfiguration, out *config.ShootCertificateExpirationControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootCertificateExpirationControllerConfiguration_To_config_ShootCertificateExpirationControllerConfiguration(in, out, s)
+}
+
+func autoConvert_config_ShootCertificateExpirationControllerConfiguration_To_v1alpha1_ShootCertificateExpirationControllerConfiguration(in *config.ShootCertificateExpirationControllerConfiguration, out *ShootCertificateExpirationControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	out.ExpirationThreshold = (*v1.Duration)(unsafe.Pointer(in.ExpirationThreshold))
+	return nil
+}
+
+// Convert_config_ShootCertificateExpirationControllerConfiguration_To_v1alpha1_ShootCertificateExpirationControllerConfiguration is an autogenerated conversion function.
+func Convert_config_ShootCertificateExpirationControllerConfiguration_To_v1alpha1_ShootCertificateExpirationControllerConfiguration(in *config.ShootCertificateExpirationControllerConfiguration, out *ShootCertificateExpirationControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_config_ShootCertificateExpirationControllerConfiguration_To_v1alpha1_ShootCertificateExpirationControllerConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_ShootConditionsControllerConfiguration_To_config_ShootConditionsControllerConfiguration(in *ShootConditionsControllerConfiguration, out *config.ShootConditionsControllerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
 	return nil
@@ -991,6 +1063,7 @@ func autoConvert_v1alpha1_ShootMaintenanceControllerConfiguration_To_config_Shoo
 	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
 	out.EnableShootControlPlaneRestarter = (*bool)(unsafe.Pointer(in.EnableShootControlPlaneRestarter))
 	out.EnableShootCoreAddonRestarter = (*bool)(unsafe.Pointer(in.EnableShootCoreAddonRestarter))
+	out.PreviewSyncPeriod = (*v1.Duration)(unsafe.Pointer(in.PreviewSyncPeriod))
 	return nil
 }
 
@@ -1003,6 +1076,7 @@ func autoConvert_config_ShootMaintenanceControllerConfiguration_To_v1alpha1_Shoo
 	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
 	out.EnableShootControlPlaneRestarter = (*bool)(unsafe.Pointer(in.EnableShootControlPlaneRestarter))
 	out.EnableShootCoreAddonRestarter = (*bool)(unsafe.Pointer(in.EnableShootCoreAddonRestarter))
+	out.PreviewSyncPeriod = (*v1.Duration)(unsafe.Pointer(in.PreviewSyncPeriod))
 	return nil
 }
 
@@ -51,6 +51,9 @@ func SetObjectDefaults_ControllerManagerConfiguration(in *ControllerManagerConfi
 	if in.Controllers.ExposureClass != nil {
 		SetDefaults_ExposureClassControllerConfiguration(in.Controllers.ExposureClass)
 	}
+	if in.Controllers.MaintenancePolicy != nil {
+		SetDefaults_MaintenancePolicyControllerConfiguration(in.Controllers.MaintenancePolicy)
+	}
 	if in.Controllers.Project != nil {
 		SetDefaults_ProjectControllerConfiguration(in.Controllers.Project)
 	}
@@ -89,6 +92,9 @@ func SetObjectDefaults_ControllerManagerConfiguration(in *ControllerManagerConfi
 	if in.Controllers.ShootStatusLabel != nil {
 		SetDefaults_ShootStatusLabelControllerConfiguration(in.Controllers.ShootStatusLabel)
 	}
+	if in.Controllers.ShootCertificateExpiration != nil {
+		SetDefaults_ShootCertificateExpirationControllerConfiguration(in.Controllers.ShootCertificateExpiration)
+	}
 	if in.Controllers.ManagedSeedSet != nil {
 		SetDefaults_ManagedSeedSetControllerConfiguration(in.Controllers.ManagedSeedSet)
 	}
@@ -209,6 +209,11 @@ func (in *ControllerManagerControllerConfiguration) DeepCopyInto(out *Controller
 		*out = new(ExposureClassControllerConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.MaintenancePolicy != nil {
+		in, out := &in.MaintenancePolicy, &out.MaintenancePolicy
+		*out = new(MaintenancePolicyControllerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Project != nil {
 		in, out := &in.Project, &out.Project
 		*out = new(ProjectControllerConfiguration)
@@ -271,6 +276,11 @@ func (in *ControllerManagerControllerConfiguration) DeepCopyInto(out *Controller
 		*out = new(ShootStatusLabelControllerConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ShootCertificateExpiration != nil {
+		in, out := &in.ShootCertificateExpiration, &out.ShootCertificateExpiration
+		*out = new(ShootCertificateExpirationControllerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ManagedSeedSet != nil {
 		in, out := &in.ManagedSeedSet, &out.ManagedSeedSet
 		*out = new(ManagedSeedSetControllerConfiguration)
@@ -378,6 +388,32 @@ func (in *ExposureClassControllerConfiguration) DeepCopy() *ExposureClassControl
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenancePolicyControllerConfiguration) DeepCopyInto(out *MaintenancePolicyControllerConfiguration) {
+	*out = *in
+	if in.ConcurrentSyncs != nil {
+		in, out := &in.ConcurrentSyncs, &out.ConcurrentSyncs
+		*out = new(int)
+		**out = **in
+	}
+	if in.SyncPeriod != nil {
+		in, out := &in.SyncPeriod, &out.SyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenancePolicyControllerConfiguration.
+func (in *MaintenancePolicyControllerConfiguration) DeepCopy() *MaintenancePolicyControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenancePolicyControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ManagedSeedSetControllerConfiguration) DeepCopyInto(out *ManagedSeedSetControllerConfiguration) {
 	*out = *in
@@ -461,6 +497,11 @@ func (in *ProjectControllerConfiguration) DeepCopyInto(out *ProjectControllerCon
 		*out = new(v1.Duration)
 		**out = **in
 	}
+	if in.BudgetSyncPeriod != nil {
+		in, out := &in.BudgetSyncPeriod, &out.BudgetSyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -680,6 +721,37 @@ func (in *ServerConfiguration) DeepCopy() *ServerConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootCertificateExpirationControllerConfiguration) DeepCopyInto(out *ShootCertificateExpirationControllerConfiguration) {
+	*out = *in
+	if in.ConcurrentSyncs != nil {
+		in, out := &in.ConcurrentSyncs, &out.ConcurrentSyncs
+		*out = new(int)
+		**out = **in
+	}
+	if in.SyncPeriod != nil {
+		in, out := &in.SyncPeriod, &out.SyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.ExpirationThreshold != nil {
+		in, out := &in.ExpirationThreshold, &out.ExpirationThreshold
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootCertificateExpirationControllerConfiguration.
+func (in *ShootCertificateExpirationControllerConfiguration) DeepCopy() *ShootCertificateExpirationControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootCertificateExpirationControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootConditionsControllerConfiguration) DeepCopyInto(out *ShootConditionsControllerConfiguration) {
 	*out = *in
@@ -745,6 +817,11 @@ func (in *ShootMaintenanceControllerConfiguration) DeepCopyInto(out *ShootMainte
 		*out = new(bool)
 		**out = **in
 	}
+	if in.PreviewSyncPeriod != nil {
+		in, out := &in.PreviewSyncPeriod, &out.PreviewSyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -20,6 +20,7 @@ import (
 	"github.com/gardener/gardener/pkg/controllermanager/controller/credentialsbinding"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/event"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/exposureclass"
+	"github.com/gardener/gardener/pkg/controllermanager/controller/maintenancepolicy"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/managedseedset"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/namespacedcloudprofile"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/project"
@@ -91,6 +92,12 @@ func AddToManager(ctx context.Context, mgr manager.Manager, cfg *config.Controll
 		return fmt.Errorf("failed adding ExposureClass controller: %w", err)
 	}
 
+	if err := (&maintenancepolicy.Reconciler{
+		Config: *cfg.Controllers.MaintenancePolicy,
+	}).AddToManager(mgr); err != nil {
+		return fmt.Errorf("failed adding MaintenancePolicy controller: %w", err)
+	}
+
 	if err := (&managedseedset.Reconciler{
 		Config: *cfg.Controllers.ManagedSeedSet,
 	}).AddToManager(ctx, mgr); err != nil {
@@ -0,0 +1,97 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package maintenancepolicy
+
+import (
+	"context"
+	"reflect"
+	"slices"
+
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+)
+
+// ControllerName is the name of this controller.
+const ControllerName = "maintenancepolicy"
+
+// AddToManager adds Reconciler to the given manager.
+func (r *Reconciler) AddToManager(mgr manager.Manager) error {
+	if r.Client == nil {
+		r.Client = mgr.GetClient()
+	}
+	if r.Clock == nil {
+		r.Clock = clock.RealClock{}
+	}
+
+	return builder.
+		ControllerManagedBy(mgr).
+		Named(ControllerName).
+		For(&gardencorev1beta1.MaintenancePolicy{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: ptr.Deref(r.Config.ConcurrentSyncs, 0),
+		}).
+		Watches(
+			&gardencorev1beta1.Shoot{},
+			handler.EnqueueRequestsFromMapFunc(r.MapShootToMaintenancePolicies),
+			builder.WithPredicates(r.ShootPredicate()),
+		).
+		Complete(r)
+}
+
+// ShootPredicate returns a predicate which returns true for Shoot events which might change the rollout progress of
+// MaintenancePolicies, i.e., if the used versions, the labels, the eligibility for automatic updates or the health of
+// the Shoot change.
+func (r *Reconciler) ShootPredicate() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc: func(_ event.CreateEvent) bool { return true },
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			shoot, ok := e.ObjectNew.(*gardencorev1beta1.Shoot)
+			if !ok {
+				return false
+			}
+			oldShoot, ok := e.ObjectOld.(*gardencorev1beta1.Shoot)
+			if !ok {
+				return false
+			}
+
+			return !reflect.DeepEqual(shoot.Labels, oldShoot.Labels) ||
+				!reflect.DeepEqual(shoot.DeletionTimestamp, oldShoot.DeletionTimestamp) ||
+				!slices.Equal(usedVersions(shoot), usedVersions(oldShoot)) ||
+				!reflect.DeepEqual(eligibleKinds(shoot), eligibleKinds(oldShoot)) ||
+				shootHealthy(shoot) != shootHealthy(oldShoot)
+		},
+		DeleteFunc:  func(_ event.DeleteEvent) bool { return true },
+		GenericFunc: func(_ event.GenericEvent) bool { return false },
+	}
+}
+
+// MapShootToMaintenancePolicies maps a Shoot to all MaintenancePolicies. The number of MaintenancePolicies is expected
+// to be small, and whether a Shoot belonged to a wave before a change of its labels cannot be determined anymore.
+func (r *Reconciler) MapShootToMaintenancePolicies(ctx context.Context, _ client.Object) []reconcile.Request {
+	log := logf.FromContext(ctx)
+
+	policyList := &gardencorev1beta1.MaintenancePolicyList{}
+	if err := r.Client.List(ctx, policyList); err != nil {
+		log.Error(err, "Failed to list MaintenancePolicies")
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0, len(policyList.Items))
+	for _, policy := range policyList.Items {
+		requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&policy)})
+	}
+	return requests
+}
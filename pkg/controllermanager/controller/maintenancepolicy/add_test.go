@@ -0,0 +1,126 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package maintenancepolicy_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/maintenancepolicy"
+)
+
+var _ = Describe("Add", func() {
+	var (
+		ctx        = context.TODO()
+		fakeClient client.Client
+		reconciler *Reconciler
+		shoot      *gardencorev1beta1.Shoot
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+		reconciler = &Reconciler{Client: fakeClient}
+
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: "garden-dev", Labels: map[string]string{"foo": "bar"}},
+			Spec: gardencorev1beta1.ShootSpec{
+				Kubernetes: gardencorev1beta1.Kubernetes{Version: "1.30.1"},
+				Provider: gardencorev1beta1.Provider{Workers: []gardencorev1beta1.Worker{
+					{Name: "worker", Machine: gardencorev1beta1.Machine{Image: &gardencorev1beta1.ShootMachineImage{Name: "gardenlinux", Version: ptr.To("1.0.0")}}},
+				}},
+			},
+			Status: gardencorev1beta1.ShootStatus{
+				LastOperation: &gardencorev1beta1.LastOperation{State: gardencorev1beta1.LastOperationStateSucceeded},
+			},
+		}
+	})
+
+	Describe("#ShootPredicate", func() {
+		var p predicate.Predicate
+
+		BeforeEach(func() {
+			p = reconciler.ShootPredicate()
+		})
+
+		It("should return true for create and delete events", func() {
+			Expect(p.Create(event.CreateEvent{Object: shoot})).To(BeTrue())
+			Expect(p.Delete(event.DeleteEvent{Object: shoot})).To(BeTrue())
+		})
+
+		It("should return false for generic events", func() {
+			Expect(p.Generic(event.GenericEvent{Object: shoot})).To(BeFalse())
+		})
+
+		Describe("#Update", func() {
+			var oldShoot *gardencorev1beta1.Shoot
+
+			BeforeEach(func() {
+				oldShoot = shoot.DeepCopy()
+			})
+
+			It("should return false if nothing relevant changed", func() {
+				shoot.Spec.Purpose = ptr.To(gardencorev1beta1.ShootPurposeProduction)
+				Expect(p.Update(event.UpdateEvent{ObjectOld: oldShoot, ObjectNew: shoot})).To(BeFalse())
+			})
+
+			It("should return true if the labels changed", func() {
+				shoot.Labels["foo"] = "baz"
+				Expect(p.Update(event.UpdateEvent{ObjectOld: oldShoot, ObjectNew: shoot})).To(BeTrue())
+			})
+
+			It("should return true if the Kubernetes version changed", func() {
+				shoot.Spec.Kubernetes.Version = "1.30.2"
+				Expect(p.Update(event.UpdateEvent{ObjectOld: oldShoot, ObjectNew: shoot})).To(BeTrue())
+			})
+
+			It("should return true if the machine image version changed", func() {
+				shoot.Spec.Provider.Workers[0].Machine.Image.Version = ptr.To("1.1.0")
+				Expect(p.Update(event.UpdateEvent{ObjectOld: oldShoot, ObjectNew: shoot})).To(BeTrue())
+			})
+
+			It("should return true if the Shoot is hibernated", func() {
+				shoot.Spec.Hibernation = &gardencorev1beta1.Hibernation{Enabled: ptr.To(true)}
+				Expect(p.Update(event.UpdateEvent{ObjectOld: oldShoot, ObjectNew: shoot})).To(BeTrue())
+			})
+
+			It("should return true if automatic updates are disabled", func() {
+				shoot.Spec.Maintenance = &gardencorev1beta1.Maintenance{AutoUpdate: &gardencorev1beta1.MaintenanceAutoUpdate{KubernetesVersion: false}}
+				Expect(p.Update(event.UpdateEvent{ObjectOld: oldShoot, ObjectNew: shoot})).To(BeTrue())
+			})
+
+			It("should return true if the health changed", func() {
+				shoot.Status.LastOperation.State = gardencorev1beta1.LastOperationStateError
+				Expect(p.Update(event.UpdateEvent{ObjectOld: oldShoot, ObjectNew: shoot})).To(BeTrue())
+			})
+		})
+	})
+
+	Describe("#MapShootToMaintenancePolicies", func() {
+		It("should return nothing if there are no MaintenancePolicies", func() {
+			Expect(reconciler.MapShootToMaintenancePolicies(ctx, shoot)).To(BeEmpty())
+		})
+
+		It("should map the Shoot to all MaintenancePolicies", func() {
+			Expect(fakeClient.Create(ctx, &gardencorev1beta1.MaintenancePolicy{ObjectMeta: metav1.ObjectMeta{Name: "foo"}})).To(Succeed())
+			Expect(fakeClient.Create(ctx, &gardencorev1beta1.MaintenancePolicy{ObjectMeta: metav1.ObjectMeta{Name: "bar"}})).To(Succeed())
+
+			Expect(reconciler.MapShootToMaintenancePolicies(ctx, shoot)).To(ConsistOf(
+				reconcile.Request{NamespacedName: client.ObjectKey{Name: "foo"}},
+				reconcile.Request{NamespacedName: client.ObjectKey{Name: "bar"}},
+			))
+		})
+	})
+})
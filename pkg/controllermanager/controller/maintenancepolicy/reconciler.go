@@ -0,0 +1,239 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package maintenancepolicy
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	"github.com/gardener/gardener/pkg/controllerutils"
+)
+
+// Reconciler reconciles MaintenancePolicies and computes the rollout progress of the versions used by the Shoots of
+// their waves.
+type Reconciler struct {
+	Client client.Client
+	Config config.MaintenancePolicyControllerConfiguration
+	Clock  clock.Clock
+}
+
+// Reconcile reconciles MaintenancePolicies and computes the rollout progress of the versions used by the Shoots of
+// their waves.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	ctx, cancel := controllerutils.GetMainReconciliationContext(ctx, controllerutils.DefaultReconciliationTimeout)
+	defer cancel()
+
+	policy := &gardencorev1beta1.MaintenancePolicy{}
+	if err := r.Client.Get(ctx, request.NamespacedName, policy); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	shootList := &gardencorev1beta1.ShootList{}
+	if err := r.Client.List(ctx, shootList); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed listing shoots: %w", err)
+	}
+
+	projectList := &gardencorev1beta1.ProjectList{}
+	if err := r.Client.List(ctx, projectList); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed listing projects: %w", err)
+	}
+
+	status, err := computeStatus(policy, shootList.Items, projectList.Items, r.Clock.Now())
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	patch := client.MergeFrom(policy.DeepCopy())
+	policy.Status = *status
+	if err := r.Client.Status().Patch(ctx, policy, patch); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed updating status: %w", err)
+	}
+
+	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+}
+
+type rolloutKey struct {
+	kind    gardencorev1beta1.MaintenanceRolloutKind
+	name    string
+	version string
+}
+
+type waveProgress struct {
+	updatedShoots int32
+	healthy       bool
+}
+
+// computeStatus computes the status of the given MaintenancePolicy based on the versions which are used by the Shoots
+// of its waves.
+func computeStatus(policy *gardencorev1beta1.MaintenancePolicy, shoots []gardencorev1beta1.Shoot, projects []gardencorev1beta1.Project, now time.Time) (*gardencorev1beta1.MaintenancePolicyStatus, error) {
+	var (
+		status = &gardencorev1beta1.MaintenancePolicyStatus{ObservedGeneration: policy.Generation}
+
+		namespaceToProject = make(map[string]*gardencorev1beta1.Project, len(projects))
+		waveShoots         = make([]int32, len(policy.Spec.Waves))
+		rollouts           = make(map[rolloutKey][]*waveProgress)
+	)
+
+	for i, project := range projects {
+		if project.Spec.Namespace != nil {
+			namespaceToProject[*project.Spec.Namespace] = &projects[i]
+		}
+	}
+
+	for _, shoot := range shoots {
+		if shoot.DeletionTimestamp != nil {
+			continue
+		}
+
+		waveIndex, err := v1beta1helper.MaintenanceRolloutWaveIndex(policy, &shoot, namespaceToProject[shoot.Namespace])
+		if err != nil {
+			return nil, err
+		}
+		if waveIndex < 0 {
+			continue
+		}
+
+		waveShoots[waveIndex]++
+		healthy := shootHealthy(&shoot)
+		kinds := eligibleKinds(&shoot)
+
+		for _, key := range usedVersions(&shoot) {
+			// Shoots which are not automatically updated to the version do not take part in its rollout. Otherwise, a
+			// wave consisting only of such Shoots would block the rollout to the later waves forever.
+			if !slices.Contains(kinds, key.kind) {
+				continue
+			}
+
+			if rollouts[key] == nil {
+				rollouts[key] = make([]*waveProgress, len(policy.Spec.Waves))
+			}
+			if rollouts[key][waveIndex] == nil {
+				rollouts[key][waveIndex] = &waveProgress{healthy: true}
+			}
+			rollouts[key][waveIndex].updatedShoots++
+			rollouts[key][waveIndex].healthy = rollouts[key][waveIndex].healthy && healthy
+		}
+	}
+
+	for i, wave := range policy.Spec.Waves {
+		status.Waves = append(status.Waves, gardencorev1beta1.MaintenanceRolloutWaveStatus{Name: wave.Name, Shoots: waveShoots[i]})
+	}
+
+	for key, progresses := range rollouts {
+		var (
+			rollout    = gardencorev1beta1.MaintenanceRollout{Kind: key.kind, Name: key.name, Version: key.version}
+			oldRollout = v1beta1helper.FindMaintenanceRollout(policy, key.kind, key.name, key.version)
+		)
+
+		for i, progress := range progresses {
+			if progress == nil {
+				continue
+			}
+
+			waveProgress := gardencorev1beta1.MaintenanceRolloutWaveProgress{Name: policy.Spec.Waves[i].Name, UpdatedShoots: progress.updatedShoots}
+			if progress.healthy {
+				waveProgress.HealthySince = healthySince(oldRollout, waveProgress, now)
+			}
+			rollout.Waves = append(rollout.Waves, waveProgress)
+		}
+
+		status.Rollouts = append(status.Rollouts, rollout)
+	}
+
+	slices.SortFunc(status.Rollouts, func(a, b gardencorev1beta1.MaintenanceRollout) int {
+		return strings.Compare(string(a.Kind)+"/"+a.Name+"/"+a.Version, string(b.Kind)+"/"+b.Name+"/"+b.Version)
+	})
+
+	return status, nil
+}
+
+// healthySince keeps the previous time since when the Shoots of the wave have been healthy unless further Shoots
+// started using the version in the meantime.
+func healthySince(oldRollout *gardencorev1beta1.MaintenanceRollout, progress gardencorev1beta1.MaintenanceRolloutWaveProgress, now time.Time) *metav1.Time {
+	if oldRollout != nil {
+		for _, oldProgress := range oldRollout.Waves {
+			if oldProgress.Name == progress.Name && oldProgress.HealthySince != nil && oldProgress.UpdatedShoots >= progress.UpdatedShoots {
+				return oldProgress.HealthySince
+			}
+		}
+	}
+	return &metav1.Time{Time: now.UTC()}
+}
+
+// usedVersions returns the Kubernetes and machine image versions which are used by the given Shoot.
+func usedVersions(shoot *gardencorev1beta1.Shoot) []rolloutKey {
+	keys := []rolloutKey{{kind: gardencorev1beta1.MaintenanceRolloutKindKubernetes, version: shoot.Spec.Kubernetes.Version}}
+
+	for _, worker := range shoot.Spec.Provider.Workers {
+		if worker.Kubernetes != nil && worker.Kubernetes.Version != nil {
+			keys = append(keys, rolloutKey{kind: gardencorev1beta1.MaintenanceRolloutKindKubernetes, version: *worker.Kubernetes.Version})
+		}
+		if worker.Machine.Image != nil && worker.Machine.Image.Version != nil {
+			keys = append(keys, rolloutKey{kind: gardencorev1beta1.MaintenanceRolloutKindMachineImage, name: worker.Machine.Image.Name, version: *worker.Machine.Image.Version})
+		}
+	}
+
+	slices.SortFunc(keys, func(a, b rolloutKey) int {
+		return strings.Compare(string(a.kind)+"/"+a.name+"/"+a.version, string(b.kind)+"/"+b.name+"/"+b.version)
+	})
+	return slices.Compact(keys)
+}
+
+// eligibleKinds returns the kinds of versions which are automatically updated for the given Shoot during its
+// maintenance. Hibernated Shoots are not eligible for any kind since they are not updated until they are woken up.
+func eligibleKinds(shoot *gardencorev1beta1.Shoot) []gardencorev1beta1.MaintenanceRolloutKind {
+	if v1beta1helper.HibernationIsEnabled(shoot) || shoot.Status.IsHibernated {
+		return nil
+	}
+
+	var autoUpdate *gardencorev1beta1.MaintenanceAutoUpdate
+	if shoot.Spec.Maintenance != nil {
+		autoUpdate = shoot.Spec.Maintenance.AutoUpdate
+	}
+
+	var kinds []gardencorev1beta1.MaintenanceRolloutKind
+	if autoUpdate == nil || autoUpdate.KubernetesVersion {
+		kinds = append(kinds, gardencorev1beta1.MaintenanceRolloutKindKubernetes)
+	}
+	if autoUpdate == nil || ptr.Deref(autoUpdate.MachineImageVersion, true) {
+		kinds = append(kinds, gardencorev1beta1.MaintenanceRolloutKindMachineImage)
+	}
+	return kinds
+}
+
+// shootHealthy returns true if the last operation of the given Shoot succeeded and all of its conditions are true.
+func shootHealthy(shoot *gardencorev1beta1.Shoot) bool {
+	if shoot.Status.LastOperation == nil || shoot.Status.LastOperation.State != gardencorev1beta1.LastOperationStateSucceeded {
+		return false
+	}
+
+	for _, condition := range shoot.Status.Conditions {
+		if condition.Status != gardencorev1beta1.ConditionTrue {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,191 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package maintenancepolicy_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/maintenancepolicy"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx        = context.TODO()
+		fakeClient client.Client
+		fakeClock  *testclock.FakeClock
+		reconciler *Reconciler
+
+		policy *gardencorev1beta1.MaintenancePolicy
+	)
+
+	newShoot := func(name, namespace, kubernetesVersion, imageVersion string, healthy bool) *gardencorev1beta1.Shoot {
+		shoot := &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec: gardencorev1beta1.ShootSpec{
+				Kubernetes: gardencorev1beta1.Kubernetes{Version: kubernetesVersion},
+				Provider: gardencorev1beta1.Provider{Workers: []gardencorev1beta1.Worker{
+					{Name: "worker-1", Machine: gardencorev1beta1.Machine{Image: &gardencorev1beta1.ShootMachineImage{Name: "gardenlinux", Version: ptr.To(imageVersion)}}},
+					{Name: "worker-2", Machine: gardencorev1beta1.Machine{Image: &gardencorev1beta1.ShootMachineImage{Name: "gardenlinux", Version: ptr.To(imageVersion)}}},
+				}},
+			},
+			Status: gardencorev1beta1.ShootStatus{
+				LastOperation: &gardencorev1beta1.LastOperation{State: gardencorev1beta1.LastOperationStateSucceeded},
+				Conditions:    []gardencorev1beta1.Condition{{Type: gardencorev1beta1.ShootAPIServerAvailable, Status: gardencorev1beta1.ConditionTrue}},
+			},
+		}
+		if !healthy {
+			shoot.Status.Conditions[0].Status = gardencorev1beta1.ConditionFalse
+		}
+		return shoot
+	}
+
+	newProject := func(name, purpose string) *gardencorev1beta1.Project {
+		return &gardencorev1beta1.Project{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec:       gardencorev1beta1.ProjectSpec{Namespace: ptr.To("garden-" + name), Purpose: ptr.To(purpose)},
+		}
+	}
+
+	BeforeEach(func() {
+		fakeClock = testclock.NewFakeClock(time.Date(2024, 6, 1, 10, 0, 0, 0, time.UTC))
+
+		policy = &gardencorev1beta1.MaintenancePolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "default", Generation: 2},
+			Spec: gardencorev1beta1.MaintenancePolicySpec{
+				Waves: []gardencorev1beta1.MaintenanceRolloutWave{
+					{Name: "dev", ProjectPurposes: []string{"dev"}},
+					{Name: "prod", ProjectPurposes: []string{"prod"}},
+				},
+			},
+		}
+
+		fakeClient = fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.GardenScheme).
+			WithObjects(
+				policy,
+				newProject("dev", "dev"),
+				newProject("prod", "prod"),
+				newProject("other", "other"),
+				newShoot("dev-1", "garden-dev", "1.30.5", "1.1.0", true),
+				newShoot("dev-2", "garden-dev", "1.30.1", "1.1.0", true),
+				newShoot("prod-1", "garden-prod", "1.30.1", "1.0.0", true),
+				newShoot("prod-2", "garden-prod", "1.30.1", "1.0.0", false),
+				newShoot("other-1", "garden-other", "1.31.0", "1.0.0", true),
+			).
+			WithStatusSubresource(policy).
+			Build()
+
+		reconciler = &Reconciler{
+			Client: fakeClient,
+			Config: config.MaintenancePolicyControllerConfiguration{SyncPeriod: &metav1.Duration{Duration: 5 * time.Minute}},
+			Clock:  fakeClock,
+		}
+	})
+
+	It("should do nothing if the policy is gone", func() {
+		Expect(fakeClient.Delete(ctx, policy)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(policy)})).To(Equal(reconcile.Result{}))
+	})
+
+	It("should compute the rollout progress", func() {
+		Expect(reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(policy)})).To(Equal(reconcile.Result{RequeueAfter: 5 * time.Minute}))
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(policy), policy)).To(Succeed())
+		now := &metav1.Time{Time: fakeClock.Now()}
+		Expect(policy.Status.ObservedGeneration).To(Equal(int64(2)))
+		Expect(policy.Status.Waves).To(Equal([]gardencorev1beta1.MaintenanceRolloutWaveStatus{
+			{Name: "dev", Shoots: 2},
+			{Name: "prod", Shoots: 2},
+		}))
+		Expect(policy.Status.Rollouts).To(HaveLen(4))
+		Expect(policy.Status.Rollouts[0].Kind).To(Equal(gardencorev1beta1.MaintenanceRolloutKindKubernetes))
+		Expect(policy.Status.Rollouts[0].Version).To(Equal("1.30.1"))
+		Expect(policy.Status.Rollouts[0].Waves).To(HaveLen(2))
+		Expect(policy.Status.Rollouts[0].Waves[0].UpdatedShoots).To(Equal(int32(1)))
+		Expect(policy.Status.Rollouts[0].Waves[0].HealthySince.Equal(now)).To(BeTrue())
+		Expect(policy.Status.Rollouts[0].Waves[1].UpdatedShoots).To(Equal(int32(2)))
+		Expect(policy.Status.Rollouts[0].Waves[1].HealthySince).To(BeNil())
+		Expect(policy.Status.Rollouts[1].Version).To(Equal("1.30.5"))
+		Expect(policy.Status.Rollouts[1].Waves).To(HaveLen(1))
+		Expect(policy.Status.Rollouts[2].Kind).To(Equal(gardencorev1beta1.MaintenanceRolloutKindMachineImage))
+		Expect(policy.Status.Rollouts[2].Name).To(Equal("gardenlinux"))
+		Expect(policy.Status.Rollouts[2].Version).To(Equal("1.0.0"))
+		Expect(policy.Status.Rollouts[3].Version).To(Equal("1.1.0"))
+		Expect(policy.Status.Rollouts[3].Waves).To(HaveLen(1))
+		Expect(policy.Status.Rollouts[3].Waves[0].Name).To(Equal("dev"))
+		Expect(policy.Status.Rollouts[3].Waves[0].UpdatedShoots).To(Equal(int32(2)))
+	})
+
+	It("should not count Shoots which are not automatically updated", func() {
+		shoot := &gardencorev1beta1.Shoot{}
+		Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: "garden-prod", Name: "prod-1"}, shoot)).To(Succeed())
+		shoot.Spec.Hibernation = &gardencorev1beta1.Hibernation{Enabled: ptr.To(true)}
+		Expect(fakeClient.Update(ctx, shoot)).To(Succeed())
+
+		Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: "garden-prod", Name: "prod-2"}, shoot)).To(Succeed())
+		shoot.Spec.Maintenance = &gardencorev1beta1.Maintenance{AutoUpdate: &gardencorev1beta1.MaintenanceAutoUpdate{KubernetesVersion: true, MachineImageVersion: ptr.To(false)}}
+		Expect(fakeClient.Update(ctx, shoot)).To(Succeed())
+
+		_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(policy)})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(policy), policy)).To(Succeed())
+		Expect(policy.Status.Waves).To(Equal([]gardencorev1beta1.MaintenanceRolloutWaveStatus{
+			{Name: "dev", Shoots: 2},
+			{Name: "prod", Shoots: 2},
+		}))
+		Expect(policy.Status.Rollouts).To(HaveLen(3))
+		Expect(policy.Status.Rollouts[0].Version).To(Equal("1.30.1"))
+		Expect(policy.Status.Rollouts[0].Waves[1].UpdatedShoots).To(Equal(int32(1)))
+		Expect(policy.Status.Rollouts[2].Kind).To(Equal(gardencorev1beta1.MaintenanceRolloutKindMachineImage))
+		Expect(policy.Status.Rollouts[2].Version).To(Equal("1.1.0"))
+	})
+
+	It("should keep the time since when the Shoots have been healthy", func() {
+		_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(policy)})
+		Expect(err).NotTo(HaveOccurred())
+		healthySince := metav1.Time{Time: fakeClock.Now()}
+
+		fakeClock.Step(time.Hour)
+		_, err = reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(policy)})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(policy), policy)).To(Succeed())
+		Expect(policy.Status.Rollouts[3].Waves[0].HealthySince.Equal(&healthySince)).To(BeTrue())
+	})
+
+	It("should reset the time since when the Shoots have been healthy if further Shoots use the version", func() {
+		_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(policy)})
+		Expect(err).NotTo(HaveOccurred())
+
+		shoot := &gardencorev1beta1.Shoot{}
+		Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: "garden-dev", Name: "dev-2"}, shoot)).To(Succeed())
+		shoot.Spec.Kubernetes.Version = "1.30.5"
+		Expect(fakeClient.Update(ctx, shoot)).To(Succeed())
+
+		fakeClock.Step(time.Hour)
+		_, err = reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(policy)})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(policy), policy)).To(Succeed())
+		Expect(policy.Status.Rollouts[1].Version).To(Equal("1.30.5"))
+		Expect(policy.Status.Rollouts[1].Waves[0].UpdatedShoots).To(Equal(int32(2)))
+		Expect(policy.Status.Rollouts[1].Waves[0].HealthySince.Equal(&metav1.Time{Time: fakeClock.Now()})).To(BeTrue())
+	})
+})
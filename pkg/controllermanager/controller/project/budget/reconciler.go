@@ -0,0 +1,304 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package budget
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+const gibibyte = 1 << 30
+
+// Reconciler reconciles Projects with a budget and accounts the resources consumed by their Shoots.
+type Reconciler struct {
+	Client   client.Client
+	Config   config.ProjectControllerConfiguration
+	Clock    clock.Clock
+	Recorder record.EventRecorder
+}
+
+// Reconcile reconciles Projects with a budget and accounts the resources consumed by their Shoots.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	ctx, cancel := controllerutils.GetMainReconciliationContext(ctx, controllerutils.DefaultReconciliationTimeout)
+	defer cancel()
+
+	project := &gardencorev1beta1.Project{}
+	if err := r.Client.Get(ctx, request.NamespacedName, project); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	if project.Spec.Budget == nil {
+		if project.Status.Usage == nil {
+			return reconcile.Result{}, nil
+		}
+
+		log.Info("Project has no budget anymore, removing usage from status")
+		patch := client.MergeFrom(project.DeepCopy())
+		project.Status.Usage = nil
+		if err := r.Client.Status().Patch(ctx, project, patch); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed removing usage from Project status: %w", err)
+		}
+		return reconcile.Result{}, nil
+	}
+
+	if project.DeletionTimestamp != nil || project.Spec.Namespace == nil {
+		return reconcile.Result{RequeueAfter: r.Config.BudgetSyncPeriod.Duration}, nil
+	}
+
+	if err := r.reconcile(ctx, log, project); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{RequeueAfter: r.Config.BudgetSyncPeriod.Duration}, nil
+}
+
+func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, project *gardencorev1beta1.Project) error {
+	var (
+		now         = r.Clock.Now().UTC()
+		periodStart = time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+		usage       = project.Status.Usage.DeepCopy()
+		oldState    gardencorev1beta1.ProjectBudgetState
+	)
+
+	if usage != nil {
+		oldState = usage.BudgetState
+	}
+
+	switch {
+	case usage == nil:
+		// Accounting starts when the budget is configured, consumption before that point in time is not known.
+		usage = newUsage(periodStart, now)
+	case usage.PeriodStart.UTC().Before(periodStart):
+		log.Info("Starting new accounting period", "periodStart", periodStart)
+		usage = newUsage(periodStart, periodStart)
+	}
+
+	rate, err := r.computeConsumptionRate(ctx, project)
+	if err != nil {
+		return err
+	}
+
+	// The consumption since the last update is approximated with the currently observed machines.
+	hours := math.Max(0, now.Sub(usage.LastUpdateTime.UTC()).Hours())
+	usage.MachineHours.Add(milliQuantity(rate.machines * hours))
+	usage.StorageGibibyteHours.Add(milliQuantity(rate.storageGibibytes * hours))
+	if project.Spec.Budget.Prices != nil {
+		cost := milliQuantity(rate.cost * hours)
+		if usage.Cost != nil {
+			cost.Add(*usage.Cost)
+		}
+		usage.Cost = &cost
+	} else {
+		usage.Cost = nil
+	}
+	usage.LastUpdateTime = metav1.Time{Time: now}
+	usage.BudgetState = computeBudgetState(project.Spec.Budget, usage)
+
+	if usage.BudgetState != oldState {
+		log.Info("Budget state of Project changed", "oldState", oldState, "newState", usage.BudgetState)
+
+		switch usage.BudgetState {
+		case gardencorev1beta1.ProjectBudgetStateWarning:
+			r.Recorder.Eventf(project, corev1.EventTypeWarning, gardencorev1beta1.ProjectEventBudgetWarning, "Usage of project reached %d%% of its budget", *project.Spec.Budget.WarningThresholdPercentage)
+		case gardencorev1beta1.ProjectBudgetStateExceeded:
+			r.Recorder.Event(project, corev1.EventTypeWarning, gardencorev1beta1.ProjectEventBudgetExceeded, "Usage of project exceeded its budget, new shoots and scale-ups are rejected")
+		}
+	}
+
+	patch := client.MergeFrom(project.DeepCopy())
+	project.Status.Usage = usage
+	if err := r.Client.Status().Patch(ctx, project, patch); err != nil {
+		return fmt.Errorf("failed updating usage in Project status: %w", err)
+	}
+
+	return nil
+}
+
+// consumptionRate is the consumption of the Shoots of a project per hour.
+type consumptionRate struct {
+	machines         float64
+	storageGibibytes float64
+	cost             float64
+}
+
+func (r *Reconciler) computeConsumptionRate(ctx context.Context, project *gardencorev1beta1.Project) (consumptionRate, error) {
+	var rate consumptionRate
+
+	shootList := &gardencorev1beta1.ShootList{}
+	if err := r.Client.List(ctx, shootList, client.InNamespace(*project.Spec.Namespace)); err != nil {
+		return rate, fmt.Errorf("failed listing Shoots of Project: %w", err)
+	}
+
+	var (
+		machineTypePrices = map[string]float64{}
+		volumeTypePrices  = map[string]float64{}
+	)
+
+	if prices := project.Spec.Budget.Prices; prices != nil {
+		for _, price := range prices.MachineTypes {
+			machineTypePrices[price.Name] = price.PerHour.AsApproximateFloat64()
+		}
+		for _, price := range prices.VolumeTypes {
+			volumeTypePrices[price.Name] = price.PerGibibyteHour.AsApproximateFloat64()
+		}
+	}
+
+	for _, shoot := range shootList.Items {
+		if len(shoot.Status.WorkerPools) == 0 {
+			continue
+		}
+
+		var cloudProfile *gardencorev1beta1.CloudProfile
+
+		for _, pool := range shoot.Status.WorkerPools {
+			worker := findWorker(shoot.Spec.Provider.Workers, pool.Name)
+			if worker == nil || pool.Machines <= 0 {
+				continue
+			}
+
+			machines := float64(pool.Machines)
+			rate.machines += machines
+			rate.cost += machines * machineTypePrices[worker.Machine.Type]
+
+			volumes, err := workerVolumes(worker)
+			if err != nil {
+				return rate, fmt.Errorf("failed determining volumes of worker pool %q of Shoot %s: %w", worker.Name, client.ObjectKeyFromObject(&shoot), err)
+			}
+
+			if worker.Volume == nil {
+				if cloudProfile == nil {
+					if cloudProfile, err = gardenerutils.GetCloudProfile(ctx, r.Client, &shoot); err != nil {
+						return rate, fmt.Errorf("failed reading CloudProfile of Shoot %s: %w", client.ObjectKeyFromObject(&shoot), err)
+					}
+				}
+
+				if machineType := findMachineType(cloudProfile.Spec.MachineTypes, worker.Machine.Type); machineType != nil && machineType.Storage != nil && machineType.Storage.StorageSize != nil {
+					volumes = append(volumes, volume{volumeType: machineType.Storage.Type, size: *machineType.Storage.StorageSize})
+				}
+			}
+
+			for _, v := range volumes {
+				gibibytes := machines * v.size.AsApproximateFloat64() / gibibyte
+				rate.storageGibibytes += gibibytes
+				rate.cost += gibibytes * volumeTypePrices[v.volumeType]
+			}
+		}
+	}
+
+	return rate, nil
+}
+
+type volume struct {
+	volumeType string
+	size       resource.Quantity
+}
+
+func workerVolumes(worker *gardencorev1beta1.Worker) ([]volume, error) {
+	var volumes []volume
+
+	if worker.Volume != nil {
+		size, err := resource.ParseQuantity(worker.Volume.VolumeSize)
+		if err != nil {
+			return nil, err
+		}
+		volumes = append(volumes, volume{volumeType: ptr.Deref(worker.Volume.Type, ""), size: size})
+	}
+
+	for _, dataVolume := range worker.DataVolumes {
+		size, err := resource.ParseQuantity(dataVolume.VolumeSize)
+		if err != nil {
+			return nil, err
+		}
+		volumes = append(volumes, volume{volumeType: ptr.Deref(dataVolume.Type, ""), size: size})
+	}
+
+	return volumes, nil
+}
+
+func findWorker(workers []gardencorev1beta1.Worker, name string) *gardencorev1beta1.Worker {
+	for i := range workers {
+		if workers[i].Name == name {
+			return &workers[i]
+		}
+	}
+	return nil
+}
+
+func findMachineType(machineTypes []gardencorev1beta1.MachineType, name string) *gardencorev1beta1.MachineType {
+	for i := range machineTypes {
+		if machineTypes[i].Name == name {
+			return &machineTypes[i]
+		}
+	}
+	return nil
+}
+
+func computeBudgetState(budget *gardencorev1beta1.ProjectBudget, usage *gardencorev1beta1.ProjectUsage) gardencorev1beta1.ProjectBudgetState {
+	var (
+		// The warning threshold is defaulted by the API.
+		threshold = float64(*budget.WarningThresholdPercentage) / 100
+		state     = gardencorev1beta1.ProjectBudgetStateWithinBudget
+	)
+
+	for _, limit := range []struct {
+		max  *resource.Quantity
+		used *resource.Quantity
+	}{
+		{budget.MachineHours, &usage.MachineHours},
+		{budget.StorageGibibyteHours, &usage.StorageGibibyteHours},
+		{budget.Cost, usage.Cost},
+	} {
+		if limit.max == nil || limit.used == nil {
+			continue
+		}
+
+		if limit.used.Cmp(*limit.max) >= 0 {
+			return gardencorev1beta1.ProjectBudgetStateExceeded
+		}
+		if limit.used.AsApproximateFloat64() >= threshold*limit.max.AsApproximateFloat64() {
+			state = gardencorev1beta1.ProjectBudgetStateWarning
+		}
+	}
+
+	return state
+}
+
+func newUsage(periodStart, lastUpdateTime time.Time) *gardencorev1beta1.ProjectUsage {
+	return &gardencorev1beta1.ProjectUsage{
+		PeriodStart:          metav1.Time{Time: periodStart},
+		LastUpdateTime:       metav1.Time{Time: lastUpdateTime},
+		MachineHours:         *resource.NewQuantity(0, resource.DecimalSI),
+		StorageGibibyteHours: *resource.NewQuantity(0, resource.DecimalSI),
+	}
+}
+
+func milliQuantity(value float64) resource.Quantity {
+	return *resource.NewMilliQuantity(int64(math.Round(value*1000)), resource.DecimalSI)
+}
@@ -0,0 +1,259 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package budget_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/project/budget"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx        = context.TODO()
+		fakeClient client.Client
+		fakeClock  *testclock.FakeClock
+		recorder   *record.FakeRecorder
+		reconciler *Reconciler
+
+		syncPeriod = 10 * time.Minute
+		now        = time.Date(2024, time.March, 15, 12, 0, 0, 0, time.UTC)
+
+		project      *gardencorev1beta1.Project
+		shoot        *gardencorev1beta1.Shoot
+		cloudProfile *gardencorev1beta1.CloudProfile
+		request      reconcile.Request
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).WithStatusSubresource(&gardencorev1beta1.Project{}).Build()
+		fakeClock = testclock.NewFakeClock(now)
+		recorder = record.NewFakeRecorder(10)
+
+		reconciler = &Reconciler{
+			Client:   fakeClient,
+			Config:   config.ProjectControllerConfiguration{BudgetSyncPeriod: &metav1.Duration{Duration: syncPeriod}},
+			Clock:    fakeClock,
+			Recorder: recorder,
+		}
+
+		project = &gardencorev1beta1.Project{
+			ObjectMeta: metav1.ObjectMeta{Name: "foo", Generation: 1},
+			Spec: gardencorev1beta1.ProjectSpec{
+				Namespace: ptr.To("garden-foo"),
+				Budget: &gardencorev1beta1.ProjectBudget{
+					MachineHours:               ptr.To(resource.MustParse("100")),
+					WarningThresholdPercentage: ptr.To[int32](80),
+				},
+			},
+		}
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(project)}
+
+		cloudProfile = &gardencorev1beta1.CloudProfile{
+			ObjectMeta: metav1.ObjectMeta{Name: "profile"},
+			Spec: gardencorev1beta1.CloudProfileSpec{
+				MachineTypes: []gardencorev1beta1.MachineType{{
+					Name:    "small",
+					Storage: &gardencorev1beta1.MachineTypeStorage{Type: "ssd", StorageSize: ptr.To(resource.MustParse("20Gi"))},
+				}},
+			},
+		}
+		Expect(fakeClient.Create(ctx, cloudProfile)).To(Succeed())
+
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: "garden-foo"},
+			Spec: gardencorev1beta1.ShootSpec{
+				CloudProfileName: ptr.To(cloudProfile.Name),
+				Provider: gardencorev1beta1.Provider{
+					Workers: []gardencorev1beta1.Worker{
+						{
+							Name:    "pool1",
+							Machine: gardencorev1beta1.Machine{Type: "large"},
+							Volume:  &gardencorev1beta1.Volume{Type: ptr.To("standard"), VolumeSize: "50Gi"},
+						},
+						{
+							Name:    "pool2",
+							Machine: gardencorev1beta1.Machine{Type: "small"},
+						},
+					},
+				},
+			},
+			Status: gardencorev1beta1.ShootStatus{
+				WorkerPools: []gardencorev1beta1.WorkerPoolStatus{
+					{Name: "pool1", Machines: 3},
+					{Name: "pool2", Machines: 1},
+				},
+			},
+		}
+		Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+	})
+
+	expectUsage := func() *gardencorev1beta1.ProjectUsage {
+		GinkgoHelper()
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(project), project)).To(Succeed())
+		Expect(project.Status.Usage).NotTo(BeNil())
+		return project.Status.Usage
+	}
+
+	usageSince := func(lastUpdateTime time.Time, machineHours string) *gardencorev1beta1.ProjectUsage {
+		return &gardencorev1beta1.ProjectUsage{
+			PeriodStart:          metav1.Time{Time: time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)},
+			LastUpdateTime:       metav1.Time{Time: lastUpdateTime},
+			MachineHours:         resource.MustParse(machineHours),
+			StorageGibibyteHours: resource.MustParse("0"),
+			BudgetState:          gardencorev1beta1.ProjectBudgetStateWithinBudget,
+		}
+	}
+
+	It("should do nothing if the project is gone", func() {
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+	})
+
+	Context("without budget", func() {
+		BeforeEach(func() {
+			project.Spec.Budget = nil
+		})
+
+		It("should not set any usage", func() {
+			Expect(fakeClient.Create(ctx, project)).To(Succeed())
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(project), project)).To(Succeed())
+			Expect(project.Status.Usage).To(BeNil())
+		})
+
+		It("should remove the usage if the budget was removed", func() {
+			project.Status.Usage = usageSince(now.Add(-time.Hour), "5")
+			Expect(fakeClient.Create(ctx, project)).To(Succeed())
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(project), project)).To(Succeed())
+			Expect(project.Status.Usage).To(BeNil())
+		})
+	})
+
+	Context("with budget", func() {
+		It("should start accounting when the budget is configured", func() {
+			Expect(fakeClient.Create(ctx, project)).To(Succeed())
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+			usage := expectUsage()
+			Expect(usage.PeriodStart.Time.UTC()).To(Equal(time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)))
+			Expect(usage.LastUpdateTime.Time.UTC()).To(Equal(now))
+			Expect(usage.MachineHours.Value()).To(BeZero())
+			Expect(usage.StorageGibibyteHours.Value()).To(BeZero())
+			Expect(usage.Cost).To(BeNil())
+			Expect(usage.BudgetState).To(Equal(gardencorev1beta1.ProjectBudgetStateWithinBudget))
+		})
+
+		It("should accumulate the machine and storage hours of the observed machines", func() {
+			project.Status.Usage = usageSince(now.Add(-2*time.Hour), "10")
+			Expect(fakeClient.Create(ctx, project)).To(Succeed())
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+			usage := expectUsage()
+			Expect(usage.LastUpdateTime.Time.UTC()).To(Equal(now))
+			// 10 + 2h * (3 + 1) machines
+			Expect(usage.MachineHours.Cmp(resource.MustParse("18"))).To(BeZero())
+			// 2h * (3 * 50Gi + 1 * 20Gi from the CloudProfile)
+			Expect(usage.StorageGibibyteHours.Cmp(resource.MustParse("340"))).To(BeZero())
+			Expect(usage.BudgetState).To(Equal(gardencorev1beta1.ProjectBudgetStateWithinBudget))
+		})
+
+		It("should compute the cost based on the prices", func() {
+			project.Spec.Budget.Cost = ptr.To(resource.MustParse("1000"))
+			project.Spec.Budget.Prices = &gardencorev1beta1.BudgetPrices{
+				MachineTypes: []gardencorev1beta1.MachineTypePrice{{Name: "large", PerHour: resource.MustParse("0.5")}},
+				VolumeTypes:  []gardencorev1beta1.VolumeTypePrice{{Name: "ssd", PerGibibyteHour: resource.MustParse("0.1")}},
+			}
+			project.Status.Usage = usageSince(now.Add(-2*time.Hour), "0")
+			Expect(fakeClient.Create(ctx, project)).To(Succeed())
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+			usage := expectUsage()
+			Expect(usage.Cost).NotTo(BeNil())
+			// 2h * (3 * 0.5 + 20Gi * 0.1)
+			Expect(usage.Cost.Cmp(resource.MustParse("7"))).To(BeZero())
+		})
+
+		It("should not account machines of hibernated shoots", func() {
+			shoot.Status.WorkerPools = nil
+			Expect(fakeClient.Update(ctx, shoot)).To(Succeed())
+			project.Status.Usage = usageSince(now.Add(-2*time.Hour), "10")
+			Expect(fakeClient.Create(ctx, project)).To(Succeed())
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+			Expect(expectUsage().MachineHours.Cmp(resource.MustParse("10"))).To(BeZero())
+		})
+
+		It("should start a new period at the beginning of a month", func() {
+			project.Status.Usage = usageSince(time.Date(2024, time.February, 29, 23, 0, 0, 0, time.UTC), "90")
+			project.Status.Usage.PeriodStart = metav1.Time{Time: time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC)}
+			project.Status.Usage.BudgetState = gardencorev1beta1.ProjectBudgetStateWarning
+			fakeClock.SetTime(time.Date(2024, time.March, 1, 1, 0, 0, 0, time.UTC))
+			Expect(fakeClient.Create(ctx, project)).To(Succeed())
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+			usage := expectUsage()
+			Expect(usage.PeriodStart.Time.UTC()).To(Equal(time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)))
+			Expect(usage.MachineHours.Cmp(resource.MustParse("4"))).To(BeZero())
+			Expect(usage.BudgetState).To(Equal(gardencorev1beta1.ProjectBudgetStateWithinBudget))
+		})
+
+		It("should issue a warning if the threshold is reached", func() {
+			project.Status.Usage = usageSince(now.Add(-time.Hour), "77")
+			Expect(fakeClient.Create(ctx, project)).To(Succeed())
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+			Expect(expectUsage().BudgetState).To(Equal(gardencorev1beta1.ProjectBudgetStateWarning))
+			Expect(recorder.Events).To(Receive(ContainSubstring("BudgetWarning")))
+		})
+
+		It("should mark the budget as exceeded if a limit is reached", func() {
+			project.Status.Usage = usageSince(now.Add(-time.Hour), "97")
+			project.Status.Usage.BudgetState = gardencorev1beta1.ProjectBudgetStateWarning
+			Expect(fakeClient.Create(ctx, project)).To(Succeed())
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+			Expect(expectUsage().BudgetState).To(Equal(gardencorev1beta1.ProjectBudgetStateExceeded))
+			Expect(recorder.Events).To(Receive(ContainSubstring("BudgetExceeded")))
+		})
+
+		It("should not issue an event if the state is unchanged", func() {
+			project.Status.Usage = usageSince(now.Add(-time.Hour), "110")
+			project.Status.Usage.BudgetState = gardencorev1beta1.ProjectBudgetStateExceeded
+			Expect(fakeClient.Create(ctx, project)).To(Succeed())
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+			Expect(expectUsage().BudgetState).To(Equal(gardencorev1beta1.ProjectBudgetStateExceeded))
+			Expect(recorder.Events).NotTo(Receive())
+		})
+	})
+})
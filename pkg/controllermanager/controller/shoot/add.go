@@ -11,6 +11,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot/certificateexpiration"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot/conditions"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot/hibernation"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot/maintenance"
@@ -22,6 +23,12 @@ import (
 
 // AddToManager adds all Shoot controllers to the given manager.
 func AddToManager(ctx context.Context, mgr manager.Manager, cfg config.ControllerManagerConfiguration) error {
+	if err := (&certificateexpiration.Reconciler{
+		Config: *cfg.Controllers.ShootCertificateExpiration,
+	}).AddToManager(mgr); err != nil {
+		return fmt.Errorf("failed adding certificateexpiration reconciler: %w", err)
+	}
+
 	if err := (&conditions.Reconciler{
 		Config: *cfg.Controllers.ShootConditions,
 	}).AddToManager(ctx, mgr); err != nil {
@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package certificateexpiration_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/shoot/certificateexpiration"
+)
+
+var _ = Describe("Add", func() {
+	var (
+		reconciler *Reconciler
+		configMap  *metav1.PartialObjectMetadata
+	)
+
+	BeforeEach(func() {
+		reconciler = &Reconciler{}
+		configMap = &metav1.PartialObjectMetadata{ObjectMeta: metav1.ObjectMeta{
+			Name:      "foo.secrets-inventory",
+			Namespace: "garden-bar",
+			Labels:    map[string]string{v1beta1constants.GardenRole: v1beta1constants.GardenRoleSecretsInventory},
+		}}
+	})
+
+	Describe("#SecretsInventoryPredicate", func() {
+		It("should return true for secrets inventory ConfigMaps", func() {
+			Expect(reconciler.SecretsInventoryPredicate().Update(event.UpdateEvent{ObjectOld: configMap, ObjectNew: configMap})).To(BeTrue())
+		})
+
+		It("should return false for ConfigMaps without the secrets inventory role", func() {
+			configMap.Labels = nil
+			Expect(reconciler.SecretsInventoryPredicate().Update(event.UpdateEvent{ObjectOld: configMap, ObjectNew: configMap})).To(BeFalse())
+		})
+
+		It("should return false for ConfigMaps with an unexpected name", func() {
+			configMap.Name = "foo"
+			Expect(reconciler.SecretsInventoryPredicate().Update(event.UpdateEvent{ObjectOld: configMap, ObjectNew: configMap})).To(BeFalse())
+		})
+	})
+
+	Describe("#MapSecretsInventoryToShoot", func() {
+		It("should map the ConfigMap to its shoot", func() {
+			Expect(reconciler.MapSecretsInventoryToShoot(context.Background(), configMap)).To(ConsistOf(
+				reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "garden-bar", Name: "foo"}},
+			))
+		})
+
+		It("should not map ConfigMaps with an unexpected name", func() {
+			configMap.Name = "foo"
+			Expect(reconciler.MapSecretsInventoryToShoot(context.Background(), configMap)).To(BeEmpty())
+		})
+	})
+})
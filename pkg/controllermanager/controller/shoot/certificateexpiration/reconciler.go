@@ -0,0 +1,129 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package certificateexpiration
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+// Reconciler reconciles Shoots and labels them if certificates of their control planes expire within the configured
+// threshold according to the secrets inventory published by gardenlet.
+type Reconciler struct {
+	Client    client.Client
+	APIReader client.Reader
+	Config    config.ShootCertificateExpirationControllerConfiguration
+	Clock     clock.Clock
+	Recorder  record.EventRecorder
+}
+
+// Reconcile reconciles Shoots and labels them if certificates of their control planes expire within the configured
+// threshold according to the secrets inventory published by gardenlet.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	ctx, cancel := controllerutils.GetMainReconciliationContext(ctx, controllerutils.DefaultReconciliationTimeout)
+	defer cancel()
+
+	shoot := &gardencorev1beta1.Shoot{}
+	if err := r.Client.Get(ctx, request.NamespacedName, shoot); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	// The controller-manager only caches the metadata of ConfigMaps, hence the inventory is read directly from the API
+	// server. It is read once per sync period per shoot and whenever gardenlet publishes an updated inventory.
+	configMap := &corev1.ConfigMap{}
+	if err := r.APIReader.Get(ctx, client.ObjectKey{Namespace: shoot.Namespace, Name: gardenerutils.ComputeShootProjectResourceName(shoot.Name, gardenerutils.ShootProjectConfigMapSuffixSecretsInventory)}, configMap); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return reconcile.Result{}, fmt.Errorf("failed reading secrets inventory: %w", err)
+		}
+
+		log.V(1).Info("Secrets inventory was not published yet")
+		return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, r.removeLabel(ctx, shoot)
+	}
+
+	inventory, err := gardenerutils.SecretsInventoryFromConfigMap(configMap)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	var (
+		now      = r.Clock.Now().UTC()
+		deadline = now.Add(r.Config.ExpirationThreshold.Duration)
+		expiring = inventory.ExpiringCertificates(deadline)
+	)
+
+	if len(expiring) == 0 {
+		return reconcile.Result{RequeueAfter: r.requeueAfter(inventory, deadline)}, r.removeLabel(ctx, shoot)
+	}
+
+	if shoot.Labels[v1beta1constants.ShootCertificatesExpiring] != "true" {
+		var descriptions []string
+		for _, certificate := range expiring {
+			descriptions = append(descriptions, fmt.Sprintf("%s (secret %s, valid until %s)", certificate.Name, certificate.SecretName, certificate.ValidUntil.UTC().Format(time.RFC3339)))
+		}
+
+		log.Info("Certificates expire soon, adding label", "certificates", descriptions)
+		r.Recorder.Eventf(shoot, corev1.EventTypeWarning, gardencorev1beta1.ShootEventCertificatesExpiring, "Certificates expiring within %s: %s", r.Config.ExpirationThreshold.Duration, strings.Join(descriptions, ", "))
+
+		patch := client.MergeFrom(shoot.DeepCopy())
+		metav1.SetMetaDataLabel(&shoot.ObjectMeta, v1beta1constants.ShootCertificatesExpiring, "true")
+		if err := r.Client.Patch(ctx, shoot, patch); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
+	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+}
+
+// requeueAfter returns the sync period or the duration until the next certificate expires within the threshold if
+// this is earlier.
+func (r *Reconciler) requeueAfter(inventory *gardenerutils.SecretsInventory, deadline time.Time) time.Duration {
+	requeueAfter := r.Config.SyncPeriod.Duration
+
+	for _, certificate := range inventory.Certificates {
+		if certificate.ValidUntil == nil {
+			continue
+		}
+		if untilExpiring := certificate.ValidUntil.Sub(deadline); untilExpiring < requeueAfter {
+			requeueAfter = untilExpiring
+		}
+	}
+
+	return requeueAfter
+}
+
+func (r *Reconciler) removeLabel(ctx context.Context, shoot *gardencorev1beta1.Shoot) error {
+	if !metav1.HasLabel(shoot.ObjectMeta, v1beta1constants.ShootCertificatesExpiring) {
+		return nil
+	}
+
+	logf.FromContext(ctx).Info("Certificates no longer expire soon, removing label")
+
+	patch := client.MergeFrom(shoot.DeepCopy())
+	delete(shoot.Labels, v1beta1constants.ShootCertificatesExpiring)
+	return r.Client.Patch(ctx, shoot, patch)
+}
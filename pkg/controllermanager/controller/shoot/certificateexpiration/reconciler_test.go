@@ -0,0 +1,136 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package certificateexpiration_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	testclock "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/shoot/certificateexpiration"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx = context.TODO()
+
+		fakeClient client.Client
+		fakeClock  *testclock.FakeClock
+		recorder   *record.FakeRecorder
+		reconciler *Reconciler
+
+		shoot     *gardencorev1beta1.Shoot
+		configMap *corev1.ConfigMap
+		request   reconcile.Request
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+		fakeClock = testclock.NewFakeClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+		recorder = record.NewFakeRecorder(1)
+
+		reconciler = &Reconciler{
+			Client:    fakeClient,
+			APIReader: fakeClient,
+			Clock:     fakeClock,
+			Recorder:  recorder,
+			Config: config.ShootCertificateExpirationControllerConfiguration{
+				SyncPeriod:          &metav1.Duration{Duration: time.Hour},
+				ExpirationThreshold: &metav1.Duration{Duration: 30 * 24 * time.Hour},
+			},
+		}
+
+		shoot = &gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: "garden-foo"}}
+		Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(shoot)}
+
+		configMap = &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "bar.secrets-inventory", Namespace: "garden-foo"}}
+	})
+
+	createInventory := func(validUntil ...time.Time) {
+		var certificates []secretsmanager.CertificateInfo
+		for _, t := range validUntil {
+			certificates = append(certificates, secretsmanager.CertificateInfo{Name: "ca", SecretName: "ca-1234", CA: true, ValidUntil: &metav1.Time{Time: t}})
+		}
+
+		data, err := gardenerutils.NewSecretsInventory(shoot, certificates).ConfigMapData()
+		Expect(err).NotTo(HaveOccurred())
+		configMap.Data = data
+		Expect(fakeClient.Create(ctx, configMap)).To(Succeed())
+	}
+
+	It("should do nothing if the shoot is gone", func() {
+		Expect(fakeClient.Delete(ctx, shoot)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+	})
+
+	It("should remove the label if the inventory was not published", func() {
+		metav1.SetMetaDataLabel(&shoot.ObjectMeta, "shoot.gardener.cloud/certificates-expiring", "true")
+		Expect(fakeClient.Update(ctx, shoot)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+
+		Expect(fakeClient.Get(ctx, request.NamespacedName, shoot)).To(Succeed())
+		Expect(shoot.Labels).NotTo(HaveKey("shoot.gardener.cloud/certificates-expiring"))
+	})
+
+	It("should fail if the inventory cannot be decoded", func() {
+		configMap.Data = map[string]string{"inventory": "{"}
+		Expect(fakeClient.Create(ctx, configMap)).To(Succeed())
+
+		_, err := reconciler.Reconcile(ctx, request)
+		Expect(err).To(MatchError(ContainSubstring("failed decoding secrets inventory")))
+	})
+
+	It("should not label the shoot if no certificate expires within the threshold", func() {
+		createInventory(fakeClock.Now().AddDate(10, 0, 0), fakeClock.Now().Add(30*24*time.Hour+time.Minute))
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+
+		Expect(fakeClient.Get(ctx, request.NamespacedName, shoot)).To(Succeed())
+		Expect(shoot.Labels).NotTo(HaveKey("shoot.gardener.cloud/certificates-expiring"))
+		Expect(recorder.Events).To(BeEmpty())
+	})
+
+	It("should label the shoot and record an event if certificates expire within the threshold", func() {
+		createInventory(fakeClock.Now().AddDate(10, 0, 0), fakeClock.Now().AddDate(0, 0, 7))
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+
+		Expect(fakeClient.Get(ctx, request.NamespacedName, shoot)).To(Succeed())
+		Expect(shoot.Labels).To(HaveKeyWithValue("shoot.gardener.cloud/certificates-expiring", "true"))
+		Expect(recorder.Events).To(Receive(Equal("Warning CertificatesExpiring Certificates expiring within 720h0m0s: ca (secret ca-1234, valid until 2024-01-08T00:00:00Z)")))
+
+		By("Reconcile again")
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+		Expect(recorder.Events).To(BeEmpty())
+	})
+
+	It("should remove the label once the certificates were renewed", func() {
+		metav1.SetMetaDataLabel(&shoot.ObjectMeta, "shoot.gardener.cloud/certificates-expiring", "true")
+		Expect(fakeClient.Update(ctx, shoot)).To(Succeed())
+		createInventory(fakeClock.Now().AddDate(10, 0, 0))
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+
+		Expect(fakeClient.Get(ctx, request.NamespacedName, shoot)).To(Succeed())
+		Expect(shoot.Labels).NotTo(HaveKey("shoot.gardener.cloud/certificates-expiring"))
+	})
+})
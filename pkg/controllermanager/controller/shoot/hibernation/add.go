@@ -5,15 +5,24 @@
 package hibernation
 
 import (
+	"context"
 	"reflect"
+	"slices"
 
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/clock"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 )
@@ -26,6 +35,9 @@ func (r *Reconciler) AddToManager(mgr manager.Manager) error {
 	if r.Client == nil {
 		r.Client = mgr.GetClient()
 	}
+	if r.APIReader == nil {
+		r.APIReader = mgr.GetAPIReader()
+	}
 	if r.Clock == nil {
 		r.Clock = clock.RealClock{}
 	}
@@ -33,14 +45,25 @@ func (r *Reconciler) AddToManager(mgr manager.Manager) error {
 		r.Recorder = mgr.GetEventRecorderFor(ControllerName + "-controller")
 	}
 
-	return builder.
+	c, err := builder.
 		ControllerManagedBy(mgr).
 		Named(ControllerName).
 		For(&gardencorev1beta1.Shoot{}, builder.WithPredicates(r.ShootPredicate())).
 		WithOptions(controller.Options{
 			MaxConcurrentReconciles: ptr.Deref(r.Config.ConcurrentSyncs, 0),
 		}).
-		Complete(r)
+		Build(r)
+	if err != nil {
+		return err
+	}
+
+	// Only the metadata of the ConfigMaps is cached, the calendars themselves are still read from the API server.
+	configMap := &metav1.PartialObjectMetadata{}
+	configMap.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("ConfigMap"))
+
+	return c.Watch(source.Kind[client.Object](mgr.GetCache(), configMap,
+		handler.EnqueueRequestsFromMapFunc(r.MapCalendarToShoots),
+	))
 }
 
 // ShootPredicate returns the predicates for the core.gardener.cloud/v1beta1.Shoot watch.
@@ -51,7 +74,7 @@ func (r *Reconciler) ShootPredicate() predicate.Predicate {
 			if !ok {
 				return false
 			}
-			return len(getShootHibernationSchedules(shoot.Spec.Hibernation)) > 0
+			return hasAutomaticHibernation(shoot.Spec.Hibernation)
 		},
 		UpdateFunc: func(e event.UpdateEvent) bool {
 			shoot, ok := e.ObjectNew.(*gardencorev1beta1.Shoot)
@@ -69,7 +92,43 @@ func (r *Reconciler) ShootPredicate() predicate.Predicate {
 				newSchedules = getShootHibernationSchedules(shoot.Spec.Hibernation)
 			)
 
-			return !reflect.DeepEqual(oldSchedules, newSchedules) && len(newSchedules) > 0
+			changed := !reflect.DeepEqual(oldSchedules, newSchedules) ||
+				!reflect.DeepEqual(getShootHibernationExceptions(oldShoot.Spec.Hibernation), getShootHibernationExceptions(shoot.Spec.Hibernation)) ||
+				!reflect.DeepEqual(getShootAwakeUntil(oldShoot.Spec.Hibernation), getShootAwakeUntil(shoot.Spec.Hibernation)) ||
+				!reflect.DeepEqual(getShootHibernationIdle(oldShoot.Spec.Hibernation), getShootHibernationIdle(shoot.Spec.Hibernation)) ||
+				// The idle hibernation time is computed once activity is observed after the shoot was woken up.
+				(oldShoot.Status.LastActivityTime == nil) != (shoot.Status.LastActivityTime == nil)
+
+			// Shoots without schedules are also reconciled to remove the hibernation schedule status.
+			return changed && (hasAutomaticHibernation(shoot.Spec.Hibernation) || shoot.Status.HibernationSchedule != nil)
 		},
 	}
 }
+
+func hasAutomaticHibernation(hibernation *gardencorev1beta1.Hibernation) bool {
+	return len(getShootHibernationSchedules(hibernation)) > 0 || getShootAwakeUntil(hibernation) != nil || getShootHibernationIdle(hibernation) != nil
+}
+
+// MapCalendarToShoots maps the given ConfigMap to the Shoots in its namespace which reference it as hibernation
+// calendar.
+func (r *Reconciler) MapCalendarToShoots(ctx context.Context, obj client.Object) []reconcile.Request {
+	log := logf.FromContext(ctx)
+
+	shootList := &gardencorev1beta1.ShootList{}
+	if err := r.Client.List(ctx, shootList, client.InNamespace(obj.GetNamespace())); err != nil {
+		log.Error(err, "Failed to list shoots", "namespace", obj.GetNamespace())
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, shoot := range shootList.Items {
+		exceptions := getShootHibernationExceptions(shoot.Spec.Hibernation)
+		if exceptions == nil || !slices.ContainsFunc(exceptions.CalendarRefs, func(ref corev1.LocalObjectReference) bool {
+			return ref.Name == obj.GetName()
+		}) {
+			continue
+		}
+		requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&shoot)})
+	}
+	return requests
+}
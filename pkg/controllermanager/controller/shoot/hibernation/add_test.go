@@ -5,14 +5,22 @@
 package hibernation_test
 
 import (
+	"context"
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
 	. "github.com/gardener/gardener/pkg/controllermanager/controller/shoot/hibernation"
 )
 
@@ -59,6 +67,16 @@ var _ = Describe("Add", func() {
 			It("should return true because shoot has hibernation schedules", func() {
 				Expect(p.Create(event.CreateEvent{Object: shoot})).To(BeTrue())
 			})
+
+			It("should return true because shoot has a hibernation override", func() {
+				shoot.Spec.Hibernation = &gardencorev1beta1.Hibernation{AwakeUntil: &metav1.Time{Time: time.Now()}}
+				Expect(p.Create(event.CreateEvent{Object: shoot})).To(BeTrue())
+			})
+
+			It("should return true because shoot is configured to be hibernated when idle", func() {
+				shoot.Spec.Hibernation = &gardencorev1beta1.Hibernation{Idle: &gardencorev1beta1.HibernationIdle{Timeout: metav1.Duration{Duration: time.Hour}}}
+				Expect(p.Create(event.CreateEvent{Object: shoot})).To(BeTrue())
+			})
 		})
 
 		Describe("#Update", func() {
@@ -85,6 +103,92 @@ var _ = Describe("Add", func() {
 				shoot.Spec.Hibernation.Schedules[0].Start = ptr.To("00 20 * * 1,2,3,4,5,6,7")
 				Expect(p.Update(event.UpdateEvent{ObjectNew: shoot, ObjectOld: oldShoot})).To(BeTrue())
 			})
+
+			It("should return true because hibernation schedules were removed but the shoot still has a hibernation schedule status", func() {
+				oldShoot := shoot.DeepCopy()
+				shoot.Spec.Hibernation = nil
+				shoot.Status.HibernationSchedule = &gardencorev1beta1.HibernationScheduleStatus{}
+				Expect(p.Update(event.UpdateEvent{ObjectNew: shoot, ObjectOld: oldShoot})).To(BeTrue())
+			})
+
+			It("should return true because hibernation exceptions changed", func() {
+				oldShoot := shoot.DeepCopy()
+				shoot.Spec.Hibernation.Exceptions = &gardencorev1beta1.HibernationExceptions{Dates: []string{"2024-12-24"}}
+				Expect(p.Update(event.UpdateEvent{ObjectNew: shoot, ObjectOld: oldShoot})).To(BeTrue())
+			})
+
+			It("should return true because hibernation override changed", func() {
+				oldShoot := shoot.DeepCopy()
+				shoot.Spec.Hibernation.AwakeUntil = &metav1.Time{Time: time.Now()}
+				Expect(p.Update(event.UpdateEvent{ObjectNew: shoot, ObjectOld: oldShoot})).To(BeTrue())
+			})
+
+			It("should return true because idle configuration changed", func() {
+				oldShoot := shoot.DeepCopy()
+				shoot.Spec.Hibernation.Idle = &gardencorev1beta1.HibernationIdle{Timeout: metav1.Duration{Duration: time.Hour}}
+				Expect(p.Update(event.UpdateEvent{ObjectNew: shoot, ObjectOld: oldShoot})).To(BeTrue())
+			})
+
+			It("should return true because activity was observed for the first time", func() {
+				oldShoot := shoot.DeepCopy()
+				shoot.Status.LastActivityTime = &metav1.Time{Time: time.Now()}
+				Expect(p.Update(event.UpdateEvent{ObjectNew: shoot, ObjectOld: oldShoot})).To(BeTrue())
+			})
+
+			It("should return false because only the last activity time was updated", func() {
+				shoot.Status.LastActivityTime = &metav1.Time{Time: time.Now().Add(-time.Hour)}
+				oldShoot := shoot.DeepCopy()
+				shoot.Status.LastActivityTime = &metav1.Time{Time: time.Now()}
+				Expect(p.Update(event.UpdateEvent{ObjectNew: shoot, ObjectOld: oldShoot})).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("#MapCalendarToShoots", func() {
+		var (
+			ctx        = context.TODO()
+			fakeClient client.Client
+			configMap  *corev1.ConfigMap
+		)
+
+		BeforeEach(func() {
+			fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+			reconciler.Client = fakeClient
+
+			configMap = &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "holidays", Namespace: shoot.Namespace}}
+		})
+
+		It("should map the ConfigMap to the shoots referencing it", func() {
+			shoot.Spec.Hibernation.Exceptions = &gardencorev1beta1.HibernationExceptions{
+				CalendarRefs: []corev1.LocalObjectReference{{Name: "other"}, {Name: "holidays"}},
+			}
+			Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+
+			otherShoot := shoot.DeepCopy()
+			otherShoot.ResourceVersion = ""
+			otherShoot.Name = "other"
+			otherShoot.Spec.Hibernation.Exceptions.CalendarRefs = []corev1.LocalObjectReference{{Name: "other"}}
+			Expect(fakeClient.Create(ctx, otherShoot)).To(Succeed())
+
+			shootWithoutExceptions := shoot.DeepCopy()
+			shootWithoutExceptions.ResourceVersion = ""
+			shootWithoutExceptions.Name = "without-exceptions"
+			shootWithoutExceptions.Spec.Hibernation.Exceptions = nil
+			Expect(fakeClient.Create(ctx, shootWithoutExceptions)).To(Succeed())
+
+			Expect(reconciler.MapCalendarToShoots(ctx, configMap)).To(ConsistOf(
+				reconcile.Request{NamespacedName: client.ObjectKeyFromObject(shoot)},
+			))
+		})
+
+		It("should not map the ConfigMap to shoots in other namespaces", func() {
+			shoot.Namespace = "other"
+			shoot.Spec.Hibernation.Exceptions = &gardencorev1beta1.HibernationExceptions{
+				CalendarRefs: []corev1.LocalObjectReference{{Name: "holidays"}},
+			}
+			Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+
+			Expect(reconciler.MapCalendarToShoots(ctx, configMap)).To(BeEmpty())
 		})
 	})
 })
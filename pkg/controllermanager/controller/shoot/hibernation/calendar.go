@@ -0,0 +1,242 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package hibernation
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+const (
+	// calendarDataKey is the data key of ConfigMaps referenced in `.spec.hibernation.exceptions.calendarRefs` of Shoots
+	// which contains the calendar in the iCalendar format.
+	calendarDataKey = "calendar.ics"
+
+	// maxEventDays is the maximum number of days of a single calendar event.
+	maxEventDays = 366
+	// recurrenceYears is the number of years after the current year for which yearly recurring events are expanded.
+	recurrenceYears = 2
+)
+
+var durationRegex = regexp.MustCompile(`^P(\d+)([DW])$`)
+
+// calendarEvent is an event of a calendar spanning the dates [start, end).
+type calendarEvent struct {
+	start, end time.Time
+	rrule      string
+}
+
+// parseCalendarDates parses the given calendar in the iCalendar format (RFC 5545) and returns the dates (in the format
+// `YYYY-MM-DD`) of all of its events. Yearly recurring events are expanded until the given number of years after the
+// year of the given time. Other recurrence rules are not supported.
+func parseCalendarDates(calendar string, now time.Time) (sets.Set[string], error) {
+	events, err := parseCalendarEvents(calendar)
+	if err != nil {
+		return nil, err
+	}
+
+	dates := sets.New[string]()
+	for _, event := range events {
+		occurrences, err := expandRecurrence(event, now.Year()+recurrenceYears)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, occurrence := range occurrences {
+			for d := occurrence.start; d.Before(occurrence.end); d = d.AddDate(0, 0, 1) {
+				dates.Insert(d.Format(time.DateOnly))
+			}
+		}
+	}
+
+	return dates, nil
+}
+
+func parseCalendarEvents(calendar string) ([]calendarEvent, error) {
+	var (
+		events  []calendarEvent
+		current *calendarEvent
+		end     *time.Time
+		days    int
+	)
+
+	for i, line := range unfoldCalendarLines(calendar) {
+		name, value, ok := splitCalendarLine(line)
+		if !ok {
+			continue
+		}
+
+		switch {
+		case name == "BEGIN" && value == "VEVENT":
+			current, end, days = &calendarEvent{}, nil, 0
+
+		case name == "END" && value == "VEVENT":
+			if current == nil || current.start.IsZero() {
+				return nil, fmt.Errorf("event ending in line %d has no start date", i+1)
+			}
+
+			switch {
+			case end != nil:
+				current.end = *end
+			case days > 0:
+				current.end = current.start.AddDate(0, 0, days)
+			default:
+				current.end = current.start.AddDate(0, 0, 1)
+			}
+
+			if !current.end.After(current.start) {
+				return nil, fmt.Errorf("event ending in line %d ends before it starts", i+1)
+			}
+			if current.end.After(current.start.AddDate(0, 0, maxEventDays)) {
+				return nil, fmt.Errorf("event ending in line %d spans more than %d days", i+1, maxEventDays)
+			}
+
+			events = append(events, *current)
+			current = nil
+
+		case current == nil:
+			continue
+
+		case name == "DTSTART":
+			start, _, err := parseCalendarDate(value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid DTSTART in line %d: %w", i+1, err)
+			}
+			current.start = start
+
+		case name == "DTEND":
+			date, hasTime, err := parseCalendarDate(value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid DTEND in line %d: %w", i+1, err)
+			}
+			// The end of an event is exclusive. Events ending at a certain time on a day also cover this day.
+			if hasTime {
+				date = date.AddDate(0, 0, 1)
+			}
+			end = &date
+
+		case name == "DURATION":
+			match := durationRegex.FindStringSubmatch(value)
+			if match == nil {
+				return nil, fmt.Errorf("unsupported DURATION %q in line %d, only durations in days or weeks are supported", value, i+1)
+			}
+			days, _ = strconv.Atoi(match[1])
+			if match[2] == "W" {
+				days *= 7
+			}
+
+		case name == "RRULE":
+			current.rrule = value
+		}
+	}
+
+	return events, nil
+}
+
+// expandRecurrence returns the occurrences of the given event until the end of the given year.
+func expandRecurrence(event calendarEvent, untilYear int) ([]calendarEvent, error) {
+	if event.rrule == "" {
+		return []calendarEvent{event}, nil
+	}
+
+	var (
+		interval = 1
+		count    = -1
+		until    time.Time
+	)
+
+	for _, part := range strings.Split(event.rrule, ";") {
+		key, value, _ := strings.Cut(part, "=")
+
+		switch key {
+		case "FREQ":
+			if value != "YEARLY" {
+				return nil, fmt.Errorf("unsupported recurrence rule %q, only yearly recurring events are supported", event.rrule)
+			}
+		case "INTERVAL":
+			v, err := strconv.Atoi(value)
+			if err != nil || v < 1 {
+				return nil, fmt.Errorf("invalid interval in recurrence rule %q", event.rrule)
+			}
+			interval = v
+		case "COUNT":
+			v, err := strconv.Atoi(value)
+			if err != nil || v < 1 {
+				return nil, fmt.Errorf("invalid count in recurrence rule %q", event.rrule)
+			}
+			count = v
+		case "UNTIL":
+			v, _, err := parseCalendarDate(value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid until date in recurrence rule %q: %w", event.rrule, err)
+			}
+			until = v
+		default:
+			return nil, fmt.Errorf("unsupported recurrence rule %q, only FREQ, INTERVAL, COUNT and UNTIL are supported", event.rrule)
+		}
+	}
+
+	var occurrences []calendarEvent
+	for years := 0; event.start.Year()+years <= untilYear && count != 0; years += interval {
+		occurrence := calendarEvent{start: event.start.AddDate(years, 0, 0), end: event.end.AddDate(years, 0, 0)}
+		if !until.IsZero() && occurrence.start.After(until) {
+			break
+		}
+
+		occurrences = append(occurrences, occurrence)
+		count--
+	}
+
+	return occurrences, nil
+}
+
+// parseCalendarDate parses a date (`YYYYMMDD`) or date-time (`YYYYMMDDTHHMMSS[Z]`) value and returns its date. Time
+// zones are not taken into account, i.e., the date is the date in the time zone of the event.
+func parseCalendarDate(value string) (time.Time, bool, error) {
+	if len(value) < 8 {
+		return time.Time{}, false, fmt.Errorf("%q is not a valid date", value)
+	}
+
+	date, err := time.Parse("20060102", value[:8])
+	if err != nil {
+		return time.Time{}, false, fmt.Errorf("%q is not a valid date: %w", value, err)
+	}
+
+	hasTime := len(value) > 8 && strings.TrimSuffix(value[8:], "Z") != "T000000"
+	return date, hasTime, nil
+}
+
+// unfoldCalendarLines returns the logical lines of the given calendar, see
+// https://datatracker.ietf.org/doc/html/rfc5545#section-3.1.
+func unfoldCalendarLines(calendar string) []string {
+	var lines []string
+
+	for _, line := range strings.Split(calendar, "\n") {
+		line = strings.TrimSuffix(line, "\r")
+		if (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+
+	return lines
+}
+
+// splitCalendarLine splits a content line into its upper-case name and its value. Parameters are dropped.
+func splitCalendarLine(line string) (string, string, bool) {
+	nameAndParams, value, ok := strings.Cut(line, ":")
+	if !ok {
+		return "", "", false
+	}
+
+	name, _, _ := strings.Cut(nameAndParams, ";")
+	return strings.ToUpper(name), strings.TrimSpace(value), true
+}
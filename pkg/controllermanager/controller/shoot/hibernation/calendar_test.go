@@ -0,0 +1,83 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package hibernation
+
+import (
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+var _ = Describe("Calendar", func() {
+	var (
+		now = time.Date(2024, 6, 1, 10, 0, 0, 0, time.UTC)
+
+		calendar = func(events ...string) string {
+			return "BEGIN:VCALENDAR\r\nVERSION:2.0\r\nPRODID:-//Example//Holidays//EN\r\n" + strings.Join(events, "") + "END:VCALENDAR\r\n"
+		}
+		event = func(lines ...string) string {
+			return "BEGIN:VEVENT\r\n" + strings.Join(lines, "\r\n") + "\r\nEND:VEVENT\r\n"
+		}
+	)
+
+	Describe("#parseCalendarDates", func() {
+		It("should return the dates of all-day events", func() {
+			Expect(parseCalendarDates(calendar(
+				event("SUMMARY:Christmas Eve", "DTSTART;VALUE=DATE:20241224"),
+				event("SUMMARY:Christmas", "DTSTART;VALUE=DATE:20241225", "DTEND;VALUE=DATE:20241227"),
+			), now)).To(Equal(sets.New("2024-12-24", "2024-12-25", "2024-12-26")))
+		})
+
+		It("should return the dates of events with a duration", func() {
+			Expect(parseCalendarDates(calendar(
+				event("DTSTART;VALUE=DATE:20240805", "DURATION:P1W"),
+			), now)).To(HaveLen(7))
+		})
+
+		It("should return all dates covered by events with a start and end time", func() {
+			Expect(parseCalendarDates(calendar(
+				event("DTSTART;TZID=Europe/Berlin:20240703T180000", "DTEND;TZID=Europe/Berlin:20240704T020000"),
+				event("DTSTART:20240710T200000Z", "DTEND:20240711T000000Z"),
+			), now)).To(Equal(sets.New("2024-07-03", "2024-07-04", "2024-07-10")))
+		})
+
+		It("should unfold long lines", func() {
+			Expect(parseCalendarDates(calendar(
+				event("SUMMARY:Release", "DTSTART;VALUE=DATE:2024", " 0920"),
+			), now)).To(Equal(sets.New("2024-09-20")))
+		})
+
+		It("should expand yearly recurring events", func() {
+			Expect(parseCalendarDates(calendar(
+				event("DTSTART;VALUE=DATE:20200501", "RRULE:FREQ=YEARLY"),
+				event("DTSTART;VALUE=DATE:20240101", "RRULE:FREQ=YEARLY;COUNT=2"),
+			), now)).To(Equal(sets.New("2020-05-01", "2021-05-01", "2022-05-01", "2023-05-01", "2024-05-01", "2025-05-01", "2026-05-01", "2024-01-01", "2025-01-01")))
+		})
+
+		It("should fail for unsupported recurrence rules", func() {
+			_, err := parseCalendarDates(calendar(
+				event("DTSTART;VALUE=DATE:20240101", "RRULE:FREQ=WEEKLY;BYDAY=MO"),
+			), now)
+			Expect(err).To(MatchError(ContainSubstring("only yearly recurring events are supported")))
+		})
+
+		It("should fail for events without start", func() {
+			_, err := parseCalendarDates(calendar(
+				event("SUMMARY:Nothing"),
+			), now)
+			Expect(err).To(MatchError(ContainSubstring("has no start date")))
+		})
+
+		It("should fail for events ending before they start", func() {
+			_, err := parseCalendarDates(calendar(
+				event("DTSTART;VALUE=DATE:20240102", "DTEND;VALUE=DATE:20240101"),
+			), now)
+			Expect(err).To(MatchError(ContainSubstring("ends before it starts")))
+		})
+	})
+})
@@ -6,14 +6,17 @@ package hibernation
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"slices"
 	"time"
 
 	"github.com/robfig/cron"
 	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/clock"
 	"k8s.io/utils/ptr"
@@ -30,6 +33,9 @@ import (
 const (
 	sevenDays         = 7 * 24 * time.Hour
 	nextScheduleDelta = 100 * time.Millisecond
+	// maxExceptionLookahead is the maximum duration for which schedule times falling on exception dates are skipped
+	// when looking for the next schedule time.
+	maxExceptionLookahead = 366 * 24 * time.Hour
 )
 
 type operation uint8
@@ -45,12 +51,23 @@ type parsedHibernationSchedule struct {
 	location  time.Location
 	schedule  cron.Schedule
 	operation operation
+	// exceptions contains the dates (in the format YYYY-MM-DD) on which the schedule is not applied.
+	exceptions sets.Set[string]
 }
 
-// next returns the time in UTC from the schedule, that is immediately after the input time 't'.
-// The input 't' is converted in the schedule's location before any calculations are done.
+// excepted returns true if the given time falls on an exception date in the schedule's location.
+func (s *parsedHibernationSchedule) excepted(t time.Time) bool {
+	return s.exceptions.Has(t.In(&s.location).Format(time.DateOnly))
+}
+
+// next returns the time in UTC from the schedule, that is immediately after the input time 't' and does not fall on
+// an exception date. The input 't' is converted in the schedule's location before any calculations are done.
 func (s *parsedHibernationSchedule) next(t time.Time) time.Time {
-	return s.schedule.Next(t.In(&s.location)).UTC()
+	next := s.schedule.Next(t.In(&s.location))
+	for limit := t.Add(maxExceptionLookahead); !next.IsZero() && s.excepted(next) && next.Before(limit); {
+		next = s.schedule.Next(next)
+	}
+	return next.UTC()
 }
 
 // previous returns the time in UTC from the schedule that is immediately before 'to' and after 'from'.
@@ -61,6 +78,9 @@ func (s *parsedHibernationSchedule) previous(from, to time.Time) *time.Time {
 	// that is after "from" until the one that is immediately after `to` is reached.
 	var previousActivationTime *time.Time
 	for t := s.schedule.Next(from.In(&s.location)); !t.UTC().After(to.UTC()); t = s.schedule.Next(t) {
+		if s.excepted(t) {
+			continue
+		}
 		inUTC := t.UTC()
 		previousActivationTime = &inUTC
 	}
@@ -70,10 +90,11 @@ func (s *parsedHibernationSchedule) previous(from, to time.Time) *time.Time {
 
 // Reconciler reconciles Shoots and hibernates or wakes them up according to their hibernation schedules.
 type Reconciler struct {
-	Client   client.Client
-	Config   config.ShootHibernationControllerConfiguration
-	Clock    clock.Clock
-	Recorder record.EventRecorder
+	Client    client.Client
+	APIReader client.Reader
+	Config    config.ShootHibernationControllerConfiguration
+	Clock     clock.Clock
+	Recorder  record.EventRecorder
 }
 
 // Reconcile reconciles Shoots and hibernates or wakes them up according to their hibernation schedules.
@@ -98,49 +119,112 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	}
 
 	schedules := getShootHibernationSchedules(shoot.Spec.Hibernation)
-	if len(schedules) == 0 {
+	awakeUntil := getShootAwakeUntil(shoot.Spec.Hibernation)
+	if len(schedules) == 0 && awakeUntil == nil && getShootHibernationIdle(shoot.Spec.Hibernation) == nil {
 		log.Info("Hibernation schedules have been removed from shoot, stopping reconciliation")
+		return reconcile.Result{}, r.patchHibernationScheduleStatus(ctx, shoot, nil)
+	}
+
+	now := r.Clock.Now()
+	exceptions, err := r.getExceptionDates(ctx, shoot, now)
+	if err != nil {
+		var calendarErr *invalidCalendarError
+		if !errors.As(err, &calendarErr) {
+			return reconcile.Result{}, err
+		}
+
+		// Retrying does not help as long as the calendar is invalid. The shoot is reconciled again as soon as the
+		// referenced ConfigMaps or the hibernation settings of the shoot change.
+		log.Error(err, "Invalid hibernation calendar, stopping reconciliation")
+		r.Recorder.Event(shoot, corev1.EventTypeWarning, gardencorev1beta1.ShootEventHibernationCalendarInvalid, err.Error())
 		return reconcile.Result{}, nil
 	}
 
-	parsedSchedules, err := parseHibernationSchedules(schedules)
+	parsedSchedules, err := parseHibernationSchedules(schedules, exceptions)
 	if err != nil {
 		log.Error(err, "Invalid hibernation schedules, stopping reconciliation")
 		return reconcile.Result{}, nil
 	}
 
-	now := r.Clock.Now()
 	if gardenerutils.IsShootFailedAndUpToDate(shoot) {
-		requeueAfter := nextHibernationTimeDuration(parsedSchedules, now)
+		idleHibernationTime := getIdleHibernationTime(shoot, awakeUntil)
+		requeueAfter := nextHibernationTimeDuration(parsedSchedules, awakeUntil, idleHibernationTime, now)
 		log.Info("Shoot is in Failed state, requeuing shoot hibernation", "requeueAfter", requeueAfter)
+		if err := r.patchHibernationScheduleStatus(ctx, shoot, computeHibernationScheduleStatus(parsedSchedules, awakeUntil, idleHibernationTime, now)); err != nil {
+			return reconcile.Result{}, err
+		}
 		return reconcile.Result{RequeueAfter: requeueAfter}, nil
 	}
 
-	// Get the schedule which caused the current reconciliation and check whether the shoot should be hibernated or woken up.
-	// If no such schedule is found, the hibernation schedules were changed mid-air and the shoot must be
-	// hibernated or wakeup the at a later time.
-	mostRecentSchedule := getScheduleWithMostRecentTime(parsedSchedules, r.Config.TriggerDeadlineDuration, shoot, now)
-	if mostRecentSchedule != nil {
-		if err := r.hibernateOrWakeUpShootBasedOnSchedule(ctx, shoot, mostRecentSchedule, now); err != nil {
+	switch {
+	case awakeUntil != nil && now.Before(awakeUntil.Time):
+		// The hibernation schedules are not applied while the override is active.
+		if ptr.Deref(shoot.Spec.Hibernation.Enabled, false) {
+			if err := r.hibernateOrWakeUpShoot(ctx, shoot, wakeUp, "override", now); err != nil {
+				return reconcile.Result{}, err
+			}
+			log.Info("Successfully woke up shoot due to hibernation override", "awakeUntil", awakeUntil)
+		}
+
+	case awakeUntil != nil:
+		patch := client.MergeFrom(shoot.DeepCopy())
+		shoot.Spec.Hibernation.AwakeUntil = nil
+		if err := r.Client.Patch(ctx, shoot, patch); err != nil {
 			return reconcile.Result{}, err
 		}
-		log.Info("Successfully set hibernation.enabled", "enabled", *shoot.Spec.Hibernation.Enabled)
+		log.Info("Removed expired hibernation override", "awakeUntil", awakeUntil)
+		awakeUntil = nil
+
+		// Restore the state which the hibernation schedules require now, independent of when the shoot was hibernated
+		// or woken up the last time.
+		if mostRecentSchedule := getScheduleWithMostRecentTimeSince(parsedSchedules, now.Add(-sevenDays), now); mostRecentSchedule != nil {
+			if err := r.hibernateOrWakeUpShoot(ctx, shoot, mostRecentSchedule.operation, "schedule", now); err != nil {
+				return reconcile.Result{}, err
+			}
+			log.Info("Successfully set hibernation.enabled", "enabled", *shoot.Spec.Hibernation.Enabled)
+		}
+
+	default:
+		// Get the schedule which caused the current reconciliation and check whether the shoot should be hibernated or woken up.
+		// If no such schedule is found, the hibernation schedules were changed mid-air and the shoot must be
+		// hibernated or wakeup the at a later time.
+		mostRecentSchedule := getScheduleWithMostRecentTime(parsedSchedules, r.Config.TriggerDeadlineDuration, shoot, now)
+		if mostRecentSchedule != nil {
+			if err := r.hibernateOrWakeUpShoot(ctx, shoot, mostRecentSchedule.operation, "schedule", now); err != nil {
+				return reconcile.Result{}, err
+			}
+			log.Info("Successfully set hibernation.enabled", "enabled", *shoot.Spec.Hibernation.Enabled)
+		} else if idleHibernationTime := getIdleHibernationTime(shoot, awakeUntil); idleHibernationTime != nil && !now.Before(*idleHibernationTime) {
+			if err := r.hibernateOrWakeUpShoot(ctx, shoot, hibernate, "inactivity", now); err != nil {
+				return reconcile.Result{}, err
+			}
+			log.Info("Successfully hibernated shoot due to inactivity", "lastActivityTime", shoot.Status.LastActivityTime)
+		}
+	}
+
+	idleHibernationTime := getIdleHibernationTime(shoot, awakeUntil)
+	if err := r.patchHibernationScheduleStatus(ctx, shoot, computeHibernationScheduleStatus(parsedSchedules, awakeUntil, idleHibernationTime, now)); err != nil {
+		return reconcile.Result{}, err
 	}
 
-	requeueAfter := nextHibernationTimeDuration(parsedSchedules, now)
+	requeueAfter := nextHibernationTimeDuration(parsedSchedules, awakeUntil, idleHibernationTime, now)
+	if requeueAfter == 0 {
+		log.Info("No further hibernation schedules, stopping reconciliation")
+		return reconcile.Result{}, nil
+	}
 	log.Info("Requeuing shoot hibernation", "requeueAfter", requeueAfter)
 	return reconcile.Result{RequeueAfter: requeueAfter}, nil
 }
 
-func (r *Reconciler) hibernateOrWakeUpShootBasedOnSchedule(ctx context.Context, shoot *gardencorev1beta1.Shoot, schedule *parsedHibernationSchedule, now time.Time) error {
+func (r *Reconciler) hibernateOrWakeUpShoot(ctx context.Context, shoot *gardencorev1beta1.Shoot, op operation, cause string, now time.Time) error {
 	patch := client.MergeFrom(shoot.DeepCopy())
-	switch schedule.operation {
+	switch op {
 	case hibernate:
 		shoot.Spec.Hibernation.Enabled = ptr.To(true)
-		r.Recorder.Event(shoot, corev1.EventTypeNormal, gardencorev1beta1.ShootEventHibernationEnabled, "Hibernating cluster due to schedule")
+		r.Recorder.Event(shoot, corev1.EventTypeNormal, gardencorev1beta1.ShootEventHibernationEnabled, "Hibernating cluster due to "+cause)
 	case wakeUp:
 		shoot.Spec.Hibernation.Enabled = ptr.To(false)
-		r.Recorder.Event(shoot, corev1.EventTypeNormal, gardencorev1beta1.ShootEventHibernationDisabled, "Waking up cluster due to schedule")
+		r.Recorder.Event(shoot, corev1.EventTypeNormal, gardencorev1beta1.ShootEventHibernationDisabled, "Waking up cluster due to "+cause)
 	}
 	if err := r.Client.Patch(ctx, shoot, patch); err != nil {
 		return err
@@ -151,9 +235,123 @@ func (r *Reconciler) hibernateOrWakeUpShootBasedOnSchedule(ctx context.Context,
 	return r.Client.Status().Patch(ctx, shoot, patch)
 }
 
+func (r *Reconciler) patchHibernationScheduleStatus(ctx context.Context, shoot *gardencorev1beta1.Shoot, status *gardencorev1beta1.HibernationScheduleStatus) error {
+	if apiequality.Semantic.DeepEqual(shoot.Status.HibernationSchedule, status) {
+		return nil
+	}
+
+	patch := client.MergeFrom(shoot.DeepCopy())
+	shoot.Status.HibernationSchedule = status
+	return r.Client.Status().Patch(ctx, shoot, patch)
+}
+
+// invalidCalendarError is returned by getExceptionDates if a referenced calendar does not exist or is invalid.
+type invalidCalendarError struct {
+	error
+}
+
+func (e *invalidCalendarError) Unwrap() error {
+	return e.error
+}
+
+// getExceptionDates returns the exception dates of the given Shoot including the dates of all events of the referenced
+// calendars.
+func (r *Reconciler) getExceptionDates(ctx context.Context, shoot *gardencorev1beta1.Shoot, now time.Time) (sets.Set[string], error) {
+	exceptions := getShootHibernationExceptions(shoot.Spec.Hibernation)
+	if exceptions == nil {
+		return nil, nil
+	}
+
+	dates := sets.New(exceptions.Dates...)
+	for _, ref := range exceptions.CalendarRefs {
+		configMap := &corev1.ConfigMap{}
+		if err := r.APIReader.Get(ctx, client.ObjectKey{Namespace: shoot.Namespace, Name: ref.Name}, configMap); err != nil {
+			err = fmt.Errorf("failed reading hibernation calendar ConfigMap %q: %w", ref.Name, err)
+			if apierrors.IsNotFound(err) {
+				return nil, &invalidCalendarError{err}
+			}
+			return nil, err
+		}
+
+		calendar, ok := configMap.Data[calendarDataKey]
+		if !ok {
+			return nil, &invalidCalendarError{fmt.Errorf("hibernation calendar ConfigMap %q has no %q data key", ref.Name, calendarDataKey)}
+		}
+
+		calendarDates, err := parseCalendarDates(calendar, now)
+		if err != nil {
+			return nil, &invalidCalendarError{fmt.Errorf("failed parsing hibernation calendar ConfigMap %q: %w", ref.Name, err)}
+		}
+		dates = dates.Union(calendarDates)
+	}
+
+	return dates, nil
+}
+
+// getIdleHibernationTime returns the time at which the shoot is hibernated due to inactivity. The inactivity is
+// measured from the last observed activity, the last hibernation trigger, or the expiry of an active override,
+// whichever is the latest. Nil is returned if the shoot is not configured to be hibernated when idle, if its desired
+// state is already hibernated, or if no activity has been observed yet.
+func getIdleHibernationTime(shoot *gardencorev1beta1.Shoot, awakeUntil *metav1.Time) *time.Time {
+	idle := getShootHibernationIdle(shoot.Spec.Hibernation)
+	if idle == nil || ptr.Deref(shoot.Spec.Hibernation.Enabled, false) || shoot.Status.LastActivityTime == nil {
+		return nil
+	}
+
+	since := shoot.Status.LastActivityTime.Time
+	if lastTrigger := shoot.Status.LastHibernationTriggerTime; lastTrigger != nil && lastTrigger.After(since) {
+		since = lastTrigger.Time
+	}
+	if awakeUntil != nil && awakeUntil.After(since) {
+		since = awakeUntil.Time
+	}
+
+	idleHibernationTime := since.Add(idle.Timeout.Duration).UTC()
+	return &idleHibernationTime
+}
+
+// computeHibernationScheduleStatus returns the next times at which the shoot will be hibernated and woken up. Shoots
+// are not hibernated before an active override expires. The given idle hibernation time is considered as well.
+func computeHibernationScheduleStatus(schedules []parsedHibernationSchedule, awakeUntil *metav1.Time, idleHibernationTime *time.Time, now time.Time) *gardencorev1beta1.HibernationScheduleStatus {
+	var nextHibernationTime, nextWakeUpTime *metav1.Time
+
+	if idleHibernationTime != nil {
+		nextHibernationTime = &metav1.Time{Time: *idleHibernationTime}
+	}
+
+	for i := range schedules {
+		from := now
+		if schedules[i].operation == hibernate && awakeUntil != nil && awakeUntil.After(now) {
+			from = awakeUntil.Time
+		}
+
+		next := schedules[i].next(from)
+		if next.IsZero() {
+			continue
+		}
+
+		switch schedules[i].operation {
+		case hibernate:
+			if nextHibernationTime == nil || next.Before(nextHibernationTime.Time) {
+				nextHibernationTime = &metav1.Time{Time: next}
+			}
+		case wakeUp:
+			if nextWakeUpTime == nil || next.Before(nextWakeUpTime.Time) {
+				nextWakeUpTime = &metav1.Time{Time: next}
+			}
+		}
+	}
+
+	if nextHibernationTime == nil && nextWakeUpTime == nil {
+		return nil
+	}
+	return &gardencorev1beta1.HibernationScheduleStatus{NextHibernationTime: nextHibernationTime, NextWakeUpTime: nextWakeUpTime}
+}
+
 // parseHibernationSchedules parses the given HibernationSchedules and returns an array of ParsedHibernationSchedules
-// If the Location of a HibernationSchedule is `nil`, it is defaulted to UTC.
-func parseHibernationSchedules(schedules []gardencorev1beta1.HibernationSchedule) ([]parsedHibernationSchedule, error) {
+// If the Location of a HibernationSchedule is `nil`, it is defaulted to UTC. The schedules are not applied on the
+// given exception dates.
+func parseHibernationSchedules(schedules []gardencorev1beta1.HibernationSchedule, exceptions sets.Set[string]) ([]parsedHibernationSchedule, error) {
 	var parsedHibernationSchedules []parsedHibernationSchedule
 
 	for _, schedule := range schedules {
@@ -173,7 +371,7 @@ func parseHibernationSchedules(schedules []gardencorev1beta1.HibernationSchedule
 				return nil, err
 			}
 			parsedHibernationSchedules = append(parsedHibernationSchedules,
-				parsedHibernationSchedule{location: *location, schedule: parsed, operation: hibernate},
+				parsedHibernationSchedule{location: *location, schedule: parsed, operation: hibernate, exceptions: exceptions},
 			)
 		}
 
@@ -183,7 +381,7 @@ func parseHibernationSchedules(schedules []gardencorev1beta1.HibernationSchedule
 				return nil, err
 			}
 			parsedHibernationSchedules = append(parsedHibernationSchedules,
-				parsedHibernationSchedule{location: *location, schedule: parsed, operation: wakeUp},
+				parsedHibernationSchedule{location: *location, schedule: parsed, operation: wakeUp, exceptions: exceptions},
 			)
 		}
 	}
@@ -195,11 +393,24 @@ func parseHibernationSchedules(schedules []gardencorev1beta1.HibernationSchedule
 // It adds a 100ms padding to the next requeue to account for Network Time Protocol(NTP) time skews.
 // If the time drifts are adjusted which in most realistic cases would be around 100ms, scheduled hibernation
 // will still be executed without missing the schedule.
-func nextHibernationTimeDuration(schedules []parsedHibernationSchedule, now time.Time) time.Duration {
-	timeStamps := make([]time.Time, 0, len(schedules))
+// While an override is active, the schedules are not applied and the shoot is requeued when the override expires.
+// The shoot is also requeued when it is due to be hibernated because of inactivity.
+// Zero is returned if there is neither a schedule, an active override, nor an idle hibernation time.
+func nextHibernationTimeDuration(schedules []parsedHibernationSchedule, awakeUntil *metav1.Time, idleHibernationTime *time.Time, now time.Time) time.Duration {
+	if awakeUntil != nil && awakeUntil.After(now) {
+		return awakeUntil.Add(nextScheduleDelta).Sub(now)
+	}
+	if len(schedules) == 0 && idleHibernationTime == nil {
+		return 0
+	}
+
+	timeStamps := make([]time.Time, 0, len(schedules)+1)
 	for _, schedule := range schedules {
 		timeStamps = append(timeStamps, schedule.next(now))
 	}
+	if idleHibernationTime != nil {
+		timeStamps = append(timeStamps, *idleHibernationTime)
+	}
 
 	slices.SortFunc(timeStamps, func(a, b time.Time) int {
 		return a.Compare(b)
@@ -228,6 +439,12 @@ func getScheduleWithMostRecentTime(schedules []parsedHibernationSchedule, trigge
 		earliestTime = sevenDaysAgo
 	}
 
+	return getScheduleWithMostRecentTimeSince(schedules, earliestTime, now)
+}
+
+// getScheduleWithMostRecentTimeSince returns the ParsedHibernationSchedule that contains the schedule with the most recent
+// execution time between `earliestTime` and `now`.
+func getScheduleWithMostRecentTimeSince(schedules []parsedHibernationSchedule, earliestTime, now time.Time) *parsedHibernationSchedule {
 	// Iterate over all schedules that were parsed from the shoot specification until we find one that contains
 	// a time entry between `earliestTime` and `now`` and that time entry is the latest one (most recent) with respect to `now`
 	var scheduleWithMostRecentTime *parsedHibernationSchedule
@@ -258,3 +475,24 @@ func getShootHibernationSchedules(hibernation *gardencorev1beta1.Hibernation) []
 	}
 	return hibernation.Schedules
 }
+
+func getShootHibernationExceptions(hibernation *gardencorev1beta1.Hibernation) *gardencorev1beta1.HibernationExceptions {
+	if hibernation == nil {
+		return nil
+	}
+	return hibernation.Exceptions
+}
+
+func getShootHibernationIdle(hibernation *gardencorev1beta1.Hibernation) *gardencorev1beta1.HibernationIdle {
+	if hibernation == nil {
+		return nil
+	}
+	return hibernation.Idle
+}
+
+func getShootAwakeUntil(hibernation *gardencorev1beta1.Hibernation) *metav1.Time {
+	if hibernation == nil {
+		return nil
+	}
+	return hibernation.AwakeUntil
+}
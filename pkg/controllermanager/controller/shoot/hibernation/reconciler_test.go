@@ -12,7 +12,9 @@ import (
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
 	"github.com/robfig/cron"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/component-base/version"
 	testclock "k8s.io/utils/clock/testing"
@@ -101,6 +103,18 @@ var _ = Describe("Shoot Hibernation", func() {
 				}
 				Expect(parsedSchedule.next(now)).To(Equal(expected))
 			})
+
+			It("should skip scheduling times on exception dates", func() {
+				now := mustParseRFC3339Time(weekDayAt2)
+				expected := mustParseRFC3339Time(weekDayAt2).Add(2 * 24 * time.Hour)
+
+				parsedSchedule := parsedHibernationSchedule{
+					location:   mustLoadLocation("UTC"),
+					schedule:   mustParseStandard(everyDayAt2),
+					exceptions: sets.New("2022-04-13"),
+				}
+				Expect(parsedSchedule.next(now)).To(Equal(expected))
+			})
 		})
 
 		Describe("#previous", func() {
@@ -129,6 +143,21 @@ var _ = Describe("Shoot Hibernation", func() {
 				prev := parsedSchedule.previous(from, now)
 				Expect(prev).To(BeNil())
 			})
+
+			It("should skip scheduling times on exception dates", func() {
+				now := mustParseRFC3339Time(weekDayAt2)
+				from := now.Add(-2 * 24 * time.Hour)
+
+				expected := mustParseRFC3339Time(weekDayAt2).Add(-24 * time.Hour)
+				parsedSchedule := parsedHibernationSchedule{
+					location:   mustLoadLocation("UTC"),
+					schedule:   mustParseStandard(everyDayAt2),
+					exceptions: sets.New("2022-04-12"),
+				}
+				prev := parsedSchedule.previous(from, now)
+				Expect(prev).NotTo(BeNil())
+				Expect(*prev).To(Equal(expected))
+			})
 		})
 	})
 
@@ -417,6 +446,237 @@ var _ = Describe("Shoot Hibernation", func() {
 					expectedRequeueDurationFunc: requeueAfterBasedOnSchedule(everyDayAt2, "UTC"),
 				}),
 			)
+
+			Context("exceptions and overrides", func() {
+				var (
+					recorder   *record.FakeRecorder
+					reconciler *Reconciler
+
+					reconcileShoot = func() (reconcile.Result, error) {
+						Expect(c.Create(ctx, shoot)).To(Succeed())
+						return reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(shoot)})
+					}
+				)
+
+				BeforeEach(func() {
+					now = timeWithOffset(weekDayAt7, time.Second)()
+					recorder = record.NewFakeRecorder(2)
+					reconciler = &Reconciler{
+						Client:    c,
+						APIReader: c,
+						Config:    config.ShootHibernationControllerConfiguration{TriggerDeadlineDuration: &metav1.Duration{Duration: shortDeadline}},
+						Recorder:  recorder,
+						Clock:     testclock.NewFakeClock(now),
+					}
+
+					shoot.CreationTimestamp = metav1.Time{Time: now.Add(-24 * time.Hour)}
+					shoot.Spec.Hibernation.Schedules = []gardencorev1beta1.HibernationSchedule{{
+						Start: &everyDayAt7,
+						End:   &everyDayAt2,
+					}}
+				})
+
+				It("should not hibernate the shoot on exception dates", func() {
+					shoot.Spec.Hibernation.Exceptions = &gardencorev1beta1.HibernationExceptions{Dates: []string{"2022-04-12"}}
+
+					result, err := reconcileShoot()
+					Expect(err).NotTo(HaveOccurred())
+					Expect(result).To(Equal(reconcile.Result{RequeueAfter: requeueAfterBasedOnSchedule(everyDayAt2, "UTC")(now)}))
+
+					Expect(c.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+					Expect(shoot.Spec.Hibernation.Enabled).To(BeNil())
+					Expect(shoot.Status.HibernationSchedule.NextHibernationTime.Time.UTC()).To(Equal(timeWithOffset(weekDayAt7, 24*time.Hour)()))
+					Expect(shoot.Status.HibernationSchedule.NextWakeUpTime.Time.UTC()).To(Equal(timeWithOffset(weekDayAt2, 24*time.Hour)()))
+				})
+
+				It("should not hibernate the shoot on dates of referenced calendars", func() {
+					Expect(c.Create(ctx, &corev1.ConfigMap{
+						ObjectMeta: metav1.ObjectMeta{Name: "holidays", Namespace: shoot.Namespace},
+						Data: map[string]string{
+							"calendar.ics": "BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\nDTSTART;VALUE=DATE:20220411\r\nDTEND;VALUE=DATE:20220413\r\nEND:VEVENT\r\nEND:VCALENDAR\r\n",
+						},
+					})).To(Succeed())
+					shoot.Spec.Hibernation.Exceptions = &gardencorev1beta1.HibernationExceptions{
+						CalendarRefs: []corev1.LocalObjectReference{{Name: "holidays"}},
+					}
+
+					_, err := reconcileShoot()
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(c.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+					Expect(shoot.Spec.Hibernation.Enabled).To(BeNil())
+					Expect(shoot.Status.HibernationSchedule.NextHibernationTime.Time.UTC()).To(Equal(timeWithOffset(weekDayAt7, 24*time.Hour)()))
+				})
+
+				It("should stop and record an event if a referenced calendar does not exist", func() {
+					shoot.Spec.Hibernation.Exceptions = &gardencorev1beta1.HibernationExceptions{
+						CalendarRefs: []corev1.LocalObjectReference{{Name: "holidays"}},
+					}
+
+					result, err := reconcileShoot()
+					Expect(err).NotTo(HaveOccurred())
+					Expect(result).To(Equal(reconcile.Result{}))
+					Expect(recorder.Events).To(Receive(And(
+						ContainSubstring(gardencorev1beta1.ShootEventHibernationCalendarInvalid),
+						ContainSubstring(`failed reading hibernation calendar ConfigMap "holidays"`),
+					)))
+
+					Expect(c.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+					Expect(shoot.Spec.Hibernation.Enabled).To(BeNil())
+				})
+
+				It("should stop and record an event if a referenced calendar is invalid", func() {
+					Expect(c.Create(ctx, &corev1.ConfigMap{
+						ObjectMeta: metav1.ObjectMeta{Name: "holidays", Namespace: shoot.Namespace},
+						Data: map[string]string{
+							"calendar.ics": "BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\nDTSTART;VALUE=DATE:20220411\r\nRRULE:FREQ=WEEKLY\r\nEND:VEVENT\r\nEND:VCALENDAR\r\n",
+						},
+					})).To(Succeed())
+					shoot.Spec.Hibernation.Exceptions = &gardencorev1beta1.HibernationExceptions{
+						CalendarRefs: []corev1.LocalObjectReference{{Name: "holidays"}},
+					}
+
+					result, err := reconcileShoot()
+					Expect(err).NotTo(HaveOccurred())
+					Expect(result).To(Equal(reconcile.Result{}))
+					Expect(recorder.Events).To(Receive(ContainSubstring("only yearly recurring events are supported")))
+
+					Expect(c.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+					Expect(shoot.Spec.Hibernation.Enabled).To(BeNil())
+				})
+
+				It("should wake up the shoot and suppress the schedules while the override is active", func() {
+					awakeUntil := now.Add(2 * time.Hour)
+					shoot.Spec.Hibernation.Enabled = ptr.To(true)
+					shoot.Spec.Hibernation.AwakeUntil = &metav1.Time{Time: awakeUntil}
+
+					result, err := reconcileShoot()
+					Expect(err).NotTo(HaveOccurred())
+					Expect(result).To(Equal(reconcile.Result{RequeueAfter: 2*time.Hour + nextScheduleDelta}))
+
+					Expect(c.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+					Expect(shoot.Spec.Hibernation.Enabled).To(PointTo(BeFalse()))
+					Expect(shoot.Spec.Hibernation.AwakeUntil).NotTo(BeNil())
+					Expect(shoot.Status.LastHibernationTriggerTime.Time.UTC()).To(Equal(now))
+					Expect(shoot.Status.HibernationSchedule.NextHibernationTime.Time.UTC()).To(Equal(timeWithOffset(weekDayAt7, 24*time.Hour)()))
+				})
+
+				It("should remove an expired override and restore the state required by the schedules", func() {
+					now = timeWithOffset(weekDayAt19, 0)()
+					reconciler.Clock = testclock.NewFakeClock(now)
+					shoot.Spec.Hibernation.Enabled = ptr.To(false)
+					shoot.Spec.Hibernation.AwakeUntil = &metav1.Time{Time: now.Add(-time.Minute)}
+					shoot.Status.LastHibernationTriggerTime = &metav1.Time{Time: timeWithOffset(weekDayAt7, 0)()}
+
+					result, err := reconcileShoot()
+					Expect(err).NotTo(HaveOccurred())
+					Expect(result).To(Equal(reconcile.Result{RequeueAfter: requeueAfterBasedOnSchedule(everyDayAt2, "UTC")(now)}))
+
+					Expect(c.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+					Expect(shoot.Spec.Hibernation.AwakeUntil).To(BeNil())
+					Expect(shoot.Spec.Hibernation.Enabled).To(PointTo(BeTrue()))
+				})
+
+				It("should remove the status if the schedules have been removed", func() {
+					shoot.Spec.Hibernation.Schedules = nil
+					shoot.Status.HibernationSchedule = &gardencorev1beta1.HibernationScheduleStatus{NextWakeUpTime: &metav1.Time{Time: now}}
+
+					Expect(reconcileShoot()).To(Equal(reconcile.Result{}))
+
+					Expect(c.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+					Expect(shoot.Status.HibernationSchedule).To(BeNil())
+				})
+			})
+
+			Context("idle", func() {
+				var (
+					recorder   *record.FakeRecorder
+					reconciler *Reconciler
+
+					reconcileShoot = func() (reconcile.Result, error) {
+						Expect(c.Create(ctx, shoot)).To(Succeed())
+						return reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(shoot)})
+					}
+				)
+
+				BeforeEach(func() {
+					now = timeWithOffset(weekDayAt7, time.Second)()
+					recorder = record.NewFakeRecorder(2)
+					reconciler = &Reconciler{
+						Client:    c,
+						APIReader: c,
+						Config:    config.ShootHibernationControllerConfiguration{TriggerDeadlineDuration: &metav1.Duration{Duration: shortDeadline}},
+						Recorder:  recorder,
+						Clock:     testclock.NewFakeClock(now),
+					}
+
+					shoot.CreationTimestamp = metav1.Time{Time: now.Add(-24 * time.Hour)}
+					shoot.Spec.Hibernation.Schedules = nil
+					shoot.Spec.Hibernation.Idle = &gardencorev1beta1.HibernationIdle{Timeout: metav1.Duration{Duration: time.Hour}}
+				})
+
+				It("should hibernate the shoot if it has been idle for the configured timeout", func() {
+					shoot.Status.LastActivityTime = &metav1.Time{Time: now.Add(-2 * time.Hour)}
+
+					Expect(reconcileShoot()).To(Equal(reconcile.Result{}))
+					Expect(recorder.Events).To(Receive(ContainSubstring("Hibernating cluster due to inactivity")))
+
+					Expect(c.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+					Expect(shoot.Spec.Hibernation.Enabled).To(PointTo(BeTrue()))
+					Expect(shoot.Status.LastHibernationTriggerTime.Time.UTC()).To(Equal(now))
+				})
+
+				It("should not hibernate the shoot before the timeout has passed", func() {
+					shoot.Status.LastActivityTime = &metav1.Time{Time: now.Add(-30 * time.Minute)}
+
+					Expect(reconcileShoot()).To(Equal(reconcile.Result{RequeueAfter: 30*time.Minute + nextScheduleDelta}))
+
+					Expect(c.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+					Expect(shoot.Spec.Hibernation.Enabled).To(BeNil())
+					Expect(shoot.Status.HibernationSchedule.NextHibernationTime.Time.UTC()).To(Equal(now.Add(30 * time.Minute)))
+				})
+
+				It("should measure the idle period from the last hibernation trigger", func() {
+					shoot.Status.LastActivityTime = &metav1.Time{Time: now.Add(-2 * time.Hour)}
+					shoot.Status.LastHibernationTriggerTime = &metav1.Time{Time: now.Add(-10 * time.Minute)}
+
+					Expect(reconcileShoot()).To(Equal(reconcile.Result{RequeueAfter: 50*time.Minute + nextScheduleDelta}))
+
+					Expect(c.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+					Expect(shoot.Spec.Hibernation.Enabled).To(BeNil())
+				})
+
+				It("should not hibernate the shoot while an override is active", func() {
+					awakeUntil := now.Add(2 * time.Hour)
+					shoot.Spec.Hibernation.AwakeUntil = &metav1.Time{Time: awakeUntil}
+					shoot.Status.LastActivityTime = &metav1.Time{Time: now.Add(-2 * time.Hour)}
+
+					Expect(reconcileShoot()).To(Equal(reconcile.Result{RequeueAfter: 2*time.Hour + nextScheduleDelta}))
+
+					Expect(c.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+					Expect(shoot.Spec.Hibernation.Enabled).To(BeNil())
+					Expect(shoot.Status.HibernationSchedule.NextHibernationTime.Time.UTC()).To(Equal(awakeUntil.Add(time.Hour)))
+				})
+
+				It("should requeue at the idle hibernation time if it is earlier than the next schedule", func() {
+					shoot.Spec.Hibernation.Schedules = []gardencorev1beta1.HibernationSchedule{{End: &everyDayAt2}}
+					shoot.Status.LastActivityTime = &metav1.Time{Time: now}
+
+					Expect(reconcileShoot()).To(Equal(reconcile.Result{RequeueAfter: time.Hour + nextScheduleDelta}))
+
+					Expect(c.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+					Expect(shoot.Status.HibernationSchedule.NextHibernationTime.Time.UTC()).To(Equal(now.Add(time.Hour)))
+					Expect(shoot.Status.HibernationSchedule.NextWakeUpTime.Time.UTC()).To(Equal(timeWithOffset(weekDayAt2, 24*time.Hour)()))
+				})
+
+				It("should stop reconciling if no activity has been observed yet", func() {
+					Expect(reconcileShoot()).To(Equal(reconcile.Result{}))
+
+					Expect(c.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+					Expect(shoot.Spec.Hibernation.Enabled).To(BeNil())
+					Expect(shoot.Status.HibernationSchedule).To(BeNil())
+				})
+			})
 		})
 	})
 })
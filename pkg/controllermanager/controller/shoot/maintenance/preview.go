@@ -0,0 +1,189 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package maintenance
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+// previewEnabled returns true if the preview of the next maintenance shall be published in the Shoot status.
+func (r *Reconciler) previewEnabled() bool {
+	return r.Config.PreviewSyncPeriod != nil && r.Config.PreviewSyncPeriod.Duration > 0
+}
+
+// previewNeedsRefresh returns true if the maintenance preview of the given Shoot is outdated, missing, or must be removed
+// because the preview is disabled.
+func (r *Reconciler) previewNeedsRefresh(shoot *gardencorev1beta1.Shoot) bool {
+	preview := shoot.Status.MaintenancePreview
+	if !r.previewEnabled() {
+		return preview != nil
+	}
+
+	return preview == nil || r.Clock.Since(preview.LastUpdateTime.Time) >= r.Config.PreviewSyncPeriod.Duration
+}
+
+// reconcilePreview computes the operations which will be performed in the next maintenance of the given Shoot and
+// publishes them in its status. If the preview is disabled, a previously published preview is removed.
+func (r *Reconciler) reconcilePreview(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot) error {
+	patch := client.MergeFrom(shoot.DeepCopy())
+
+	if !r.previewEnabled() {
+		shoot.Status.MaintenancePreview = nil
+		return r.Client.Status().Patch(ctx, shoot, patch)
+	}
+
+	cloudProfile, err := gardenerutils.GetCloudProfile(ctx, r.Client, shoot)
+	if err != nil {
+		return err
+	}
+
+	gate, err := r.rolloutGateForShoot(ctx, shoot)
+	if err != nil {
+		return err
+	}
+
+	// Operations are only logged when they are actually performed during the maintenance.
+	result, err := computeMaintenance(logr.Discard(), shoot, cloudProfile, gate)
+	if err != nil {
+		return err
+	}
+
+	now := r.Clock.Now()
+	shoot.Status.MaintenancePreview = result.preview(shoot, now, nextMaintenanceBegin(shoot, now))
+	if err := r.Client.Status().Patch(ctx, shoot, patch); err != nil {
+		return err
+	}
+
+	log.V(1).Info("Updated maintenance preview of Shoot", "description", shoot.Status.MaintenancePreview.Description)
+	return nil
+}
+
+// preview converts the maintenance result into a MaintenancePreview for the given Shoot.
+func (m *maintenanceResult) preview(shoot *gardencorev1beta1.Shoot, now, nextMaintenance time.Time) *gardencorev1beta1.MaintenancePreview {
+	preview := &gardencorev1beta1.MaintenancePreview{
+		Description:         "No maintenance operations planned",
+		LastUpdateTime:      metav1.Time{Time: now},
+		NextMaintenanceTime: &metav1.Time{Time: nextMaintenance},
+		KubernetesVersion:   m.kubernetesControlPlaneUpdate.versionUpdate(),
+		SpecChanges:         describeSpecChanges(m.operations, true),
+	}
+
+	if m.requirePatch() {
+		description, failureReason := m.previewDescription()
+		preview.Description = description
+		if failureReason != "" {
+			preview.FailureReason = &failureReason
+		}
+	}
+
+	for _, worker := range shoot.Spec.Provider.Workers {
+		workerPreview := gardencorev1beta1.WorkerMaintenancePreview{Name: worker.Name}
+
+		if result, ok := m.workerToKubernetesUpdate[worker.Name]; ok {
+			workerPreview.KubernetesVersion = result.versionUpdate()
+		}
+		if result, ok := m.workerToMachineImageUpdate[worker.Name]; ok {
+			workerPreview.MachineImageVersion = result.versionUpdate()
+		}
+
+		if workerPreview.KubernetesVersion != nil || workerPreview.MachineImageVersion != nil {
+			preview.Workers = append(preview.Workers, workerPreview)
+		}
+	}
+
+	return preview
+}
+
+// previewDescription is the counterpart of description for the preview, i.e., it describes the planned maintenance
+// operations instead of the performed ones. Worker pools are sorted by name to keep the description stable.
+func (m *maintenanceResult) previewDescription() (string, string) {
+	var (
+		operations, failures []string
+		countFailed          int
+	)
+
+	addResult := func(prefix, subject string, result *updateResult) {
+		if result.isSuccessful {
+			operations = append(operations, fmt.Sprintf("%s: %s will be updated from %q to %q. Reason: %s", prefix, subject, result.version, result.targetVersion, result.reason))
+			return
+		}
+
+		countFailed++
+		operations = append(operations, fmt.Sprintf("%s: %s cannot be updated. Reason for update: %s", prefix, subject, result.reason))
+		failures = append(failures, fmt.Sprintf("%s: %s", prefix, result.failureReason()))
+	}
+
+	if m.kubernetesControlPlaneUpdate != nil {
+		addResult("Control Plane", "Kubernetes version", m.kubernetesControlPlaneUpdate)
+	}
+	for _, worker := range slices.Sorted(maps.Keys(m.workerToKubernetesUpdate)) {
+		result := m.workerToKubernetesUpdate[worker]
+		addResult(fmt.Sprintf("Worker pool %q", worker), "Kubernetes version", &result)
+	}
+	for _, worker := range slices.Sorted(maps.Keys(m.workerToMachineImageUpdate)) {
+		result := m.workerToMachineImageUpdate[worker]
+		addResult(fmt.Sprintf("Worker pool %q", worker), fmt.Sprintf("machine image %q", result.name), &result)
+	}
+
+	operations = append(operations, describeSpecChanges(m.operations, true)...)
+
+	summary := fmt.Sprintf("%d maintenance operations planned", len(operations))
+	if countFailed > 0 {
+		summary = fmt.Sprintf("%d maintenance operations planned, %d of them cannot be performed", len(operations), countFailed)
+	}
+
+	return fmt.Sprintf("%s: %s", summary, strings.Join(operations, ", ")), strings.Join(failures, ", ")
+}
+
+// failureReason returns the reason why the update cannot be performed.
+func (u *updateResult) failureReason() string {
+	if u.err != nil {
+		return u.err.Error()
+	}
+	return u.description
+}
+
+// versionUpdate converts the update result into a MaintenanceVersionUpdate.
+func (u *updateResult) versionUpdate() *gardencorev1beta1.MaintenanceVersionUpdate {
+	if u == nil {
+		return nil
+	}
+
+	update := &gardencorev1beta1.MaintenanceVersionUpdate{
+		Version: u.version,
+		Reason:  u.reason,
+		Forced:  u.isForced,
+	}
+
+	if u.isSuccessful {
+		update.TargetVersion = ptr.To(u.targetVersion)
+	} else {
+		update.FailureReason = ptr.To(u.failureReason())
+	}
+
+	return update
+}
+
+// nextMaintenanceBegin returns the beginning of the next maintenance time window of the given Shoot after <now>.
+func nextMaintenanceBegin(shoot *gardencorev1beta1.Shoot, now time.Time) time.Time {
+	begin := gardenerutils.EffectiveShootMaintenanceTimeWindow(shoot).AdjustedBegin(now)
+	if begin.Before(now) {
+		begin = begin.AddDate(0, 0, 1)
+	}
+	return begin
+}
@@ -0,0 +1,296 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package maintenance
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+)
+
+var _ = Describe("Maintenance preview", func() {
+	var (
+		ctx        = context.TODO()
+		fakeClient client.Client
+		fakeClock  *testclock.FakeClock
+		reconciler *Reconciler
+
+		cloudProfile *gardencorev1beta1.CloudProfile
+		shoot        *gardencorev1beta1.Shoot
+	)
+
+	BeforeEach(func() {
+		fakeClock = testclock.NewFakeClock(time.Date(2024, 6, 1, 10, 0, 0, 0, time.UTC))
+
+		cloudProfile = &gardencorev1beta1.CloudProfile{
+			ObjectMeta: metav1.ObjectMeta{Name: "profile"},
+			Spec: gardencorev1beta1.CloudProfileSpec{
+				Kubernetes: gardencorev1beta1.KubernetesSettings{
+					Versions: []gardencorev1beta1.ExpirableVersion{
+						{Version: "1.30.1", ExpirationDate: &metav1.Time{Time: fakeClock.Now().AddDate(0, 0, -1)}},
+						{Version: "1.30.5"},
+					},
+				},
+				MachineImages: []gardencorev1beta1.MachineImage{{
+					Name:           "gardenlinux",
+					UpdateStrategy: ptr.To(gardencorev1beta1.UpdateStrategyMajor),
+					Versions: []gardencorev1beta1.MachineImageVersion{
+						{ExpirableVersion: gardencorev1beta1.ExpirableVersion{Version: "1.0.0"}, CRI: []gardencorev1beta1.CRI{{Name: gardencorev1beta1.CRINameContainerD}}, Architectures: []string{"amd64"}},
+						{ExpirableVersion: gardencorev1beta1.ExpirableVersion{Version: "1.1.0"}, CRI: []gardencorev1beta1.CRI{{Name: gardencorev1beta1.CRINameContainerD}}, Architectures: []string{"amd64"}},
+					},
+				}},
+			},
+		}
+
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: "garden-dev"},
+			Spec: gardencorev1beta1.ShootSpec{
+				CloudProfileName: ptr.To(cloudProfile.Name),
+				Kubernetes:       gardencorev1beta1.Kubernetes{Version: "1.30.1"},
+				Maintenance: &gardencorev1beta1.Maintenance{
+					AutoUpdate: &gardencorev1beta1.MaintenanceAutoUpdate{
+						KubernetesVersion:   false,
+						MachineImageVersion: ptr.To(true),
+					},
+					TimeWindow: &gardencorev1beta1.MaintenanceTimeWindow{
+						Begin: "220000+0000",
+						End:   "230000+0000",
+					},
+				},
+				Provider: gardencorev1beta1.Provider{Workers: []gardencorev1beta1.Worker{
+					{
+						Name: "worker-1",
+						Machine: gardencorev1beta1.Machine{
+							Image:        &gardencorev1beta1.ShootMachineImage{Name: "gardenlinux", Version: ptr.To("1.0.0")},
+							Architecture: ptr.To("amd64"),
+						},
+					},
+					{
+						Name: "worker-2",
+						Machine: gardencorev1beta1.Machine{
+							Image:        &gardencorev1beta1.ShootMachineImage{Name: "gardenlinux", Version: ptr.To("1.1.0")},
+							Architecture: ptr.To("amd64"),
+						},
+					},
+				}},
+			},
+		}
+
+		fakeClient = fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.GardenScheme).
+			WithObjects(cloudProfile, shoot).
+			WithStatusSubresource(shoot).
+			Build()
+
+		reconciler = &Reconciler{
+			Client: fakeClient,
+			Config: config.ShootMaintenanceControllerConfiguration{
+				PreviewSyncPeriod: &metav1.Duration{Duration: time.Hour},
+			},
+			Clock: fakeClock,
+		}
+	})
+
+	Describe("#Reconcile", func() {
+		It("should publish the preview outside of the maintenance time window without changing the spec", func() {
+			result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(shoot)})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(Equal(time.Hour))
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			Expect(shoot.Spec.Kubernetes.Version).To(Equal("1.30.1"))
+			Expect(*shoot.Spec.Provider.Workers[0].Machine.Image.Version).To(Equal("1.0.0"))
+			Expect(shoot.Status.LastMaintenance).To(BeNil())
+
+			preview := shoot.Status.MaintenancePreview
+			Expect(preview).NotTo(BeNil())
+			Expect(preview.LastUpdateTime.Time.Equal(fakeClock.Now())).To(BeTrue())
+			Expect(preview.NextMaintenanceTime.Time.Equal(time.Date(2024, 6, 1, 22, 0, 0, 0, time.UTC))).To(BeTrue())
+			Expect(preview.FailureReason).To(BeNil())
+			Expect(preview.Description).To(ContainSubstring(`Control Plane: Kubernetes version will be updated from "1.30.1" to "1.30.5". Reason: Kubernetes version expired - force update required`))
+			Expect(preview.Description).To(ContainSubstring(`Worker pool "worker-1": machine image "gardenlinux" will be updated from "1.0.0" to "1.1.0"`))
+			Expect(preview.KubernetesVersion).To(Equal(&gardencorev1beta1.MaintenanceVersionUpdate{
+				Version:       "1.30.1",
+				TargetVersion: ptr.To("1.30.5"),
+				Reason:        "Kubernetes version expired - force update required",
+				Forced:        true,
+			}))
+			Expect(preview.Workers).To(ConsistOf(gardencorev1beta1.WorkerMaintenancePreview{
+				Name: "worker-1",
+				MachineImageVersion: &gardencorev1beta1.MaintenanceVersionUpdate{
+					Version:       "1.0.0",
+					TargetVersion: ptr.To("1.1.0"),
+					Reason:        "Automatic update of the machine image version is configured (image update strategy: major)",
+				},
+			}))
+			Expect(preview.SpecChanges).To(BeEmpty())
+		})
+
+		It("should not preview automatic updates which are held back by a maintenance policy", func() {
+			policy := &gardencorev1beta1.MaintenancePolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: "default"},
+				Spec: gardencorev1beta1.MaintenancePolicySpec{
+					Waves: []gardencorev1beta1.MaintenanceRolloutWave{
+						{Name: "canary", ShootSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"canary": "true"}}},
+						{Name: "rest", ShootSelector: &metav1.LabelSelector{}},
+					},
+				},
+				Status: gardencorev1beta1.MaintenancePolicyStatus{
+					Rollouts: []gardencorev1beta1.MaintenanceRollout{{
+						Kind:    gardencorev1beta1.MaintenanceRolloutKindMachineImage,
+						Name:    "gardenlinux",
+						Version: "1.0.0",
+						Waves:   []gardencorev1beta1.MaintenanceRolloutWaveProgress{{Name: "canary", UpdatedShoots: 1}},
+					}},
+				},
+			}
+			Expect(fakeClient.Create(ctx, policy)).To(Succeed())
+
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(shoot)})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			preview := shoot.Status.MaintenancePreview
+			Expect(preview).NotTo(BeNil())
+			Expect(preview.KubernetesVersion.TargetVersion).To(PointTo(Equal("1.30.5")))
+			Expect(preview.Workers).To(BeEmpty())
+		})
+
+		It("should not refresh a preview which is not outdated yet", func() {
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(shoot)})
+			Expect(err).NotTo(HaveOccurred())
+
+			fakeClock.Step(30 * time.Minute)
+			_, err = reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(shoot)})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			Expect(shoot.Status.MaintenancePreview.LastUpdateTime.Time.Equal(fakeClock.Now().Add(-30 * time.Minute))).To(BeTrue())
+
+			fakeClock.Step(30 * time.Minute)
+			_, err = reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(shoot)})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			Expect(shoot.Status.MaintenancePreview.LastUpdateTime.Time.Equal(fakeClock.Now())).To(BeTrue())
+		})
+
+		It("should remove the preview if it is disabled", func() {
+			shoot.Status.MaintenancePreview = &gardencorev1beta1.MaintenancePreview{Description: "foo"}
+			Expect(fakeClient.Status().Update(ctx, shoot)).To(Succeed())
+
+			reconciler.Config.PreviewSyncPeriod = &metav1.Duration{}
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(shoot)})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			Expect(shoot.Status.MaintenancePreview).To(BeNil())
+		})
+	})
+
+	Describe("#preview", func() {
+		It("should report that no operations are planned", func() {
+			shoot.Spec.Kubernetes.Version = "1.30.5"
+			shoot.Spec.Provider.Workers = shoot.Spec.Provider.Workers[1:]
+
+			result, err := computeMaintenance(logr.Discard(), shoot, cloudProfile, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			preview := result.preview(shoot, fakeClock.Now(), fakeClock.Now())
+			Expect(preview.Description).To(Equal("No maintenance operations planned"))
+			Expect(preview.KubernetesVersion).To(BeNil())
+			Expect(preview.Workers).To(BeEmpty())
+		})
+
+		It("should describe the spec changes as planned operations", func() {
+			shoot.Spec.Kubernetes.Version = "1.30.5"
+			shoot.Spec.Provider.Workers = shoot.Spec.Provider.Workers[1:]
+
+			result, err := computeMaintenance(logr.Discard(), shoot, cloudProfile, nil)
+			Expect(err).NotTo(HaveOccurred())
+			result.operations = []specChange{{action: specChangeAnnotate, to: "reconcile"}}
+
+			preview := result.preview(shoot, fakeClock.Now(), fakeClock.Now())
+			Expect(preview.Description).To(Equal(`1 maintenance operations planned: "reconcile" operation annotation will be added`))
+			Expect(preview.SpecChanges).To(ConsistOf(`"reconcile" operation annotation will be added`))
+
+			description, _ := result.description()
+			Expect(description).To(ContainSubstring(`Added "reconcile" operation annotation`))
+		})
+
+		It("should report failing updates", func() {
+			cloudProfile.Spec.Kubernetes.Versions = cloudProfile.Spec.Kubernetes.Versions[:1]
+
+			result, err := computeMaintenance(logr.Discard(), shoot, cloudProfile, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			preview := result.preview(shoot, fakeClock.Now(), fakeClock.Now())
+			Expect(preview.Description).To(HavePrefix("2 maintenance operations planned, 1 of them cannot be performed: Control Plane: Kubernetes version cannot be updated."))
+			Expect(preview.Description).NotTo(ContainSubstring("successful"))
+			Expect(preview.FailureReason).To(PointTo(HavePrefix("Control Plane: ")))
+			Expect(preview.KubernetesVersion.TargetVersion).To(BeNil())
+			Expect(preview.KubernetesVersion.FailureReason).To(PointTo(HavePrefix("cannot perform forceful update of expired version")))
+			Expect(preview.FailureReason).To(PointTo(Equal("Control Plane: " + *preview.KubernetesVersion.FailureReason)))
+			Expect(preview.KubernetesVersion.Forced).To(BeTrue())
+		})
+	})
+
+	Describe("#specChange", func() {
+		DescribeTable("should describe the change as performed and as planned operation",
+			func(change specChange, applied, planned string) {
+				Expect(change.String()).To(Equal(applied))
+				Expect(change.planned()).To(Equal(planned))
+			},
+			Entry("operation annotation",
+				specChange{action: specChangeAnnotate, to: "reconcile"},
+				`Added "reconcile" operation annotation`,
+				`"reconcile" operation annotation will be added`),
+			Entry("feature gates",
+				specChange{action: specChangeRemove, field: "spec.kubernetes.kubelet.featureGates", subject: "feature gates", from: "Foo", reason: `they are not supported in Kubernetes version "1.31.0"`},
+				`Removed feature gates from "spec.kubernetes.kubelet.featureGates" because they are not supported in Kubernetes version "1.31.0": Foo`,
+				`Feature gates will be removed from "spec.kubernetes.kubelet.featureGates" because they are not supported in Kubernetes version "1.31.0": Foo`),
+			Entry("admission plugins",
+				specChange{action: specChangeRemove, field: "spec.kubernetes.kubeAPIServer.admissionPlugins", subject: "admission plugins", from: "Foo", reason: `they are not supported in Kubernetes version "1.31.0"`},
+				`Removed admission plugins from "spec.kubernetes.kubeAPIServer.admissionPlugins" because they are not supported in Kubernetes version "1.31.0": Foo`,
+				`Admission plugins will be removed from "spec.kubernetes.kubeAPIServer.admissionPlugins" because they are not supported in Kubernetes version "1.31.0": Foo`),
+			Entry("maximum of worker pool",
+				specChange{action: specChangeUpgrade, field: `Maximum of worker-pool "worker-1"`, from: "1", to: "3", reason: "foo"},
+				`Maximum of worker-pool "worker-1" upgraded from 1 to 3. Reason: foo`,
+				`Maximum of worker-pool "worker-1" will be upgraded from 1 to 3. Reason: foo`),
+			Entry("field set",
+				specChange{field: ".spec.kubernetes.kubeAPIServer.oidcConfig", to: "nil", reason: "foo"},
+				`.spec.kubernetes.kubeAPIServer.oidcConfig is set to nil. Reason: foo`,
+				`.spec.kubernetes.kubeAPIServer.oidcConfig will be set to nil. Reason: foo`),
+			Entry("field added",
+				specChange{action: specChangeAdd, field: ".spec.kubernetes.kubelet.systemReserved", to: ".spec.kubernetes.kubelet.kubeReserved", reason: "foo"},
+				`.spec.kubernetes.kubelet.systemReserved is added to .spec.kubernetes.kubelet.kubeReserved. Reason: foo`,
+				`.spec.kubernetes.kubelet.systemReserved will be added to .spec.kubernetes.kubelet.kubeReserved. Reason: foo`),
+		)
+	})
+
+	Describe("#nextMaintenanceBegin", func() {
+		It("should return the beginning of the time window on the same day", func() {
+			Expect(nextMaintenanceBegin(shoot, fakeClock.Now())).To(Equal(time.Date(2024, 6, 1, 22, 0, 0, 0, time.UTC)))
+		})
+
+		It("should return the beginning of the time window on the next day", func() {
+			Expect(nextMaintenanceBegin(shoot, fakeClock.Now().Add(13*time.Hour))).To(Equal(time.Date(2024, 6, 2, 22, 0, 0, 0, time.UTC)))
+		})
+	})
+})
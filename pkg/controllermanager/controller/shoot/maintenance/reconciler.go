@@ -68,16 +68,38 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 
 	if !mustMaintainNow(shoot, r.Clock) {
 		log.V(1).Info("Skipping Shoot because it doesn't need to be maintained now")
+
+		if r.previewNeedsRefresh(shoot) {
+			if err := r.reconcilePreview(ctx, log, shoot); err != nil {
+				return reconcile.Result{}, err
+			}
+		}
+
 		log.V(1).Info("Scheduled next maintenance for Shoot", "duration", requeueAfter.Round(time.Minute), "nextMaintenance", nextMaintenance.Round(time.Minute))
-		return reconcile.Result{RequeueAfter: requeueAfter}, nil
+		return reconcile.Result{RequeueAfter: r.requeueAfter(requeueAfter)}, nil
 	}
 
 	if err := r.reconcile(ctx, log, shoot); err != nil {
 		return reconcile.Result{}, err
 	}
 
+	// The maintenance has changed the Shoot, hence the preview is refreshed right away for the next maintenance.
+	if r.previewEnabled() || shoot.Status.MaintenancePreview != nil {
+		if err := r.reconcilePreview(ctx, log, shoot); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
 	log.V(1).Info("Scheduled next maintenance for Shoot", "duration", requeueAfter.Round(time.Minute), "nextMaintenance", nextMaintenance.Round(time.Minute))
-	return reconcile.Result{RequeueAfter: requeueAfter}, nil
+	return reconcile.Result{RequeueAfter: r.requeueAfter(requeueAfter)}, nil
+}
+
+// requeueAfter returns the given duration until the next maintenance, or the preview sync period if it is shorter.
+func (r *Reconciler) requeueAfter(untilNextMaintenance time.Duration) time.Duration {
+	if r.previewEnabled() && r.Config.PreviewSyncPeriod.Duration < untilNextMaintenance {
+		return r.Config.PreviewSyncPeriod.Duration
+	}
+	return untilNextMaintenance
 }
 
 func requeueAfterDuration(shoot *gardencorev1beta1.Shoot) (time.Duration, time.Time) {
@@ -98,35 +120,267 @@ type updateResult struct {
 	description  string
 	reason       string
 	isSuccessful bool
+	// err is the error which prevented a failed update.
+	err error
+	// name is the name of the machine image for machine image updates.
+	name string
+	// version is the version before the update, targetVersion the version after a successful update.
+	version       string
+	targetVersion string
+	// isForced is true if the update is enforced because the current version is expired or does not exist anymore.
+	isForced bool
+}
+
+// maintenanceResult contains the maintained Shoot along with the results of all maintenance operations.
+type maintenanceResult struct {
+	maintainedShoot              *gardencorev1beta1.Shoot
+	kubernetesControlPlaneUpdate *updateResult
+	workerToKubernetesUpdate     map[string]updateResult
+	workerToMachineImageUpdate   map[string]updateResult
+	// for maintenance operations unrelated to machine images and Kubernetes versions
+	operations []specChange
+}
+
+// specChangeAction is the kind of a change of the Shoot specification performed by the maintenance.
+type specChangeAction int
+
+const (
+	// specChangeSet sets the field to the value <to>.
+	specChangeSet specChangeAction = iota
+	// specChangeAdd adds the value of the field to the field <to>.
+	specChangeAdd
+	// specChangeUpgrade upgrades the field from the value <from> to the value <to>.
+	specChangeUpgrade
+	// specChangeRemove removes the entries <from> of the kind <subject> from the field.
+	specChangeRemove
+	// specChangeAnnotate adds the operation annotation with the value <to>.
+	specChangeAnnotate
+)
+
+// specChange is a change of the Shoot specification which is unrelated to machine images and Kubernetes versions.
+// It can be described both as performed and as planned operation.
+type specChange struct {
+	action  specChangeAction
+	field   string
+	subject string
+	from    string
+	to      string
+	reason  string
+}
+
+// String returns the description of the performed change.
+func (c specChange) String() string {
+	switch c.action {
+	case specChangeAdd:
+		return fmt.Sprintf("%s is added to %s. Reason: %s", c.field, c.to, c.reason)
+	case specChangeUpgrade:
+		return fmt.Sprintf("%s upgraded from %s to %s. Reason: %s", c.field, c.from, c.to, c.reason)
+	case specChangeRemove:
+		return fmt.Sprintf("Removed %s from %q because %s: %s", c.subject, c.field, c.reason, c.from)
+	case specChangeAnnotate:
+		return fmt.Sprintf("Added %q operation annotation", c.to)
+	default:
+		return fmt.Sprintf("%s is set to %s. Reason: %s", c.field, c.to, c.reason)
+	}
+}
+
+// planned returns the description of the change as planned operation.
+func (c specChange) planned() string {
+	switch c.action {
+	case specChangeAdd:
+		return fmt.Sprintf("%s will be added to %s. Reason: %s", c.field, c.to, c.reason)
+	case specChangeUpgrade:
+		return fmt.Sprintf("%s will be upgraded from %s to %s. Reason: %s", c.field, c.from, c.to, c.reason)
+	case specChangeRemove:
+		return fmt.Sprintf("%s%s will be removed from %q because %s: %s", strings.ToUpper(c.subject[:1]), c.subject[1:], c.field, c.reason, c.from)
+	case specChangeAnnotate:
+		return fmt.Sprintf("%q operation annotation will be added", c.to)
+	default:
+		return fmt.Sprintf("%s will be set to %s. Reason: %s", c.field, c.to, c.reason)
+	}
+}
+
+// describeSpecChanges returns the descriptions of the given changes, either as performed or as planned operations.
+func describeSpecChanges(changes []specChange, planned bool) []string {
+	descriptions := make([]string, 0, len(changes))
+	for _, change := range changes {
+		if planned {
+			descriptions = append(descriptions, change.planned())
+		} else {
+			descriptions = append(descriptions, change.String())
+		}
+	}
+	return descriptions
+}
+
+func (m *maintenanceResult) requirePatch() bool {
+	return len(m.operations) > 0 || m.kubernetesControlPlaneUpdate != nil || len(m.workerToKubernetesUpdate) > 0 || len(m.workerToMachineImageUpdate) > 0
+}
+
+// description returns the description and the failure reason of the maintenance operations.
+func (m *maintenanceResult) description() (string, string) {
+	// make sure to include both successful and failed maintenance operations
+	description, failureReason := buildMaintenanceMessages(
+		m.kubernetesControlPlaneUpdate,
+		m.workerToKubernetesUpdate,
+		m.workerToMachineImageUpdate,
+	)
+
+	// append also other maintenance operation
+	if len(m.operations) > 0 {
+		description = fmt.Sprintf("%s, %s", description, strings.Join(describeSpecChanges(m.operations, false), ", "))
+	}
+
+	return description, failureReason
 }
 
 func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot) error {
 	log.Info("Maintaining Shoot")
 
+	cloudProfile, err := gardenerutils.GetCloudProfile(ctx, r.Client, shoot)
+	if err != nil {
+		return err
+	}
+
+	gate, err := r.rolloutGateForShoot(ctx, shoot)
+	if err != nil {
+		return err
+	}
+
+	result, err := computeMaintenance(log, shoot, cloudProfile, gate)
+	if err != nil {
+		return err
+	}
+
+	var (
+		maintainedShoot              = result.maintainedShoot
+		kubernetesControlPlaneUpdate = result.kubernetesControlPlaneUpdate
+		workerToKubernetesUpdate     = result.workerToKubernetesUpdate
+		workerToMachineImageUpdate   = result.workerToMachineImageUpdate
+	)
+
+	requirePatch := result.requirePatch()
+	if requirePatch {
+		patch := client.MergeFrom(shoot.DeepCopy())
+
+		description, failureReason := result.description()
+
+		shoot.Status.LastMaintenance = &gardencorev1beta1.LastMaintenance{
+			Description:   description,
+			TriggeredTime: metav1.Time{Time: r.Clock.Now()},
+			State:         gardencorev1beta1.LastOperationStateProcessing,
+		}
+
+		// if any maintenance operation failed, set the status to 'Failed' and retry in the next maintenance cycle
+		if failureReason != "" {
+			shoot.Status.LastMaintenance.State = gardencorev1beta1.LastOperationStateFailed
+			shoot.Status.LastMaintenance.FailureReason = &failureReason
+		}
+
+		// First dry run the update call to check if it can be executed successfully (maintenance might yield a Shoot configuration that is rejected by the ApiServer).
+		// If the dry run fails, the shoot maintenance is marked as failed and is retried only in
+		// next maintenance window.
+		if err := r.Client.Update(ctx, maintainedShoot.DeepCopy(), &client.UpdateOptions{
+			DryRun: []string{metav1.DryRunAll},
+		}); err != nil {
+			// If shoot maintenance is triggered by `gardener.cloud/operation=maintain` annotation and if it fails in dry run,
+			// `maintain` operation annotation needs to be removed so that if reason for failure is fixed and maintenance is triggered
+			// again via `maintain` operation annotation then it should not fail with the reason that annotation is already present.
+			// Removal of annotation during shoot status patch is possible cause only spec is kept in original form during status update
+			// https://github.com/gardener/gardener/blob/a2f7de0badaae6170d7b9b84c163b8cab43a84d2/pkg/apiserver/registry/core/shoot/strategy.go#L258-L267
+			if hasMaintainNowAnnotation(shoot) {
+				delete(shoot.Annotations, v1beta1constants.GardenerOperation)
+			}
+			shoot.Status.LastMaintenance.Description = "Maintenance failed"
+			shoot.Status.LastMaintenance.State = gardencorev1beta1.LastOperationStateFailed
+			shoot.Status.LastMaintenance.FailureReason = ptr.To(fmt.Sprintf("Updates to the Shoot failed to be applied: %s", err.Error()))
+			if err := r.Client.Status().Patch(ctx, shoot, patch); err != nil {
+				return err
+			}
+
+			log.Info("Shoot maintenance failed", "reason", err)
+			return nil
+		}
+
+		if err := r.Client.Status().Patch(ctx, shoot, patch); err != nil {
+			return err
+		}
+	}
+
+	// update shoot spec changes in maintenance call
+	shoot.Spec = *maintainedShoot.Spec.DeepCopy()
+	_ = maintainOperation(shoot)
+	maintainTasks(shoot, r.Config)
+
+	// try to maintain shoot, but don't retry on conflict, because a conflict means that we potentially operated on stale
+	// data (e.g. when calculating the updated k8s version), so rather return error and backoff
+	if err := r.Client.Update(ctx, shoot); err != nil {
+		r.Recorder.Event(shoot, corev1.EventTypeWarning, gardencorev1beta1.ShootMaintenanceFailed, err.Error())
+		return err
+	}
+
+	// if the maintenance patch is not required and the last maintenance operation state is failed,
+	// this means the maintenance was retried and succeeded. Alternatively, changes could have been made
+	// outside of the maintenance window to fix the maintenance error. In either case, remove the failed state.
+	if !requirePatch && shoot.Status.LastMaintenance != nil && shoot.Status.LastMaintenance.State == gardencorev1beta1.LastOperationStateFailed {
+		patch := client.MergeFrom(shoot.DeepCopy())
+		shoot.Status.LastMaintenance.State = gardencorev1beta1.LastOperationStateSucceeded
+		shoot.Status.LastMaintenance.Description = "Maintenance succeeded"
+		shoot.Status.LastMaintenance.FailureReason = nil
+
+		if err := r.Client.Status().Patch(ctx, shoot, patch); err != nil {
+			return err
+		}
+	}
+
+	if shoot.Status.LastMaintenance != nil && shoot.Status.LastMaintenance.State == gardencorev1beta1.LastOperationStateProcessing {
+		patch := client.MergeFrom(shoot.DeepCopy())
+		shoot.Status.LastMaintenance.State = gardencorev1beta1.LastOperationStateSucceeded
+
+		if err := r.Client.Status().Patch(ctx, shoot, patch); err != nil {
+			return err
+		}
+	}
+
+	// make sure to report (partial) maintenance failures
+	if kubernetesControlPlaneUpdate != nil {
+		if kubernetesControlPlaneUpdate.isSuccessful {
+			r.Recorder.Eventf(shoot, corev1.EventTypeNormal, gardencorev1beta1.ShootEventK8sVersionMaintenance, "%s", fmt.Sprintf("Control Plane: %s. Reason: %s.", kubernetesControlPlaneUpdate.description, kubernetesControlPlaneUpdate.reason))
+		} else {
+			r.Recorder.Eventf(shoot, corev1.EventTypeWarning, gardencorev1beta1.ShootEventK8sVersionMaintenance, "%s", fmt.Sprintf("Control Plane: Kubernetes version maintenance failed. Reason for update: %s. Error: %v", kubernetesControlPlaneUpdate.reason, kubernetesControlPlaneUpdate.description))
+		}
+	}
+
+	r.recordMaintenanceEventsForPool(workerToKubernetesUpdate, shoot, gardencorev1beta1.ShootEventK8sVersionMaintenance, "Kubernetes")
+	r.recordMaintenanceEventsForPool(workerToMachineImageUpdate, shoot, gardencorev1beta1.ShootEventImageVersionMaintenance, "Machine image")
+
+	log.Info("Shoot maintenance completed")
+	return nil
+}
+
+// computeMaintenance computes the maintenance operations for the given Shoot. The given Shoot is not modified, the
+// changes are applied to a copy which is returned as part of the result. Automatic updates to versions which are not
+// yet released by the given rollout gate are held back.
+func computeMaintenance(log logr.Logger, shoot *gardencorev1beta1.Shoot, cloudProfile *gardencorev1beta1.CloudProfile, gate rolloutGate) (*maintenanceResult, error) {
 	var (
 		maintainedShoot = shoot.DeepCopy()
 		// for maintenance operations unrelated to machine images and Kubernetes versions
-		operations []string
+		operations []specChange
 		err        error
 	)
 
 	workerToKubernetesUpdate := make(map[string]updateResult)
 	workerToMachineImageUpdate := make(map[string]updateResult)
 
-	cloudProfile, err := gardenerutils.GetCloudProfile(ctx, r.Client, shoot)
-	if err != nil {
-		return err
-	}
-
 	if !v1beta1helper.IsWorkerless(shoot) {
-		workerToMachineImageUpdate, err = maintainMachineImages(log, maintainedShoot, cloudProfile)
+		workerToMachineImageUpdate, err = maintainMachineImages(log, maintainedShoot, cloudProfile, gate)
 		if err != nil {
 			// continue execution to allow the kubernetes version update
 			log.Error(err, "Failed to maintain Shoot machine images")
 		}
 	}
 
-	kubernetesControlPlaneUpdate, err := maintainKubernetesVersion(log, maintainedShoot.Spec.Kubernetes.Version, maintainedShoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, func(v string) (string, error) {
+	kubernetesControlPlaneUpdate, err := maintainKubernetesVersion(log, maintainedShoot.Spec.Kubernetes.Version, maintainedShoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, gate, func(v string) (string, error) {
 		maintainedShoot.Spec.Kubernetes.Version = v
 		return v, nil
 	})
@@ -137,12 +391,12 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, shoot *gard
 
 	oldShootKubernetesVersion, err := semver.NewVersion(shoot.Spec.Kubernetes.Version)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	shootKubernetesVersion, err := semver.NewVersion(maintainedShoot.Spec.Kubernetes.Version)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// Set the .spec.kubernetes.enableStaticTokenKubeconfig field to false, when Shoot cluster is being forcefully updated to K8s >= 1.27.
@@ -152,8 +406,11 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, shoot *gard
 			if ptr.Deref(maintainedShoot.Spec.Kubernetes.EnableStaticTokenKubeconfig, false) {
 				maintainedShoot.Spec.Kubernetes.EnableStaticTokenKubeconfig = ptr.To(false)
 
-				reason := ".spec.kubernetes.enableStaticTokenKubeconfig is set to false. Reason: The static token kubeconfig can no longer be enabled for Shoot clusters using Kubernetes version 1.27+"
-				operations = append(operations, reason)
+				operations = append(operations, specChange{
+					field:  ".spec.kubernetes.enableStaticTokenKubeconfig",
+					to:     "false",
+					reason: "The static token kubeconfig can no longer be enabled for Shoot clusters using Kubernetes version 1.27+",
+				})
 			}
 
 			reasonsForIncreasingMaxWorkers := ensureSufficientMaxWorkers(maintainedShoot, fmt.Sprintf("Maximum number of workers of a worker group must be greater or equal to its number of zones for updating Kubernetes to %q", shootKubernetesVersion.String()))
@@ -169,8 +426,11 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, shoot *gard
 				maintainedShoot.Spec.Kubernetes.KubeAPIServer.OIDCConfig.ClientAuthentication != nil {
 				maintainedShoot.Spec.Kubernetes.KubeAPIServer.OIDCConfig.ClientAuthentication = nil
 
-				reason := ".spec.kubernetes.kubeAPIServer.oidcConfig.clientAuthentication is set to nil. Reason: The field was no-op since its introduction and can no longer be enabled for Shoot clusters using Kubernetes version 1.31+"
-				operations = append(operations, reason)
+				operations = append(operations, specChange{
+					field:  ".spec.kubernetes.kubeAPIServer.oidcConfig.clientAuthentication",
+					to:     "nil",
+					reason: "The field was no-op since its introduction and can no longer be enabled for Shoot clusters using Kubernetes version 1.31+",
+				})
 			}
 		}
 	}
@@ -184,8 +444,11 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, shoot *gard
 			if maintainedShoot.Spec.Kubernetes.KubeAPIServer != nil && maintainedShoot.Spec.Kubernetes.KubeAPIServer.OIDCConfig != nil {
 				maintainedShoot.Spec.Kubernetes.KubeAPIServer.OIDCConfig = nil
 
-				reason := ".spec.kubernetes.kubeAPIServer.oidcConfig is set to nil. Reason: The field has been deprecated in favor of structured authentication and can no longer be enabled for Shoot clusters using Kubernetes version 1.32+"
-				operations = append(operations, reason)
+				operations = append(operations, specChange{
+					field:  ".spec.kubernetes.kubeAPIServer.oidcConfig",
+					to:     "nil",
+					reason: "The field has been deprecated in favor of structured authentication and can no longer be enabled for Shoot clusters using Kubernetes version 1.32+",
+				})
 			}
 		}
 	}
@@ -197,7 +460,7 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, shoot *gard
 		}
 
 		workerLog := log.WithValues("worker", pool.Name)
-		workerKubernetesUpdate, err := maintainKubernetesVersion(workerLog, *pool.Kubernetes.Version, maintainedShoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, func(v string) (string, error) {
+		workerKubernetesUpdate, err := maintainKubernetesVersion(workerLog, *pool.Kubernetes.Version, maintainedShoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, gate, func(v string) (string, error) {
 			workerPoolSemver, err := semver.NewVersion(v)
 			if err != nil {
 				return "", err
@@ -216,21 +479,16 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, shoot *gard
 		}
 
 		if workerKubernetesUpdate != nil {
-			result := updateResult{
-				reason: workerKubernetesUpdate.reason,
-			}
-			result.isSuccessful = workerKubernetesUpdate.isSuccessful
-			result.description = workerKubernetesUpdate.description
-			workerToKubernetesUpdate[pool.Name] = result
+			workerToKubernetesUpdate[pool.Name] = *workerKubernetesUpdate
 		}
 	}
 
-	if reasons := maintainFeatureGatesForShoot(maintainedShoot); len(reasons) > 0 {
-		operations = append(operations, reasons...)
+	if changes := maintainFeatureGatesForShoot(maintainedShoot); len(changes) > 0 {
+		operations = append(operations, changes...)
 	}
 
-	if reasons := maintainAdmissionPluginsForShoot(maintainedShoot); len(reasons) > 0 {
-		operations = append(operations, reasons...)
+	if changes := maintainAdmissionPluginsForShoot(maintainedShoot); len(changes) > 0 {
+		operations = append(operations, changes...)
 	}
 
 	// Set the swap behavior to `LimitedSwap`, when the shoot cluster and/or worker pool is updated to k8s version >= 1.30.
@@ -246,7 +504,7 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, shoot *gard
 				kubeletVersion := ptr.Deref(maintainedShoot.Spec.Provider.Workers[i].Kubernetes.Version, maintainedShoot.Spec.Kubernetes.Version)
 				kubeletSemverVersion, err := semver.NewVersion(kubeletVersion)
 				if err != nil {
-					return fmt.Errorf("error parsing kubelet version for worker pool %q: %w", maintainedShoot.Spec.Provider.Workers[i].Name, err)
+					return nil, fmt.Errorf("error parsing kubelet version for worker pool %q: %w", maintainedShoot.Spec.Provider.Workers[i].Name, err)
 				}
 
 				if versionutils.ConstraintK8sGreaterEqual130.Check(kubeletSemverVersion) {
@@ -264,8 +522,12 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, shoot *gard
 				maintainedShoot.Spec.Kubernetes.Kubelet.KubeReserved = v1beta1helper.SumResourceReservations(maintainedShoot.Spec.Kubernetes.Kubelet.KubeReserved, maintainedShoot.Spec.Kubernetes.Kubelet.SystemReserved)
 				maintainedShoot.Spec.Kubernetes.Kubelet.SystemReserved = nil
 
-				reason := ".spec.kubernetes.kubelet.systemReserved is added to .spec.kubernetes.kubelet.kubeReserved. Reason: The systemReserved field is forbidden for Shoot clusters using Kubernetes version 1.31+, its value has to be added to kubeReserved"
-				operations = append(operations, reason)
+				operations = append(operations, specChange{
+					action: specChangeAdd,
+					field:  ".spec.kubernetes.kubelet.systemReserved",
+					to:     ".spec.kubernetes.kubelet.kubeReserved",
+					reason: "The systemReserved field is forbidden for Shoot clusters using Kubernetes version 1.31+, its value has to be added to kubeReserved",
+				})
 			}
 		}
 
@@ -275,15 +537,19 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, shoot *gard
 				kubeletVersion := ptr.Deref(maintainedShoot.Spec.Provider.Workers[i].Kubernetes.Version, maintainedShoot.Spec.Kubernetes.Version)
 				kubeletSemverVersion, err := semver.NewVersion(kubeletVersion)
 				if err != nil {
-					return fmt.Errorf("error parsing kubelet version for worker pool %q: %w", maintainedShoot.Spec.Provider.Workers[i].Name, err)
+					return nil, fmt.Errorf("error parsing kubelet version for worker pool %q: %w", maintainedShoot.Spec.Provider.Workers[i].Name, err)
 				}
 
 				if versionutils.ConstraintK8sGreaterEqual131.Check(kubeletSemverVersion) {
 					maintainedShoot.Spec.Provider.Workers[i].Kubernetes.Kubelet.KubeReserved = v1beta1helper.SumResourceReservations(maintainedShoot.Spec.Provider.Workers[i].Kubernetes.Kubelet.KubeReserved, maintainedShoot.Spec.Provider.Workers[i].Kubernetes.Kubelet.SystemReserved)
 					maintainedShoot.Spec.Provider.Workers[i].Kubernetes.Kubelet.SystemReserved = nil
 
-					reason := fmt.Sprintf(".spec.provider.workers[%[1]d].kubernetes.kubelet.systemReserved is added to .spec.provider.workers[%[1]d].kubernetes.kubelet.kubeReserved. Reason: The systemReserved field is forbidden for Shoot clusters using Kubernetes version 1.31+, its value has to be added to kubeReserved", i)
-					operations = append(operations, reason)
+					operations = append(operations, specChange{
+						action: specChangeAdd,
+						field:  fmt.Sprintf(".spec.provider.workers[%d].kubernetes.kubelet.systemReserved", i),
+						to:     fmt.Sprintf(".spec.provider.workers[%d].kubernetes.kubelet.kubeReserved", i),
+						reason: "The systemReserved field is forbidden for Shoot clusters using Kubernetes version 1.31+, its value has to be added to kubeReserved",
+					})
 				}
 			}
 		}
@@ -291,116 +557,16 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, shoot *gard
 
 	operation := maintainOperation(maintainedShoot)
 	if operation != "" {
-		operations = append(operations, fmt.Sprintf("Added %q operation annotation", operation))
-	}
-
-	requirePatch := len(operations) > 0 || kubernetesControlPlaneUpdate != nil || len(workerToKubernetesUpdate) > 0 || len(workerToMachineImageUpdate) > 0
-	if requirePatch {
-		patch := client.MergeFrom(shoot.DeepCopy())
-
-		// make sure to include both successful and failed maintenance operations
-		description, failureReason := buildMaintenanceMessages(
-			kubernetesControlPlaneUpdate,
-			workerToKubernetesUpdate,
-			workerToMachineImageUpdate,
-		)
-
-		// append also other maintenance operation
-		if len(operations) > 0 {
-			description = fmt.Sprintf("%s, %s", description, strings.Join(operations, ", "))
-		}
-
-		shoot.Status.LastMaintenance = &gardencorev1beta1.LastMaintenance{
-			Description:   description,
-			TriggeredTime: metav1.Time{Time: r.Clock.Now()},
-			State:         gardencorev1beta1.LastOperationStateProcessing,
-		}
-
-		// if any maintenance operation failed, set the status to 'Failed' and retry in the next maintenance cycle
-		if failureReason != "" {
-			shoot.Status.LastMaintenance.State = gardencorev1beta1.LastOperationStateFailed
-			shoot.Status.LastMaintenance.FailureReason = &failureReason
-		}
-
-		// First dry run the update call to check if it can be executed successfully (maintenance might yield a Shoot configuration that is rejected by the ApiServer).
-		// If the dry run fails, the shoot maintenance is marked as failed and is retried only in
-		// next maintenance window.
-		if err := r.Client.Update(ctx, maintainedShoot.DeepCopy(), &client.UpdateOptions{
-			DryRun: []string{metav1.DryRunAll},
-		}); err != nil {
-			// If shoot maintenance is triggered by `gardener.cloud/operation=maintain` annotation and if it fails in dry run,
-			// `maintain` operation annotation needs to be removed so that if reason for failure is fixed and maintenance is triggered
-			// again via `maintain` operation annotation then it should not fail with the reason that annotation is already present.
-			// Removal of annotation during shoot status patch is possible cause only spec is kept in original form during status update
-			// https://github.com/gardener/gardener/blob/a2f7de0badaae6170d7b9b84c163b8cab43a84d2/pkg/apiserver/registry/core/shoot/strategy.go#L258-L267
-			if hasMaintainNowAnnotation(shoot) {
-				delete(shoot.Annotations, v1beta1constants.GardenerOperation)
-			}
-			shoot.Status.LastMaintenance.Description = "Maintenance failed"
-			shoot.Status.LastMaintenance.State = gardencorev1beta1.LastOperationStateFailed
-			shoot.Status.LastMaintenance.FailureReason = ptr.To(fmt.Sprintf("Updates to the Shoot failed to be applied: %s", err.Error()))
-			if err := r.Client.Status().Patch(ctx, shoot, patch); err != nil {
-				return err
-			}
-
-			log.Info("Shoot maintenance failed", "reason", err)
-			return nil
-		}
-
-		if err := r.Client.Status().Patch(ctx, shoot, patch); err != nil {
-			return err
-		}
-	}
-
-	// update shoot spec changes in maintenance call
-	shoot.Spec = *maintainedShoot.Spec.DeepCopy()
-	_ = maintainOperation(shoot)
-	maintainTasks(shoot, r.Config)
-
-	// try to maintain shoot, but don't retry on conflict, because a conflict means that we potentially operated on stale
-	// data (e.g. when calculating the updated k8s version), so rather return error and backoff
-	if err := r.Client.Update(ctx, shoot); err != nil {
-		r.Recorder.Event(shoot, corev1.EventTypeWarning, gardencorev1beta1.ShootMaintenanceFailed, err.Error())
-		return err
+		operations = append(operations, specChange{action: specChangeAnnotate, to: operation})
 	}
 
-	// if the maintenance patch is not required and the last maintenance operation state is failed,
-	// this means the maintenance was retried and succeeded. Alternatively, changes could have been made
-	// outside of the maintenance window to fix the maintenance error. In either case, remove the failed state.
-	if !requirePatch && shoot.Status.LastMaintenance != nil && shoot.Status.LastMaintenance.State == gardencorev1beta1.LastOperationStateFailed {
-		patch := client.MergeFrom(shoot.DeepCopy())
-		shoot.Status.LastMaintenance.State = gardencorev1beta1.LastOperationStateSucceeded
-		shoot.Status.LastMaintenance.Description = "Maintenance succeeded"
-		shoot.Status.LastMaintenance.FailureReason = nil
-
-		if err := r.Client.Status().Patch(ctx, shoot, patch); err != nil {
-			return err
-		}
-	}
-
-	if shoot.Status.LastMaintenance != nil && shoot.Status.LastMaintenance.State == gardencorev1beta1.LastOperationStateProcessing {
-		patch := client.MergeFrom(shoot.DeepCopy())
-		shoot.Status.LastMaintenance.State = gardencorev1beta1.LastOperationStateSucceeded
-
-		if err := r.Client.Status().Patch(ctx, shoot, patch); err != nil {
-			return err
-		}
-	}
-
-	// make sure to report (partial) maintenance failures
-	if kubernetesControlPlaneUpdate != nil {
-		if kubernetesControlPlaneUpdate.isSuccessful {
-			r.Recorder.Eventf(shoot, corev1.EventTypeNormal, gardencorev1beta1.ShootEventK8sVersionMaintenance, "%s", fmt.Sprintf("Control Plane: %s. Reason: %s.", kubernetesControlPlaneUpdate.description, kubernetesControlPlaneUpdate.reason))
-		} else {
-			r.Recorder.Eventf(shoot, corev1.EventTypeWarning, gardencorev1beta1.ShootEventK8sVersionMaintenance, "%s", fmt.Sprintf("Control Plane: Kubernetes version maintenance failed. Reason for update: %s. Error: %v", kubernetesControlPlaneUpdate.reason, kubernetesControlPlaneUpdate.description))
-		}
-	}
-
-	r.recordMaintenanceEventsForPool(workerToKubernetesUpdate, shoot, gardencorev1beta1.ShootEventK8sVersionMaintenance, "Kubernetes")
-	r.recordMaintenanceEventsForPool(workerToMachineImageUpdate, shoot, gardencorev1beta1.ShootEventImageVersionMaintenance, "Machine image")
-
-	log.Info("Shoot maintenance completed")
-	return nil
+	return &maintenanceResult{
+		maintainedShoot:              maintainedShoot,
+		kubernetesControlPlaneUpdate: kubernetesControlPlaneUpdate,
+		workerToKubernetesUpdate:     workerToKubernetesUpdate,
+		workerToMachineImageUpdate:   workerToMachineImageUpdate,
+		operations:                   operations,
+	}, nil
 }
 
 // buildMaintenanceMessages builds a combined message containing the performed maintenance operations over all worker pools. If the maintenance operation failed, the description
@@ -517,7 +683,7 @@ func maintainTasks(shoot *gardencorev1beta1.Shoot, config config.ShootMaintenanc
 }
 
 // maintainMachineImages updates the machine images of a Shoot's worker pools if necessary
-func maintainMachineImages(log logr.Logger, shoot *gardencorev1beta1.Shoot, cloudProfile *gardencorev1beta1.CloudProfile) (map[string]updateResult, error) {
+func maintainMachineImages(log logr.Logger, shoot *gardencorev1beta1.Shoot, cloudProfile *gardencorev1beta1.CloudProfile, gate rolloutGate) (map[string]updateResult, error) {
 	maintenanceResults := make(map[string]updateResult)
 
 	controlPlaneVersion, err := semver.NewVersion(shoot.Spec.Kubernetes.Version)
@@ -556,6 +722,10 @@ func maintainMachineImages(log logr.Logger, shoot *gardencorev1beta1.Shoot, clou
 				description:  fmt.Sprintf("failed to update machine image %q: %s", workerImage.Name, err.Error()),
 				reason:       reason,
 				isSuccessful: false,
+				err:          err,
+				name:         workerImage.Name,
+				version:      *workerImage.Version,
+				isForced:     isExpired,
 			}
 			continue
 		}
@@ -564,11 +734,21 @@ func maintainMachineImages(log logr.Logger, shoot *gardencorev1beta1.Shoot, clou
 			continue
 		}
 
+		// forced updates of expired versions are never held back
+		if released, heldBackReason := gate.released(gardencorev1beta1.MaintenanceRolloutKindMachineImage, workerImage.Name, updatedMachineImageVersion); !isExpired && !released {
+			workerLog.Info("MachineImage update is held back by maintenance policy", "newVersion", updatedMachineImageVersion, "reason", heldBackReason)
+			continue
+		}
+
 		workerLog.Info("MachineImage will be updated", "newVersion", updatedMachineImageVersion, "reason", reason)
 		maintenanceResults[worker.Name] = updateResult{
-			description:  fmt.Sprintf("Updated machine image %q from %q to %q", workerImage.Name, *workerImage.Version, updatedMachineImageVersion),
-			reason:       reason,
-			isSuccessful: true,
+			description:   fmt.Sprintf("Updated machine image %q from %q to %q", workerImage.Name, *workerImage.Version, updatedMachineImageVersion),
+			reason:        reason,
+			isSuccessful:  true,
+			name:          workerImage.Name,
+			version:       *workerImage.Version,
+			targetVersion: updatedMachineImageVersion,
+			isForced:      isExpired,
 		}
 
 		// update the machine image version
@@ -579,7 +759,7 @@ func maintainMachineImages(log logr.Logger, shoot *gardencorev1beta1.Shoot, clou
 }
 
 // maintainKubernetesVersion updates the Kubernetes version if necessary and returns the reason why an update was done
-func maintainKubernetesVersion(log logr.Logger, kubernetesVersion string, autoUpdate bool, profile *gardencorev1beta1.CloudProfile, updateFunc func(string) (string, error)) (*updateResult, error) {
+func maintainKubernetesVersion(log logr.Logger, kubernetesVersion string, autoUpdate bool, profile *gardencorev1beta1.CloudProfile, gate rolloutGate, updateFunc func(string) (string, error)) (*updateResult, error) {
 	shouldBeUpdated, reason, isExpired, err := shouldKubernetesVersionBeUpdated(kubernetesVersion, autoUpdate, profile)
 	if err != nil {
 		return nil, err
@@ -594,6 +774,9 @@ func maintainKubernetesVersion(log logr.Logger, kubernetesVersion string, autoUp
 			description:  fmt.Sprintf("could not determine higher suitable version than %q: %v", kubernetesVersion, err),
 			reason:       reason,
 			isSuccessful: false,
+			err:          err,
+			version:      kubernetesVersion,
+			isForced:     isExpired,
 		}, err
 	}
 	// current version is already the latest
@@ -601,6 +784,12 @@ func maintainKubernetesVersion(log logr.Logger, kubernetesVersion string, autoUp
 		return nil, nil
 	}
 
+	// forced updates of expired versions are never held back
+	if released, heldBackReason := gate.released(gardencorev1beta1.MaintenanceRolloutKindKubernetes, "", updatedKubernetesVersion); !isExpired && !released {
+		log.Info("Kubernetes version update is held back by maintenance policy", "version", kubernetesVersion, "newVersion", updatedKubernetesVersion, "reason", heldBackReason)
+		return nil, nil
+	}
+
 	// In case the updatedKubernetesVersion for workerpool is higher than the controlplane version, actualUpdatedKubernetesVersion is set to controlplane version
 	actualUpdatedKubernetesVersion, err := updateFunc(updatedKubernetesVersion)
 	if err != nil {
@@ -608,14 +797,20 @@ func maintainKubernetesVersion(log logr.Logger, kubernetesVersion string, autoUp
 			description:  err.Error(),
 			reason:       reason,
 			isSuccessful: false,
+			err:          err,
+			version:      kubernetesVersion,
+			isForced:     isExpired,
 		}, err
 	}
 
 	log.Info("Kubernetes version will be updated", "version", kubernetesVersion, "newVersion", actualUpdatedKubernetesVersion, "reason", reason)
 	return &updateResult{
-		description:  fmt.Sprintf("Updated Kubernetes version from %q to %q", kubernetesVersion, actualUpdatedKubernetesVersion),
-		reason:       reason,
-		isSuccessful: true,
+		description:   fmt.Sprintf("Updated Kubernetes version from %q to %q", kubernetesVersion, actualUpdatedKubernetesVersion),
+		reason:        reason,
+		isSuccessful:  true,
+		version:       kubernetesVersion,
+		targetVersion: actualUpdatedKubernetesVersion,
+		isForced:      isExpired,
 	}, nil
 }
 
@@ -895,8 +1090,8 @@ func ExpirationDateExpired(timestamp *metav1.Time) bool {
 }
 
 // ensureSufficientMaxWorkers ensures that the number of max workers of a worker group is greater or equal to its number of zones
-func ensureSufficientMaxWorkers(shoot *gardencorev1beta1.Shoot, reason string) []string {
-	var reasonsForUpdate []string
+func ensureSufficientMaxWorkers(shoot *gardencorev1beta1.Shoot, reason string) []specChange {
+	var changes []specChange
 
 	for i, worker := range shoot.Spec.Provider.Workers {
 		if !v1beta1helper.SystemComponentsAllowed(&worker) {
@@ -907,29 +1102,39 @@ func ensureSufficientMaxWorkers(shoot *gardencorev1beta1.Shoot, reason string) [
 			continue
 		}
 		newMaximum := int32(len(worker.Zones)) // #nosec G115 -- `len(worker.Zones)` cannot be higher than max int32. Zones come from shoot spec and there is a validation that there cannot be more zones than worker.Maximum which is int32.
-		reasonsForUpdate = append(reasonsForUpdate, fmt.Sprintf("Maximum of worker-pool %q upgraded from %d to %d. Reason: %s", worker.Name, worker.Maximum, newMaximum, reason))
+		changes = append(changes, specChange{
+			action: specChangeUpgrade,
+			field:  fmt.Sprintf("Maximum of worker-pool %q", worker.Name),
+			from:   strconv.Itoa(int(worker.Maximum)),
+			to:     strconv.Itoa(int(newMaximum)),
+			reason: reason,
+		})
 		shoot.Spec.Provider.Workers[i].Maximum = newMaximum
 	}
 
-	return reasonsForUpdate
+	return changes
 }
 
 // setLimitedSwap sets the swap behavior to `LimitedSwap` if it's currently set to `UnlimitedSwap`
-func setLimitedSwap(kubelet *gardencorev1beta1.KubeletConfig, reason string) []string {
-	var reasonsForUpdate []string
+func setLimitedSwap(kubelet *gardencorev1beta1.KubeletConfig, field string) []specChange {
+	var changes []specChange
 
 	if kubelet.MemorySwap != nil &&
 		kubelet.MemorySwap.SwapBehavior != nil &&
 		*kubelet.MemorySwap.SwapBehavior == gardencorev1beta1.UnlimitedSwap {
 		kubelet.MemorySwap.SwapBehavior = ptr.To(gardencorev1beta1.LimitedSwap)
-		reasonsForUpdate = append(reasonsForUpdate, reason+" is set to 'LimitedSwap'. Reason: 'UnlimitedSwap' cannot be used for Kubernetes version 1.30 and higher.")
+		changes = append(changes, specChange{
+			field:  field,
+			to:     "'LimitedSwap'",
+			reason: "'UnlimitedSwap' cannot be used for Kubernetes version 1.30 and higher.",
+		})
 	}
 
-	return reasonsForUpdate
+	return changes
 }
 
-func maintainFeatureGatesForShoot(shoot *gardencorev1beta1.Shoot) []string {
-	var reasons []string
+func maintainFeatureGatesForShoot(shoot *gardencorev1beta1.Shoot) []specChange {
+	var reasons []specChange
 
 	if shoot.Spec.Kubernetes.KubeAPIServer != nil && shoot.Spec.Kubernetes.KubeAPIServer.FeatureGates != nil {
 		if reason := maintainFeatureGates(&shoot.Spec.Kubernetes.KubeAPIServer.KubernetesConfig, shoot.Spec.Kubernetes.Version, "spec.kubernetes.kubeAPIServer.featureGates"); len(reason) > 0 {
@@ -977,9 +1182,9 @@ func maintainFeatureGatesForShoot(shoot *gardencorev1beta1.Shoot) []string {
 // IsFeatureGateSupported is an alias for featuresvalidation.IsFeatureGateSupported. Exposed for testing purposes.
 var IsFeatureGateSupported = featuresvalidation.IsFeatureGateSupported
 
-func maintainFeatureGates(kubernetes *gardencorev1beta1.KubernetesConfig, version, fieldPath string) []string {
+func maintainFeatureGates(kubernetes *gardencorev1beta1.KubernetesConfig, version, fieldPath string) []specChange {
 	var (
-		reasons             []string
+		reasons             []specChange
 		validFeatureGates   = make(map[string]bool, len(kubernetes.FeatureGates))
 		removedFeatureGates []string
 	)
@@ -997,7 +1202,13 @@ func maintainFeatureGates(kubernetes *gardencorev1beta1.KubernetesConfig, versio
 
 	if len(removedFeatureGates) > 0 {
 		slices.Sort(removedFeatureGates)
-		reasons = append(reasons, fmt.Sprintf("Removed feature gates from %q because they are not supported in Kubernetes version %q: %s", fieldPath, version, strings.Join(removedFeatureGates, ", ")))
+		reasons = append(reasons, specChange{
+			action:  specChangeRemove,
+			field:   fieldPath,
+			subject: "feature gates",
+			from:    strings.Join(removedFeatureGates, ", "),
+			reason:  fmt.Sprintf("they are not supported in Kubernetes version %q", version),
+		})
 	}
 
 	return reasons
@@ -1006,9 +1217,9 @@ func maintainFeatureGates(kubernetes *gardencorev1beta1.KubernetesConfig, versio
 // IsAdmissionPluginSupported is an alias for admissionpluginsvalidation.IsAdmissionPluginSupported. Exposed for testing purposes.
 var IsAdmissionPluginSupported = admissionpluginsvalidation.IsAdmissionPluginSupported
 
-func maintainAdmissionPluginsForShoot(shoot *gardencorev1beta1.Shoot) []string {
+func maintainAdmissionPluginsForShoot(shoot *gardencorev1beta1.Shoot) []specChange {
 	var (
-		reasons                 []string
+		reasons                 []specChange
 		removedAdmissionPlugins []string
 	)
 
@@ -1027,7 +1238,13 @@ func maintainAdmissionPluginsForShoot(shoot *gardencorev1beta1.Shoot) []string {
 
 		if len(removedAdmissionPlugins) > 0 {
 			slices.Sort(removedAdmissionPlugins)
-			reasons = append(reasons, fmt.Sprintf("Removed admission plugins from %q because they are not supported in Kubernetes version %q: %s", "spec.kubernetes.kubeAPIServer.admissionPlugins", shoot.Spec.Kubernetes.Version, strings.Join(removedAdmissionPlugins, ", ")))
+			reasons = append(reasons, specChange{
+				action:  specChangeRemove,
+				field:   "spec.kubernetes.kubeAPIServer.admissionPlugins",
+				subject: "admission plugins",
+				from:    strings.Join(removedAdmissionPlugins, ", "),
+				reason:  fmt.Sprintf("they are not supported in Kubernetes version %q", shoot.Spec.Kubernetes.Version),
+			})
 		}
 	}
 
@@ -0,0 +1,89 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package maintenance
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+// rolloutGate checks whether the given version may be rolled out to a Shoot during an automatic update. If not, a
+// reason is returned. A nil gate releases all versions.
+type rolloutGate func(kind gardencorev1beta1.MaintenanceRolloutKind, name, version string) (bool, string)
+
+// released returns true if the given gate is nil or releases the given version.
+func (g rolloutGate) released(kind gardencorev1beta1.MaintenanceRolloutKind, name, version string) (bool, string) {
+	if g == nil {
+		return true, ""
+	}
+	return g(kind, name, version)
+}
+
+type policyWave struct {
+	policy    *gardencorev1beta1.MaintenancePolicy
+	waveIndex int
+}
+
+// rolloutGateForShoot returns the rollout gate for the given Shoot based on the waves of all MaintenancePolicies which
+// the Shoot is part of. It returns nil if the Shoot is not part of any wave which must wait for previous waves.
+func (r *Reconciler) rolloutGateForShoot(ctx context.Context, shoot *gardencorev1beta1.Shoot) (rolloutGate, error) {
+	policyList := &gardencorev1beta1.MaintenancePolicyList{}
+	if err := r.Client.List(ctx, policyList); err != nil {
+		return nil, fmt.Errorf("failed listing maintenance policies: %w", err)
+	}
+	if len(policyList.Items) == 0 {
+		return nil, nil
+	}
+
+	var project *gardencorev1beta1.Project
+	if slices.ContainsFunc(policyList.Items, usesProjectPurposes) {
+		var err error
+		project, err = gardenerutils.ProjectForNamespaceFromReader(ctx, r.Client, shoot.Namespace)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf("failed getting project of shoot: %w", err)
+		}
+	}
+
+	var policyWaves []policyWave
+	for i := range policyList.Items {
+		policy := &policyList.Items[i]
+
+		waveIndex, err := v1beta1helper.MaintenanceRolloutWaveIndex(policy, shoot, project)
+		if err != nil {
+			return nil, err
+		}
+		// Shoots of the first wave do not need to wait for anything.
+		if waveIndex > 0 {
+			policyWaves = append(policyWaves, policyWave{policy: policy, waveIndex: waveIndex})
+		}
+	}
+
+	if len(policyWaves) == 0 {
+		return nil, nil
+	}
+
+	now := r.Clock.Now()
+	return func(kind gardencorev1beta1.MaintenanceRolloutKind, name, version string) (bool, string) {
+		for _, pw := range policyWaves {
+			if released, reason := v1beta1helper.MaintenanceRolloutReleased(pw.policy, pw.waveIndex, kind, name, version, now); !released {
+				return false, reason
+			}
+		}
+		return true, ""
+	}, nil
+}
+
+func usesProjectPurposes(policy gardencorev1beta1.MaintenancePolicy) bool {
+	return slices.ContainsFunc(policy.Spec.Waves, func(wave gardencorev1beta1.MaintenanceRolloutWave) bool {
+		return len(wave.ProjectPurposes) > 0
+	})
+}
@@ -0,0 +1,45 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+)
+
+// NewClientSetFromFile creates a new client set with an uncached client for the cluster the given kubeconfig file
+// points to. Exposed for testing.
+var NewClientSetFromFile = func(kubeconfigPath string, scheme *runtime.Scheme) (kubernetes.Interface, error) {
+	return kubernetes.NewClientFromFile("", kubeconfigPath,
+		kubernetes.WithClientOptions(client.Options{Scheme: scheme}),
+		kubernetes.WithDisabledCachedClient(),
+	)
+}
+
+// NewClientFromFile creates a new uncached client for the cluster the given kubeconfig file points to. Exposed for
+// testing.
+var NewClientFromFile = func(kubeconfigPath string, scheme *runtime.Scheme) (client.Client, error) {
+	clientSet, err := NewClientSetFromFile(kubeconfigPath, scheme)
+	if err != nil {
+		return nil, err
+	}
+
+	return clientSet.Client(), nil
+}
+
+// NewClientFromBytes creates a new uncached client for the cluster the given kubeconfig points to. Exposed for testing.
+var NewClientFromBytes = func(kubeconfig []byte, scheme *runtime.Scheme) (client.Client, error) {
+	clientSet, err := kubernetes.NewClientFromBytes(kubeconfig,
+		kubernetes.WithClientOptions(client.Options{Scheme: scheme}),
+		kubernetes.WithDisabledCachedClient(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return clientSet.Client(), nil
+}
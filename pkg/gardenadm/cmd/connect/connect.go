@@ -7,9 +7,41 @@ package connect
 import (
 	"context"
 	"fmt"
+	"io"
+	"time"
 
+	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logzap "sigs.k8s.io/controller-runtime/pkg/log/zap"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	seedmanagementv1alpha1 "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1"
+	"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1/helper"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/controller/gardenletdeployer"
+	gardenadmcmd "github.com/gardener/gardener/pkg/gardenadm/cmd"
+	"github.com/gardener/gardener/pkg/gardenadm/resources"
+	"github.com/gardener/gardener/pkg/logger"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+	"github.com/gardener/gardener/pkg/utils/oci"
+	retryutils "github.com/gardener/gardener/pkg/utils/retry"
+)
+
+var (
+	// FS is the file system used for reading the resources. Exposed for testing.
+	FS = afero.Afero{Fs: afero.NewOsFs()}
+	// NewActuator creates the gardenletdeployer.Interface used for deploying gardenlet. Exposed for testing.
+	NewActuator = newActuator
+	// IntervalWaitForSeedRegistration is the interval in which the registration of the Seed is checked. Exposed for
+	// testing.
+	IntervalWaitForSeedRegistration = 5 * time.Second
 )
 
 // NewCommand creates a new cobra.Command.
@@ -19,10 +51,16 @@ func NewCommand(ioStreams genericiooptions.IOStreams) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "connect",
 		Short: "Deploy a gardenlet for further cluster management",
-		Long:  "Deploy a gardenlet for further cluster management",
+		Long: "Deploy a gardenlet for further cluster management. The Shoot of the autonomous shoot cluster is created in the " +
+			"garden cluster, and gardenlet is deployed into the autonomous shoot cluster based on the Gardenlet resource read " +
+			"from the config directory. Once gardenlet has registered its Seed, the Gardenlet resource is created in the " +
+			"garden cluster so that gardenlet takes over its own lifecycle (self-upgrades). The command is idempotent.",
 
 		Example: `# Deploy a gardenlet
-gardenadm connect`,
+gardenadm connect --bootstrap-kubeconfig /tmp/garden-kubeconfig
+
+# Deploy a gardenlet based on the resources in a specific directory
+gardenadm connect --bootstrap-kubeconfig /tmp/garden-kubeconfig --config-dir /tmp/resources`,
 
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			if err := opts.Complete(); err != nil {
@@ -42,7 +80,148 @@ gardenadm connect`,
 	return cmd
 }
 
-func run(_ context.Context, ioStreams genericiooptions.IOStreams, _ *Options) error {
-	fmt.Fprint(ioStreams.Out, "not implemented")
+func run(ctx context.Context, ioStreams genericiooptions.IOStreams, opts *Options) error {
+	r, err := resources.Read(FS, opts.ConfigDirectory)
+	if err != nil {
+		return fmt.Errorf("failed reading resources from %s: %w", opts.ConfigDirectory, err)
+	}
+
+	if r.Shoot == nil {
+		return fmt.Errorf("no Shoot found in %s", opts.ConfigDirectory)
+	}
+	if r.Gardenlet == nil {
+		return fmt.Errorf("no Gardenlet found in %s", opts.ConfigDirectory)
+	}
+
+	shoot, gardenlet := r.Shoot, r.Gardenlet
+	if len(gardenlet.Namespace) == 0 {
+		gardenlet.Namespace = v1beta1constants.GardenNamespace
+	}
+
+	gardenClientSet, err := gardenadmcmd.NewClientSetFromFile(opts.BootstrapKubeconfig, kubernetes.GardenScheme)
+	if err != nil {
+		return fmt.Errorf("failed creating client for garden cluster: %w", err)
+	}
+
+	targetClientSet, err := gardenadmcmd.NewClientSetFromFile(opts.Kubeconfig, kubernetes.SeedScheme)
+	if err != nil {
+		return fmt.Errorf("failed creating client for autonomous shoot cluster: %w", err)
+	}
+
+	var (
+		log          = logger.MustNewZapLogger(logger.InfoLevel, logger.FormatText, logzap.WriteTo(ioStreams.ErrOut))
+		gardenClient = gardenClientSet.Client()
+	)
+
+	fmt.Fprintf(ioStreams.Out, "Ensuring Shoot %s in garden cluster\n", client.ObjectKeyFromObject(shoot))
+	if err := createIfNotExists(ctx, ioStreams.Out, gardenClient, shoot); err != nil {
+		return fmt.Errorf("failed creating Shoot %s: %w", client.ObjectKeyFromObject(shoot), err)
+	}
+
+	// The deployment of gardenlet is reconciled on every run, so that a previously interrupted or outdated deployment is
+	// repaired. If the Seed is already registered, waiting for the registration succeeds immediately.
+	fmt.Fprintf(ioStreams.Out, "Deploying gardenlet into autonomous shoot cluster\n")
+
+	actuator, err := NewActuator(gardenClientSet, targetClientSet, gardenlet, &progressRecorder{out: ioStreams.Out})
+	if err != nil {
+		return fmt.Errorf("failed creating gardenlet deployer: %w", err)
+	}
+
+	if _, err := actuator.Reconcile(ctx, log, gardenlet, nil, &gardenlet.Spec.Deployment.GardenletDeployment, &gardenlet.Spec.Config, seedmanagementv1alpha1.BootstrapToken, false); err != nil {
+		return fmt.Errorf("failed deploying gardenlet: %w", err)
+	}
+
+	fmt.Fprintf(ioStreams.Out, "Waiting for Seed %q to be registered by gardenlet\n", gardenlet.Name)
+	if err := retryutils.UntilTimeout(ctx, IntervalWaitForSeedRegistration, opts.Timeout, func(ctx context.Context) (bool, error) {
+		seedRegistered, err := isSeedRegistered(ctx, gardenClient, gardenlet.Name)
+		if err != nil {
+			return retryutils.SevereError(err)
+		}
+		if !seedRegistered {
+			return retryutils.MinorError(fmt.Errorf("seed %q is not yet registered", gardenlet.Name))
+		}
+		return retryutils.Ok()
+	}); err != nil {
+		return fmt.Errorf("failed waiting for Seed registration: %w", err)
+	}
+
+	// The Gardenlet resource is only created after the Seed has been registered. Otherwise, gardener-operator would
+	// consider itself responsible for deploying gardenlet.
+	fmt.Fprintf(ioStreams.Out, "Ensuring Gardenlet %s in garden cluster\n", client.ObjectKeyFromObject(gardenlet))
+	if err := createIfNotExists(ctx, ioStreams.Out, gardenClient, gardenlet); err != nil {
+		return fmt.Errorf("failed creating Gardenlet %s: %w", client.ObjectKeyFromObject(gardenlet), err)
+	}
+
+	fmt.Fprintf(ioStreams.Out, "Autonomous shoot cluster %q is connected to the garden cluster via Seed %q\n", shoot.Name, gardenlet.Name)
+	return nil
+}
+
+func createIfNotExists(ctx context.Context, out io.Writer, c client.Client, obj client.Object) error {
+	resources.CleanObject(obj)
+
+	if err := c.Create(ctx, obj); err != nil {
+		if !apierrors.IsAlreadyExists(err) {
+			return err
+		}
+		fmt.Fprintln(out, "  Already exists, leaving it unchanged")
+		return nil
+	}
+
+	fmt.Fprintln(out, "  Created")
 	return nil
 }
+
+func isSeedRegistered(ctx context.Context, c client.Client, name string) (bool, error) {
+	if err := c.Get(ctx, client.ObjectKey{Name: name}, &gardencorev1beta1.Seed{}); err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed reading Seed %q: %w", name, err)
+	}
+	return true, nil
+}
+
+func newActuator(gardenClientSet, targetClientSet kubernetes.Interface, gardenlet *seedmanagementv1alpha1.Gardenlet, recorder record.EventRecorder) (gardenletdeployer.Interface, error) {
+	helmRegistry, err := oci.NewHelmRegistry()
+	if err != nil {
+		return nil, fmt.Errorf("failed creating new Helm registry: %w", err)
+	}
+
+	return &gardenletdeployer.Actuator{
+		GardenConfig:    gardenClientSet.RESTConfig(),
+		GardenAPIReader: gardenClientSet.APIReader(),
+		GardenClient:    gardenClientSet.Client(),
+		GetTargetClientFunc: func(_ context.Context) (kubernetes.Interface, error) {
+			return targetClientSet, nil
+		},
+		CheckIfVPAAlreadyExists: func(_ context.Context) (bool, error) {
+			return false, nil
+		},
+		GetInfrastructureSecret: func(ctx context.Context) (*corev1.Secret, error) {
+			seedTemplate, _, err := helper.ExtractSeedTemplateAndGardenletConfig(gardenlet.GetName(), &gardenlet.Spec.Config)
+			if err != nil {
+				return nil, fmt.Errorf("failed to extract seed template and gardenlet config: %w", err)
+			}
+
+			if seedTemplate.Spec.Backup == nil {
+				return nil, nil
+			}
+			return kubernetesutils.GetSecretByReference(ctx, gardenClientSet.Client(), &seedTemplate.Spec.Backup.SecretRef)
+		},
+		GetTargetDomain: func() string {
+			return ""
+		},
+		ApplyGardenletChart: func(ctx context.Context, targetChartApplier kubernetes.ChartApplier, values map[string]interface{}) error {
+			archive, err := helmRegistry.Pull(ctx, &gardenlet.Spec.Deployment.Helm.OCIRepository)
+			if err != nil {
+				return fmt.Errorf("failed pulling Helm chart from OCI repository: %w", err)
+			}
+
+			return targetChartApplier.ApplyFromArchive(ctx, archive, v1beta1constants.GardenNamespace, "gardenlet", kubernetes.Values(values))
+		},
+		Clock:                 clock.RealClock{},
+		ValuesHelper:          gardenletdeployer.NewValuesHelper(nil),
+		Recorder:              recorder,
+		GardenNamespaceTarget: v1beta1constants.GardenNamespace,
+	}, nil
+}
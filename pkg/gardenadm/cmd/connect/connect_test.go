@@ -6,35 +6,208 @@ package connect_test
 
 import (
 	"bytes"
+	"context"
+	"fmt"
 	"io"
+	"time"
 
+	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	seedmanagementv1alpha1 "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	fakekubernetes "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	"github.com/gardener/gardener/pkg/controller/gardenletdeployer"
+	gardenadmcmd "github.com/gardener/gardener/pkg/gardenadm/cmd"
 	. "github.com/gardener/gardener/pkg/gardenadm/cmd/connect"
+	"github.com/gardener/gardener/pkg/gardenadm/resources"
+	"github.com/gardener/gardener/pkg/utils/test"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
 )
 
 var _ = Describe("Connect", func() {
 	var (
+		ctx       = context.Background()
 		ioStreams genericiooptions.IOStreams
 		out       *bytes.Buffer
 		cmd       *cobra.Command
+
+		fs                  afero.Afero
+		fakeGardenClient    client.Client
+		gardenClientSet     kubernetes.Interface
+		targetClientSet     kubernetes.Interface
+		actuator            *fakeActuator
+		actuatorGardenlet   *seedmanagementv1alpha1.Gardenlet
+		actuatorCreateCount int
+
+		shoot     *gardencorev1beta1.Shoot
+		gardenlet *seedmanagementv1alpha1.Gardenlet
 	)
 
 	BeforeEach(func() {
 		ioStreams, _, out, _ = genericiooptions.NewTestIOStreams()
 		cmd = NewCommand(ioStreams)
+		cmd.SetContext(ctx)
+
+		fs = afero.Afero{Fs: afero.NewMemMapFs()}
+		fakeGardenClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+		gardenClientSet = fakekubernetes.NewClientSetBuilder().WithClient(fakeGardenClient).Build()
+		targetClientSet = fakekubernetes.NewClientSetBuilder().WithClient(fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()).Build()
+		actuator = &fakeActuator{gardenClient: fakeGardenClient}
+		actuatorGardenlet = nil
+		actuatorCreateCount = 0
+
+		DeferCleanup(test.WithVars(
+			&FS, fs,
+			&IntervalWaitForSeedRegistration, time.Millisecond,
+			&gardenadmcmd.NewClientSetFromFile, func(kubeconfigPath string, _ *runtime.Scheme) (kubernetes.Interface, error) {
+				switch kubeconfigPath {
+				case "/garden-kubeconfig":
+					return gardenClientSet, nil
+				case "/shoot-kubeconfig":
+					return targetClientSet, nil
+				}
+				return nil, fmt.Errorf("unexpected kubeconfig path %s", kubeconfigPath)
+			},
+			&NewActuator, func(garden, target kubernetes.Interface, g *seedmanagementv1alpha1.Gardenlet, recorder record.EventRecorder) (gardenletdeployer.Interface, error) {
+				Expect(garden).To(BeIdenticalTo(gardenClientSet))
+				Expect(target).To(BeIdenticalTo(targetClientSet))
+				actuatorGardenlet = g
+				actuatorCreateCount++
+				actuator.recorder = recorder
+				return actuator, nil
+			},
+		))
+
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "garden-bar"},
+			Spec:       gardencorev1beta1.ShootSpec{CloudProfileName: ptr.To("local")},
+		}
+		gardenlet = &seedmanagementv1alpha1.Gardenlet{
+			ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+		}
+
+		Expect(cmd.Flags().Set("config-dir", "/resources")).To(Succeed())
+		Expect(cmd.Flags().Set("bootstrap-kubeconfig", "/garden-kubeconfig")).To(Succeed())
+		Expect(cmd.Flags().Set("kubeconfig", "/shoot-kubeconfig")).To(Succeed())
 	})
 
 	Describe("#RunE", func() {
-		It("should return the expected output", func() {
-			Expect(cmd.RunE(cmd, nil)).To(Succeed())
+		It("should fail if the config directory does not contain a shoot", func() {
+			Expect((&resources.Resources{Gardenlet: gardenlet}).Write(fs, "/resources")).To(Succeed())
+
+			Expect(cmd.RunE(cmd, nil)).To(MatchError(ContainSubstring("no Shoot found in /resources")))
+		})
 
-			output, err := io.ReadAll(out)
-			Expect(err).NotTo(HaveOccurred())
-			Expect(string(output)).To(Equal("not implemented"))
+		It("should fail if the config directory does not contain a gardenlet", func() {
+			Expect((&resources.Resources{Shoot: shoot}).Write(fs, "/resources")).To(Succeed())
+
+			Expect(cmd.RunE(cmd, nil)).To(MatchError(ContainSubstring("no Gardenlet found in /resources")))
+		})
+
+		Context("with resources", func() {
+			BeforeEach(func() {
+				Expect((&resources.Resources{Shoot: shoot, Gardenlet: gardenlet}).Write(fs, "/resources")).To(Succeed())
+			})
+
+			It("should create the shoot, deploy gardenlet and create the gardenlet resource", func() {
+				Expect(cmd.RunE(cmd, nil)).To(Succeed())
+
+				Expect(actuatorCreateCount).To(Equal(1))
+				Expect(actuatorGardenlet.Namespace).To(Equal("garden"))
+				Expect(actuator.bootstrap).To(Equal(seedmanagementv1alpha1.BootstrapToken))
+
+				Expect(fakeGardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), &gardencorev1beta1.Shoot{})).To(Succeed())
+				Expect(fakeGardenClient.Get(ctx, client.ObjectKey{Name: "foo", Namespace: "garden"}, &seedmanagementv1alpha1.Gardenlet{})).To(Succeed())
+
+				output, err := io.ReadAll(out)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(output)).To(And(
+					ContainSubstring("Ensuring Shoot garden-bar/foo in garden cluster\n  Created\n"),
+					ContainSubstring("Deploying gardenlet into autonomous shoot cluster\n  Deploying gardenlet into target cluster\n"),
+					ContainSubstring(`Waiting for Seed "foo" to be registered by gardenlet`),
+					ContainSubstring("Ensuring Gardenlet garden/foo in garden cluster\n  Created\n"),
+					ContainSubstring(`Autonomous shoot cluster "foo" is connected to the garden cluster via Seed "foo"`),
+				))
+			})
+
+			It("should be idempotent", func() {
+				Expect(cmd.RunE(cmd, nil)).To(Succeed())
+				out.Reset()
+
+				Expect(cmd.RunE(cmd, nil)).To(Succeed())
+
+				Expect(actuatorCreateCount).To(Equal(2))
+
+				output, err := io.ReadAll(out)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(output)).To(And(
+					ContainSubstring("Ensuring Shoot garden-bar/foo in garden cluster\n  Already exists, leaving it unchanged\n"),
+					ContainSubstring("Deploying gardenlet into autonomous shoot cluster\n  Deploying gardenlet into target cluster\n"),
+					ContainSubstring("Ensuring Gardenlet garden/foo in garden cluster\n  Already exists, leaving it unchanged\n"),
+				))
+			})
+
+			It("should fail if the gardenlet deployment fails", func() {
+				actuator.err = fmt.Errorf("fake")
+
+				Expect(cmd.RunE(cmd, nil)).To(MatchError(ContainSubstring("failed deploying gardenlet: fake")))
+
+				Expect(fakeGardenClient.Get(ctx, client.ObjectKey{Name: "foo", Namespace: "garden"}, &seedmanagementv1alpha1.Gardenlet{})).To(BeNotFoundError())
+			})
+
+			It("should fail if the seed does not get registered", func() {
+				actuator.skipSeedRegistration = true
+				Expect(cmd.Flags().Set("timeout", "10ms")).To(Succeed())
+
+				Expect(cmd.RunE(cmd, nil)).To(MatchError(ContainSubstring(`seed "foo" is not yet registered`)))
+
+				Expect(fakeGardenClient.Get(ctx, client.ObjectKey{Name: "foo", Namespace: "garden"}, &seedmanagementv1alpha1.Gardenlet{})).To(BeNotFoundError())
+			})
 		})
 	})
 })
+
+// fakeActuator simulates the gardenlet deployment by registering the Seed like a deployed gardenlet would do.
+type fakeActuator struct {
+	gardenClient         client.Client
+	recorder             record.EventRecorder
+	bootstrap            seedmanagementv1alpha1.Bootstrap
+	skipSeedRegistration bool
+	err                  error
+}
+
+func (f *fakeActuator) Reconcile(ctx context.Context, _ logr.Logger, obj client.Object, conditions []gardencorev1beta1.Condition, _ *seedmanagementv1alpha1.GardenletDeployment, _ *runtime.RawExtension, bootstrap seedmanagementv1alpha1.Bootstrap, _ bool) ([]gardencorev1beta1.Condition, error) {
+	f.bootstrap = bootstrap
+	f.recorder.Event(obj, corev1.EventTypeNormal, gardencorev1beta1.EventReconciling, "Deploying gardenlet into target cluster")
+
+	if f.err != nil {
+		return conditions, f.err
+	}
+
+	if f.skipSeedRegistration {
+		return conditions, nil
+	}
+
+	if err := f.gardenClient.Create(ctx, &gardencorev1beta1.Seed{ObjectMeta: metav1.ObjectMeta{Name: obj.GetName()}}); err != nil && !apierrors.IsAlreadyExists(err) {
+		return conditions, err
+	}
+	return conditions, nil
+}
+
+func (f *fakeActuator) Delete(context.Context, logr.Logger, client.Object, []gardencorev1beta1.Condition, *seedmanagementv1alpha1.GardenletDeployment, *runtime.RawExtension, seedmanagementv1alpha1.Bootstrap, bool) ([]gardencorev1beta1.Condition, bool, bool, error) {
+	return nil, false, false, nil
+}
@@ -5,16 +5,65 @@
 package connect
 
 import (
+	"fmt"
+	"os"
+	"time"
+
 	"github.com/spf13/pflag"
+
+	"github.com/gardener/gardener/pkg/gardenadm/resources"
 )
 
 // Options contains options for this command.
-type Options struct{}
+type Options struct {
+	// ConfigDirectory is the directory containing the resources (Shoot, Gardenlet, ...) of the autonomous shoot.
+	ConfigDirectory string
+	// BootstrapKubeconfig is the path to the kubeconfig file pointing to the garden cluster. It is used for creating
+	// the Shoot and Gardenlet objects and for requesting the bootstrap token of gardenlet.
+	BootstrapKubeconfig string
+	// Kubeconfig is the path to the kubeconfig file pointing to the autonomous shoot cluster.
+	Kubeconfig string
+	// Timeout is the duration to wait for the Seed to be registered by gardenlet.
+	Timeout time.Duration
+}
 
 // Complete completes the options.
-func (o *Options) Complete() error { return nil }
+func (o *Options) Complete() error {
+	if len(o.ConfigDirectory) == 0 {
+		o.ConfigDirectory = resources.DefaultDirectory
+	}
+
+	if len(o.Kubeconfig) == 0 {
+		o.Kubeconfig = os.Getenv("KUBECONFIG")
+	}
+
+	return nil
+}
 
 // Validate validates the options.
-func (o *Options) Validate() error { return nil }
+func (o *Options) Validate() error {
+	if len(o.ConfigDirectory) == 0 {
+		return fmt.Errorf("must provide a path to the config directory")
+	}
+
+	if len(o.BootstrapKubeconfig) == 0 {
+		return fmt.Errorf("must provide a path to a bootstrap kubeconfig for the garden cluster")
+	}
+
+	if len(o.Kubeconfig) == 0 {
+		return fmt.Errorf("must provide a path to a kubeconfig for the autonomous shoot cluster")
+	}
+
+	if o.Timeout <= 0 {
+		return fmt.Errorf("timeout must be positive")
+	}
+
+	return nil
+}
 
-func (o *Options) addFlags(_ *pflag.FlagSet) {}
+func (o *Options) addFlags(fs *pflag.FlagSet) {
+	fs.StringVarP(&o.ConfigDirectory, "config-dir", "d", resources.DefaultDirectory, "Path to the directory containing the resources of the autonomous shoot (Shoot, Gardenlet, etc.)")
+	fs.StringVarP(&o.BootstrapKubeconfig, "bootstrap-kubeconfig", "b", "", "Path to the kubeconfig file pointing to the garden cluster")
+	fs.StringVarP(&o.Kubeconfig, "kubeconfig", "k", "", "Path to the kubeconfig file pointing to the autonomous shoot cluster (defaults to the KUBECONFIG environment variable)")
+	fs.DurationVar(&o.Timeout, "timeout", 5*time.Minute, "Duration to wait for the Seed to be registered by the deployed gardenlet")
+}
@@ -5,10 +5,13 @@
 package connect_test
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
 	. "github.com/gardener/gardener/pkg/gardenadm/cmd/connect"
+	"github.com/gardener/gardener/pkg/gardenadm/resources"
 )
 
 var _ = Describe("Options", func() {
@@ -21,14 +24,49 @@ var _ = Describe("Options", func() {
 	})
 
 	Describe("#Complete", func() {
-		It("should return nil", func() {
+		It("should default the config directory and the kubeconfig", func() {
+			GinkgoT().Setenv("KUBECONFIG", "/shoot-kubeconfig")
+
 			Expect(options.Complete()).To(Succeed())
+			Expect(options.ConfigDirectory).To(Equal(resources.DefaultDirectory))
+			Expect(options.Kubeconfig).To(Equal("/shoot-kubeconfig"))
 		})
 	})
 
 	Describe("#Validate", func() {
-		It("should return nil", func() {
+		BeforeEach(func() {
+			options.ConfigDirectory = "/resources"
+			options.BootstrapKubeconfig = "/garden-kubeconfig"
+			options.Kubeconfig = "/shoot-kubeconfig"
+			options.Timeout = time.Minute
+		})
+
+		It("should succeed", func() {
 			Expect(options.Validate()).To(Succeed())
 		})
+
+		It("should fail if the config directory is empty", func() {
+			options.ConfigDirectory = ""
+
+			Expect(options.Validate()).To(MatchError(ContainSubstring("must provide a path to the config directory")))
+		})
+
+		It("should fail if the bootstrap kubeconfig is empty", func() {
+			options.BootstrapKubeconfig = ""
+
+			Expect(options.Validate()).To(MatchError(ContainSubstring("must provide a path to a bootstrap kubeconfig")))
+		})
+
+		It("should fail if the kubeconfig is empty", func() {
+			options.Kubeconfig = ""
+
+			Expect(options.Validate()).To(MatchError(ContainSubstring("must provide a path to a kubeconfig")))
+		})
+
+		It("should fail if the timeout is not positive", func() {
+			options.Timeout = 0
+
+			Expect(options.Validate()).To(MatchError(ContainSubstring("timeout must be positive")))
+		})
 	})
 })
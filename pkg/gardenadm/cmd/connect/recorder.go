@@ -0,0 +1,37 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package connect
+
+import (
+	"fmt"
+	"io"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+)
+
+// progressRecorder is a record.EventRecorder which reports the events emitted by the gardenlet deployer as progress
+// to the user instead of creating Event objects.
+type progressRecorder struct {
+	out io.Writer
+}
+
+var _ record.EventRecorder = &progressRecorder{}
+
+func (p *progressRecorder) Event(_ runtime.Object, eventType, _, message string) {
+	if eventType == corev1.EventTypeWarning {
+		message = "Warning: " + message
+	}
+	fmt.Fprintf(p.out, "  %s\n", message)
+}
+
+func (p *progressRecorder) Eventf(object runtime.Object, eventType, reason, messageFmt string, args ...any) {
+	p.Event(object, eventType, reason, fmt.Sprintf(messageFmt, args...))
+}
+
+func (p *progressRecorder) AnnotatedEventf(object runtime.Object, _ map[string]string, eventType, reason, messageFmt string, args ...any) {
+	p.Eventf(object, eventType, reason, messageFmt, args...)
+}
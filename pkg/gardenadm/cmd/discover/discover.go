@@ -8,10 +8,24 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1 "github.com/gardener/gardener/pkg/apis/core/v1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	securityv1alpha1 "github.com/gardener/gardener/pkg/apis/security/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	gardenadmcmd "github.com/gardener/gardener/pkg/gardenadm/cmd"
+	"github.com/gardener/gardener/pkg/gardenadm/resources"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 )
 
+// FS is the file system used for writing the resources. Exposed for testing.
+var FS = afero.Afero{Fs: afero.NewOsFs()}
+
 // NewCommand creates a new cobra.Command.
 func NewCommand(ioStreams genericiooptions.IOStreams) *cobra.Command {
 	opts := &Options{}
@@ -19,10 +33,15 @@ func NewCommand(ioStreams genericiooptions.IOStreams) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "discover",
 		Short: "Conveniently download Gardener configuration resources from an existing garden cluster",
-		Long:  "Conveniently download Gardener configuration resources from an existing garden cluster (CloudProfile, ControllerRegistrations, ControllerDeployments, etc.)",
+		Long: "Conveniently download Gardener configuration resources from an existing garden cluster (CloudProfile, ControllerRegistrations, ControllerDeployments, etc.). " +
+			"The resources are written into a local directory which can be consumed by other gardenadm commands, e.g. gardenadm init. " +
+			"Secret data is redacted and fields managed by the API server are removed.",
+
+		Example: `# Download the configuration for shoot "foo" in project namespace "garden-bar"
+gardenadm discover --kubeconfig ~/.kube/config --shoot-namespace garden-bar --shoot-name foo
 
-		Example: `# Download the configuration
-gardenadm discover --kubeconfig ~/.kube/config`,
+# Download the configuration into a specific directory
+gardenadm discover --kubeconfig ~/.kube/config --shoot-namespace garden-bar --shoot-name foo --output-dir /tmp/resources`,
 
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			if err := opts.Complete(); err != nil {
@@ -42,7 +61,106 @@ gardenadm discover --kubeconfig ~/.kube/config`,
 	return cmd
 }
 
-func run(_ context.Context, ioStreams genericiooptions.IOStreams, _ *Options) error {
-	fmt.Fprint(ioStreams.Out, "not implemented")
+func run(ctx context.Context, ioStreams genericiooptions.IOStreams, opts *Options) error {
+	c, err := gardenadmcmd.NewClientFromFile(opts.Kubeconfig, kubernetes.GardenScheme)
+	if err != nil {
+		return fmt.Errorf("failed creating garden client: %w", err)
+	}
+
+	r, err := discover(ctx, c, opts.ShootNamespace, opts.ShootName)
+	if err != nil {
+		return err
+	}
+
+	if err := r.Write(FS, opts.OutputDirectory); err != nil {
+		return fmt.Errorf("failed writing resources: %w", err)
+	}
+
+	fmt.Fprintf(ioStreams.Out, "Discovered CloudProfile %q, Shoot %q, %d ControllerRegistration(s), %d ControllerDeployment(s) and %d Secret(s), written to %s\n",
+		r.CloudProfile.Name, client.ObjectKeyFromObject(r.Shoot), len(r.ControllerRegistrations), len(r.ControllerDeployments), len(r.Secrets), opts.OutputDirectory)
 	return nil
 }
+
+func discover(ctx context.Context, c client.Client, shootNamespace, shootName string) (*resources.Resources, error) {
+	r := &resources.Resources{Shoot: &gardencorev1beta1.Shoot{}}
+
+	if err := c.Get(ctx, client.ObjectKey{Namespace: shootNamespace, Name: shootName}, r.Shoot); err != nil {
+		return nil, fmt.Errorf("failed reading Shoot %s/%s: %w", shootNamespace, shootName, err)
+	}
+
+	cloudProfile, err := gardenerutils.GetCloudProfile(ctx, c, r.Shoot)
+	if err != nil {
+		return nil, fmt.Errorf("failed reading CloudProfile: %w", err)
+	}
+	// A NamespacedCloudProfile is flattened into its effective CloudProfile, hence the namespace is removed.
+	cloudProfile.Namespace = ""
+	r.CloudProfile = cloudProfile
+
+	controllerRegistrationList := &gardencorev1beta1.ControllerRegistrationList{}
+	if err := c.List(ctx, controllerRegistrationList); err != nil {
+		return nil, fmt.Errorf("failed listing ControllerRegistrations: %w", err)
+	}
+	for _, controllerRegistration := range controllerRegistrationList.Items {
+		r.ControllerRegistrations = append(r.ControllerRegistrations, controllerRegistration.DeepCopy())
+	}
+
+	controllerDeploymentList := &gardencorev1.ControllerDeploymentList{}
+	if err := c.List(ctx, controllerDeploymentList); err != nil {
+		return nil, fmt.Errorf("failed listing ControllerDeployments: %w", err)
+	}
+	for _, controllerDeployment := range controllerDeploymentList.Items {
+		r.ControllerDeployments = append(r.ControllerDeployments, controllerDeployment.DeepCopy())
+	}
+
+	secretKeys, err := referencedSecretKeys(ctx, c, r.Shoot)
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range secretKeys {
+		secret := &corev1.Secret{}
+		if err := c.Get(ctx, key, secret); err != nil {
+			return nil, fmt.Errorf("failed reading Secret %s: %w", key, err)
+		}
+		resources.RedactSecret(secret)
+		r.Secrets = append(r.Secrets, secret)
+	}
+
+	r.Shoot.Status = gardencorev1beta1.ShootStatus{}
+	for _, obj := range r.Objects() {
+		resources.CleanObject(obj)
+	}
+
+	return r, nil
+}
+
+// referencedSecretKeys returns the keys of the secrets referenced by the shoot, i.e., the infrastructure credentials
+// (via SecretBinding or CredentialsBinding) and the secrets in `.spec.resources`.
+func referencedSecretKeys(ctx context.Context, c client.Client, shoot *gardencorev1beta1.Shoot) ([]client.ObjectKey, error) {
+	var keys []client.ObjectKey
+
+	if shoot.Spec.SecretBindingName != nil {
+		secretBinding := &gardencorev1beta1.SecretBinding{}
+		if err := c.Get(ctx, client.ObjectKey{Namespace: shoot.Namespace, Name: *shoot.Spec.SecretBindingName}, secretBinding); err != nil {
+			return nil, fmt.Errorf("failed reading SecretBinding %s: %w", *shoot.Spec.SecretBindingName, err)
+		}
+		keys = append(keys, client.ObjectKey{Namespace: secretBinding.SecretRef.Namespace, Name: secretBinding.SecretRef.Name})
+	}
+
+	if shoot.Spec.CredentialsBindingName != nil {
+		credentialsBinding := &securityv1alpha1.CredentialsBinding{}
+		if err := c.Get(ctx, client.ObjectKey{Namespace: shoot.Namespace, Name: *shoot.Spec.CredentialsBindingName}, credentialsBinding); err != nil {
+			return nil, fmt.Errorf("failed reading CredentialsBinding %s: %w", *shoot.Spec.CredentialsBindingName, err)
+		}
+		if ref := credentialsBinding.CredentialsRef; ref.APIVersion == corev1.SchemeGroupVersion.String() && ref.Kind == "Secret" {
+			keys = append(keys, client.ObjectKey{Namespace: ref.Namespace, Name: ref.Name})
+		}
+	}
+
+	for _, resource := range shoot.Spec.Resources {
+		if resource.ResourceRef.APIVersion == corev1.SchemeGroupVersion.String() && resource.ResourceRef.Kind == "Secret" {
+			keys = append(keys, client.ObjectKey{Namespace: shoot.Namespace, Name: resource.ResourceRef.Name})
+		}
+	}
+
+	return keys, nil
+}
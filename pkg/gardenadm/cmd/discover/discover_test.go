@@ -6,37 +6,112 @@ package discover_test
 
 import (
 	"bytes"
+	"context"
+	"fmt"
 	"io"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	gardencorev1 "github.com/gardener/gardener/pkg/apis/core/v1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	gardenadmcmd "github.com/gardener/gardener/pkg/gardenadm/cmd"
 	. "github.com/gardener/gardener/pkg/gardenadm/cmd/discover"
+	"github.com/gardener/gardener/pkg/gardenadm/resources"
+	"github.com/gardener/gardener/pkg/utils/test"
 )
 
 var _ = Describe("Discover", func() {
 	var (
+		ctx       = context.Background()
 		ioStreams genericiooptions.IOStreams
 		out       *bytes.Buffer
 		cmd       *cobra.Command
+
+		fakeClient client.Client
+		fs         afero.Afero
 	)
 
 	BeforeEach(func() {
 		ioStreams, _, out, _ = genericiooptions.NewTestIOStreams()
 		cmd = NewCommand(ioStreams)
+		cmd.SetContext(ctx)
+
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+		fs = afero.Afero{Fs: afero.NewMemMapFs()}
+
+		DeferCleanup(test.WithVars(
+			&gardenadmcmd.NewClientFromFile, func(string, *runtime.Scheme) (client.Client, error) { return fakeClient, nil },
+			&FS, fs,
+		))
 	})
 
 	Describe("#RunE", func() {
-		It("should return the expected output", func() {
+		BeforeEach(func() {
 			Expect(cmd.Flags().Set("kubeconfig", "some-path-to-kubeconfig")).To(Succeed())
+			Expect(cmd.Flags().Set("shoot-namespace", "garden-bar")).To(Succeed())
+			Expect(cmd.Flags().Set("shoot-name", "foo")).To(Succeed())
+			Expect(cmd.Flags().Set("output-dir", "/out")).To(Succeed())
+		})
+
+		It("should fail if the client cannot be created", func() {
+			DeferCleanup(test.WithVar(&gardenadmcmd.NewClientFromFile, func(string, *runtime.Scheme) (client.Client, error) { return nil, fmt.Errorf("fake") }))
+
+			Expect(cmd.RunE(cmd, nil)).To(MatchError(ContainSubstring("failed creating garden client")))
+		})
+
+		It("should fail if the shoot does not exist", func() {
+			Expect(cmd.RunE(cmd, nil)).To(MatchError(ContainSubstring("failed reading Shoot garden-bar/foo")))
+		})
+
+		It("should download the resources, strip server-side fields and redact secrets", func() {
+			Expect(fakeClient.Create(ctx, &gardencorev1beta1.CloudProfile{ObjectMeta: metav1.ObjectMeta{Name: "local"}})).To(Succeed())
+			Expect(fakeClient.Create(ctx, &gardencorev1beta1.SecretBinding{
+				ObjectMeta: metav1.ObjectMeta{Name: "binding", Namespace: "garden-bar"},
+				SecretRef:  corev1.SecretReference{Name: "credentials", Namespace: "garden-bar"},
+			})).To(Succeed())
+			Expect(fakeClient.Create(ctx, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "credentials", Namespace: "garden-bar"},
+				Data:       map[string][]byte{"token": []byte("super-secret")},
+			})).To(Succeed())
+			Expect(fakeClient.Create(ctx, &gardencorev1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "garden-bar"},
+				Spec: gardencorev1beta1.ShootSpec{
+					CloudProfileName:  ptr.To("local"),
+					SecretBindingName: ptr.To("binding"),
+				},
+				Status: gardencorev1beta1.ShootStatus{TechnicalID: "shoot--bar--foo"},
+			})).To(Succeed())
+			Expect(fakeClient.Create(ctx, &gardencorev1beta1.ControllerRegistration{ObjectMeta: metav1.ObjectMeta{Name: "provider-local"}})).To(Succeed())
+			Expect(fakeClient.Create(ctx, &gardencorev1.ControllerDeployment{ObjectMeta: metav1.ObjectMeta{Name: "provider-local"}})).To(Succeed())
 
 			Expect(cmd.RunE(cmd, nil)).To(Succeed())
 
 			output, err := io.ReadAll(out)
 			Expect(err).NotTo(HaveOccurred())
-			Expect(string(output)).To(Equal("not implemented"))
+			Expect(string(output)).To(ContainSubstring(`Discovered CloudProfile "local", Shoot "garden-bar/foo", 1 ControllerRegistration(s), 1 ControllerDeployment(s) and 1 Secret(s), written to /out`))
+
+			r, err := resources.Read(fs, "/out")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(r.CloudProfile.Name).To(Equal("local"))
+			Expect(r.Shoot.Name).To(Equal("foo"))
+			Expect(r.Shoot.ResourceVersion).To(BeEmpty())
+			Expect(r.Shoot.Status).To(Equal(gardencorev1beta1.ShootStatus{}))
+			Expect(r.ControllerRegistrations).To(HaveLen(1))
+			Expect(r.ControllerDeployments).To(HaveLen(1))
+			Expect(r.Secrets).To(HaveLen(1))
+			Expect(r.Secrets[0].Data).To(BeEmpty())
+			Expect(r.Secrets[0].StringData).To(Equal(map[string]string{"token": resources.RedactedValue}))
 		})
 	})
 })
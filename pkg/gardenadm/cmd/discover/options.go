@@ -8,16 +8,30 @@ import (
 	"fmt"
 
 	"github.com/spf13/pflag"
+
+	"github.com/gardener/gardener/pkg/gardenadm/resources"
 )
 
 // Options contains options for this command.
 type Options struct {
 	// Kubeconfig is the path to the kubeconfig file pointing to the garden cluster.
 	Kubeconfig string
+	// ShootNamespace is the namespace of the Shoot whose configuration shall be discovered.
+	ShootNamespace string
+	// ShootName is the name of the Shoot whose configuration shall be discovered.
+	ShootName string
+	// OutputDirectory is the path to the directory into which the resources are written.
+	OutputDirectory string
 }
 
 // Complete completes the options.
-func (o *Options) Complete() error { return nil }
+func (o *Options) Complete() error {
+	if len(o.OutputDirectory) == 0 {
+		o.OutputDirectory = resources.DefaultDirectory
+	}
+
+	return nil
+}
 
 // Validate validates the options.
 func (o *Options) Validate() error {
@@ -25,9 +39,20 @@ func (o *Options) Validate() error {
 		return fmt.Errorf("must provide a path to a garden cluster kubeconfig")
 	}
 
+	if len(o.ShootNamespace) == 0 {
+		return fmt.Errorf("must provide the namespace of the shoot")
+	}
+
+	if len(o.ShootName) == 0 {
+		return fmt.Errorf("must provide the name of the shoot")
+	}
+
 	return nil
 }
 
 func (o *Options) addFlags(fs *pflag.FlagSet) {
 	fs.StringVarP(&o.Kubeconfig, "kubeconfig", "k", "", "Path to the kubeconfig file pointing to the garden cluster")
+	fs.StringVarP(&o.ShootNamespace, "shoot-namespace", "n", "", "Namespace of the Shoot whose configuration shall be discovered")
+	fs.StringVarP(&o.ShootName, "shoot-name", "s", "", "Name of the Shoot whose configuration shall be discovered")
+	fs.StringVarP(&o.OutputDirectory, "output-dir", "d", resources.DefaultDirectory, "Path to the directory into which the resources are written")
 }
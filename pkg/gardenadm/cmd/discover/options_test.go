@@ -21,20 +21,39 @@ var _ = Describe("Options", func() {
 	})
 
 	Describe("#Complete", func() {
-		It("should return nil", func() {
+		It("should default the output directory", func() {
 			Expect(options.Complete()).To(Succeed())
+			Expect(options.OutputDirectory).To(Equal("gardener-resources"))
 		})
 	})
 
 	Describe("#Validate", func() {
-		It("should pass for valid options", func() {
+		BeforeEach(func() {
 			options.Kubeconfig = "some-path-to-kubeconfig"
+			options.ShootNamespace = "garden-bar"
+			options.ShootName = "foo"
+		})
 
+		It("should pass for valid options", func() {
 			Expect(options.Validate()).To(Succeed())
 		})
 
 		It("should fail because kubeconfig path is not set", func() {
+			options.Kubeconfig = ""
+
 			Expect(options.Validate()).To(MatchError(ContainSubstring("must provide a path to a garden cluster kubeconfig")))
 		})
+
+		It("should fail because shoot namespace is not set", func() {
+			options.ShootNamespace = ""
+
+			Expect(options.Validate()).To(MatchError(ContainSubstring("must provide the namespace of the shoot")))
+		})
+
+		It("should fail because shoot name is not set", func() {
+			options.ShootName = ""
+
+			Expect(options.Validate()).To(MatchError(ContainSubstring("must provide the name of the shoot")))
+		})
 	})
 })
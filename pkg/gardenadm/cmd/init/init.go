@@ -7,9 +7,35 @@ package init
 import (
 	"context"
 	"fmt"
+	"net"
+	"path/filepath"
+	"strings"
+	"time"
 
+	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"k8s.io/utils/clock"
+	logzap "sigs.k8s.io/controller-runtime/pkg/log/zap"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	gardenadmcmd "github.com/gardener/gardener/pkg/gardenadm/cmd"
+	"github.com/gardener/gardener/pkg/gardenadm/controlplane"
+	"github.com/gardener/gardener/pkg/gardenadm/resources"
+	"github.com/gardener/gardener/pkg/gardenadm/staticpod"
+	"github.com/gardener/gardener/pkg/logger"
+)
+
+// DirectoryState is the directory in which the state (e.g., the generated certificates and keys) is persisted so that
+// it can be reused by subsequent invocations.
+const DirectoryState = "/var/lib/gardenadm/state"
+
+var (
+	// FS is the file system used for reading the resources and writing the files. Exposed for testing.
+	FS = afero.Afero{Fs: afero.NewOsFs()}
+	// Clock is the clock used for generating certificates. Exposed for testing.
+	Clock clock.Clock = clock.RealClock{}
 )
 
 // NewCommand creates a new cobra.Command.
@@ -19,10 +45,21 @@ func NewCommand(ioStreams genericiooptions.IOStreams) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "init",
 		Short: "Bootstrap the first control plane node",
-		Long:  "Bootstrap the first control plane node",
+		Long: "Bootstrap the first control plane node. The resources of the autonomous shoot cluster (Shoot, CloudProfile, etc.) " +
+			"are read from a local directory (as produced by gardenadm discover). The static pods for etcd, kube-apiserver, " +
+			"kube-controller-manager and kube-scheduler are rendered and written to the host so that they are started by the kubelet. " +
+			"Once kube-apiserver is running, the tokens for kube-controller-manager and kube-scheduler are requested and their " +
+			"expiration is printed. Running the command again reuses the generated certificates and keys and renews the tokens. " +
+			"It must be run again before the tokens expire, e.g., periodically by a systemd timer.",
 
 		Example: `# Bootstrap the first control plane node
-gardenadm init`,
+gardenadm init
+
+# Bootstrap the first control plane node based on the resources in a specific directory
+gardenadm init --config-dir /tmp/resources
+
+# Only render the files and write them to a local directory instead of the host
+gardenadm init --dry-run --output-dir /tmp/gardenadm-init`,
 
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			if err := opts.Complete(); err != nil {
@@ -42,7 +79,109 @@ gardenadm init`,
 	return cmd
 }
 
-func run(_ context.Context, ioStreams genericiooptions.IOStreams, _ *Options) error {
-	fmt.Fprint(ioStreams.Out, "not implemented")
+func run(ctx context.Context, ioStreams genericiooptions.IOStreams, opts *Options) error {
+	r, err := resources.Read(FS, opts.ConfigDirectory)
+	if err != nil {
+		return fmt.Errorf("failed reading resources from %s: %w", opts.ConfigDirectory, err)
+	}
+
+	if r.Shoot == nil {
+		return fmt.Errorf("no Shoot found in %s", opts.ConfigDirectory)
+	}
+
+	rootDirectory := "/"
+	if opts.DryRun {
+		rootDirectory = opts.OutputDirectory
+	}
+	stateDirectory := filepath.Join(rootDirectory, DirectoryState)
+
+	existingSecrets, err := readState(stateDirectory)
+	if err != nil {
+		return err
+	}
+
+	log := logger.MustNewZapLogger(logger.InfoLevel, logger.FormatText, logzap.WriteTo(ioStreams.ErrOut))
+
+	result, err := controlplane.Render(ctx, log, Clock, r.Shoot, existingSecrets, controlplane.Options{AdvertiseAddress: net.ParseIP(opts.AdvertiseAddress)})
+	if err != nil {
+		return fmt.Errorf("failed rendering control plane: %w", err)
+	}
+
+	if err := staticpod.WriteFiles(FS, rootDirectory, result.Files); err != nil {
+		return err
+	}
+
+	if err := writeState(stateDirectory, result.Secrets); err != nil {
+		return err
+	}
+
+	var tokenExpiration, tokenRenewal time.Time
+	if !opts.DryRun {
+		c, err := gardenadmcmd.NewClientFromBytes(result.BootstrapKubeconfig, kubernetes.ShootScheme)
+		if err != nil {
+			return fmt.Errorf("failed creating client for kube-apiserver: %w", err)
+		}
+
+		requested := Clock.Now()
+		tokenExpiration, err = controlplane.Bootstrap(ctx, log, c, FS, rootDirectory, result)
+		if err != nil {
+			return fmt.Errorf("failed bootstrapping control plane: %w", err)
+		}
+		tokenRenewal = controlplane.RenewalTime(requested, tokenExpiration)
+	}
+
+	var manifests []string
+	for _, file := range result.Files {
+		if strings.HasPrefix(file.Path, staticpod.DirectoryManifests) {
+			manifests = append(manifests, filepath.Join(rootDirectory, file.Path))
+		}
+	}
+
+	fmt.Fprintf(ioStreams.Out, "Rendered control plane of Shoot %q, wrote %d file(s) below %s\n", r.Shoot.Name, len(result.Files), rootDirectory)
+	for _, manifest := range manifests {
+		fmt.Fprintf(ioStreams.Out, "  %s\n", manifest)
+	}
+
+	if !tokenExpiration.IsZero() {
+		fmt.Fprintf(ioStreams.Out, "The tokens of the control plane components expire at %s, run 'gardenadm init' again before %s to renew them\n",
+			tokenExpiration.UTC().Format(time.RFC3339), tokenRenewal.UTC().Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+func readState(dir string) ([]corev1.Secret, error) {
+	exists, err := FS.DirExists(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed checking state directory %s: %w", dir, err)
+	}
+	if !exists {
+		return nil, nil
+	}
+
+	state, err := resources.Read(FS, dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed reading state from %s: %w", dir, err)
+	}
+
+	secrets := make([]corev1.Secret, 0, len(state.Secrets))
+	for _, secret := range state.Secrets {
+		secrets = append(secrets, *secret)
+	}
+
+	return secrets, nil
+}
+
+func writeState(dir string, secrets []corev1.Secret) error {
+	state := &resources.Resources{}
+	for _, secret := range secrets {
+		resources.CleanObject(&secret)
+		state.Secrets = append(state.Secrets, &secret)
+	}
+
+	if err := state.Write(FS, dir); err != nil {
+		return fmt.Errorf("failed writing state to %s: %w", dir, err)
+	}
+
 	return nil
 }
@@ -6,35 +6,244 @@ package init_test
 
 import (
 	"bytes"
+	"context"
 	"io"
+	"net"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/cli-runtime/pkg/genericiooptions"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	gardenadmcmd "github.com/gardener/gardener/pkg/gardenadm/cmd"
 	. "github.com/gardener/gardener/pkg/gardenadm/cmd/init"
+	"github.com/gardener/gardener/pkg/gardenadm/resources"
+	"github.com/gardener/gardener/pkg/utils"
+	"github.com/gardener/gardener/pkg/utils/test"
 )
 
 var _ = Describe("Init", func() {
 	var (
+		ctx       = context.Background()
 		ioStreams genericiooptions.IOStreams
 		out       *bytes.Buffer
 		cmd       *cobra.Command
+
+		fs    afero.Afero
+		shoot *gardencorev1beta1.Shoot
 	)
 
 	BeforeEach(func() {
 		ioStreams, _, out, _ = genericiooptions.NewTestIOStreams()
 		cmd = NewCommand(ioStreams)
+		cmd.SetContext(ctx)
+
+		fs = afero.Afero{Fs: afero.NewMemMapFs()}
+		DeferCleanup(test.WithVars(
+			&FS, fs,
+			&Clock, testclock.NewFakeClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+		))
+
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "garden-bar"},
+			Spec: gardencorev1beta1.ShootSpec{
+				CloudProfileName: ptr.To("local"),
+				Kubernetes:       gardencorev1beta1.Kubernetes{Version: "1.31.1"},
+				Networking: &gardencorev1beta1.Networking{
+					Type:     ptr.To("calico"),
+					Pods:     ptr.To("10.3.0.0/16"),
+					Services: ptr.To("10.4.0.0/16"),
+					Nodes:    ptr.To("10.1.0.0/16"),
+				},
+				Provider: gardencorev1beta1.Provider{
+					Type:    "local",
+					Workers: []gardencorev1beta1.Worker{{Name: "control-plane"}},
+				},
+				Region: "local",
+			},
+		}
 	})
 
 	Describe("#RunE", func() {
-		It("should return the expected output", func() {
+		BeforeEach(func() {
+			Expect(cmd.Flags().Set("config-dir", "/resources")).To(Succeed())
+			Expect(cmd.Flags().Set("dry-run", "true")).To(Succeed())
+			Expect(cmd.Flags().Set("output-dir", "/out")).To(Succeed())
+			Expect(cmd.Flags().Set("advertise-address", "10.1.0.1")).To(Succeed())
+		})
+
+		It("should fail if the config directory does not exist", func() {
+			Expect(cmd.RunE(cmd, nil)).To(MatchError(ContainSubstring("failed reading resources from /resources")))
+		})
+
+		It("should fail if the config directory does not contain a shoot", func() {
+			Expect(fs.MkdirAll("/resources", 0755)).To(Succeed())
+
+			Expect(cmd.RunE(cmd, nil)).To(MatchError(ContainSubstring("no Shoot found in /resources")))
+		})
+
+		It("should render the static pods and write them to the output directory", func() {
+			Expect((&resources.Resources{Shoot: shoot}).Write(fs, "/resources")).To(Succeed())
+
 			Expect(cmd.RunE(cmd, nil)).To(Succeed())
 
 			output, err := io.ReadAll(out)
 			Expect(err).NotTo(HaveOccurred())
-			Expect(string(output)).To(Equal("not implemented"))
+			Expect(string(output)).To(ContainSubstring(`Rendered control plane of Shoot "foo"`))
+
+			for _, name := range []string{"etcd-main", "etcd-events", "kube-apiserver", "kube-controller-manager", "kube-scheduler"} {
+				path := "/out/etc/kubernetes/manifests/" + name + ".yaml"
+				Expect(string(output)).To(ContainSubstring(path))
+
+				content, err := fs.ReadFile(path)
+				Expect(err).NotTo(HaveOccurred(), path)
+				Expect(string(content)).To(And(
+					ContainSubstring("kind: Pod"),
+					ContainSubstring("namespace: kube-system"),
+					ContainSubstring("hostNetwork: true"),
+					ContainSubstring("priorityClassName: system-node-critical"),
+				), path)
+			}
+
+			content, err := fs.ReadFile("/out/etc/kubernetes/manifests/kube-apiserver.yaml")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(content)).To(And(
+				ContainSubstring("--etcd-servers=https://etcd-main-client:2379"),
+				ContainSubstring("/events#https://etcd-events-client:2382"),
+				ContainSubstring("path: /var/lib/gardenadm/volumes/kube-apiserver/"),
+			))
+
+			content, err = fs.ReadFile("/out/etc/kubernetes/manifests/etcd-events.yaml")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(content)).To(And(
+				ContainSubstring("--listen-client-urls=https://127.0.0.1:2382,https://10.1.0.1:2382"),
+				ContainSubstring("--advertise-client-urls=https://10.1.0.1:2382"),
+				ContainSubstring("--listen-peer-urls=https://10.1.0.1:2383"),
+				ContainSubstring("--initial-cluster=etcd-events-10.1.0.1=https://10.1.0.1:2383"),
+				ContainSubstring("--peer-client-cert-auth=true"),
+				ContainSubstring("path: /var/lib/gardenadm/data/etcd-events/data"),
+			))
+
+			serverCertificate, err := fs.ReadFile("/out/var/lib/gardenadm/volumes/etcd-events/server-tls/tls.crt")
+			Expect(err).NotTo(HaveOccurred())
+			cert, err := utils.DecodeCertificate(serverCertificate)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cert.IPAddresses).To(ContainElements(net.ParseIP("127.0.0.1").To4(), net.ParseIP("10.1.0.1").To4()))
+			Expect(cert.DNSNames).To(ContainElement("etcd-events-client"))
+
+			Expect(fs.DirExists("/out/var/lib/gardenadm/volumes/kube-apiserver")).To(BeTrue())
+			Expect(fs.DirExists("/out/var/lib/gardenadm/state/secrets")).To(BeTrue())
+		})
+
+		It("should reuse the generated secrets on subsequent runs", func() {
+			Expect((&resources.Resources{Shoot: shoot}).Write(fs, "/resources")).To(Succeed())
+
+			Expect(cmd.RunE(cmd, nil)).To(Succeed())
+			caBefore, err := fs.ReadFile("/out/var/lib/gardenadm/volumes/etcd-main/ca/bundle.crt")
+			Expect(err).NotTo(HaveOccurred())
+			stateBefore, err := resources.Read(fs, "/out/var/lib/gardenadm/state")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(cmd.RunE(cmd, nil)).To(Succeed())
+			caAfter, err := fs.ReadFile("/out/var/lib/gardenadm/volumes/etcd-main/ca/bundle.crt")
+			Expect(err).NotTo(HaveOccurred())
+			stateAfter, err := resources.Read(fs, "/out/var/lib/gardenadm/state")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(caAfter).To(Equal(caBefore))
+			Expect(secretNames(stateAfter.Secrets)).To(ConsistOf(secretNames(stateBefore.Secrets)))
+		})
+
+		Context("without dry-run", func() {
+			var fakeClient client.Client
+
+			BeforeEach(func() {
+				Expect(cmd.Flags().Set("dry-run", "false")).To(Succeed())
+				Expect(cmd.Flags().Set("output-dir", "")).To(Succeed())
+
+				fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.ShootScheme).WithInterceptorFuncs(interceptor.Funcs{
+					SubResourceCreate: func(_ context.Context, _ client.Client, subResourceName string, obj client.Object, subResource client.Object, _ ...client.SubResourceCreateOption) error {
+						Expect(subResourceName).To(Equal("token"))
+						tokenRequest := subResource.(*authenticationv1.TokenRequest)
+						tokenRequest.Status.Token = "token-" + obj.GetName()
+						tokenRequest.Status.ExpirationTimestamp = metav1.NewTime(time.Date(2024, 1, 11, 0, 0, 0, 0, time.UTC))
+						return nil
+					},
+				}).Build()
+
+				DeferCleanup(test.WithVars(
+					&gardenadmcmd.NewClientFromBytes, func(kubeconfig []byte, _ *runtime.Scheme) (client.Client, error) {
+						Expect(string(kubeconfig)).To(ContainSubstring("server: https://127.0.0.1:443"))
+						return fakeClient, nil
+					},
+				))
+			})
+
+			It("should create the shoot resources and write the tokens of the control plane components", func() {
+				Expect((&resources.Resources{Shoot: shoot}).Write(fs, "/resources")).To(Succeed())
+
+				Expect(cmd.RunE(cmd, nil)).To(Succeed())
+
+				Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: "kube-system", Name: "kube-controller-manager"}, &corev1.ServiceAccount{})).To(Succeed())
+				Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: "kube-system", Name: "kube-scheduler"}, &corev1.ServiceAccount{})).To(Succeed())
+				Expect(fakeClient.Get(ctx, client.ObjectKey{Name: "gardener.cloud:target:kube-scheduler"}, &rbacv1.ClusterRoleBinding{})).To(Succeed())
+
+				clusterInfo := &corev1.ConfigMap{}
+				Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: "kube-public", Name: "cluster-info"}, clusterInfo)).To(Succeed())
+				Expect(clusterInfo.Data["kubeconfig"]).To(ContainSubstring("server: https://10.1.0.1:443"))
+				roleBinding := &rbacv1.RoleBinding{}
+				Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: "kube-public", Name: "gardenadm:bootstrap-signer-clusterinfo"}, roleBinding)).To(Succeed())
+				Expect(roleBinding.Subjects).To(ConsistOf(rbacv1.Subject{APIGroup: "rbac.authorization.k8s.io", Kind: "Group", Name: "system:bootstrappers"}))
+
+				content, err := fs.ReadFile("/var/lib/gardenadm/volumes/kube-scheduler/kubeconfig/token")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(content)).To(Equal("token-kube-scheduler"))
+
+				content, err = fs.ReadFile("/var/lib/gardenadm/volumes/kube-controller-manager/kubeconfig/token")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(content)).To(Equal("token-kube-controller-manager"))
+
+				output, err := io.ReadAll(out)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(output)).To(ContainSubstring("The tokens of the control plane components expire at 2024-01-11T00:00:00Z, run 'gardenadm init' again before 2024-01-09T00:00:00Z to renew them"))
+			})
+
+			It("should keep the signatures of the cluster-info ConfigMap", func() {
+				Expect((&resources.Resources{Shoot: shoot}).Write(fs, "/resources")).To(Succeed())
+				Expect(fakeClient.Create(ctx, &corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "kube-public", Name: "cluster-info"},
+					Data:       map[string]string{"kubeconfig": "old", "jws-kubeconfig-abcdef": "signature"},
+				})).To(Succeed())
+
+				Expect(cmd.RunE(cmd, nil)).To(Succeed())
+
+				clusterInfo := &corev1.ConfigMap{}
+				Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: "kube-public", Name: "cluster-info"}, clusterInfo)).To(Succeed())
+				Expect(clusterInfo.Data).To(HaveKeyWithValue("jws-kubeconfig-abcdef", "signature"))
+				Expect(clusterInfo.Data["kubeconfig"]).NotTo(Equal("old"))
+			})
 		})
 	})
 })
+
+func secretNames(secrets []*corev1.Secret) []string {
+	var names []string
+	for _, secret := range secrets {
+		names = append(names, secret.Name)
+	}
+	return names
+}
@@ -5,16 +5,76 @@
 package init
 
 import (
+	"fmt"
+	"net"
+
 	"github.com/spf13/pflag"
+	utilnet "k8s.io/apimachinery/pkg/util/net"
+
+	"github.com/gardener/gardener/pkg/gardenadm/resources"
 )
 
+// DefaultDryRunOutputDirectory is the default directory into which the files are written in dry-run mode.
+const DefaultDryRunOutputDirectory = "gardenadm-init"
+
+// ChooseHostInterface returns the IP address of the default network interface of the host. It is used for defaulting
+// the advertise address. Exposed for testing.
+var ChooseHostInterface = utilnet.ChooseHostInterface
+
 // Options contains options for this command.
-type Options struct{}
+type Options struct {
+	// ConfigDirectory is the directory containing the resources (Shoot, CloudProfile, ...) of the autonomous shoot.
+	ConfigDirectory string
+	// DryRun specifies whether the files should only be written to the output directory instead of the host.
+	DryRun bool
+	// OutputDirectory is the directory into which the files are written in dry-run mode.
+	OutputDirectory string
+	// AdvertiseAddress is the IP address of this node on which etcd and kube-apiserver listen for further control plane
+	// nodes and worker nodes. Defaults to the IP address of the default network interface.
+	AdvertiseAddress string
+}
 
 // Complete completes the options.
-func (o *Options) Complete() error { return nil }
+func (o *Options) Complete() error {
+	if len(o.ConfigDirectory) == 0 {
+		o.ConfigDirectory = resources.DefaultDirectory
+	}
+
+	if o.DryRun && len(o.OutputDirectory) == 0 {
+		o.OutputDirectory = DefaultDryRunOutputDirectory
+	}
+
+	if len(o.AdvertiseAddress) == 0 {
+		ip, err := ChooseHostInterface()
+		if err != nil {
+			return fmt.Errorf("failed determining advertise address, please provide it explicitly: %w", err)
+		}
+		o.AdvertiseAddress = ip.String()
+	}
+
+	return nil
+}
 
 // Validate validates the options.
-func (o *Options) Validate() error { return nil }
+func (o *Options) Validate() error {
+	if len(o.ConfigDirectory) == 0 {
+		return fmt.Errorf("must provide a path to the config directory")
+	}
+
+	if !o.DryRun && len(o.OutputDirectory) > 0 {
+		return fmt.Errorf("the output directory can only be specified in dry-run mode")
+	}
+
+	if net.ParseIP(o.AdvertiseAddress) == nil {
+		return fmt.Errorf("advertise address %q is not a valid IP address", o.AdvertiseAddress)
+	}
+
+	return nil
+}
 
-func (o *Options) addFlags(_ *pflag.FlagSet) {}
+func (o *Options) addFlags(fs *pflag.FlagSet) {
+	fs.StringVarP(&o.ConfigDirectory, "config-dir", "d", resources.DefaultDirectory, "Path to the directory containing the resources of the autonomous shoot (as produced by 'gardenadm discover')")
+	fs.BoolVar(&o.DryRun, "dry-run", false, "Only render the files and write them to the output directory instead of the host")
+	fs.StringVarP(&o.OutputDirectory, "output-dir", "o", "", "Path to the directory into which the files are written in dry-run mode (default \""+DefaultDryRunOutputDirectory+"\")")
+	fs.StringVar(&o.AdvertiseAddress, "advertise-address", "", "IP address of this node on which etcd and kube-apiserver listen for other nodes (defaults to the address of the default network interface)")
+}
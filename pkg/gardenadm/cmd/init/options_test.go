@@ -5,10 +5,15 @@
 package init_test
 
 import (
+	"fmt"
+	"net"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
 	. "github.com/gardener/gardener/pkg/gardenadm/cmd/init"
+	"github.com/gardener/gardener/pkg/gardenadm/resources"
+	"github.com/gardener/gardener/pkg/utils/test"
 )
 
 var _ = Describe("Options", func() {
@@ -18,16 +23,83 @@ var _ = Describe("Options", func() {
 
 	BeforeEach(func() {
 		options = &Options{}
+
+		DeferCleanup(test.WithVar(&ChooseHostInterface, func() (net.IP, error) { return net.ParseIP("10.1.0.1"), nil }))
 	})
 
 	Describe("#Complete", func() {
-		It("should return nil", func() {
+		It("should default the config directory", func() {
+			Expect(options.Complete()).To(Succeed())
+			Expect(options.ConfigDirectory).To(Equal(resources.DefaultDirectory))
+			Expect(options.OutputDirectory).To(BeEmpty())
+		})
+
+		It("should default the output directory in dry-run mode", func() {
+			options.DryRun = true
+
+			Expect(options.Complete()).To(Succeed())
+			Expect(options.OutputDirectory).To(Equal(DefaultDryRunOutputDirectory))
+		})
+
+		It("should not overwrite the output directory", func() {
+			options.DryRun = true
+			options.OutputDirectory = "/out"
+
 			Expect(options.Complete()).To(Succeed())
+			Expect(options.OutputDirectory).To(Equal("/out"))
+		})
+
+		It("should default the advertise address", func() {
+			Expect(options.Complete()).To(Succeed())
+			Expect(options.AdvertiseAddress).To(Equal("10.1.0.1"))
+		})
+
+		It("should not overwrite the advertise address", func() {
+			options.AdvertiseAddress = "10.1.0.2"
+
+			Expect(options.Complete()).To(Succeed())
+			Expect(options.AdvertiseAddress).To(Equal("10.1.0.2"))
+		})
+
+		It("should fail if the advertise address cannot be determined", func() {
+			DeferCleanup(test.WithVar(&ChooseHostInterface, func() (net.IP, error) { return nil, fmt.Errorf("no route") }))
+
+			Expect(options.Complete()).To(MatchError(ContainSubstring("failed determining advertise address")))
 		})
 	})
 
 	Describe("#Validate", func() {
-		It("should return nil", func() {
+		BeforeEach(func() {
+			options.ConfigDirectory = "/resources"
+			options.AdvertiseAddress = "10.1.0.1"
+		})
+
+		It("should succeed", func() {
+			Expect(options.Validate()).To(Succeed())
+		})
+
+		It("should fail if the config directory is empty", func() {
+			options.ConfigDirectory = ""
+
+			Expect(options.Validate()).To(MatchError(ContainSubstring("must provide a path to the config directory")))
+		})
+
+		It("should fail if the output directory is set without dry-run mode", func() {
+			options.OutputDirectory = "/out"
+
+			Expect(options.Validate()).To(MatchError(ContainSubstring("only be specified in dry-run mode")))
+		})
+
+		It("should fail if the advertise address is invalid", func() {
+			options.AdvertiseAddress = "foo"
+
+			Expect(options.Validate()).To(MatchError(ContainSubstring("not a valid IP address")))
+		})
+
+		It("should succeed if the output directory is set in dry-run mode", func() {
+			options.DryRun = true
+			options.OutputDirectory = "/out"
+
 			Expect(options.Validate()).To(Succeed())
 		})
 	})
@@ -0,0 +1,161 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package join
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/client-go/util/cert"
+	bootstraptokenapi "k8s.io/cluster-bootstrap/token/api"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/utils/kubernetes/bootstraptoken"
+)
+
+// NewClient creates a new uncached client for the cluster at the given address which authenticates with the given
+// bearer token. If the CA bundle is empty, the serving certificate of the kube-apiserver is not verified. Exposed for
+// testing.
+var NewClient = func(address string, caBundle []byte, token string) (client.Client, error) {
+	return client.New(&rest.Config{
+		Host:        address,
+		BearerToken: token,
+		TLSClientConfig: rest.TLSClientConfig{
+			CAData:   caBundle,
+			Insecure: len(caBundle) == 0,
+		},
+	}, client.Options{Scheme: kubernetes.ShootScheme})
+}
+
+// CACertificateHash computes the hash of the public key of the given CA certificate in the form
+// "sha256:<hex-encoded-hash>".
+func CACertificateHash(certificate *x509.Certificate) string {
+	sum := sha256.Sum256(certificate.RawSubjectPublicKeyInfo)
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+// discoverCABundle fetches the cluster-info ConfigMap without verifying the serving certificate of the kube-apiserver.
+// Similar to kubeadm, the contained kubeconfig is only trusted if it is signed with the given bootstrap token (the
+// signature is added by the bootstrapsigner controller of kube-controller-manager) and if its CA bundle contains a CA
+// certificate whose public key matches one of the given hashes.
+func discoverCABundle(ctx context.Context, address, token string, caCertificateHashes []string) ([]byte, error) {
+	tokenID, tokenSecret, err := bootstraptoken.Parse(token)
+	if err != nil {
+		return nil, err
+	}
+
+	insecureClient, err := NewClient(address, nil, token)
+	if err != nil {
+		return nil, fmt.Errorf("failed creating client: %w", err)
+	}
+
+	configMap := &corev1.ConfigMap{}
+	if err := insecureClient.Get(ctx, client.ObjectKey{Namespace: metav1.NamespacePublic, Name: bootstraptokenapi.ConfigMapClusterInfo}, configMap); err != nil {
+		return nil, fmt.Errorf("failed reading ConfigMap %s/%s: %w", metav1.NamespacePublic, bootstraptokenapi.ConfigMapClusterInfo, err)
+	}
+
+	kubeconfig, ok := configMap.Data[bootstraptokenapi.KubeConfigKey]
+	if !ok {
+		return nil, fmt.Errorf("ConfigMap %s/%s does not contain a kubeconfig", metav1.NamespacePublic, bootstraptokenapi.ConfigMapClusterInfo)
+	}
+
+	signature, ok := configMap.Data[bootstraptokenapi.JWSSignatureKeyPrefix+tokenID]
+	if !ok {
+		return nil, fmt.Errorf("ConfigMap %s/%s is not signed with bootstrap token %q, the token must have the \"signing\" usage", metav1.NamespacePublic, bootstraptokenapi.ConfigMapClusterInfo, tokenID)
+	}
+
+	if err := verifyDetachedSignature(signature, []byte(kubeconfig), tokenID, tokenSecret); err != nil {
+		return nil, fmt.Errorf("failed verifying signature of ConfigMap %s/%s: %w", metav1.NamespacePublic, bootstraptokenapi.ConfigMapClusterInfo, err)
+	}
+
+	config, err := clientcmd.Load([]byte(kubeconfig))
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing kubeconfig of ConfigMap %s/%s: %w", metav1.NamespacePublic, bootstraptokenapi.ConfigMapClusterInfo, err)
+	}
+
+	if len(config.Clusters) != 1 {
+		return nil, fmt.Errorf("kubeconfig of ConfigMap %s/%s must contain exactly one cluster", metav1.NamespacePublic, bootstraptokenapi.ConfigMapClusterInfo)
+	}
+
+	var caBundle []byte
+	for _, cluster := range config.Clusters {
+		caBundle = cluster.CertificateAuthorityData
+	}
+
+	if len(caBundle) == 0 {
+		return nil, fmt.Errorf("kubeconfig of ConfigMap %s/%s does not contain a CA bundle", metav1.NamespacePublic, bootstraptokenapi.ConfigMapClusterInfo)
+	}
+
+	certificates, err := cert.ParseCertsPEM(caBundle)
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing CA bundle: %w", err)
+	}
+
+	for _, certificate := range certificates {
+		if slices.Contains(caCertificateHashes, CACertificateHash(certificate)) {
+			return caBundle, nil
+		}
+	}
+
+	return nil, fmt.Errorf("none of the CA certificates of the cluster matches the given CA certificate hashes")
+}
+
+// verifyDetachedSignature verifies the given JWS with detached payload as computed by the bootstrapsigner controller
+// of kube-controller-manager, i.e., it must be signed with HS256 using the token secret as key and the token ID as key
+// ID. The signature is verified manually since go-jose rejects HMAC keys shorter than the hash size, which is the case
+// for the token secrets.
+func verifyDetachedSignature(signature string, payload []byte, tokenID, tokenSecret string) error {
+	parts := strings.Split(signature, ".")
+	if len(parts) != 3 || parts[1] != "" {
+		return fmt.Errorf("signature is not a JWS with detached payload in compact serialization")
+	}
+
+	headerRaw, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return fmt.Errorf("failed decoding signature header: %w", err)
+	}
+
+	var header struct {
+		Algorithm string `json:"alg"`
+		KeyID     string `json:"kid"`
+	}
+	if err := json.Unmarshal(headerRaw, &header); err != nil {
+		return fmt.Errorf("failed parsing signature header: %w", err)
+	}
+
+	if header.Algorithm != "HS256" {
+		return fmt.Errorf("signature has algorithm %q but expected %q", header.Algorithm, "HS256")
+	}
+
+	if header.KeyID != tokenID {
+		return fmt.Errorf("signature has key ID %q but expected %q", header.KeyID, tokenID)
+	}
+
+	actual, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return fmt.Errorf("failed decoding signature: %w", err)
+	}
+
+	mac := hmac.New(sha256.New, []byte(tokenSecret))
+	mac.Write([]byte(parts[0] + "." + base64.RawURLEncoding.EncodeToString(payload)))
+	if !hmac.Equal(actual, mac.Sum(nil)) {
+		return fmt.Errorf("signature is invalid")
+	}
+
+	return nil
+}
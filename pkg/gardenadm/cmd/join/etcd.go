@@ -0,0 +1,322 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package join
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"net/url"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	"go.etcd.io/etcd/api/v3/etcdserverpb"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/clock"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/component/etcd/etcd"
+	etcdconstants "github.com/gardener/gardener/pkg/component/etcd/etcd/constants"
+	initcmd "github.com/gardener/gardener/pkg/gardenadm/cmd/init"
+	"github.com/gardener/gardener/pkg/gardenadm/controlplane"
+	"github.com/gardener/gardener/pkg/gardenadm/resources"
+	"github.com/gardener/gardener/pkg/gardenadm/staticpod"
+	retryutils "github.com/gardener/gardener/pkg/utils/retry"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
+)
+
+// EtcdClient is the part of the etcd client which is used for managing the members of an etcd cluster.
+type EtcdClient interface {
+	clientv3.Cluster
+	// Close closes the client.
+	Close() error
+}
+
+var (
+	// Clock is the clock used for generating the certificates of the etcd members. Exposed for testing.
+	Clock clock.Clock = clock.RealClock{}
+	// NewEtcdClient creates a client for the etcd cluster reachable at the given endpoint. Exposed for testing.
+	NewEtcdClient = func(ctx context.Context, endpoint string, tlsConfig *tls.Config) (EtcdClient, error) {
+		return clientv3.New(clientv3.Config{
+			Endpoints:   []string{endpoint},
+			TLS:         tlsConfig,
+			DialTimeout: 10 * time.Second,
+			Context:     ctx,
+		})
+	}
+	// PromoteEtcdMemberTimeout is the timeout for waiting until the etcd learners of this node can be promoted. Exposed
+	// for testing.
+	PromoteEtcdMemberTimeout = 10 * time.Minute
+	// PromoteEtcdMemberInterval is the interval for trying to promote the etcd learners of this node. Exposed for testing.
+	PromoteEtcdMemberInterval = 5 * time.Second
+)
+
+var etcdRoles = []string{v1beta1constants.ETCDRoleMain, v1beta1constants.ETCDRoleEvents}
+
+// etcdJoiner adds the etcd-main and etcd-events members of this node to the existing clusters. The certificates of
+// the members are generated based on the state of the first control plane node which must have been copied to this
+// node.
+type etcdJoiner struct {
+	log              logr.Logger
+	host             string
+	advertiseAddress net.IP
+	state            []*corev1.Secret
+	tlsConfig        *tls.Config
+}
+
+func newEtcdJoiner(log logr.Logger, opts *Options) (*etcdJoiner, error) {
+	controlPlaneURL, err := url.Parse(opts.ControlPlaneAddress)
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing control plane address: %w", err)
+	}
+
+	state, err := readState()
+	if err != nil {
+		return nil, err
+	}
+
+	tlsConfig, err := etcdClientTLSConfig(state)
+	if err != nil {
+		return nil, err
+	}
+
+	return &etcdJoiner{
+		log:              log,
+		host:             controlPlaneURL.Hostname(),
+		advertiseAddress: net.ParseIP(opts.AdvertiseAddress),
+		state:            state,
+		tlsConfig:        tlsConfig,
+	}, nil
+}
+
+// addLearners adds the members of this node as learners to the etcd clusters, and renders and writes the static pods
+// for them. Learners do not count for the quorum, hence the clusters stay available even if the members of this node
+// do not start. They are promoted to voting members by promoteLearners once they have caught up with the leader.
+func (j *etcdJoiner) addLearners(ctx context.Context, configDirectory string) error {
+	r, err := resources.Read(FS, configDirectory)
+	if err != nil {
+		return fmt.Errorf("failed reading resources from %s: %w", configDirectory, err)
+	}
+
+	if r.Shoot == nil {
+		return fmt.Errorf("no Shoot found in %s", configDirectory)
+	}
+
+	initialClusters := make(map[string]string, len(etcdRoles))
+	for _, role := range etcdRoles {
+		initialCluster, err := j.withClient(ctx, role, func(etcdClient EtcdClient, memberName, peerURL string) (string, error) {
+			j.log.Info("Adding etcd learner", "role", role, "peerURL", peerURL)
+			return addLearner(ctx, etcdClient, memberName, peerURL)
+		})
+		if err != nil {
+			return fmt.Errorf("failed adding learner to etcd-%s: %w", role, err)
+		}
+		initialClusters[role] = initialCluster
+	}
+
+	existingSecrets := make([]corev1.Secret, 0, len(j.state))
+	for _, secret := range j.state {
+		existingSecrets = append(existingSecrets, *secret)
+	}
+
+	result, err := controlplane.Render(ctx, j.log, Clock, r.Shoot, existingSecrets, controlplane.Options{
+		AdvertiseAddress:    j.advertiseAddress,
+		EtcdInitialClusters: initialClusters,
+	})
+	if err != nil {
+		return fmt.Errorf("failed rendering etcd: %w", err)
+	}
+
+	files := etcdFiles(result.Files)
+	j.log.Info("Writing etcd static pods", "files", len(files))
+	return staticpod.WriteFiles(FS, "/", files)
+}
+
+// promoteLearners promotes the etcd learners of this node to voting members. etcd rejects the promotion until the
+// learner has caught up with the leader, hence it is retried until it succeeds.
+func (j *etcdJoiner) promoteLearners(ctx context.Context) error {
+	for _, role := range etcdRoles {
+		if _, err := j.withClient(ctx, role, func(etcdClient EtcdClient, _, peerURL string) (string, error) {
+			j.log.Info("Promoting etcd learner", "role", role, "peerURL", peerURL)
+			return "", promoteLearner(ctx, etcdClient, peerURL)
+		}); err != nil {
+			return fmt.Errorf("failed promoting learner of etcd-%s: %w", role, err)
+		}
+	}
+
+	return nil
+}
+
+func (j *etcdJoiner) withClient(ctx context.Context, role string, fn func(etcdClient EtcdClient, memberName, peerURL string) (string, error)) (string, error) {
+	var (
+		ports     = controlplane.PortsForEtcdRole(role)
+		endpoint  = "https://" + net.JoinHostPort(j.host, strconv.Itoa(int(ports.Client)))
+		tlsConfig = j.tlsConfig.Clone()
+	)
+
+	// The server certificate of etcd does not contain the host name of the control plane address, but the name of the
+	// client service.
+	tlsConfig.ServerName = etcdconstants.ServiceName(role)
+
+	etcdClient, err := NewEtcdClient(ctx, endpoint, tlsConfig)
+	if err != nil {
+		return "", fmt.Errorf("failed creating etcd client for %s: %w", endpoint, err)
+	}
+	defer etcdClient.Close()
+
+	return fn(etcdClient, controlplane.EtcdMemberName("etcd-"+role, j.advertiseAddress), controlplane.EtcdPeerURL(j.advertiseAddress, ports))
+}
+
+// addLearner adds a learner with the given peer URL unless such a member already exists, and returns the initial
+// cluster configuration for it.
+func addLearner(ctx context.Context, etcdClient EtcdClient, memberName, peerURL string) (string, error) {
+	memberList, err := etcdClient.MemberList(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed listing members: %w", err)
+	}
+	members := memberList.Members
+
+	if memberWithPeerURL(members, peerURL) == nil {
+		memberAdd, err := etcdClient.MemberAddAsLearner(ctx, []string{peerURL})
+		if err != nil {
+			return "", fmt.Errorf("failed adding learner: %w", err)
+		}
+		members = memberAdd.Members
+	}
+
+	var initialCluster []string
+	for _, member := range members {
+		name := member.Name
+		if slices.Contains(member.PeerURLs, peerURL) {
+			name = memberName
+		}
+		if name == "" {
+			return "", fmt.Errorf("member %x has not been started yet, only one member can join at a time", member.ID)
+		}
+
+		for _, memberPeerURL := range member.PeerURLs {
+			initialCluster = append(initialCluster, name+"="+memberPeerURL)
+		}
+	}
+
+	return strings.Join(initialCluster, ","), nil
+}
+
+func promoteLearner(ctx context.Context, etcdClient EtcdClient, peerURL string) error {
+	return retryutils.UntilTimeout(ctx, PromoteEtcdMemberInterval, PromoteEtcdMemberTimeout, func(ctx context.Context) (bool, error) {
+		memberList, err := etcdClient.MemberList(ctx)
+		if err != nil {
+			return retryutils.MinorError(fmt.Errorf("failed listing members: %w", err))
+		}
+
+		member := memberWithPeerURL(memberList.Members, peerURL)
+		if member == nil {
+			return retryutils.SevereError(fmt.Errorf("no member with peer URL %s found", peerURL))
+		}
+
+		if !member.IsLearner {
+			return retryutils.Ok()
+		}
+
+		if _, err := etcdClient.MemberPromote(ctx, member.ID); err != nil {
+			return retryutils.MinorError(fmt.Errorf("failed promoting learner: %w", err))
+		}
+
+		return retryutils.Ok()
+	})
+}
+
+func memberWithPeerURL(members []*etcdserverpb.Member, peerURL string) *etcdserverpb.Member {
+	for _, member := range members {
+		if slices.Contains(member.PeerURLs, peerURL) {
+			return member
+		}
+	}
+	return nil
+}
+
+// etcdFiles returns the static pod manifests of the etcd members and the files of their volumes.
+func etcdFiles(files []extensionsv1alpha1.File) []extensionsv1alpha1.File {
+	var result []extensionsv1alpha1.File
+
+	for _, file := range files {
+		for _, role := range etcdRoles {
+			name := "etcd-" + role
+			if file.Path == filepath.Join(staticpod.DirectoryManifests, name+".yaml") || strings.HasPrefix(file.Path, filepath.Join(staticpod.DirectoryVolumes, name)+"/") {
+				result = append(result, file)
+			}
+		}
+	}
+
+	return result
+}
+
+func readState() ([]*corev1.Secret, error) {
+	exists, err := FS.DirExists(initcmd.DirectoryState)
+	if err != nil {
+		return nil, fmt.Errorf("failed checking state directory %s: %w", initcmd.DirectoryState, err)
+	}
+	if !exists {
+		return nil, fmt.Errorf("joining a control plane node requires the state of the first control plane node in %s", initcmd.DirectoryState)
+	}
+
+	state, err := resources.Read(FS, initcmd.DirectoryState)
+	if err != nil {
+		return nil, fmt.Errorf("failed reading state from %s: %w", initcmd.DirectoryState, err)
+	}
+
+	return state.Secrets, nil
+}
+
+func etcdClientTLSConfig(state []*corev1.Secret) (*tls.Config, error) {
+	caSecret := secretByName(state, v1beta1constants.SecretNameCAETCD)
+	if caSecret == nil {
+		return nil, fmt.Errorf("secret %q not found in state", v1beta1constants.SecretNameCAETCD)
+	}
+
+	clientSecret := secretByName(state, etcd.SecretNameClient)
+	if clientSecret == nil {
+		return nil, fmt.Errorf("secret %q not found in state", etcd.SecretNameClient)
+	}
+
+	rootCAs := x509.NewCertPool()
+	if !rootCAs.AppendCertsFromPEM(caSecret.Data[secretsutils.DataKeyCertificateCA]) {
+		return nil, fmt.Errorf("failed parsing CA certificate of secret %q", caSecret.Name)
+	}
+
+	certificate, err := tls.X509KeyPair(clientSecret.Data[secretsutils.DataKeyCertificate], clientSecret.Data[secretsutils.DataKeyPrivateKey])
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing client certificate of secret %q: %w", clientSecret.Name, err)
+	}
+
+	return &tls.Config{
+		RootCAs:      rootCAs,
+		Certificates: []tls.Certificate{certificate},
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
+
+// secretByName returns the newest secret managed by the secrets manager with the given name.
+func secretByName(secrets []*corev1.Secret, name string) *corev1.Secret {
+	var result *corev1.Secret
+	for _, secret := range secrets {
+		if secret.Labels[secretsmanager.LabelKeyName] != name {
+			continue
+		}
+		if result == nil || secret.Labels[secretsmanager.LabelKeyIssuedAtTime] > result.Labels[secretsmanager.LabelKeyIssuedAtTime] {
+			result = secret
+		}
+	}
+	return result
+}
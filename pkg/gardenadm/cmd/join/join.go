@@ -7,9 +7,41 @@ package join
 import (
 	"context"
 	"fmt"
+	"os"
+	"path/filepath"
 
+	"github.com/go-logr/logr"
+	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logzap "sigs.k8s.io/controller-runtime/pkg/log/zap"
+	"sigs.k8s.io/yaml"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	extensionsv1alpha1helper "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1/helper"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	nodeagentcomponent "github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/original/components/nodeagent"
+	"github.com/gardener/gardener/pkg/features"
+	"github.com/gardener/gardener/pkg/logger"
+	"github.com/gardener/gardener/pkg/nodeagent"
+	nodeagentv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/nodeagent/bootstrap"
+	"github.com/gardener/gardener/pkg/nodeagent/dbus"
+	"github.com/gardener/gardener/pkg/nodeagent/registry"
+)
+
+var (
+	// FS is the file system used for writing the files of gardener-node-agent. Exposed for testing.
+	FS = afero.Afero{Fs: afero.NewOsFs()}
+	// NewDBus creates the DBus used for managing the systemd unit of gardener-node-agent. Exposed for testing.
+	NewDBus = dbus.New
+	// Extractor is used for extracting the gardener-node-agent binary from its container image. Exposed for testing.
+	Extractor = registry.NewExtractor()
 )
 
 // NewCommand creates a new cobra.Command.
@@ -17,15 +49,24 @@ func NewCommand(ioStreams genericiooptions.IOStreams) *cobra.Command {
 	opts := &Options{}
 
 	cmd := &cobra.Command{
-		Use:   "join",
+		Use:   "join <control-plane-address>",
 		Short: "Bootstrap further control plane nodes or worker nodes and join them to the cluster",
-		Long:  "Bootstrap further control plane nodes or worker nodes and join them to the cluster",
+		Long: "Bootstrap further control plane nodes or worker nodes and join them to the cluster. The CA bundle of the " +
+			"cluster is discovered from the control plane and verified against the bootstrap token and the given CA certificate " +
+			"hashes. Afterwards, the OperatingSystemConfig of the worker pool is fetched and gardener-node-agent is installed " +
+			"and started, which takes care of configuring the node (kubelet, containerd, etc.) and registering it with the " +
+			"cluster. The complete command can be printed with 'gardenadm token create --print-join-command'.",
 
 		Example: `# Bootstrap a worker node and join it to the cluster
-gardenadm join`,
+gardenadm join 10.1.0.1 --bootstrap-token foo123.bar4567890baz123 --ca-certificate-hash sha256:<hash> --operating-system-config-secret gardener-node-agent-pool1-<hash>
+
+# Bootstrap a control plane node and add it as a further etcd member
+gardenadm join 10.1.0.1 --bootstrap-token foo123.bar4567890baz123 --ca-certificate-hash sha256:<hash> --operating-system-config-secret gardener-node-agent-control-plane-<hash> --control-plane --advertise-address 10.1.0.2`,
 
-		RunE: func(cmd *cobra.Command, _ []string) error {
-			if err := opts.Complete(); err != nil {
+		Args: cobra.MaximumNArgs(1),
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := opts.Complete(args); err != nil {
 				return err
 			}
 
@@ -42,7 +83,149 @@ gardenadm join`,
 	return cmd
 }
 
-func run(_ context.Context, ioStreams genericiooptions.IOStreams, _ *Options) error {
-	fmt.Fprint(ioStreams.Out, "not implemented")
+func run(ctx context.Context, ioStreams genericiooptions.IOStreams, opts *Options) error {
+	log := logger.MustNewZapLogger(logger.InfoLevel, logger.FormatText, logzap.WriteTo(ioStreams.ErrOut))
+
+	log.Info("Discovering CA bundle of the cluster", "address", opts.ControlPlaneAddress)
+	caBundle, err := discoverCABundle(ctx, opts.ControlPlaneAddress, opts.BootstrapToken, opts.CACertificateHashes)
+	if err != nil {
+		return fmt.Errorf("failed discovering CA bundle: %w", err)
+	}
+
+	c, err := NewClient(opts.ControlPlaneAddress, caBundle, opts.BootstrapToken)
+	if err != nil {
+		return fmt.Errorf("failed creating client: %w", err)
+	}
+
+	secret, err := fetchOperatingSystemConfigSecret(ctx, c, opts.OperatingSystemConfigSecretName)
+	if err != nil {
+		return err
+	}
+
+	osc := &extensionsv1alpha1.OperatingSystemConfig{}
+	if err := runtime.DecodeInto(kubernetes.SeedCodec.UniversalDeserializer(), secret.Data[nodeagentv1alpha1.DataKeyOperatingSystemConfig], osc); err != nil {
+		return fmt.Errorf("failed decoding OperatingSystemConfig from secret %s: %w", client.ObjectKeyFromObject(secret), err)
+	}
+
+	var etcdJoiner *etcdJoiner
+	if opts.ControlPlane {
+		etcdJoiner, err = newEtcdJoiner(log, opts)
+		if err != nil {
+			return err
+		}
+
+		if err := etcdJoiner.addLearners(ctx, opts.ConfigDirectory); err != nil {
+			return err
+		}
+	}
+
+	if err := installNodeAgent(ctx, log, osc, opts.BootstrapToken); err != nil {
+		return err
+	}
+
+	if err := bootstrap.Bootstrap(ctx, log, FS, NewDBus(log), nil); err != nil {
+		return fmt.Errorf("failed bootstrapping gardener-node-agent: %w", err)
+	}
+
+	// The etcd static pods are started by the kubelet which is configured by gardener-node-agent, hence the learners
+	// can only be promoted afterward.
+	if etcdJoiner != nil {
+		if err := etcdJoiner.promoteLearners(ctx); err != nil {
+			return err
+		}
+	}
+
+	fmt.Fprintf(ioStreams.Out, "Node joined worker pool %q, gardener-node-agent is now configuring it based on secret %s\n", secret.Labels[v1beta1constants.LabelWorkerPool], secret.Name)
+	return nil
+}
+
+// fetchOperatingSystemConfigSecret fetches the secret containing the OperatingSystemConfig of the worker pool. Nodes
+// authenticated with a bootstrap token are only allowed to read these secrets by name.
+func fetchOperatingSystemConfigSecret(ctx context.Context, c client.Client, name string) (*corev1.Secret, error) {
+	secret := &corev1.Secret{}
+	if err := c.Get(ctx, client.ObjectKey{Namespace: metav1.NamespaceSystem, Name: name}, secret); err != nil {
+		return nil, fmt.Errorf("failed reading OperatingSystemConfig secret %s: %w", name, err)
+	}
+
+	if secret.Labels[v1beta1constants.GardenRole] != v1beta1constants.GardenRoleOperatingSystemConfig {
+		return nil, fmt.Errorf("secret %s is not an OperatingSystemConfig secret", name)
+	}
+
+	return secret, nil
+}
+
+// installNodeAgent writes the files required for starting gardener-node-agent: its configuration (taken from the
+// OperatingSystemConfig), its binary (extracted from the container image referenced in the OperatingSystemConfig),
+// the bootstrap token and the machine name.
+func installNodeAgent(ctx context.Context, log logr.Logger, osc *extensionsv1alpha1.OperatingSystemConfig, bootstrapToken string) error {
+	configFile := fileWithPath(osc.Spec.Files, nodeagentv1alpha1.ConfigFilePath)
+	if configFile == nil || configFile.Content.Inline == nil {
+		return fmt.Errorf("OperatingSystemConfig does not contain the gardener-node-agent configuration file %s", nodeagentv1alpha1.ConfigFilePath)
+	}
+
+	binaryFile := fileWithPath(osc.Spec.Files, nodeagentcomponent.PathBinary)
+	if binaryFile == nil || binaryFile.Content.ImageRef == nil {
+		return fmt.Errorf("OperatingSystemConfig does not contain an image reference for the gardener-node-agent binary %s", nodeagentcomponent.PathBinary)
+	}
+
+	configRaw, err := extensionsv1alpha1helper.Decode(configFile.Content.Inline.Encoding, []byte(configFile.Content.Inline.Data))
+	if err != nil {
+		return fmt.Errorf("failed decoding gardener-node-agent configuration: %w", err)
+	}
+
+	config := &nodeagentv1alpha1.NodeAgentConfiguration{}
+	if err := yaml.Unmarshal(configRaw, config); err != nil {
+		return fmt.Errorf("failed unmarshalling gardener-node-agent configuration: %w", err)
+	}
+
+	log.Info("Writing gardener-node-agent configuration", "path", nodeagentv1alpha1.ConfigFilePath)
+	if err := writeFile(nodeagentv1alpha1.ConfigFilePath, configRaw, 0600); err != nil {
+		return err
+	}
+
+	log.Info("Extracting gardener-node-agent binary", "image", binaryFile.Content.ImageRef.Image, "path", nodeagentcomponent.PathBinary)
+	if err := Extractor.CopyFromImage(ctx, binaryFile.Content.ImageRef.Image, binaryFile.Content.ImageRef.FilePathInImage, nodeagentcomponent.PathBinary, 0755); err != nil {
+		return fmt.Errorf("failed extracting gardener-node-agent binary from image %s: %w", binaryFile.Content.ImageRef.Image, err)
+	}
+
+	log.Info("Writing bootstrap token", "path", nodeagentv1alpha1.BootstrapTokenFilePath)
+	if err := writeFile(nodeagentv1alpha1.BootstrapTokenFilePath, []byte(bootstrapToken), 0640); err != nil {
+		return err
+	}
+
+	if config.FeatureGates[string(features.NodeAgentAuthorizer)] {
+		// There is no machine object for nodes joined via gardenadm, hence the host name is used as machine name.
+		machineName, err := nodeagent.GetHostName()
+		if err != nil {
+			return fmt.Errorf("failed fetching host name: %w", err)
+		}
+
+		log.Info("Writing machine name", "path", nodeagentv1alpha1.MachineNameFilePath, "machineName", machineName)
+		if err := writeFile(nodeagentv1alpha1.MachineNameFilePath, []byte(machineName), 0640); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func fileWithPath(files []extensionsv1alpha1.File, path string) *extensionsv1alpha1.File {
+	for _, file := range files {
+		if file.Path == path {
+			return &file
+		}
+	}
+	return nil
+}
+
+func writeFile(path string, content []byte, permissions os.FileMode) error {
+	if err := FS.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("failed creating directory %s: %w", filepath.Dir(path), err)
+	}
+
+	if err := FS.WriteFile(path, content, permissions); err != nil {
+		return fmt.Errorf("failed writing file %s: %w", path, err)
+	}
+
 	return nil
 }
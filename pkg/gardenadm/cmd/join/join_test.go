@@ -6,35 +6,404 @@ package join_test
 
 import (
 	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
 	"io"
+	"strings"
+	"time"
 
+	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
+	"go.etcd.io/etcd/api/v3/etcdserverpb"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/cli-runtime/pkg/genericiooptions"
+	clientcmdlatest "k8s.io/client-go/tools/clientcmd/api/latest"
+	clientcmdv1 "k8s.io/client-go/tools/clientcmd/api/v1"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	nodeagentcomponent "github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/original/components/nodeagent"
 	. "github.com/gardener/gardener/pkg/gardenadm/cmd/join"
+	"github.com/gardener/gardener/pkg/gardenadm/resources"
+	"github.com/gardener/gardener/pkg/nodeagent"
+	"github.com/gardener/gardener/pkg/nodeagent/dbus"
+	fakedbus "github.com/gardener/gardener/pkg/nodeagent/dbus/fake"
+	fakeregistry "github.com/gardener/gardener/pkg/nodeagent/registry/fake"
+	"github.com/gardener/gardener/pkg/utils"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+	"github.com/gardener/gardener/pkg/utils/test"
 )
 
 var _ = Describe("Join", func() {
+	const (
+		token         = "abcdef.abcdef1234567890"
+		nodeName      = "node1"
+		oscSecretName = "gardener-node-agent-pool1-abc"
+	)
+
 	var (
+		ctx       = context.Background()
 		ioStreams genericiooptions.IOStreams
 		out       *bytes.Buffer
 		cmd       *cobra.Command
+
+		fs         afero.Afero
+		fakeDBus   *fakedbus.DBus
+		fakeClient client.Client
+		caBundles  [][]byte
+
+		ca            *secretsutils.Certificate
+		caCertificate *x509.Certificate
+		caHash        string
 	)
 
 	BeforeEach(func() {
 		ioStreams, _, out, _ = genericiooptions.NewTestIOStreams()
 		cmd = NewCommand(ioStreams)
+		cmd.SetContext(ctx)
+
+		var err error
+		ca, err = (&secretsutils.CertificateSecretConfig{Name: "ca", CommonName: "kubernetes", CertType: secretsutils.CACert}).GenerateCertificate()
+		Expect(err).NotTo(HaveOccurred())
+		caCertificate, err = utils.DecodeCertificate(ca.CertificatePEM)
+		Expect(err).NotTo(HaveOccurred())
+		caHash = CACertificateHash(caCertificate)
+
+		fs = afero.Afero{Fs: afero.NewMemMapFs()}
+		fakeDBus = fakedbus.New()
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.ShootScheme).Build()
+		caBundles = nil
+
+		DeferCleanup(test.WithVars(
+			&FS, fs,
+			&NewDBus, func(logr.Logger) dbus.DBus { return fakeDBus },
+			&Extractor, fakeregistry.NewExtractor(fs, "/image"),
+			&NewClient, func(_ string, caBundle []byte, _ string) (client.Client, error) {
+				caBundles = append(caBundles, caBundle)
+				return fakeClient, nil
+			},
+			&nodeagent.Hostname, func() (string, error) { return nodeName, nil },
+		))
+
+		Expect(fs.WriteFile("/image/gardener-node-agent", []byte("binary"), 0755)).To(Succeed())
+
+		Expect(fakeClient.Create(ctx, clusterInfo(ca.CertificatePEM, "abcdef", "abcdef1234567890"))).To(Succeed())
+
+		Expect(cmd.Flags().Set("bootstrap-token", token)).To(Succeed())
+		Expect(cmd.Flags().Set("ca-certificate-hash", caHash)).To(Succeed())
+		Expect(cmd.Flags().Set("operating-system-config-secret", oscSecretName)).To(Succeed())
 	})
 
+	createOSCSecret := func(name, workerPoolName string, nodeAgentAuthorizer bool) {
+		config := "apiVersion: nodeagent.config.gardener.cloud/v1alpha1\nkind: NodeAgentConfiguration\n"
+		if nodeAgentAuthorizer {
+			config += "featureGates:\n  NodeAgentAuthorizer: true\n"
+		}
+
+		osc := &extensionsv1alpha1.OperatingSystemConfig{
+			Spec: extensionsv1alpha1.OperatingSystemConfigSpec{
+				Files: []extensionsv1alpha1.File{
+					{
+						Path:    "/var/lib/gardener-node-agent/config.yaml",
+						Content: extensionsv1alpha1.FileContent{Inline: &extensionsv1alpha1.FileContentInline{Encoding: "b64", Data: utils.EncodeBase64([]byte(config))}},
+					},
+					{
+						Path:        "/opt/bin/gardener-node-agent",
+						Permissions: ptr.To[uint32](0755),
+						Content:     extensionsv1alpha1.FileContent{ImageRef: &extensionsv1alpha1.FileContentImageRef{Image: "node-agent:v1", FilePathInImage: "/gardener-node-agent"}},
+					},
+				},
+			},
+		}
+
+		oscRaw, err := runtime.Encode(kubernetes.SeedCodec.LegacyCodec(extensionsv1alpha1.SchemeGroupVersion), osc)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(fakeClient.Create(ctx, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "kube-system",
+				Labels: map[string]string{
+					"gardener.cloud/role":        "operating-system-config",
+					"worker.gardener.cloud/pool": workerPoolName,
+				},
+			},
+			Data: map[string][]byte{"osc.yaml": oscRaw},
+		})).To(Succeed())
+	}
+
 	Describe("#RunE", func() {
-		It("should return the expected output", func() {
-			Expect(cmd.RunE(cmd, nil)).To(Succeed())
+		It("should fail if the CA bundle does not match the CA certificate hash", func() {
+			otherCA, err := (&secretsutils.CertificateSecretConfig{Name: "ca", CommonName: "other", CertType: secretsutils.CACert}).GenerateCertificate()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fakeClient.Update(ctx, clusterInfo(otherCA.CertificatePEM, "abcdef", "abcdef1234567890"))).To(Succeed())
+
+			Expect(cmd.RunE(cmd, []string{"api.example.com"})).To(MatchError(ContainSubstring("none of the CA certificates of the cluster matches the given CA certificate hashes")))
+		})
+
+		It("should fail if the cluster-info ConfigMap is not signed with the bootstrap token", func() {
+			Expect(fakeClient.Update(ctx, clusterInfo(ca.CertificatePEM, "other1", "abcdef1234567890"))).To(Succeed())
+
+			Expect(cmd.RunE(cmd, []string{"api.example.com"})).To(MatchError(ContainSubstring(`is not signed with bootstrap token "abcdef"`)))
+		})
+
+		It("should fail if the signature of the cluster-info ConfigMap is invalid", func() {
+			configMap := clusterInfo(ca.CertificatePEM, "abcdef", "other12345678901")
+			Expect(fakeClient.Update(ctx, configMap)).To(Succeed())
+
+			Expect(cmd.RunE(cmd, []string{"api.example.com"})).To(MatchError(ContainSubstring("signature is invalid")))
+		})
+
+		It("should fail if the kubeconfig of the cluster-info ConfigMap was tampered with", func() {
+			configMap := clusterInfo(ca.CertificatePEM, "abcdef", "abcdef1234567890")
+			configMap.Data["kubeconfig"] = strings.ReplaceAll(configMap.Data["kubeconfig"], "10.1.0.1", "10.1.0.9")
+			Expect(fakeClient.Update(ctx, configMap)).To(Succeed())
+
+			Expect(cmd.RunE(cmd, []string{"api.example.com"})).To(MatchError(ContainSubstring("signature is invalid")))
+		})
+
+		It("should fail if the OperatingSystemConfig secret does not exist", func() {
+			Expect(cmd.RunE(cmd, []string{"api.example.com"})).To(MatchError(ContainSubstring("failed reading OperatingSystemConfig secret " + oscSecretName)))
+		})
+
+		It("should fail if the secret is no OperatingSystemConfig secret", func() {
+			Expect(fakeClient.Create(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: oscSecretName, Namespace: "kube-system"}})).To(Succeed())
+
+			Expect(cmd.RunE(cmd, []string{"api.example.com"})).To(MatchError(ContainSubstring("is not an OperatingSystemConfig secret")))
+		})
+
+		It("should install and start gardener-node-agent", func() {
+			createOSCSecret(oscSecretName, "pool1", false)
+
+			Expect(cmd.RunE(cmd, []string{"api.example.com"})).To(Succeed())
+
+			Expect(caBundles).To(Equal([][]byte{nil, ca.CertificatePEM}))
 
 			output, err := io.ReadAll(out)
 			Expect(err).NotTo(HaveOccurred())
-			Expect(string(output)).To(Equal("not implemented"))
+			Expect(string(output)).To(ContainSubstring(`Node joined worker pool "pool1"`))
+
+			test.AssertFileOnDisk(fs, "/var/lib/gardener-node-agent/config.yaml", "apiVersion: nodeagent.config.gardener.cloud/v1alpha1\nkind: NodeAgentConfiguration\n", 0600)
+			test.AssertFileOnDisk(fs, "/opt/bin/gardener-node-agent", "binary", 0755)
+			test.AssertFileOnDisk(fs, "/var/lib/gardener-node-agent/credentials/bootstrap-token", token, 0640)
+			test.AssertNoFileOnDisk(fs, "/var/lib/gardener-node-agent/machine-name")
+			test.AssertFileOnDisk(fs, "/etc/systemd/system/gardener-node-agent.service", nodeagentcomponent.UnitContent(), 0644)
+
+			Expect(fakeDBus.Actions).To(Equal([]fakedbus.SystemdAction{
+				{Action: fakedbus.ActionDaemonReload},
+				{Action: fakedbus.ActionEnable, UnitNames: []string{"gardener-node-agent.service"}},
+				{Action: fakedbus.ActionStart, UnitNames: []string{"gardener-node-agent.service"}},
+				{Action: fakedbus.ActionDisable, UnitNames: []string{"gardener-node-init.service"}},
+			}))
+		})
+
+		It("should write the machine name if the NodeAgentAuthorizer feature gate is enabled", func() {
+			createOSCSecret(oscSecretName, "pool1", true)
+
+			Expect(cmd.RunE(cmd, []string{"api.example.com"})).To(Succeed())
+
+			test.AssertFileOnDisk(fs, "/var/lib/gardener-node-agent/machine-name", nodeName, 0640)
+		})
+
+		Context("control plane node", func() {
+			var etcdClients map[string]*fakeEtcdClient
+
+			BeforeEach(func() {
+				createOSCSecret(oscSecretName, "control-plane", false)
+
+				Expect(cmd.Flags().Set("control-plane", "true")).To(Succeed())
+				Expect(cmd.Flags().Set("advertise-address", "10.1.0.2")).To(Succeed())
+				Expect(cmd.Flags().Set("config-dir", "/resources")).To(Succeed())
+
+				etcdClients = map[string]*fakeEtcdClient{
+					"https://api.example.com:2379": {members: []*etcdserverpb.Member{{ID: 1, Name: "etcd-main-10.1.0.1", PeerURLs: []string{"https://10.1.0.1:2380"}}}},
+					"https://api.example.com:2382": {members: []*etcdserverpb.Member{{ID: 1, Name: "etcd-events-10.1.0.1", PeerURLs: []string{"https://10.1.0.1:2383"}}}},
+				}
+
+				DeferCleanup(test.WithVars(
+					&Clock, testclock.NewFakeClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+					&PromoteEtcdMemberInterval, time.Millisecond,
+					&NewEtcdClient, func(_ context.Context, endpoint string, tlsConfig *tls.Config) (EtcdClient, error) {
+						Expect(tlsConfig.Certificates).To(HaveLen(1))
+						Expect(tlsConfig.ServerName).To(HavePrefix("etcd-"))
+						etcdClient, ok := etcdClients[endpoint]
+						Expect(ok).To(BeTrue(), endpoint)
+						return etcdClient, nil
+					},
+				))
+			})
+
+			writeState := func() {
+				etcdCA, err := (&secretsutils.CertificateSecretConfig{Name: "ca-etcd", CommonName: "etcd", CertType: secretsutils.CACert}).GenerateCertificate()
+				Expect(err).NotTo(HaveOccurred())
+				etcdClient, err := (&secretsutils.CertificateSecretConfig{Name: "etcd-client", CommonName: "etcd-client", CertType: secretsutils.ClientCert, SigningCA: etcdCA}).GenerateCertificate()
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect((&resources.Resources{Secrets: []*corev1.Secret{
+					{ObjectMeta: metav1.ObjectMeta{Name: "ca-etcd-abc", Namespace: "kube-system", Labels: map[string]string{"name": "ca-etcd"}}, Data: etcdCA.SecretData()},
+					{ObjectMeta: metav1.ObjectMeta{Name: "etcd-client-abc", Namespace: "kube-system", Labels: map[string]string{"name": "etcd-client"}}, Data: etcdClient.SecretData()},
+				}}).Write(fs, "/var/lib/gardenadm/state")).To(Succeed())
+
+				Expect((&resources.Resources{Shoot: &gardencorev1beta1.Shoot{
+					ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "garden-bar"},
+					Spec: gardencorev1beta1.ShootSpec{
+						CloudProfileName: ptr.To("local"),
+						Kubernetes:       gardencorev1beta1.Kubernetes{Version: "1.31.1"},
+						Networking: &gardencorev1beta1.Networking{
+							Type:     ptr.To("calico"),
+							Pods:     ptr.To("10.3.0.0/16"),
+							Services: ptr.To("10.4.0.0/16"),
+							Nodes:    ptr.To("10.1.0.0/16"),
+						},
+						Provider: gardencorev1beta1.Provider{
+							Type:    "local",
+							Workers: []gardencorev1beta1.Worker{{Name: "control-plane"}},
+						},
+						Region: "local",
+					},
+				}}).Write(fs, "/resources")).To(Succeed())
+			}
+
+			It("should fail if the state of the first control plane node is missing", func() {
+				Expect(cmd.RunE(cmd, []string{"api.example.com"})).To(MatchError(ContainSubstring("requires the state of the first control plane node in /var/lib/gardenadm/state")))
+				Expect(fakeDBus.Actions).To(BeEmpty())
+			})
+
+			It("should add etcd learners, start them, install gardener-node-agent and promote the learners", func() {
+				writeState()
+				etcdClients["https://api.example.com:2379"].promoteErrors = 2
+
+				Expect(cmd.RunE(cmd, []string{"api.example.com"})).To(Succeed())
+
+				Expect(etcdClients["https://api.example.com:2379"].members).To(ConsistOf(
+					&etcdserverpb.Member{ID: 1, Name: "etcd-main-10.1.0.1", PeerURLs: []string{"https://10.1.0.1:2380"}},
+					&etcdserverpb.Member{ID: 2, PeerURLs: []string{"https://10.1.0.2:2380"}},
+				))
+				Expect(etcdClients["https://api.example.com:2382"].members).To(ConsistOf(
+					&etcdserverpb.Member{ID: 1, Name: "etcd-events-10.1.0.1", PeerURLs: []string{"https://10.1.0.1:2383"}},
+					&etcdserverpb.Member{ID: 2, PeerURLs: []string{"https://10.1.0.2:2383"}},
+				))
+				Expect(fakeDBus.Actions).To(HaveLen(4))
+
+				content, err := fs.ReadFile("/etc/kubernetes/manifests/etcd-main.yaml")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(content)).To(And(
+					ContainSubstring("--name=etcd-main-10.1.0.2"),
+					ContainSubstring("--initial-cluster=etcd-main-10.1.0.1=https://10.1.0.1:2380,etcd-main-10.1.0.2=https://10.1.0.2:2380"),
+					ContainSubstring("--initial-cluster-state=existing"),
+					ContainSubstring("--listen-peer-urls=https://10.1.0.2:2380"),
+				))
+
+				content, err = fs.ReadFile("/etc/kubernetes/manifests/etcd-events.yaml")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(content)).To(ContainSubstring("--initial-cluster=etcd-events-10.1.0.1=https://10.1.0.1:2383,etcd-events-10.1.0.2=https://10.1.0.2:2383"))
+
+				Expect(fs.DirExists("/var/lib/gardenadm/volumes/etcd-main/peer-server-tls")).To(BeTrue())
+				Expect(fs.Exists("/etc/kubernetes/manifests/kube-apiserver.yaml")).To(BeFalse())
+			})
+
+			It("should not add the learner again if it already exists", func() {
+				writeState()
+				etcdClients["https://api.example.com:2379"].members = append(etcdClients["https://api.example.com:2379"].members,
+					&etcdserverpb.Member{ID: 5, PeerURLs: []string{"https://10.1.0.2:2380"}, IsLearner: true},
+				)
+
+				Expect(cmd.RunE(cmd, []string{"api.example.com"})).To(Succeed())
+
+				Expect(etcdClients["https://api.example.com:2379"].members).To(ConsistOf(
+					&etcdserverpb.Member{ID: 1, Name: "etcd-main-10.1.0.1", PeerURLs: []string{"https://10.1.0.1:2380"}},
+					&etcdserverpb.Member{ID: 5, PeerURLs: []string{"https://10.1.0.2:2380"}},
+				))
+			})
+
+			It("should fail if another member has not been started yet", func() {
+				writeState()
+				etcdClients["https://api.example.com:2379"].members = append(etcdClients["https://api.example.com:2379"].members,
+					&etcdserverpb.Member{ID: 5, PeerURLs: []string{"https://10.1.0.3:2380"}, IsLearner: true},
+				)
+
+				Expect(cmd.RunE(cmd, []string{"api.example.com"})).To(MatchError(ContainSubstring("member 5 has not been started yet")))
+				Expect(fakeDBus.Actions).To(BeEmpty())
+			})
+		})
+	})
+
+	Describe("#CACertificateHash", func() {
+		It("should compute the SHA-256 hash of the public key", func() {
+			sum := sha256.Sum256(caCertificate.RawSubjectPublicKeyInfo)
+			Expect(caHash).To(Equal("sha256:" + hex.EncodeToString(sum[:])))
 		})
 	})
 })
+
+func clusterInfo(caCertificate []byte, tokenID, tokenSecret string) *corev1.ConfigMap {
+	kubeconfig, err := runtime.Encode(clientcmdlatest.Codec, kubernetesutils.NewKubeconfig("", clientcmdv1.Cluster{Server: "10.1.0.1:443", CertificateAuthorityData: caCertificate}, clientcmdv1.AuthInfo{}))
+	Expect(err).NotTo(HaveOccurred())
+
+	// Compute the signature like the bootstrapsigner controller of kube-controller-manager.
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"HS256","kid":"` + tokenID + `"}`))
+	mac := hmac.New(sha256.New, []byte(tokenSecret))
+	mac.Write([]byte(header + "." + base64.RawURLEncoding.EncodeToString(kubeconfig)))
+	signature := header + ".." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "cluster-info", Namespace: "kube-public"},
+		Data: map[string]string{
+			"kubeconfig":                string(kubeconfig),
+			"jws-kubeconfig-" + tokenID: signature,
+		},
+	}
+}
+
+type fakeEtcdClient struct {
+	clientv3.Cluster
+
+	members       []*etcdserverpb.Member
+	promoteErrors int
+}
+
+func (f *fakeEtcdClient) MemberList(context.Context) (*clientv3.MemberListResponse, error) {
+	return &clientv3.MemberListResponse{Members: f.members}, nil
+}
+
+func (f *fakeEtcdClient) MemberAddAsLearner(_ context.Context, peerURLs []string) (*clientv3.MemberAddResponse, error) {
+	member := &etcdserverpb.Member{ID: uint64(len(f.members) + 1), PeerURLs: peerURLs, IsLearner: true}
+	f.members = append(f.members, member)
+	return &clientv3.MemberAddResponse{Member: member, Members: f.members}, nil
+}
+
+func (f *fakeEtcdClient) MemberPromote(_ context.Context, id uint64) (*clientv3.MemberPromoteResponse, error) {
+	if f.promoteErrors > 0 {
+		f.promoteErrors--
+		return nil, fmt.Errorf("can only promote a learner member which is in sync with leader")
+	}
+
+	for _, member := range f.members {
+		if member.ID == id {
+			member.IsLearner = false
+		}
+	}
+	return &clientv3.MemberPromoteResponse{Members: f.members}, nil
+}
+
+func (f *fakeEtcdClient) Close() error {
+	return nil
+}
@@ -5,16 +5,111 @@
 package join
 
 import (
+	"fmt"
+	"net"
+	"net/url"
+	"regexp"
+	"strings"
+
 	"github.com/spf13/pflag"
+	bootstraptokenapi "k8s.io/cluster-bootstrap/token/api"
+	bootstraptokenutil "k8s.io/cluster-bootstrap/token/util"
+
+	"github.com/gardener/gardener/pkg/gardenadm/resources"
 )
 
+var caCertificateHashRegex = regexp.MustCompile(`^sha256:[0-9a-f]{64}$`)
+
 // Options contains options for this command.
-type Options struct{}
+type Options struct {
+	// ControlPlaneAddress is the address of the kube-apiserver of the autonomous shoot cluster.
+	ControlPlaneAddress string
+	// BootstrapToken is the bootstrap token used for authenticating against the kube-apiserver.
+	BootstrapToken string
+	// CACertificateHashes are the hashes of the public keys of the CA certificates which the cluster CA bundle must
+	// contain. They are of the form "sha256:<hex-encoded-hash>".
+	CACertificateHashes []string
+	// OperatingSystemConfigSecretName is the name of the secret containing the OperatingSystemConfig of the worker pool
+	// the node should join.
+	OperatingSystemConfigSecretName string
+	// ControlPlane specifies whether the node should join as a control plane node.
+	ControlPlane bool
+	// AdvertiseAddress is the IP address of this node which is advertised to the other etcd members.
+	AdvertiseAddress string
+	// ConfigDirectory is the directory containing the resources (Shoot, CloudProfile, ...) of the autonomous shoot. It
+	// is required for rendering the etcd members of control plane nodes.
+	ConfigDirectory string
+}
 
 // Complete completes the options.
-func (o *Options) Complete() error { return nil }
+func (o *Options) Complete(args []string) error {
+	if len(args) > 0 {
+		o.ControlPlaneAddress = strings.TrimSpace(args[0])
+	}
+
+	if len(o.ControlPlaneAddress) > 0 && !strings.Contains(o.ControlPlaneAddress, "://") {
+		o.ControlPlaneAddress = "https://" + o.ControlPlaneAddress
+	}
+
+	if len(o.ConfigDirectory) == 0 {
+		o.ConfigDirectory = resources.DefaultDirectory
+	}
+
+	for i, hash := range o.CACertificateHashes {
+		o.CACertificateHashes[i] = strings.ToLower(strings.TrimSpace(hash))
+	}
+
+	return nil
+}
 
 // Validate validates the options.
-func (o *Options) Validate() error { return nil }
+func (o *Options) Validate() error {
+	if len(o.ControlPlaneAddress) == 0 {
+		return fmt.Errorf("must provide the address of the control plane")
+	}
+
+	if u, err := url.Parse(o.ControlPlaneAddress); err != nil || u.Scheme != "https" || u.Hostname() == "" {
+		return fmt.Errorf("control plane address %q must be of the form [https://]<host>[:<port>]", o.ControlPlaneAddress)
+	}
+
+	if len(o.BootstrapToken) == 0 {
+		return fmt.Errorf("must provide a bootstrap token")
+	}
+
+	if !bootstraptokenutil.IsValidBootstrapToken(o.BootstrapToken) {
+		return fmt.Errorf("bootstrap token must be of form %q", bootstraptokenapi.BootstrapTokenPattern)
+	}
+
+	if len(o.CACertificateHashes) == 0 {
+		return fmt.Errorf("must provide at least one CA certificate hash")
+	}
+
+	for _, hash := range o.CACertificateHashes {
+		if !caCertificateHashRegex.MatchString(hash) {
+			return fmt.Errorf("CA certificate hash %q must be of form \"sha256:<hex-encoded-hash>\"", hash)
+		}
+	}
+
+	if len(o.OperatingSystemConfigSecretName) == 0 {
+		return fmt.Errorf("must provide the name of the OperatingSystemConfig secret")
+	}
+
+	if o.ControlPlane && net.ParseIP(o.AdvertiseAddress) == nil {
+		return fmt.Errorf("must provide a valid advertise address when joining a control plane node")
+	}
+
+	if !o.ControlPlane && len(o.AdvertiseAddress) > 0 {
+		return fmt.Errorf("the advertise address can only be specified when joining a control plane node")
+	}
+
+	return nil
+}
 
-func (o *Options) addFlags(_ *pflag.FlagSet) {}
+func (o *Options) addFlags(fs *pflag.FlagSet) {
+	fs.StringVarP(&o.BootstrapToken, "bootstrap-token", "t", "", "Bootstrap token used for authenticating against the control plane (as created by 'gardenadm token create')")
+	fs.StringSliceVar(&o.CACertificateHashes, "ca-certificate-hash", nil, "Hash of the public key of a CA certificate the cluster CA bundle must contain (format: \"sha256:<hex-encoded-hash>\")")
+	fs.StringVarP(&o.OperatingSystemConfigSecretName, "operating-system-config-secret", "s", "", "Name of the secret containing the OperatingSystemConfig of the worker pool the node should join (as printed by 'gardenadm token create --print-join-command')")
+	fs.BoolVar(&o.ControlPlane, "control-plane", false, "Join the node as a control plane node, i.e., add it as a further etcd member")
+	fs.StringVar(&o.AdvertiseAddress, "advertise-address", "", "IP address of this node advertised to the other etcd members (only applicable with --control-plane)")
+	fs.StringVarP(&o.ConfigDirectory, "config-dir", "d", resources.DefaultDirectory, "Path to the directory containing the resources of the autonomous shoot (only applicable with --control-plane)")
+}
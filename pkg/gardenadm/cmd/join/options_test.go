@@ -5,15 +5,19 @@
 package join_test
 
 import (
+	"strings"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
 	. "github.com/gardener/gardener/pkg/gardenadm/cmd/join"
+	"github.com/gardener/gardener/pkg/gardenadm/resources"
 )
 
 var _ = Describe("Options", func() {
 	var (
 		options *Options
+		hash    = "sha256:" + strings.Repeat("a", 64)
 	)
 
 	BeforeEach(func() {
@@ -21,14 +25,100 @@ var _ = Describe("Options", func() {
 	})
 
 	Describe("#Complete", func() {
-		It("should return nil", func() {
-			Expect(options.Complete()).To(Succeed())
+		It("should take the control plane address from the arguments and default the scheme", func() {
+			Expect(options.Complete([]string{"api.example.com:443"})).To(Succeed())
+			Expect(options.ControlPlaneAddress).To(Equal("https://api.example.com:443"))
+		})
+
+		It("should not overwrite the scheme of the control plane address", func() {
+			Expect(options.Complete([]string{"https://api.example.com"})).To(Succeed())
+			Expect(options.ControlPlaneAddress).To(Equal("https://api.example.com"))
+		})
+
+		It("should normalize the CA certificate hashes", func() {
+			options.CACertificateHashes = []string{" SHA256:" + strings.Repeat("A", 64) + " "}
+
+			Expect(options.Complete(nil)).To(Succeed())
+			Expect(options.CACertificateHashes).To(ConsistOf(hash))
+		})
+
+		It("should default the config directory", func() {
+			Expect(options.Complete(nil)).To(Succeed())
+			Expect(options.ConfigDirectory).To(Equal(resources.DefaultDirectory))
 		})
 	})
 
 	Describe("#Validate", func() {
-		It("should return nil", func() {
+		BeforeEach(func() {
+			options.ControlPlaneAddress = "https://api.example.com"
+			options.BootstrapToken = "abcdef.abcdef1234567890"
+			options.CACertificateHashes = []string{hash}
+			options.OperatingSystemConfigSecretName = "gardener-node-agent-pool1-abc"
+		})
+
+		It("should succeed", func() {
 			Expect(options.Validate()).To(Succeed())
 		})
+
+		It("should fail if the control plane address is empty", func() {
+			options.ControlPlaneAddress = ""
+
+			Expect(options.Validate()).To(MatchError(ContainSubstring("must provide the address of the control plane")))
+		})
+
+		It("should fail if the control plane address does not use https", func() {
+			options.ControlPlaneAddress = "http://api.example.com"
+
+			Expect(options.Validate()).To(MatchError(ContainSubstring("must be of the form")))
+		})
+
+		It("should fail if the bootstrap token is empty", func() {
+			options.BootstrapToken = ""
+
+			Expect(options.Validate()).To(MatchError(ContainSubstring("must provide a bootstrap token")))
+		})
+
+		It("should fail if the bootstrap token is invalid", func() {
+			options.BootstrapToken = "foo"
+
+			Expect(options.Validate()).To(MatchError(ContainSubstring("bootstrap token must be of form")))
+		})
+
+		It("should fail if no CA certificate hash is given", func() {
+			options.CACertificateHashes = nil
+
+			Expect(options.Validate()).To(MatchError(ContainSubstring("must provide at least one CA certificate hash")))
+		})
+
+		It("should fail if a CA certificate hash is invalid", func() {
+			options.CACertificateHashes = []string{"md5:foo"}
+
+			Expect(options.Validate()).To(MatchError(ContainSubstring(`CA certificate hash "md5:foo" must be of form`)))
+		})
+
+		It("should fail if the OperatingSystemConfig secret name is empty", func() {
+			options.OperatingSystemConfigSecretName = ""
+
+			Expect(options.Validate()).To(MatchError(ContainSubstring("must provide the name of the OperatingSystemConfig secret")))
+		})
+
+		It("should fail if the advertise address is missing when joining a control plane node", func() {
+			options.ControlPlane = true
+
+			Expect(options.Validate()).To(MatchError(ContainSubstring("must provide a valid advertise address")))
+		})
+
+		It("should succeed when joining a control plane node with an advertise address", func() {
+			options.ControlPlane = true
+			options.AdvertiseAddress = "10.1.0.2"
+
+			Expect(options.Validate()).To(Succeed())
+		})
+
+		It("should fail if the advertise address is set when joining a worker node", func() {
+			options.AdvertiseAddress = "10.1.0.2"
+
+			Expect(options.Validate()).To(MatchError(ContainSubstring("can only be specified when joining a control plane node")))
+		})
 	})
 })
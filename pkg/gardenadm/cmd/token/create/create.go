@@ -7,9 +7,24 @@ package create
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+	"k8s.io/client-go/util/cert"
+	bootstraptokenapi "k8s.io/cluster-bootstrap/token/api"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	gardenadmcmd "github.com/gardener/gardener/pkg/gardenadm/cmd"
+	"github.com/gardener/gardener/pkg/gardenadm/cmd/join"
+	"github.com/gardener/gardener/pkg/utils/kubernetes/bootstraptoken"
 )
 
 // NewCommand creates a new cobra.Command.
@@ -19,15 +34,23 @@ func NewCommand(ioStreams genericiooptions.IOStreams) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "create [token]",
 		Short: "Create a bootstrap token on the server",
-		Long: "The [token] is the actual token to write." +
-			"This should be a securely generated random token of the form \"[a-z0-9]{6}.[a-z0-9]{16}\"." +
-			"If no [token] is given, gardenadm will generate a random token instead.",
+		Long: "The [token] is the actual token to write. " +
+			"This should be a securely generated random token of the form \"[a-z0-9]{6}.[a-z0-9]{16}\". " +
+			"If no [token] is given, gardenadm will generate a random token instead. " +
+			"The token is printed after it has been created. " +
+			"With --print-join-command, the complete 'gardenadm join' command for the token is printed instead.",
 
 		Example: `# Create a bootstrap token with id "foo123" on the server
 gardenadm token create foo123.bar4567890baz123
 
 # Create a bootstrap token generated randomly
-gardenadm token create`,
+gardenadm token create
+
+# Create a bootstrap token with a description which expires after 24 hours
+gardenadm token create --description "join worker nodes" --validity 24h
+
+# Create a bootstrap token and print the command for joining a node to worker pool "pool1"
+gardenadm token create --print-join-command --worker-pool-name pool1`,
 
 		Args: cobra.MaximumNArgs(1),
 
@@ -49,7 +72,95 @@ gardenadm token create`,
 	return cmd
 }
 
-func run(_ context.Context, ioStreams genericiooptions.IOStreams, _ *Options) error {
-	fmt.Fprint(ioStreams.Out, "not implemented")
+func run(ctx context.Context, ioStreams genericiooptions.IOStreams, opts *Options) error {
+	c, err := gardenadmcmd.NewClientFromFile(opts.Kubeconfig, kubernetes.ShootScheme)
+	if err != nil {
+		return fmt.Errorf("failed creating client: %w", err)
+	}
+
+	if _, err := bootstraptoken.CreateOrUpdate(ctx, c, opts.Token, opts.Description, opts.Validity, opts.Usages); err != nil {
+		return fmt.Errorf("failed creating bootstrap token: %w", err)
+	}
+
+	if !opts.PrintJoinCommand {
+		fmt.Fprintln(ioStreams.Out, opts.Token)
+		return nil
+	}
+
+	joinCommand, err := joinCommand(ctx, c, opts.Token, opts.WorkerPoolName)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintln(ioStreams.Out, joinCommand)
 	return nil
 }
+
+// joinCommand computes the `gardenadm join` command for the given token. The address of the control plane and the
+// hash of the CA certificate are taken from the cluster-info ConfigMap. Since nodes authenticated with a bootstrap
+// token are not allowed to list secrets, the name of the OperatingSystemConfig secret of the worker pool is resolved
+// here.
+func joinCommand(ctx context.Context, c client.Client, token, workerPoolName string) (string, error) {
+	configMap := &corev1.ConfigMap{}
+	if err := c.Get(ctx, client.ObjectKey{Namespace: metav1.NamespacePublic, Name: bootstraptokenapi.ConfigMapClusterInfo}, configMap); err != nil {
+		return "", fmt.Errorf("failed reading ConfigMap %s/%s: %w", metav1.NamespacePublic, bootstraptokenapi.ConfigMapClusterInfo, err)
+	}
+
+	config, err := clientcmd.Load([]byte(configMap.Data[bootstraptokenapi.KubeConfigKey]))
+	if err != nil {
+		return "", fmt.Errorf("failed parsing kubeconfig of ConfigMap %s/%s: %w", metav1.NamespacePublic, bootstraptokenapi.ConfigMapClusterInfo, err)
+	}
+
+	if len(config.Clusters) != 1 {
+		return "", fmt.Errorf("kubeconfig of ConfigMap %s/%s must contain exactly one cluster", metav1.NamespacePublic, bootstraptokenapi.ConfigMapClusterInfo)
+	}
+
+	var cluster *clientcmdapi.Cluster
+	for _, namedCluster := range config.Clusters {
+		cluster = namedCluster
+	}
+
+	certificates, err := cert.ParseCertsPEM(cluster.CertificateAuthorityData)
+	if err != nil {
+		return "", fmt.Errorf("failed parsing CA bundle: %w", err)
+	}
+
+	secret, err := operatingSystemConfigSecret(ctx, c, workerPoolName)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("gardenadm join %s --bootstrap-token %s --ca-certificate-hash %s --operating-system-config-secret %s",
+		cluster.Server, token, join.CACertificateHash(certificates[0]), secret.Name), nil
+}
+
+// operatingSystemConfigSecret returns the secret containing the OperatingSystemConfig of the given worker pool. If no
+// worker pool is given, the cluster must only have a single worker pool.
+func operatingSystemConfigSecret(ctx context.Context, c client.Client, workerPoolName string) (*corev1.Secret, error) {
+	labels := client.MatchingLabels{v1beta1constants.GardenRole: v1beta1constants.GardenRoleOperatingSystemConfig}
+	if len(workerPoolName) > 0 {
+		labels[v1beta1constants.LabelWorkerPool] = workerPoolName
+	}
+
+	secretList := &corev1.SecretList{}
+	if err := c.List(ctx, secretList, client.InNamespace(metav1.NamespaceSystem), labels); err != nil {
+		return nil, fmt.Errorf("failed listing OperatingSystemConfig secrets: %w", err)
+	}
+
+	switch len(secretList.Items) {
+	case 0:
+		if len(workerPoolName) > 0 {
+			return nil, fmt.Errorf("no OperatingSystemConfig secret found for worker pool %q", workerPoolName)
+		}
+		return nil, fmt.Errorf("no OperatingSystemConfig secret found")
+	case 1:
+		return &secretList.Items[0], nil
+	default:
+		var workerPoolNames []string
+		for _, secret := range secretList.Items {
+			workerPoolNames = append(workerPoolNames, secret.Labels[v1beta1constants.LabelWorkerPool])
+		}
+		slices.Sort(workerPoolNames)
+		return nil, fmt.Errorf("found OperatingSystemConfig secrets for multiple worker pools (%s), specify the worker pool with --worker-pool-name", strings.Join(workerPoolNames, ", "))
+	}
+}
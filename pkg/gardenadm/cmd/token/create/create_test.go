@@ -6,35 +6,131 @@ package create_test
 
 import (
 	"bytes"
+	"context"
+	"crypto/x509"
 	"io"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/cli-runtime/pkg/genericiooptions"
+	clientcmdlatest "k8s.io/client-go/tools/clientcmd/api/latest"
+	clientcmdv1 "k8s.io/client-go/tools/clientcmd/api/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	gardenadmcmd "github.com/gardener/gardener/pkg/gardenadm/cmd"
+	"github.com/gardener/gardener/pkg/gardenadm/cmd/join"
 	. "github.com/gardener/gardener/pkg/gardenadm/cmd/token/create"
+	"github.com/gardener/gardener/pkg/utils"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+	"github.com/gardener/gardener/pkg/utils/test"
 )
 
 var _ = Describe("Create", func() {
 	var (
-		ioStreams genericiooptions.IOStreams
-		out       *bytes.Buffer
-		cmd       *cobra.Command
+		ctx        = context.Background()
+		ioStreams  genericiooptions.IOStreams
+		out        *bytes.Buffer
+		cmd        *cobra.Command
+		fakeClient client.Client
 	)
 
 	BeforeEach(func() {
 		ioStreams, _, out, _ = genericiooptions.NewTestIOStreams()
 		cmd = NewCommand(ioStreams)
+		cmd.SetContext(ctx)
+
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.ShootScheme).Build()
+		DeferCleanup(test.WithVar(&gardenadmcmd.NewClientFromFile, func(string, *runtime.Scheme) (client.Client, error) { return fakeClient, nil }))
+
+		Expect(cmd.Flags().Set("kubeconfig", "some-path-to-kubeconfig")).To(Succeed())
 	})
 
 	Describe("#RunE", func() {
-		It("should return the expected output", func() {
-			Expect(cmd.RunE(cmd, []string{"some-token"})).To(Succeed())
+		It("should create the bootstrap token secret", func() {
+			Expect(cmd.Flags().Set("description", "some description")).To(Succeed())
+			Expect(cmd.Flags().Set("usages", "authentication")).To(Succeed())
+
+			Expect(cmd.RunE(cmd, []string{"foo123.bar4567890baz123"})).To(Succeed())
 
 			output, err := io.ReadAll(out)
 			Expect(err).NotTo(HaveOccurred())
-			Expect(string(output)).To(Equal("not implemented"))
+			Expect(string(output)).To(Equal("foo123.bar4567890baz123\n"))
+
+			secret := &corev1.Secret{}
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: "kube-system", Name: "bootstrap-token-foo123"}, secret)).To(Succeed())
+			Expect(secret.Type).To(Equal(corev1.SecretType("bootstrap.kubernetes.io/token")))
+			Expect(secret.Data).To(HaveKeyWithValue("token-id", []byte("foo123")))
+			Expect(secret.Data).To(HaveKeyWithValue("token-secret", []byte("bar4567890baz123")))
+			Expect(secret.Data).To(HaveKeyWithValue("description", []byte("some description")))
+			Expect(secret.Data).To(HaveKeyWithValue("usage-bootstrap-authentication", []byte("true")))
+			Expect(secret.Data).NotTo(HaveKey("usage-bootstrap-signing"))
+			Expect(secret.Data).To(HaveKey("expiration"))
+		})
+
+		It("should fail for an invalid token", func() {
+			Expect(cmd.RunE(cmd, []string{"some-token"})).To(MatchError(ContainSubstring("token must be of form")))
+		})
+
+		Context("join command", func() {
+			var caCertificate *x509.Certificate
+
+			createOSCSecret := func(name, workerPoolName string) {
+				Expect(fakeClient.Create(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: "kube-system",
+					Labels: map[string]string{
+						"gardener.cloud/role":        "operating-system-config",
+						"worker.gardener.cloud/pool": workerPoolName,
+					},
+				}})).To(Succeed())
+			}
+
+			BeforeEach(func() {
+				ca, err := (&secretsutils.CertificateSecretConfig{Name: "ca", CommonName: "kubernetes", CertType: secretsutils.CACert}).GenerateCertificate()
+				Expect(err).NotTo(HaveOccurred())
+				caCertificate, err = utils.DecodeCertificate(ca.CertificatePEM)
+				Expect(err).NotTo(HaveOccurred())
+
+				kubeconfig, err := runtime.Encode(clientcmdlatest.Codec, kubernetesutils.NewKubeconfig("", clientcmdv1.Cluster{Server: "10.1.0.1:443", CertificateAuthorityData: ca.CertificatePEM}, clientcmdv1.AuthInfo{}))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fakeClient.Create(ctx, &corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{Name: "cluster-info", Namespace: "kube-public"},
+					Data:       map[string]string{"kubeconfig": string(kubeconfig)},
+				})).To(Succeed())
+
+				Expect(cmd.Flags().Set("print-join-command", "true")).To(Succeed())
+			})
+
+			It("should print the join command", func() {
+				createOSCSecret("gardener-node-agent-pool1-abc", "pool1")
+				createOSCSecret("gardener-node-agent-pool2-def", "pool2")
+				Expect(cmd.Flags().Set("worker-pool-name", "pool2")).To(Succeed())
+
+				Expect(cmd.RunE(cmd, []string{"foo123.bar4567890baz123"})).To(Succeed())
+
+				output, err := io.ReadAll(out)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(output)).To(Equal("gardenadm join https://10.1.0.1:443 --bootstrap-token foo123.bar4567890baz123 --ca-certificate-hash " +
+					join.CACertificateHash(caCertificate) + " --operating-system-config-secret gardener-node-agent-pool2-def\n"))
+			})
+
+			It("should fail if there is no OperatingSystemConfig secret", func() {
+				Expect(cmd.RunE(cmd, []string{"foo123.bar4567890baz123"})).To(MatchError(ContainSubstring("no OperatingSystemConfig secret found")))
+			})
+
+			It("should fail if there are multiple worker pools and none is specified", func() {
+				createOSCSecret("gardener-node-agent-pool1-abc", "pool1")
+				createOSCSecret("gardener-node-agent-pool2-def", "pool2")
+
+				Expect(cmd.RunE(cmd, []string{"foo123.bar4567890baz123"})).To(MatchError(ContainSubstring("multiple worker pools (pool1, pool2)")))
+			})
 		})
 	})
 })
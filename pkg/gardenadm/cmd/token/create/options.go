@@ -6,15 +6,34 @@ package create
 
 import (
 	"fmt"
+	"os"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/spf13/pflag"
+	bootstraptokenapi "k8s.io/cluster-bootstrap/token/api"
+	bootstraptokenutil "k8s.io/cluster-bootstrap/token/util"
+
+	"github.com/gardener/gardener/pkg/utils/kubernetes/bootstraptoken"
 )
 
 // Options contains options for this command.
 type Options struct {
+	// Kubeconfig is the path to the kubeconfig file pointing to the autonomous shoot cluster.
+	Kubeconfig string
 	// Token is the token to create.
 	Token string
+	// Description is the description of the token.
+	Description string
+	// Validity is the duration after which the token expires.
+	Validity time.Duration
+	// Usages are the usages of the token.
+	Usages []string
+	// PrintJoinCommand specifies whether the `gardenadm join` command for the token should be printed.
+	PrintJoinCommand bool
+	// WorkerPoolName is the name of the worker pool for which the join command is printed.
+	WorkerPoolName string
 }
 
 // Complete completes the options.
@@ -24,8 +43,15 @@ func (o *Options) Complete(args []string) error {
 	}
 
 	if o.Token == "" {
-		// TODO: Generate a random token instead.
-		o.Token = "foo123.bar4567890baz123"
+		token, err := bootstraptoken.Generate()
+		if err != nil {
+			return fmt.Errorf("failed generating random token: %w", err)
+		}
+		o.Token = token
+	}
+
+	if o.Kubeconfig == "" {
+		o.Kubeconfig = os.Getenv("KUBECONFIG")
 	}
 
 	return nil
@@ -37,9 +63,43 @@ func (o *Options) Validate() error {
 		return fmt.Errorf("must provide a token to create")
 	}
 
-	// TODO: Validate that the token has the correct/expected format
+	if !bootstraptokenutil.IsValidBootstrapToken(o.Token) {
+		return fmt.Errorf("token must be of form %q", bootstraptokenapi.BootstrapTokenPattern)
+	}
+
+	if o.Kubeconfig == "" {
+		return fmt.Errorf("must provide a path to a kubeconfig")
+	}
+
+	if o.Validity <= 0 {
+		return fmt.Errorf("validity must be positive")
+	}
+
+	if err := bootstraptokenutil.ValidateUsages(o.Usages); err != nil {
+		return err
+	}
+
+	if o.PrintJoinCommand {
+		// `gardenadm join` authenticates with the token and verifies the cluster-info ConfigMap signed with it.
+		for _, usage := range []string{"authentication", "signing"} {
+			if !slices.Contains(o.Usages, usage) {
+				return fmt.Errorf("the token must have the %q usage for printing the join command", usage)
+			}
+		}
+	}
+
+	if !o.PrintJoinCommand && len(o.WorkerPoolName) > 0 {
+		return fmt.Errorf("the worker pool name can only be specified when printing the join command")
+	}
 
 	return nil
 }
 
-func (o *Options) addFlags(_ *pflag.FlagSet) {}
+func (o *Options) addFlags(fs *pflag.FlagSet) {
+	fs.StringVarP(&o.Kubeconfig, "kubeconfig", "k", "", "Path to the kubeconfig file pointing to the autonomous shoot cluster (defaults to the KUBECONFIG environment variable)")
+	fs.StringVarP(&o.Description, "description", "d", "", "Description of the bootstrap token")
+	fs.DurationVar(&o.Validity, "validity", time.Hour, "Validity duration of the bootstrap token, i.e., the token expires after this duration")
+	fs.StringSliceVar(&o.Usages, "usages", bootstraptokenapi.KnownTokenUsages, fmt.Sprintf("Usages of the bootstrap token (valid values are %v)", bootstraptokenapi.KnownTokenUsages))
+	fs.BoolVar(&o.PrintJoinCommand, "print-join-command", false, "Print the complete 'gardenadm join' command for the token instead of only the token")
+	fs.StringVarP(&o.WorkerPoolName, "worker-pool-name", "w", "", "Name of the worker pool for which the join command is printed (can be omitted if the cluster only has one worker pool)")
+}
@@ -5,6 +5,8 @@
 package create_test
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
@@ -15,7 +17,7 @@ var _ = Describe("Options", func() {
 	var (
 		options *Options
 
-		token = "some.token"
+		token = "foo123.bar4567890baz123"
 	)
 
 	BeforeEach(func() {
@@ -30,19 +32,77 @@ var _ = Describe("Options", func() {
 
 		It("should generate a random token", func() {
 			Expect(options.Complete(nil)).To(Succeed())
-			Expect(options.Token).To(Equal("foo123.bar4567890baz123"))
+			Expect(options.Token).To(MatchRegexp(`^[a-z0-9]{6}\.[a-z0-9]{16}$`))
+		})
+
+		It("should default the kubeconfig from the environment", func() {
+			GinkgoT().Setenv("KUBECONFIG", "some-path-to-kubeconfig")
+
+			Expect(options.Complete(nil)).To(Succeed())
+			Expect(options.Kubeconfig).To(Equal("some-path-to-kubeconfig"))
 		})
 	})
 
 	Describe("#Validate", func() {
-		It("should pass for valid options", func() {
-			options.Token = "foo"
+		BeforeEach(func() {
+			options.Token = token
+			options.Kubeconfig = "some-path-to-kubeconfig"
+			options.Validity = time.Hour
+			options.Usages = []string{"signing", "authentication"}
+		})
 
+		It("should pass for valid options", func() {
 			Expect(options.Validate()).To(Succeed())
 		})
 
 		It("should fail because token is not set", func() {
+			options.Token = ""
+
 			Expect(options.Validate()).To(MatchError(ContainSubstring("must provide a token to create")))
 		})
+
+		It("should fail because token has an invalid format", func() {
+			options.Token = "foo"
+
+			Expect(options.Validate()).To(MatchError(ContainSubstring("token must be of form")))
+		})
+
+		It("should fail because kubeconfig is not set", func() {
+			options.Kubeconfig = ""
+
+			Expect(options.Validate()).To(MatchError(ContainSubstring("must provide a path to a kubeconfig")))
+		})
+
+		It("should fail because validity is not positive", func() {
+			options.Validity = 0
+
+			Expect(options.Validate()).To(MatchError(ContainSubstring("validity must be positive")))
+		})
+
+		It("should fail because usages are invalid", func() {
+			options.Usages = []string{"foo"}
+
+			Expect(options.Validate()).To(MatchError(ContainSubstring("invalid bootstrap token usage string")))
+		})
+
+		It("should pass when printing the join command for a worker pool", func() {
+			options.PrintJoinCommand = true
+			options.WorkerPoolName = "pool1"
+
+			Expect(options.Validate()).To(Succeed())
+		})
+
+		It("should fail when printing the join command for a token without the signing usage", func() {
+			options.PrintJoinCommand = true
+			options.Usages = []string{"authentication"}
+
+			Expect(options.Validate()).To(MatchError(ContainSubstring(`the token must have the "signing" usage`)))
+		})
+
+		It("should fail because the worker pool name is set without printing the join command", func() {
+			options.WorkerPoolName = "pool1"
+
+			Expect(options.Validate()).To(MatchError(ContainSubstring("can only be specified when printing the join command")))
+		})
 	})
 })
@@ -10,6 +10,10 @@ import (
 
 	"github.com/spf13/cobra"
 	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	gardenadmcmd "github.com/gardener/gardener/pkg/gardenadm/cmd"
+	"github.com/gardener/gardener/pkg/utils/kubernetes/bootstraptoken"
 )
 
 // NewCommand creates a new cobra.Command.
@@ -19,8 +23,9 @@ func NewCommand(ioStreams genericiooptions.IOStreams) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "delete [token-id]",
 		Short: "Delete a bootstrap token on the server",
-		Long: "This command will delete a bootstrap token for you." +
-			"The [token-id] is the ID of the token of the form \"[a-z0-9]{6}\" to delete",
+		Long: "This command will delete a bootstrap token for you. " +
+			"The [token-id] is the ID of the token of the form \"[a-z0-9]{6}\" to delete. " +
+			"Alternatively, the full token of the form \"[a-z0-9]{6}.[a-z0-9]{16}\" can be provided.",
 
 		Example: `# Delete a bootstrap token with id "foo123" on the server
 gardenadm token delete foo123`,
@@ -45,7 +50,16 @@ gardenadm token delete foo123`,
 	return cmd
 }
 
-func run(_ context.Context, ioStreams genericiooptions.IOStreams, _ *Options) error {
-	fmt.Fprint(ioStreams.Out, "not implemented")
+func run(ctx context.Context, ioStreams genericiooptions.IOStreams, opts *Options) error {
+	c, err := gardenadmcmd.NewClientFromFile(opts.Kubeconfig, kubernetes.ShootScheme)
+	if err != nil {
+		return fmt.Errorf("failed creating client: %w", err)
+	}
+
+	if err := bootstraptoken.Delete(ctx, c, opts.TokenID); err != nil {
+		return fmt.Errorf("failed deleting bootstrap token: %w", err)
+	}
+
+	fmt.Fprintf(ioStreams.Out, "bootstrap token %q deleted\n", opts.TokenID)
 	return nil
 }
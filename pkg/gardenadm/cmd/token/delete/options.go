@@ -6,13 +6,20 @@ package delete
 
 import (
 	"fmt"
+	"os"
 	"strings"
 
 	"github.com/spf13/pflag"
+	bootstraptokenapi "k8s.io/cluster-bootstrap/token/api"
+	bootstraptokenutil "k8s.io/cluster-bootstrap/token/util"
+
+	"github.com/gardener/gardener/pkg/utils/kubernetes/bootstraptoken"
 )
 
 // Options contains options for this command.
 type Options struct {
+	// Kubeconfig is the path to the kubeconfig file pointing to the autonomous shoot cluster.
+	Kubeconfig string
 	// TokenID is the ID of the token to delete.
 	TokenID string
 }
@@ -23,6 +30,19 @@ func (o *Options) Complete(args []string) error {
 		o.TokenID = strings.TrimSpace(args[0])
 	}
 
+	// Allow passing the full token instead of only its ID.
+	if bootstraptokenutil.IsValidBootstrapToken(o.TokenID) {
+		tokenID, _, err := bootstraptoken.Parse(o.TokenID)
+		if err != nil {
+			return err
+		}
+		o.TokenID = tokenID
+	}
+
+	if o.Kubeconfig == "" {
+		o.Kubeconfig = os.Getenv("KUBECONFIG")
+	}
+
 	return nil
 }
 
@@ -32,7 +52,17 @@ func (o *Options) Validate() error {
 		return fmt.Errorf("must provide a token ID to delete")
 	}
 
+	if !bootstraptokenutil.IsValidBootstrapTokenID(o.TokenID) {
+		return fmt.Errorf("token ID must be of form %q", bootstraptokenapi.BootstrapTokenIDPattern)
+	}
+
+	if o.Kubeconfig == "" {
+		return fmt.Errorf("must provide a path to a kubeconfig")
+	}
+
 	return nil
 }
 
-func (o *Options) addFlags(_ *pflag.FlagSet) {}
+func (o *Options) addFlags(fs *pflag.FlagSet) {
+	fs.StringVarP(&o.Kubeconfig, "kubeconfig", "k", "", "Path to the kubeconfig file pointing to the autonomous shoot cluster (defaults to the KUBECONFIG environment variable)")
+}
@@ -15,7 +15,7 @@ var _ = Describe("Options", func() {
 	var (
 		options *Options
 
-		tokenID = "token-id"
+		tokenID = "foo123"
 	)
 
 	BeforeEach(func() {
@@ -27,17 +27,39 @@ var _ = Describe("Options", func() {
 			Expect(options.Complete([]string{tokenID})).To(Succeed())
 			Expect(options.TokenID).To(Equal(tokenID))
 		})
+
+		It("should extract the token ID from a full token", func() {
+			Expect(options.Complete([]string{"foo123.bar4567890baz123"})).To(Succeed())
+			Expect(options.TokenID).To(Equal(tokenID))
+		})
 	})
 
 	Describe("#Validate", func() {
-		It("should pass for valid options", func() {
-			options.TokenID = "foo123"
+		BeforeEach(func() {
+			options.TokenID = tokenID
+			options.Kubeconfig = "some-path-to-kubeconfig"
+		})
 
+		It("should pass for valid options", func() {
 			Expect(options.Validate()).To(Succeed())
 		})
 
 		It("should fail because token ID is not set", func() {
+			options.TokenID = ""
+
 			Expect(options.Validate()).To(MatchError(ContainSubstring("must provide a token ID to delete")))
 		})
+
+		It("should fail because token ID has an invalid format", func() {
+			options.TokenID = "token-id"
+
+			Expect(options.Validate()).To(MatchError(ContainSubstring("token ID must be of form")))
+		})
+
+		It("should fail because kubeconfig is not set", func() {
+			options.Kubeconfig = ""
+
+			Expect(options.Validate()).To(MatchError(ContainSubstring("must provide a path to a kubeconfig")))
+		})
 	})
 })
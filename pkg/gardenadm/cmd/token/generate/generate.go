@@ -10,6 +10,8 @@ import (
 
 	"github.com/spf13/cobra"
 	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/gardener/gardener/pkg/utils/kubernetes/bootstraptoken"
 )
 
 // NewCommand creates a new cobra.Command.
@@ -19,7 +21,8 @@ func NewCommand(ioStreams genericiooptions.IOStreams) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "generate",
 		Short: "Generate a random bootstrap token",
-		Long:  "Generate a random bootstrap token",
+		Long: "This command will print out a randomly-generated bootstrap token of the form \"[a-z0-9]{6}.[a-z0-9]{16}\". " +
+			"The token is not created on the server, use \"gardenadm token create [token]\" for this purpose.",
 
 		Example: `# Generate a random bootstrap token
 gardenadm token generate`,
@@ -43,6 +46,11 @@ gardenadm token generate`,
 }
 
 func run(_ context.Context, ioStreams genericiooptions.IOStreams, _ *Options) error {
-	fmt.Fprint(ioStreams.Out, "not implemented")
+	token, err := bootstraptoken.Generate()
+	if err != nil {
+		return fmt.Errorf("failed generating random token: %w", err)
+	}
+
+	fmt.Fprintln(ioStreams.Out, token)
 	return nil
 }
@@ -6,12 +6,36 @@ package list
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
+	"slices"
+	"strings"
 
 	"github.com/spf13/cobra"
 	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"k8s.io/cli-runtime/pkg/printers"
+	bootstraptokenapi "k8s.io/cluster-bootstrap/token/api"
+	"sigs.k8s.io/yaml"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	gardenadmcmd "github.com/gardener/gardener/pkg/gardenadm/cmd"
+	"github.com/gardener/gardener/pkg/utils/kubernetes/bootstraptoken"
 )
 
+// Token contains the information about a bootstrap token which is printed by this command. The token secret is not
+// part of it.
+type Token struct {
+	// TokenID is the ID of the token.
+	TokenID string `json:"tokenID"`
+	// Description is the description of the token.
+	Description string `json:"description,omitempty"`
+	// Expiration is the expiration timestamp of the token in RFC3339 format.
+	Expiration string `json:"expiration,omitempty"`
+	// Usages are the usages of the token.
+	Usages []string `json:"usages,omitempty"`
+}
+
 // NewCommand creates a new cobra.Command.
 func NewCommand(ioStreams genericiooptions.IOStreams) *cobra.Command {
 	opts := &Options{}
@@ -19,10 +43,13 @@ func NewCommand(ioStreams genericiooptions.IOStreams) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "list",
 		Short: "List all bootstrap tokens on the server",
-		Long:  "List all bootstrap tokens on the server",
+		Long:  "List all bootstrap tokens on the server. The token secrets are not printed.",
 
 		Example: `# List all bootstrap tokens on the server
-gardenadm token list`,
+gardenadm token list
+
+# List all bootstrap tokens on the server in JSON format
+gardenadm token list --output json`,
 
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			if err := opts.Complete(); err != nil {
@@ -42,7 +69,58 @@ gardenadm token list`,
 	return cmd
 }
 
-func run(_ context.Context, ioStreams genericiooptions.IOStreams, _ *Options) error {
-	fmt.Fprint(ioStreams.Out, "not implemented")
-	return nil
+func run(ctx context.Context, ioStreams genericiooptions.IOStreams, opts *Options) error {
+	c, err := gardenadmcmd.NewClientFromFile(opts.Kubeconfig, kubernetes.ShootScheme)
+	if err != nil {
+		return fmt.Errorf("failed creating client: %w", err)
+	}
+
+	secrets, err := bootstraptoken.List(ctx, c)
+	if err != nil {
+		return fmt.Errorf("failed listing bootstrap tokens: %w", err)
+	}
+
+	tokens := make([]Token, 0, len(secrets))
+	for _, secret := range secrets {
+		tokens = append(tokens, Token{
+			TokenID:     string(secret.Data[bootstraptokenapi.BootstrapTokenIDKey]),
+			Description: string(secret.Data[bootstraptokenapi.BootstrapTokenDescriptionKey]),
+			Expiration:  string(secret.Data[bootstraptokenapi.BootstrapTokenExpirationKey]),
+			Usages:      bootstraptoken.UsagesFromSecretData(secret.Data),
+		})
+	}
+
+	slices.SortFunc(tokens, func(a, b Token) int {
+		return strings.Compare(a.TokenID, b.TokenID)
+	})
+
+	return printTokens(ioStreams.Out, opts.OutputFormat, tokens)
+}
+
+func printTokens(out io.Writer, outputFormat string, tokens []Token) error {
+	switch outputFormat {
+	case OutputFormatJSON:
+		data, err := json.MarshalIndent(tokens, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(out, string(data))
+		return err
+
+	case OutputFormatYAML:
+		data, err := yaml.Marshal(tokens)
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprint(out, string(data))
+		return err
+
+	default:
+		w := printers.GetNewTabWriter(out)
+		fmt.Fprintln(w, "TOKEN ID\tEXPIRES\tUSAGES\tDESCRIPTION")
+		for _, token := range tokens {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", token.TokenID, token.Expiration, strings.Join(token.Usages, ","), token.Description)
+		}
+		return w.Flush()
+	}
 }
@@ -6,35 +6,104 @@ package list_test
 
 import (
 	"bytes"
+	"context"
 	"io"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	gardenadmcmd "github.com/gardener/gardener/pkg/gardenadm/cmd"
 	. "github.com/gardener/gardener/pkg/gardenadm/cmd/token/list"
+	"github.com/gardener/gardener/pkg/utils/test"
 )
 
 var _ = Describe("List", func() {
 	var (
-		ioStreams genericiooptions.IOStreams
-		out       *bytes.Buffer
-		cmd       *cobra.Command
+		ctx        = context.Background()
+		ioStreams  genericiooptions.IOStreams
+		out        *bytes.Buffer
+		cmd        *cobra.Command
+		fakeClient client.Client
 	)
 
 	BeforeEach(func() {
 		ioStreams, _, out, _ = genericiooptions.NewTestIOStreams()
 		cmd = NewCommand(ioStreams)
+		cmd.SetContext(ctx)
+
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.ShootScheme).Build()
+		DeferCleanup(test.WithVar(&gardenadmcmd.NewClientFromFile, func(string, *runtime.Scheme) (client.Client, error) { return fakeClient, nil }))
+
+		Expect(cmd.Flags().Set("kubeconfig", "some-path-to-kubeconfig")).To(Succeed())
+
+		for _, tokenID := range []string{"foo123", "bar456"} {
+			Expect(fakeClient.Create(ctx, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "bootstrap-token-" + tokenID, Namespace: "kube-system"},
+				Type:       "bootstrap.kubernetes.io/token",
+				Data: map[string][]byte{
+					"token-id":                       []byte(tokenID),
+					"token-secret":                   []byte("bar4567890baz123"),
+					"description":                    []byte("token " + tokenID),
+					"expiration":                     []byte("2024-12-01T10:00:00Z"),
+					"usage-bootstrap-authentication": []byte("true"),
+					"usage-bootstrap-signing":        []byte("true"),
+				},
+			})).To(Succeed())
+		}
+		Expect(fakeClient.Create(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "kube-system"}})).To(Succeed())
 	})
 
 	Describe("#RunE", func() {
-		It("should return the expected output", func() {
+		It("should print the tokens as a table", func() {
+			Expect(cmd.RunE(cmd, nil)).To(Succeed())
+
+			output, err := io.ReadAll(out)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(output)).To(Equal(`TOKEN ID   EXPIRES                USAGES                   DESCRIPTION
+bar456     2024-12-01T10:00:00Z   authentication,signing   token bar456
+foo123     2024-12-01T10:00:00Z   authentication,signing   token foo123
+`))
+			Expect(string(output)).NotTo(ContainSubstring("bar4567890baz123"))
+		})
+
+		It("should print the tokens as JSON", func() {
+			Expect(cmd.Flags().Set("output", "json")).To(Succeed())
+
+			Expect(cmd.RunE(cmd, nil)).To(Succeed())
+
+			output, err := io.ReadAll(out)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(output)).To(MatchJSON(`[
+  {"tokenID": "bar456", "description": "token bar456", "expiration": "2024-12-01T10:00:00Z", "usages": ["authentication", "signing"]},
+  {"tokenID": "foo123", "description": "token foo123", "expiration": "2024-12-01T10:00:00Z", "usages": ["authentication", "signing"]}
+]`))
+		})
+
+		It("should print the tokens as YAML", func() {
+			Expect(cmd.Flags().Set("output", "yaml")).To(Succeed())
+
 			Expect(cmd.RunE(cmd, nil)).To(Succeed())
 
 			output, err := io.ReadAll(out)
 			Expect(err).NotTo(HaveOccurred())
-			Expect(string(output)).To(Equal("not implemented"))
+			Expect(string(output)).To(MatchYAML(`
+- tokenID: bar456
+  description: token bar456
+  expiration: "2024-12-01T10:00:00Z"
+  usages: [authentication, signing]
+- tokenID: foo123
+  description: token foo123
+  expiration: "2024-12-01T10:00:00Z"
+  usages: [authentication, signing]
+`))
 		})
 	})
 })
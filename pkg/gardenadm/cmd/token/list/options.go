@@ -5,16 +5,50 @@
 package list
 
 import (
+	"fmt"
+	"os"
+
 	"github.com/spf13/pflag"
 )
 
+const (
+	// OutputFormatJSON is the JSON output format.
+	OutputFormatJSON = "json"
+	// OutputFormatYAML is the YAML output format.
+	OutputFormatYAML = "yaml"
+)
+
 // Options contains options for this command.
-type Options struct{}
+type Options struct {
+	// Kubeconfig is the path to the kubeconfig file pointing to the autonomous shoot cluster.
+	Kubeconfig string
+	// OutputFormat is the format of the output. If empty, the tokens are printed as a table.
+	OutputFormat string
+}
 
 // Complete completes the options.
-func (o *Options) Complete() error { return nil }
+func (o *Options) Complete() error {
+	if o.Kubeconfig == "" {
+		o.Kubeconfig = os.Getenv("KUBECONFIG")
+	}
+
+	return nil
+}
 
 // Validate validates the options.
-func (o *Options) Validate() error { return nil }
+func (o *Options) Validate() error {
+	if o.Kubeconfig == "" {
+		return fmt.Errorf("must provide a path to a kubeconfig")
+	}
+
+	if o.OutputFormat != "" && o.OutputFormat != OutputFormatJSON && o.OutputFormat != OutputFormatYAML {
+		return fmt.Errorf("output format must be one of %q, %q", OutputFormatJSON, OutputFormatYAML)
+	}
+
+	return nil
+}
 
-func (o *Options) addFlags(_ *pflag.FlagSet) {}
+func (o *Options) addFlags(fs *pflag.FlagSet) {
+	fs.StringVarP(&o.Kubeconfig, "kubeconfig", "k", "", "Path to the kubeconfig file pointing to the autonomous shoot cluster (defaults to the KUBECONFIG environment variable)")
+	fs.StringVarP(&o.OutputFormat, "output", "o", "", fmt.Sprintf("Output format, one of %q, %q (defaults to a table)", OutputFormatJSON, OutputFormatYAML))
+}
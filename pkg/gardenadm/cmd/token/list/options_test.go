@@ -21,14 +21,33 @@ var _ = Describe("Options", func() {
 	})
 
 	Describe("#Complete", func() {
-		It("should return nil", func() {
+		It("should default the kubeconfig from the environment", func() {
+			GinkgoT().Setenv("KUBECONFIG", "some-path-to-kubeconfig")
+
 			Expect(options.Complete()).To(Succeed())
+			Expect(options.Kubeconfig).To(Equal("some-path-to-kubeconfig"))
 		})
 	})
 
 	Describe("#Validate", func() {
-		It("should return nil", func() {
+		BeforeEach(func() {
+			options.Kubeconfig = "some-path-to-kubeconfig"
+		})
+
+		It("should pass for valid options", func() {
 			Expect(options.Validate()).To(Succeed())
 		})
+
+		It("should fail because kubeconfig is not set", func() {
+			options.Kubeconfig = ""
+
+			Expect(options.Validate()).To(MatchError(ContainSubstring("must provide a path to a kubeconfig")))
+		})
+
+		It("should fail because output format is invalid", func() {
+			options.OutputFormat = "foo"
+
+			Expect(options.Validate()).To(MatchError(ContainSubstring("output format must be one of")))
+		})
 	})
 })
@@ -0,0 +1,142 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/spf13/afero"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	bootstraptokenapi "k8s.io/cluster-bootstrap/token/api"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	retryutils "github.com/gardener/gardener/pkg/utils/retry"
+)
+
+const (
+	// tokenExpiration is the requested expiration of the shoot access tokens. kube-apiserver caps it to the maximum
+	// token expiration configured for the shoot. The tokens are renewed by running `gardenadm init` again, see
+	// RenewalTime.
+	tokenExpiration = 90 * 24 * time.Hour
+	// modeToken is the mode of the token files.
+	modeToken = 0600
+)
+
+var (
+	// WaitForAPIServerTimeout is the timeout for waiting until kube-apiserver is reachable. Exposed for testing.
+	WaitForAPIServerTimeout = 5 * time.Minute
+	// WaitForAPIServerInterval is the interval for checking whether kube-apiserver is reachable. Exposed for testing.
+	WaitForAPIServerInterval = 5 * time.Second
+)
+
+// Bootstrap performs the steps which usually are done by gardener-resource-manager once the kube-apiserver of the
+// control plane rendered by Render is running: It creates the resources of the rendered ManagedResources (e.g., the
+// RBAC resources for kube-controller-manager and kube-scheduler) and the cluster-info ConfigMap, requests tokens for
+// the ServiceAccounts of the control plane components, and writes them into the volumes of their static pods below the
+// given root directory. The given client must use the bootstrap kubeconfig of the result. It returns the time at which
+// the first of the requested tokens expires.
+func Bootstrap(ctx context.Context, log logr.Logger, c client.Client, fs afero.Afero, rootDirectory string, result *Result) (time.Time, error) {
+	log.Info("Waiting until kube-apiserver is reachable")
+	if err := retryutils.UntilTimeout(ctx, WaitForAPIServerInterval, WaitForAPIServerTimeout, func(ctx context.Context) (bool, error) {
+		if err := c.List(ctx, &corev1.NamespaceList{}, client.Limit(1)); err != nil {
+			return retryutils.MinorError(err)
+		}
+		return retryutils.Ok()
+	}); err != nil {
+		return time.Time{}, fmt.Errorf("failed waiting for kube-apiserver: %w", err)
+	}
+
+	log.Info("Creating resources for control plane components", "count", len(result.ShootResources))
+	for _, obj := range result.ShootResources {
+		if err := createOrUpdate(ctx, c, obj); err != nil {
+			return time.Time{}, fmt.Errorf("failed applying %T %s: %w", obj, client.ObjectKeyFromObject(obj), err)
+		}
+	}
+
+	var expiration time.Time
+
+	for _, shootAccessToken := range result.ShootAccessTokens {
+		log.Info("Requesting token for control plane component", "serviceAccount", shootAccessToken.ServiceAccount)
+
+		token, expirationTimestamp, err := requestToken(ctx, c, shootAccessToken.ServiceAccount)
+		if err != nil {
+			return time.Time{}, err
+		}
+		log.Info("Requested token for control plane component", "serviceAccount", shootAccessToken.ServiceAccount, "expirationTimestamp", expirationTimestamp)
+
+		if expiration.IsZero() || expirationTimestamp.Before(expiration) {
+			expiration = expirationTimestamp
+		}
+
+		for _, path := range shootAccessToken.Paths {
+			path = filepath.Join(rootDirectory, path)
+			if err := fs.MkdirAll(filepath.Dir(path), 0755); err != nil {
+				return time.Time{}, fmt.Errorf("failed creating directory %s: %w", filepath.Dir(path), err)
+			}
+			if err := fs.WriteFile(path, []byte(token), modeToken); err != nil {
+				return time.Time{}, fmt.Errorf("failed writing token file %s: %w", path, err)
+			}
+		}
+	}
+
+	return expiration, nil
+}
+
+// RenewalTime returns the time at which the shoot access tokens requested by Bootstrap should be renewed, given the
+// time at which they were requested and the time at which the first of them expires. Similar to the token-requestor
+// controller of gardener-resource-manager, the tokens should be renewed after 80% of their lifetime.
+func RenewalTime(requested, expiration time.Time) time.Time {
+	return requested.Add(expiration.Sub(requested) * 80 / 100)
+}
+
+func createOrUpdate(ctx context.Context, c client.Client, obj client.Object) error {
+	existing := obj.DeepCopyObject().(client.Object)
+	if err := c.Get(ctx, client.ObjectKeyFromObject(obj), existing); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+		return c.Create(ctx, obj)
+	}
+
+	// The signatures of the cluster-info ConfigMap are added by the bootstrapsigner controller of kube-controller-manager
+	// and must be kept.
+	if configMap, ok := obj.(*corev1.ConfigMap); ok {
+		for key, value := range existing.(*corev1.ConfigMap).Data {
+			if strings.HasPrefix(key, bootstraptokenapi.JWSSignatureKeyPrefix) {
+				configMap.Data[key] = value
+			}
+		}
+	}
+
+	obj.SetResourceVersion(existing.GetResourceVersion())
+	return c.Update(ctx, obj)
+}
+
+func requestToken(ctx context.Context, c client.Client, key client.ObjectKey) (string, time.Time, error) {
+	serviceAccount := &corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Namespace: key.Namespace, Name: key.Name}}
+	if err := c.Create(ctx, serviceAccount); err != nil && !apierrors.IsAlreadyExists(err) {
+		return "", time.Time{}, fmt.Errorf("failed creating service account %s: %w", key, err)
+	}
+
+	tokenRequest := &authenticationv1.TokenRequest{
+		Spec: authenticationv1.TokenRequestSpec{
+			ExpirationSeconds: ptr.To(int64(tokenExpiration / time.Second)),
+		},
+	}
+	if err := c.SubResource("token").Create(ctx, serviceAccount, tokenRequest); err != nil {
+		return "", time.Time{}, fmt.Errorf("failed requesting token for service account %s: %w", key, err)
+	}
+
+	return tokenRequest.Status.Token, tokenRequest.Status.ExpirationTimestamp.Time, nil
+}
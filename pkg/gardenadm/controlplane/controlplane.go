@@ -0,0 +1,753 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/v1alpha1"
+	"github.com/go-logr/logr"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/authentication/user"
+	clientcmdlatest "k8s.io/client-go/tools/clientcmd/api/latest"
+	clientcmdv1 "k8s.io/client-go/tools/clientcmd/api/v1"
+	bootstraptokenapi "k8s.io/cluster-bootstrap/token/api"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener/imagevector"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	fakekubernetes "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	"github.com/gardener/gardener/pkg/component"
+	"github.com/gardener/gardener/pkg/component/apiserver"
+	"github.com/gardener/gardener/pkg/component/etcd/etcd"
+	etcdconstants "github.com/gardener/gardener/pkg/component/etcd/etcd/constants"
+	kubeapiserver "github.com/gardener/gardener/pkg/component/kubernetes/apiserver"
+	kubeapiserverconstants "github.com/gardener/gardener/pkg/component/kubernetes/apiserver/constants"
+	kubecontrollermanager "github.com/gardener/gardener/pkg/component/kubernetes/controllermanager"
+	kubescheduler "github.com/gardener/gardener/pkg/component/kubernetes/scheduler"
+	"github.com/gardener/gardener/pkg/component/shared"
+	"github.com/gardener/gardener/pkg/gardenadm/staticpod"
+	shootpkg "github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
+	"github.com/gardener/gardener/pkg/utils/flow"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	"github.com/gardener/gardener/pkg/utils/gardener/tokenrequest"
+	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+	"github.com/gardener/gardener/pkg/utils/managedresources"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
+)
+
+const (
+	// Namespace is the namespace in which the control plane components are rendered.
+	Namespace = metav1.NamespaceSystem
+	// SecretsManagerIdentity is the identity of the secrets manager used for rendering the control plane.
+	SecretsManagerIdentity = "gardenadm"
+
+	secretNameBootstrapKubeconfig = "gardenadm-bootstrap-kubeconfig" // #nosec G101 -- No credential.
+	roleNameClusterInfo           = "gardenadm:bootstrap-signer-clusterinfo"
+)
+
+// Options contains options for rendering the control plane.
+type Options struct {
+	// AdvertiseAddress is the IP address of this node. etcd and kube-apiserver listen on it, so that further control
+	// plane nodes and worker nodes can reach them.
+	AdvertiseAddress net.IP
+	// EtcdInitialClusters contains the initial clusters (comma-separated list of "<member-name>=<peer-url>") of the
+	// etcd-main and etcd-events members of this node, keyed by role. If set, the members join the existing clusters
+	// instead of forming new ones, see `gardenadm join`.
+	EtcdInitialClusters map[string]string
+}
+
+// Result contains the result of rendering the control plane.
+type Result struct {
+	// Files are the files which must be written to the host, i.e., the static pod manifests and the contents of the
+	// volumes referenced by them.
+	Files []extensionsv1alpha1.File
+	// Secrets are the secrets managed by the secrets manager. They must be passed to subsequent invocations of Render
+	// so that existing certificates and keys are reused.
+	Secrets []corev1.Secret
+	// BootstrapKubeconfig is a kubeconfig with a short-lived client certificate for the kube-apiserver running on this
+	// node. It is used for creating the resources required by the control plane components once kube-apiserver is up.
+	BootstrapKubeconfig []byte
+	// ShootResources are the resources which are usually applied to the shoot cluster by gardener-resource-manager
+	// (e.g., the RBAC resources for kube-controller-manager and kube-scheduler), and the cluster-info ConfigMap used by
+	// `gardenadm join` for discovering the cluster.
+	ShootResources []client.Object
+	// ShootAccessTokens are the tokens which must be requested for the control plane components, see Bootstrap.
+	ShootAccessTokens []ShootAccessToken
+}
+
+// ShootAccessToken describes a token for a ServiceAccount in the shoot cluster which is used by a control plane
+// component.
+type ShootAccessToken struct {
+	// ServiceAccount is the key of the ServiceAccount for which the token is requested.
+	ServiceAccount client.ObjectKey
+	// Paths are the paths of the files on the host into which the token must be written.
+	Paths []string
+}
+
+// Render renders the static pods for the control plane components (etcd-main, etcd-events, kube-apiserver,
+// kube-controller-manager, kube-scheduler) of the given shoot. It reuses the component deployers which are also used
+// by gardenlet, but runs them against an in-memory client. Afterward, the resulting Deployments and Etcds are
+// translated to static pods. The given secrets are the result of a previous invocation and are reused.
+func Render(ctx context.Context, log logr.Logger, clock clock.Clock, shoot *gardencorev1beta1.Shoot, existingSecrets []corev1.Secret, opts Options) (*Result, error) {
+	if opts.AdvertiseAddress == nil {
+		return nil, fmt.Errorf("advertise address must be set")
+	}
+
+	r, err := newRenderer(log, shoot, existingSecrets, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.run(ctx, clock); err != nil {
+		return nil, err
+	}
+
+	files, shootAccessTokens, err := r.translate(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	shootResources, err := r.shootResources(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	bootstrapKubeconfigSecret, found := r.secretsManager.Get(secretNameBootstrapKubeconfig)
+	if !found {
+		return nil, fmt.Errorf("secret %q not found", secretNameBootstrapKubeconfig)
+	}
+
+	secretList := &corev1.SecretList{}
+	if err := r.client.List(ctx, secretList, client.InNamespace(Namespace), client.MatchingLabels{
+		secretsmanager.LabelKeyManagedBy:       secretsmanager.LabelValueSecretsManager,
+		secretsmanager.LabelKeyManagerIdentity: SecretsManagerIdentity,
+	}); err != nil {
+		return nil, fmt.Errorf("failed listing secrets: %w", err)
+	}
+
+	for i := range secretList.Items {
+		secretList.Items[i].ResourceVersion = ""
+	}
+
+	return &Result{
+		Files:               files,
+		Secrets:             secretList.Items,
+		BootstrapKubeconfig: bootstrapKubeconfigSecret.Data[secretsutils.DataKeyKubeconfig],
+		ShootResources:      shootResources,
+		ShootAccessTokens:   shootAccessTokens,
+	}, nil
+}
+
+type renderer struct {
+	log                 logr.Logger
+	shoot               *gardencorev1beta1.Shoot
+	advertiseAddress    net.IP
+	etcdInitialClusters map[string]string
+	isWorkerless        bool
+	version             *semver.Version
+	networks            *shootpkg.Networks
+
+	client       client.Client
+	clientSet    kubernetes.Interface
+	gardenClient client.Client
+
+	secretsManager        secretsmanager.Interface
+	etcdMain              etcd.Interface
+	etcdEvents            etcd.Interface
+	kubeAPIServer         kubeapiserver.Interface
+	kubeControllerManager kubecontrollermanager.Interface
+	kubeScheduler         component.Deployer
+}
+
+func newRenderer(log logr.Logger, shoot *gardencorev1beta1.Shoot, existingSecrets []corev1.Secret, opts Options) (*renderer, error) {
+	shoot = shoot.DeepCopy()
+	kubernetes.GardenScheme.Default(shoot)
+
+	version, err := semver.NewVersion(shoot.Spec.Kubernetes.Version)
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing kubernetes version %q: %w", shoot.Spec.Kubernetes.Version, err)
+	}
+
+	isWorkerless := v1beta1helper.IsWorkerless(shoot)
+
+	networks, err := shootpkg.ToNetworks(shoot, isWorkerless)
+	if err != nil {
+		return nil, err
+	}
+
+	builder := fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme)
+	for _, secret := range existingSecrets {
+		builder.WithObjects(secret.DeepCopy())
+	}
+	c := builder.Build()
+
+	return &renderer{
+		log:                 log,
+		shoot:               shoot,
+		advertiseAddress:    opts.AdvertiseAddress,
+		etcdInitialClusters: opts.EtcdInitialClusters,
+		isWorkerless:        isWorkerless,
+		version:             version,
+		networks:            networks,
+		client:              c,
+		clientSet:           fakekubernetes.NewClientSetBuilder().WithClient(c).WithVersion(version.String()).Build(),
+		gardenClient:        fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build(),
+	}, nil
+}
+
+func (r *renderer) run(ctx context.Context, clock clock.Clock) error {
+	var (
+		g = flow.NewGraph("Control plane rendering")
+
+		initializeSecretsManagement = g.Add(flow.Task{
+			Name: "Initializing secrets management",
+			Fn: func(ctx context.Context) error {
+				return r.initializeSecretsManagement(ctx, clock)
+			},
+		})
+		initializeComponents = g.Add(flow.Task{
+			Name:         "Initializing control plane components",
+			Fn:           r.initializeComponents,
+			Dependencies: flow.NewTaskIDs(initializeSecretsManagement),
+		})
+		deployEtcdMain = g.Add(flow.Task{
+			Name:         "Rendering main etcd",
+			Fn:           func(ctx context.Context) error { return r.etcdMain.Deploy(ctx) },
+			Dependencies: flow.NewTaskIDs(initializeComponents),
+		})
+		deployEtcdEvents = g.Add(flow.Task{
+			Name:         "Rendering events etcd",
+			Fn:           func(ctx context.Context) error { return r.etcdEvents.Deploy(ctx) },
+			Dependencies: flow.NewTaskIDs(initializeComponents),
+		})
+		deployKubeAPIServer = g.Add(flow.Task{
+			Name:         "Rendering kube-apiserver",
+			Fn:           r.deployKubeAPIServer,
+			Dependencies: flow.NewTaskIDs(deployEtcdMain, deployEtcdEvents),
+		})
+		_ = g.Add(flow.Task{
+			Name:         "Rendering kube-controller-manager",
+			Fn:           r.deployKubeControllerManager,
+			Dependencies: flow.NewTaskIDs(deployKubeAPIServer),
+		})
+		_ = g.Add(flow.Task{
+			Name:         "Rendering kube-scheduler",
+			Fn:           func(ctx context.Context) error { return r.kubeScheduler.Deploy(ctx) },
+			Dependencies: flow.NewTaskIDs(deployKubeAPIServer),
+		})
+	)
+
+	if err := g.Compile().Run(ctx, flow.Opts{Log: r.log}); err != nil {
+		return flow.Errors(err)
+	}
+
+	return nil
+}
+
+func (r *renderer) initializeSecretsManagement(ctx context.Context, clock clock.Clock) error {
+	var err error
+	r.secretsManager, err = secretsmanager.New(ctx, r.log.WithName("secretsmanager"), clock, r.client, Namespace, SecretsManagerIdentity, secretsmanager.Config{})
+	if err != nil {
+		return fmt.Errorf("failed creating secrets manager: %w", err)
+	}
+
+	for _, config := range caCertConfigurations(r.isWorkerless) {
+		if _, err := r.secretsManager.Generate(ctx, config, secretsmanager.Persist(), secretsmanager.Rotate(secretsmanager.KeepOld)); err != nil {
+			return err
+		}
+	}
+
+	if _, err := tokenrequest.GenerateGenericTokenKubeconfig(ctx, r.secretsManager, Namespace, v1beta1constants.DeploymentNameKubeAPIServer); err != nil {
+		return err
+	}
+
+	return r.generateBootstrapKubeconfig(ctx)
+}
+
+// generateBootstrapKubeconfig generates a kubeconfig with a short-lived client certificate which is used for creating
+// the resources required by the control plane components (RBAC resources, ServiceAccounts and their tokens). Similar
+// to the bootstrapping of gardener-resource-manager in gardenlet, the certificate is in the system:masters group.
+func (r *renderer) generateBootstrapKubeconfig(ctx context.Context) error {
+	caBundleSecret, found := r.secretsManager.Get(v1beta1constants.SecretNameCACluster)
+	if !found {
+		return fmt.Errorf("secret %q not found", v1beta1constants.SecretNameCACluster)
+	}
+
+	_, err := r.secretsManager.Generate(ctx, &secretsutils.ControlPlaneSecretConfig{
+		Name: secretNameBootstrapKubeconfig,
+		CertificateSecretConfig: &secretsutils.CertificateSecretConfig{
+			CommonName:                  "gardenadm:bootstrap",
+			Organization:                []string{user.SystemPrivilegedGroup},
+			CertType:                    secretsutils.ClientCert,
+			Validity:                    ptr.To(time.Hour),
+			SkipPublishingCACertificate: true,
+		},
+		KubeConfigRequests: []secretsutils.KubeConfigRequest{{
+			ClusterName:   Namespace,
+			APIServerHost: net.JoinHostPort("127.0.0.1", strconv.Itoa(kubeapiserverconstants.Port)),
+			CAData:        caBundleSecret.Data[secretsutils.DataKeyCertificateBundle],
+		}},
+	}, secretsmanager.SignedByCA(v1beta1constants.SecretNameCAClient))
+	return err
+}
+
+func caCertConfigurations(isWorkerless bool) []secretsutils.ConfigInterface {
+	certificateSecretConfigs := []secretsutils.ConfigInterface{
+		&secretsutils.CertificateSecretConfig{Name: v1beta1constants.SecretNameCACluster, CommonName: "kubernetes", CertType: secretsutils.CACert},
+		&secretsutils.CertificateSecretConfig{Name: v1beta1constants.SecretNameCAClient, CommonName: "kubernetes-client", CertType: secretsutils.CACert},
+		&secretsutils.CertificateSecretConfig{Name: v1beta1constants.SecretNameCAETCD, CommonName: "etcd", CertType: secretsutils.CACert},
+		&secretsutils.CertificateSecretConfig{Name: v1beta1constants.SecretNameCAETCDPeer, CommonName: "etcd-peer", CertType: secretsutils.CACert},
+		&secretsutils.CertificateSecretConfig{Name: v1beta1constants.SecretNameCAFrontProxy, CommonName: "front-proxy", CertType: secretsutils.CACert},
+	}
+
+	if !isWorkerless {
+		certificateSecretConfigs = append(certificateSecretConfigs,
+			&secretsutils.CertificateSecretConfig{Name: v1beta1constants.SecretNameCAKubelet, CommonName: "kubelet", CertType: secretsutils.CACert},
+			&secretsutils.CertificateSecretConfig{Name: v1beta1constants.SecretNameCAMetricsServer, CommonName: "metrics-server", CertType: secretsutils.CACert},
+		)
+	}
+
+	return certificateSecretConfigs
+}
+
+func (r *renderer) initializeComponents(ctx context.Context) error {
+	var maintenanceTimeWindow gardencorev1beta1.MaintenanceTimeWindow
+	if r.shoot.Spec.Maintenance != nil && r.shoot.Spec.Maintenance.TimeWindow != nil {
+		maintenanceTimeWindow = *r.shoot.Spec.Maintenance.TimeWindow
+	}
+
+	newEtcd := func(role string, class etcd.Class) etcd.Interface {
+		return etcd.New(r.log, r.client, Namespace, r.secretsManager, etcd.Values{
+			Role:                     role,
+			Class:                    class,
+			Replicas:                 ptr.To[int32](1),
+			StorageCapacity:          "10Gi",
+			RuntimeKubernetesVersion: r.version,
+			MaintenanceTimeWindow:    maintenanceTimeWindow,
+			PriorityClassName:        staticpod.PriorityClassName,
+		})
+	}
+	r.etcdMain = newEtcd(v1beta1constants.ETCDRoleMain, etcd.ClassImportant)
+	r.etcdEvents = newEtcd(v1beta1constants.ETCDRoleEvents, etcd.ClassNormal)
+
+	var err error
+	r.kubeAPIServer, err = shared.NewKubeAPIServer(
+		ctx,
+		r.clientSet,
+		r.gardenClient,
+		Namespace,
+		r.shoot.ObjectMeta,
+		r.version,
+		r.version,
+		r.secretsManager,
+		"",
+		r.shoot.Spec.Kubernetes.KubeAPIServer,
+		apiserver.AutoscalingConfig{MinReplicas: 1, MaxReplicas: 1, ScaleDownDisabled: true},
+		kubeapiserver.VPNConfig{Enabled: false},
+		staticpod.PriorityClassName,
+		r.isWorkerless,
+		r.shoot.Spec.Kubernetes.EnableStaticTokenKubeconfig,
+		nil,
+		nil,
+		nil,
+		nil,
+	)
+	if err != nil {
+		return err
+	}
+
+	r.kubeControllerManager, err = shared.NewKubeControllerManager(
+		r.log,
+		r.clientSet,
+		Namespace,
+		r.version,
+		r.version,
+		r.secretsManager,
+		"",
+		r.shoot.Spec.Kubernetes.KubeControllerManager,
+		staticpod.PriorityClassName,
+		r.isWorkerless,
+		false,
+		nil,
+		kubecontrollermanager.ControllerWorkers{},
+		kubecontrollermanager.ControllerSyncPeriods{},
+		nil,
+	)
+	if err != nil {
+		return err
+	}
+
+	image, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameKubeScheduler, imagevectorutils.RuntimeVersion(r.version.String()), imagevectorutils.TargetVersion(r.version.String()))
+	if err != nil {
+		return err
+	}
+	r.kubeScheduler = kubescheduler.New(r.client, Namespace, r.secretsManager, r.version, r.version, image.String(), 1, r.shoot.Spec.Kubernetes.KubeScheduler)
+
+	return nil
+}
+
+func (r *renderer) deployKubeAPIServer(ctx context.Context) error {
+	externalHostname := "localhost"
+	if r.shoot.Spec.DNS != nil && r.shoot.Spec.DNS.Domain != nil {
+		externalHostname = gardenerutils.GetAPIServerDomain(*r.shoot.Spec.DNS.Domain)
+	}
+
+	var serviceAccountConfig *gardencorev1beta1.ServiceAccountConfig
+	if r.shoot.Spec.Kubernetes.KubeAPIServer != nil {
+		serviceAccountConfig = r.shoot.Spec.Kubernetes.KubeAPIServer.ServiceAccountConfig
+	}
+
+	return shared.DeployKubeAPIServer(
+		ctx,
+		r.client,
+		Namespace,
+		r.kubeAPIServer,
+		kubeapiserver.ComputeKubeAPIServerServiceAccountConfig(serviceAccountConfig, externalHostname, v1beta1helper.GetShootServiceAccountKeyRotationPhase(r.shoot.Status.Credentials)),
+		kubeapiserver.ServerCertificateConfig{
+			ExtraIPAddresses: append([]net.IP{net.ParseIP("127.0.0.1"), r.advertiseAddress}, r.networks.APIServer...),
+			ExtraDNSNames:    []string{"localhost", externalHostname},
+		},
+		kubeapiserver.SNIConfig{Enabled: false},
+		externalHostname,
+		externalHostname,
+		r.networks.Nodes,
+		r.networks.Services,
+		r.networks.Pods,
+		nil,
+		nil,
+		v1beta1helper.GetShootETCDEncryptionKeyRotationPhase(r.shoot.Status.Credentials),
+		false,
+	)
+}
+
+func (r *renderer) deployKubeControllerManager(ctx context.Context) error {
+	r.kubeControllerManager.SetReplicaCount(1)
+	r.kubeControllerManager.SetRuntimeConfig(r.kubeAPIServer.GetValues().RuntimeConfig)
+	r.kubeControllerManager.SetServiceNetworks(r.networks.Services)
+	r.kubeControllerManager.SetPodNetworks(r.networks.Pods)
+
+	return r.kubeControllerManager.Deploy(ctx)
+}
+
+func (r *renderer) translate(ctx context.Context) ([]extensionsv1alpha1.File, []ShootAccessToken, error) {
+	var (
+		files []extensionsv1alpha1.File
+		pods  []client.Object
+	)
+
+	for _, role := range []string{v1beta1constants.ETCDRoleMain, v1beta1constants.ETCDRoleEvents} {
+		etcdObj := &druidv1alpha1.Etcd{}
+		if err := r.client.Get(ctx, client.ObjectKey{Namespace: Namespace, Name: "etcd-" + role}, etcdObj); err != nil {
+			return nil, nil, fmt.Errorf("failed reading etcd %s: %w", role, err)
+		}
+
+		image, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameEtcd)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		member, err := r.generateEtcdMemberSecrets(ctx, role)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		pod, err := etcdPod(etcdObj, image.String(), PortsForEtcdRole(role), member)
+		if err != nil {
+			return nil, nil, err
+		}
+		pods = append(pods, pod)
+	}
+
+	for _, name := range []string{
+		v1beta1constants.DeploymentNameKubeAPIServer,
+		v1beta1constants.DeploymentNameKubeControllerManager,
+		v1beta1constants.DeploymentNameKubeScheduler,
+	} {
+		deployment := &appsv1.Deployment{}
+		if err := r.client.Get(ctx, client.ObjectKey{Namespace: Namespace, Name: name}, deployment); err != nil {
+			return nil, nil, fmt.Errorf("failed reading deployment %s: %w", name, err)
+		}
+
+		adaptPodSpec(&deployment.Spec.Template.Spec)
+		pods = append(pods, deployment)
+	}
+
+	// The access tokens for the shoot access secrets are usually requested by gardener-resource-manager. Since it is not
+	// running yet, empty tokens are provided such that the volumes referencing them can be populated. The actual tokens
+	// are requested by Bootstrap once kube-apiserver is running.
+	shootAccessTokens, err := r.ensureShootAccessTokens(ctx, pods)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, obj := range pods {
+		podFiles, err := staticpod.Translate(ctx, r.client, obj)
+		if err != nil {
+			return nil, nil, err
+		}
+		files = append(files, podFiles...)
+	}
+
+	return files, shootAccessTokens, nil
+}
+
+// generateEtcdMemberSecrets generates the server certificates of the etcd member running on this node. In contrast to
+// the certificates generated by the etcd component, they contain the advertise address of the node.
+func (r *renderer) generateEtcdMemberSecrets(ctx context.Context, role string) (etcdMember, error) {
+	var (
+		serviceName = etcdconstants.ServiceName(role)
+		dnsNames    = append([]string{"localhost"}, kubernetesutils.DNSNamesForService(serviceName, Namespace)...)
+		ipAddresses = []net.IP{net.ParseIP("127.0.0.1"), r.advertiseAddress}
+	)
+
+	serverSecret, err := r.secretsManager.Generate(ctx, &secretsutils.CertificateSecretConfig{
+		Name:                        "gardenadm-etcd-server-" + role,
+		CommonName:                  "etcd-server",
+		DNSNames:                    dnsNames,
+		IPAddresses:                 ipAddresses,
+		CertType:                    secretsutils.ServerClientCert,
+		SkipPublishingCACertificate: true,
+	}, secretsmanager.SignedByCA(v1beta1constants.SecretNameCAETCD), secretsmanager.Rotate(secretsmanager.InPlace))
+	if err != nil {
+		return etcdMember{}, err
+	}
+
+	peerCASecret, found := r.secretsManager.Get(v1beta1constants.SecretNameCAETCDPeer)
+	if !found {
+		return etcdMember{}, fmt.Errorf("secret %q not found", v1beta1constants.SecretNameCAETCDPeer)
+	}
+
+	peerServerSecret, err := r.secretsManager.Generate(ctx, &secretsutils.CertificateSecretConfig{
+		Name:                        "gardenadm-etcd-peer-server-" + role,
+		CommonName:                  "etcd-server",
+		DNSNames:                    dnsNames,
+		IPAddresses:                 ipAddresses,
+		CertType:                    secretsutils.ServerClientCert,
+		SkipPublishingCACertificate: true,
+	}, secretsmanager.SignedByCA(v1beta1constants.SecretNameCAETCDPeer, secretsmanager.UseCurrentCA), secretsmanager.Rotate(secretsmanager.InPlace))
+	if err != nil {
+		return etcdMember{}, err
+	}
+
+	return etcdMember{
+		advertiseAddress:     r.advertiseAddress,
+		initialCluster:       r.etcdInitialClusters[role],
+		serverSecretName:     serverSecret.Name,
+		peerCASecretName:     peerCASecret.Name,
+		peerServerSecretName: peerServerSecret.Name,
+	}, nil
+}
+
+// ensureShootAccessTokens provides empty tokens for all shoot access secrets and returns the ServiceAccounts and the
+// paths of the token files of the given pods.
+func (r *renderer) ensureShootAccessTokens(ctx context.Context, pods []client.Object) ([]ShootAccessToken, error) {
+	secretList := &corev1.SecretList{}
+	if err := r.client.List(ctx, secretList, client.InNamespace(Namespace), client.MatchingLabels{resourcesv1alpha1.ResourceManagerPurpose: resourcesv1alpha1.LabelPurposeTokenRequest}); err != nil {
+		return nil, fmt.Errorf("failed listing shoot access secrets: %w", err)
+	}
+
+	var shootAccessTokens []ShootAccessToken
+
+	for _, secret := range secretList.Items {
+		if _, ok := secret.Data[resourcesv1alpha1.DataKeyToken]; !ok {
+			patch := client.MergeFrom(secret.DeepCopy())
+			if secret.Data == nil {
+				secret.Data = map[string][]byte{}
+			}
+			secret.Data[resourcesv1alpha1.DataKeyToken] = []byte{}
+			if err := r.client.Patch(ctx, &secret, patch); err != nil {
+				return nil, fmt.Errorf("failed patching shoot access secret %s: %w", secret.Name, err)
+			}
+		}
+
+		var paths []string
+		for _, obj := range pods {
+			paths = append(paths, tokenFilePaths(obj, secret.Name)...)
+		}
+
+		if len(paths) == 0 {
+			continue
+		}
+
+		shootAccessTokens = append(shootAccessTokens, ShootAccessToken{
+			ServiceAccount: client.ObjectKey{
+				Namespace: secret.Annotations[resourcesv1alpha1.ServiceAccountNamespace],
+				Name:      secret.Annotations[resourcesv1alpha1.ServiceAccountName],
+			},
+			Paths: paths,
+		})
+	}
+
+	return shootAccessTokens, nil
+}
+
+// tokenFilePaths returns the paths of the files on the host into which the token of the shoot access secret with the
+// given name is written for the given pod.
+func tokenFilePaths(obj client.Object, secretName string) []string {
+	deployment, ok := obj.(*appsv1.Deployment)
+	if !ok {
+		return nil
+	}
+
+	var paths []string
+
+	addPaths := func(volumeName string, items []corev1.KeyToPath) {
+		if len(items) == 0 {
+			items = []corev1.KeyToPath{{Key: resourcesv1alpha1.DataKeyToken, Path: resourcesv1alpha1.DataKeyToken}}
+		}
+
+		for _, item := range items {
+			if item.Key == resourcesv1alpha1.DataKeyToken {
+				paths = append(paths, filepath.Join(staticpod.VolumeDirectory(deployment.Name, volumeName), item.Path))
+			}
+		}
+	}
+
+	for _, volume := range deployment.Spec.Template.Spec.Volumes {
+		switch {
+		case volume.Secret != nil && volume.Secret.SecretName == secretName:
+			addPaths(volume.Name, volume.Secret.Items)
+		case volume.Projected != nil:
+			for _, source := range volume.Projected.Sources {
+				if source.Secret != nil && source.Secret.Name == secretName {
+					addPaths(volume.Name, source.Secret.Items)
+				}
+			}
+		}
+	}
+
+	return paths
+}
+
+// shootResources returns the objects of all ManagedResources for the shoot cluster.
+func (r *renderer) shootResources(ctx context.Context) ([]client.Object, error) {
+	managedResourceList := &resourcesv1alpha1.ManagedResourceList{}
+	if err := r.client.List(ctx, managedResourceList, client.InNamespace(Namespace)); err != nil {
+		return nil, fmt.Errorf("failed listing managed resources: %w", err)
+	}
+
+	var objects []client.Object
+	for _, managedResource := range managedResourceList.Items {
+		if managedResource.Spec.Class != nil {
+			continue
+		}
+
+		managedResourceObjects, err := managedresources.GetObjects(ctx, r.client, managedResource.Namespace, managedResource.Name)
+		if err != nil {
+			return nil, err
+		}
+		objects = append(objects, managedResourceObjects...)
+	}
+
+	clusterInfoObjects, err := r.clusterInfo()
+	if err != nil {
+		return nil, err
+	}
+
+	return append(objects, clusterInfoObjects...), nil
+}
+
+// clusterInfo returns the cluster-info ConfigMap in the kube-public namespace and the RBAC resources allowing nodes
+// authenticated with a bootstrap token to read it. Similar to kubeadm, the ConfigMap contains a kubeconfig with the
+// address and the CA bundle of the cluster. kube-controller-manager signs it with all bootstrap tokens, which allows
+// `gardenadm join` to verify the CA bundle before trusting the connection.
+func (r *renderer) clusterInfo() ([]client.Object, error) {
+	caBundleSecret, found := r.secretsManager.Get(v1beta1constants.SecretNameCACluster)
+	if !found {
+		return nil, fmt.Errorf("secret %q not found", v1beta1constants.SecretNameCACluster)
+	}
+
+	kubeconfig, err := runtime.Encode(clientcmdlatest.Codec, kubernetesutils.NewKubeconfig(
+		"",
+		clientcmdv1.Cluster{
+			Server:                   net.JoinHostPort(r.advertiseAddress.String(), strconv.Itoa(kubeapiserverconstants.Port)),
+			CertificateAuthorityData: caBundleSecret.Data[secretsutils.DataKeyCertificateBundle],
+		},
+		clientcmdv1.AuthInfo{},
+	))
+	if err != nil {
+		return nil, fmt.Errorf("failed encoding cluster-info kubeconfig: %w", err)
+	}
+
+	var (
+		configMap = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: bootstraptokenapi.ConfigMapClusterInfo, Namespace: metav1.NamespacePublic},
+			Data:       map[string]string{bootstraptokenapi.KubeConfigKey: string(kubeconfig)},
+		}
+		role = &rbacv1.Role{
+			ObjectMeta: metav1.ObjectMeta{Name: roleNameClusterInfo, Namespace: metav1.NamespacePublic},
+			Rules: []rbacv1.PolicyRule{{
+				APIGroups:     []string{""},
+				Resources:     []string{"configmaps"},
+				ResourceNames: []string{configMap.Name},
+				Verbs:         []string{"get"},
+			}},
+		}
+		roleBinding = &rbacv1.RoleBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: roleNameClusterInfo, Namespace: metav1.NamespacePublic},
+			RoleRef: rbacv1.RoleRef{
+				APIGroup: rbacv1.GroupName,
+				Kind:     "Role",
+				Name:     role.Name,
+			},
+			Subjects: []rbacv1.Subject{{
+				APIGroup: rbacv1.GroupName,
+				Kind:     rbacv1.GroupKind,
+				Name:     bootstraptokenapi.BootstrapDefaultGroup,
+			}},
+		}
+	)
+
+	return []client.Object{configMap, role, roleBinding}, nil
+}
+
+// adaptPodSpec makes the control plane services resolvable to the local host, and it rewrites the etcd-events endpoint
+// to the port of the etcd-events static pod.
+func adaptPodSpec(spec *corev1.PodSpec) {
+	spec.HostAliases = append(spec.HostAliases, corev1.HostAlias{
+		IP: "127.0.0.1",
+		Hostnames: []string{
+			etcdconstants.ServiceName(v1beta1constants.ETCDRoleMain),
+			etcdconstants.ServiceName(v1beta1constants.ETCDRoleEvents),
+			v1beta1constants.DeploymentNameKubeAPIServer,
+		},
+	})
+
+	var (
+		oldEventsEndpoint = fmt.Sprintf("%s:%d", etcdconstants.ServiceName(v1beta1constants.ETCDRoleEvents), etcdconstants.PortEtcdClient)
+		newEventsEndpoint = fmt.Sprintf("%s:%d", etcdconstants.ServiceName(v1beta1constants.ETCDRoleEvents), PortsForEtcdRole(v1beta1constants.ETCDRoleEvents).Client)
+	)
+
+	for i, container := range spec.Containers {
+		for j, arg := range container.Args {
+			spec.Containers[i].Args[j] = strings.ReplaceAll(arg, oldEventsEndpoint, newEventsEndpoint)
+		}
+		for j, arg := range container.Command {
+			spec.Containers[i].Command[j] = strings.ReplaceAll(arg, oldEventsEndpoint, newEventsEndpoint)
+		}
+	}
+}
@@ -0,0 +1,204 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"fmt"
+	"net"
+	"path/filepath"
+	"strconv"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+)
+
+const (
+	volumeNameEtcdCA         = "ca"
+	volumeNameEtcdServer     = "server-tls"
+	volumeNameEtcdPeerCA     = "peer-ca"
+	volumeNameEtcdPeerServer = "peer-server-tls"
+	volumeNameEtcdData       = "data"
+
+	volumeMountPathEtcdCA         = "/var/etcd/ssl/ca"
+	volumeMountPathEtcdServer     = "/var/etcd/ssl/server"
+	volumeMountPathEtcdPeerCA     = "/var/etcd/ssl/peer/ca"
+	volumeMountPathEtcdPeerServer = "/var/etcd/ssl/peer/server"
+	volumeMountPathEtcdData       = "/var/etcd/data"
+)
+
+// EtcdPorts contains the ports used by an etcd static pod. Since all static pods run in the host network, the ports of
+// the etcd-main and etcd-events instances must not overlap.
+type EtcdPorts struct {
+	// Client is the port used for client communication.
+	Client int32
+	// Peer is the port used for peer communication.
+	Peer int32
+	// Metrics is the port used for serving metrics and health checks.
+	Metrics int32
+}
+
+// PortsForEtcdRole returns the ports used by the etcd static pod of the given role.
+func PortsForEtcdRole(role string) EtcdPorts {
+	if role == v1beta1constants.ETCDRoleEvents {
+		return EtcdPorts{Client: 2382, Peer: 2383, Metrics: 2384}
+	}
+	return EtcdPorts{Client: 2379, Peer: 2380, Metrics: 2381}
+}
+
+// EtcdMemberName returns the name of the etcd member of the given Etcd running on the node with the given address.
+// Each control plane node runs one member of the etcd-main and etcd-events clusters, hence the names must be unique.
+func EtcdMemberName(etcdName string, advertiseAddress net.IP) string {
+	return etcdName + "-" + advertiseAddress.String()
+}
+
+// EtcdPeerURL returns the URL under which the etcd member running on the node with the given address is reachable
+// by its peers.
+func EtcdPeerURL(advertiseAddress net.IP, ports EtcdPorts) string {
+	return "https://" + net.JoinHostPort(advertiseAddress.String(), strconv.Itoa(int(ports.Peer)))
+}
+
+// etcdMember contains the configuration of the etcd member running on this node.
+type etcdMember struct {
+	// advertiseAddress is the IP address of the node under which the member is reachable by clients and peers.
+	advertiseAddress net.IP
+	// serverSecretName is the name of the secret containing the server certificate for client communication.
+	serverSecretName string
+	// peerCASecretName is the name of the secret containing the CA bundle for peer communication.
+	peerCASecretName string
+	// peerServerSecretName is the name of the secret containing the server certificate for peer communication.
+	peerServerSecretName string
+	// initialCluster is the initial cluster configuration if the member joins an existing cluster. If empty, the member
+	// forms a new single-member cluster.
+	initialCluster string
+}
+
+// etcdPod computes an etcd pod based on the given Etcd resource. Usually, etcd-druid reconciles the Etcd resource to a
+// StatefulSet. Since etcd-druid is not available when bootstrapping the control plane, the pod is computed directly
+// and uses the plain etcd image without the backup-restore sidecar. The member listens on the loopback interface and
+// on the advertise address of the node, so that further control plane nodes can join the cluster. The communication
+// with clients and peers is secured with TLS. A member with an initial cluster configuration joins an existing cluster.
+func etcdPod(etcd *druidv1alpha1.Etcd, image string, ports EtcdPorts, member etcdMember) (*corev1.Pod, error) {
+	if etcd.Spec.Etcd.ClientUrlTLS == nil {
+		return nil, fmt.Errorf("etcd %s has no client TLS configuration", etcd.Name)
+	}
+
+	var (
+		tlsConfig        = etcd.Spec.Etcd.ClientUrlTLS
+		caDataKey        = ptr.Deref(tlsConfig.TLSCASecretRef.DataKey, secretsutils.DataKeyCertificateBundle)
+		memberName       = EtcdMemberName(etcd.Name, member.advertiseAddress)
+		localClientURL   = "https://" + net.JoinHostPort("127.0.0.1", strconv.Itoa(int(ports.Client)))
+		clientURL        = "https://" + net.JoinHostPort(member.advertiseAddress.String(), strconv.Itoa(int(ports.Client)))
+		peerURL          = EtcdPeerURL(member.advertiseAddress, ports)
+		metricsURL       = "http://" + net.JoinHostPort("127.0.0.1", strconv.Itoa(int(ports.Metrics)))
+		quota            = ptr.Deref(etcd.Spec.Etcd.Quota, resource.MustParse("8Gi"))
+		listenClientURLs = localClientURL
+
+		initialCluster      = memberName + "=" + peerURL
+		initialClusterState = "new"
+	)
+
+	if member.initialCluster != "" {
+		initialCluster = member.initialCluster
+		initialClusterState = "existing"
+	}
+
+	if !member.advertiseAddress.IsLoopback() {
+		listenClientURLs += "," + clientURL
+	}
+
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      etcd.Name,
+			Namespace: etcd.Namespace,
+			Labels:    etcd.Spec.Labels,
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{
+				Name:            "etcd",
+				Image:           image,
+				ImagePullPolicy: corev1.PullIfNotPresent,
+				Command: []string{
+					"etcd",
+					"--name=" + memberName,
+					"--data-dir=" + volumeMountPathEtcdData,
+					"--listen-client-urls=" + listenClientURLs,
+					"--advertise-client-urls=" + clientURL,
+					"--listen-peer-urls=" + peerURL,
+					"--initial-advertise-peer-urls=" + peerURL,
+					"--initial-cluster=" + initialCluster,
+					"--initial-cluster-state=" + initialClusterState,
+					"--listen-metrics-urls=" + metricsURL,
+					"--client-cert-auth=true",
+					"--trusted-ca-file=" + filepath.Join(volumeMountPathEtcdCA, caDataKey),
+					"--cert-file=" + filepath.Join(volumeMountPathEtcdServer, secretsutils.DataKeyCertificate),
+					"--key-file=" + filepath.Join(volumeMountPathEtcdServer, secretsutils.DataKeyPrivateKey),
+					"--peer-client-cert-auth=true",
+					"--peer-trusted-ca-file=" + filepath.Join(volumeMountPathEtcdPeerCA, secretsutils.DataKeyCertificateBundle),
+					"--peer-cert-file=" + filepath.Join(volumeMountPathEtcdPeerServer, secretsutils.DataKeyCertificate),
+					"--peer-key-file=" + filepath.Join(volumeMountPathEtcdPeerServer, secretsutils.DataKeyPrivateKey),
+					"--quota-backend-bytes=" + strconv.FormatInt(quota.Value(), 10),
+					"--snapshot-count=10000",
+				},
+				LivenessProbe: &corev1.Probe{
+					ProbeHandler: corev1.ProbeHandler{
+						HTTPGet: &corev1.HTTPGetAction{
+							Host:   "127.0.0.1",
+							Path:   "/livez",
+							Port:   intstr.FromInt32(ports.Metrics),
+							Scheme: corev1.URISchemeHTTP,
+						},
+					},
+					InitialDelaySeconds: 10,
+					PeriodSeconds:       10,
+					TimeoutSeconds:      15,
+					FailureThreshold:    8,
+				},
+				Resources: ptr.Deref(etcd.Spec.Etcd.Resources.DeepCopy(), corev1.ResourceRequirements{}),
+				VolumeMounts: []corev1.VolumeMount{
+					{Name: volumeNameEtcdCA, MountPath: volumeMountPathEtcdCA},
+					{Name: volumeNameEtcdServer, MountPath: volumeMountPathEtcdServer},
+					{Name: volumeNameEtcdPeerCA, MountPath: volumeMountPathEtcdPeerCA},
+					{Name: volumeNameEtcdPeerServer, MountPath: volumeMountPathEtcdPeerServer},
+					{Name: volumeNameEtcdData, MountPath: volumeMountPathEtcdData},
+				},
+			}},
+			Volumes: []corev1.Volume{
+				{
+					Name: volumeNameEtcdCA,
+					VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{
+						SecretName: tlsConfig.TLSCASecretRef.Name,
+						Items:      []corev1.KeyToPath{{Key: caDataKey, Path: caDataKey}},
+					}},
+				},
+				{
+					Name:         volumeNameEtcdServer,
+					VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: member.serverSecretName}},
+				},
+				{
+					Name: volumeNameEtcdPeerCA,
+					VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{
+						SecretName: member.peerCASecretName,
+						Items:      []corev1.KeyToPath{{Key: secretsutils.DataKeyCertificateBundle, Path: secretsutils.DataKeyCertificateBundle}},
+					}},
+				},
+				{
+					Name:         volumeNameEtcdPeerServer,
+					VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: member.peerServerSecretName}},
+				},
+				{
+					Name:         volumeNameEtcdData,
+					VolumeSource: corev1.VolumeSource{PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: etcd.Name}},
+				},
+			},
+		},
+	}, nil
+}
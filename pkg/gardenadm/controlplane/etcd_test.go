@@ -0,0 +1,178 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane_test
+
+import (
+	"net"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	. "github.com/gardener/gardener/pkg/gardenadm/controlplane"
+)
+
+var _ = Describe("Etcd", func() {
+	var advertiseAddress = net.ParseIP("10.1.0.1")
+
+	Describe("#PortsForEtcdRole", func() {
+		It("should return the default ports for etcd-main", func() {
+			Expect(PortsForEtcdRole("main")).To(Equal(EtcdPorts{Client: 2379, Peer: 2380, Metrics: 2381}))
+		})
+
+		It("should return non-overlapping ports for etcd-events", func() {
+			Expect(PortsForEtcdRole("events")).To(Equal(EtcdPorts{Client: 2382, Peer: 2383, Metrics: 2384}))
+		})
+	})
+
+	Describe("#EtcdMemberName", func() {
+		It("should include the advertise address", func() {
+			Expect(EtcdMemberName("etcd-main", advertiseAddress)).To(Equal("etcd-main-10.1.0.1"))
+		})
+	})
+
+	Describe("#EtcdPeerURL", func() {
+		It("should return the peer URL for IPv4 addresses", func() {
+			Expect(EtcdPeerURL(advertiseAddress, PortsForEtcdRole("events"))).To(Equal("https://10.1.0.1:2383"))
+		})
+
+		It("should return the peer URL for IPv6 addresses", func() {
+			Expect(EtcdPeerURL(net.ParseIP("fd00::1"), PortsForEtcdRole("main"))).To(Equal("https://[fd00::1]:2380"))
+		})
+	})
+
+	Describe("#etcdPod", func() {
+		var etcd *druidv1alpha1.Etcd
+
+		BeforeEach(func() {
+			etcd = &druidv1alpha1.Etcd{
+				ObjectMeta: metav1.ObjectMeta{Name: "etcd-main", Namespace: "kube-system"},
+				Spec: druidv1alpha1.EtcdSpec{
+					Labels: map[string]string{"role": "main"},
+					Etcd: druidv1alpha1.EtcdConfig{
+						ClientUrlTLS: &druidv1alpha1.TLSConfig{
+							TLSCASecretRef: druidv1alpha1.SecretReference{
+								SecretReference: corev1.SecretReference{Name: "ca-etcd-bundle"},
+								DataKey:         ptr.To("bundle.crt"),
+							},
+						},
+						Resources: &corev1.ResourceRequirements{
+							Requests: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")},
+						},
+					},
+				},
+			}
+		})
+
+		It("should fail if the etcd has no client TLS configuration", func() {
+			etcd.Spec.Etcd.ClientUrlTLS = nil
+
+			pod, err := EtcdPod(etcd, "etcd:v3.5", PortsForEtcdRole("main"), advertiseAddress, "")
+			Expect(err).To(MatchError("etcd etcd-main has no client TLS configuration"))
+			Expect(pod).To(BeNil())
+		})
+
+		It("should compute a pod forming a new cluster", func() {
+			pod, err := EtcdPod(etcd, "etcd:v3.5", PortsForEtcdRole("main"), advertiseAddress, "")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(pod.Name).To(Equal("etcd-main"))
+			Expect(pod.Namespace).To(Equal("kube-system"))
+			Expect(pod.Labels).To(Equal(map[string]string{"role": "main"}))
+
+			Expect(pod.Spec.Containers).To(HaveLen(1))
+			container := pod.Spec.Containers[0]
+			Expect(container.Image).To(Equal("etcd:v3.5"))
+			Expect(container.Command).To(Equal([]string{
+				"etcd",
+				"--name=etcd-main-10.1.0.1",
+				"--data-dir=/var/etcd/data",
+				"--listen-client-urls=https://127.0.0.1:2379,https://10.1.0.1:2379",
+				"--advertise-client-urls=https://10.1.0.1:2379",
+				"--listen-peer-urls=https://10.1.0.1:2380",
+				"--initial-advertise-peer-urls=https://10.1.0.1:2380",
+				"--initial-cluster=etcd-main-10.1.0.1=https://10.1.0.1:2380",
+				"--initial-cluster-state=new",
+				"--listen-metrics-urls=http://127.0.0.1:2381",
+				"--client-cert-auth=true",
+				"--trusted-ca-file=/var/etcd/ssl/ca/bundle.crt",
+				"--cert-file=/var/etcd/ssl/server/tls.crt",
+				"--key-file=/var/etcd/ssl/server/tls.key",
+				"--peer-client-cert-auth=true",
+				"--peer-trusted-ca-file=/var/etcd/ssl/peer/ca/bundle.crt",
+				"--peer-cert-file=/var/etcd/ssl/peer/server/tls.crt",
+				"--peer-key-file=/var/etcd/ssl/peer/server/tls.key",
+				"--quota-backend-bytes=8589934592",
+				"--snapshot-count=10000",
+			}))
+			Expect(container.LivenessProbe.HTTPGet.Port.IntValue()).To(Equal(2381))
+			Expect(container.Resources.Requests.Memory().String()).To(Equal("1Gi"))
+			Expect(container.VolumeMounts).To(ConsistOf(
+				corev1.VolumeMount{Name: "ca", MountPath: "/var/etcd/ssl/ca"},
+				corev1.VolumeMount{Name: "server-tls", MountPath: "/var/etcd/ssl/server"},
+				corev1.VolumeMount{Name: "peer-ca", MountPath: "/var/etcd/ssl/peer/ca"},
+				corev1.VolumeMount{Name: "peer-server-tls", MountPath: "/var/etcd/ssl/peer/server"},
+				corev1.VolumeMount{Name: "data", MountPath: "/var/etcd/data"},
+			))
+
+			Expect(pod.Spec.Volumes).To(ConsistOf(
+				corev1.Volume{Name: "ca", VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{
+					SecretName: "ca-etcd-bundle",
+					Items:      []corev1.KeyToPath{{Key: "bundle.crt", Path: "bundle.crt"}},
+				}}},
+				corev1.Volume{Name: "server-tls", VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: "server"}}},
+				corev1.Volume{Name: "peer-ca", VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{
+					SecretName: "peer-ca",
+					Items:      []corev1.KeyToPath{{Key: "bundle.crt", Path: "bundle.crt"}},
+				}}},
+				corev1.Volume{Name: "peer-server-tls", VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: "peer-server"}}},
+				corev1.Volume{Name: "data", VolumeSource: corev1.VolumeSource{PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "etcd-main"}}},
+			))
+		})
+
+		It("should compute a pod joining an existing cluster", func() {
+			initialCluster := "etcd-events-10.1.0.1=https://10.1.0.1:2383,etcd-events-10.1.0.2=https://10.1.0.2:2383"
+
+			pod, err := EtcdPod(etcd, "etcd:v3.5", PortsForEtcdRole("events"), net.ParseIP("10.1.0.2"), initialCluster)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(pod.Spec.Containers[0].Command).To(ContainElements(
+				"--name=etcd-main-10.1.0.2",
+				"--listen-client-urls=https://127.0.0.1:2382,https://10.1.0.2:2382",
+				"--listen-peer-urls=https://10.1.0.2:2383",
+				"--initial-cluster="+initialCluster,
+				"--initial-cluster-state=existing",
+				"--listen-metrics-urls=http://127.0.0.1:2384",
+			))
+		})
+
+		It("should listen on the loopback address only once", func() {
+			pod, err := EtcdPod(etcd, "etcd:v3.5", PortsForEtcdRole("main"), net.ParseIP("127.0.0.1"), "")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(pod.Spec.Containers[0].Command).To(ContainElements(
+				"--listen-client-urls=https://127.0.0.1:2379",
+				"--advertise-client-urls=https://127.0.0.1:2379",
+			))
+		})
+
+		It("should use the configured quota and the default CA data key", func() {
+			etcd.Spec.Etcd.Quota = ptr.To(resource.MustParse("2Gi"))
+			etcd.Spec.Etcd.ClientUrlTLS.TLSCASecretRef.DataKey = nil
+
+			pod, err := EtcdPod(etcd, "etcd:v3.5", PortsForEtcdRole("main"), advertiseAddress, "")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(pod.Spec.Containers[0].Command).To(ContainElements(
+				"--quota-backend-bytes=2147483648",
+				"--trusted-ca-file=/var/etcd/ssl/ca/bundle.crt",
+			))
+		})
+	})
+})
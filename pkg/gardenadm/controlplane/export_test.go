@@ -0,0 +1,22 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"net"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func EtcdPod(etcd *druidv1alpha1.Etcd, image string, ports EtcdPorts, advertiseAddress net.IP, initialCluster string) (*corev1.Pod, error) {
+	return etcdPod(etcd, image, ports, etcdMember{
+		advertiseAddress:     advertiseAddress,
+		serverSecretName:     "server",
+		peerCASecretName:     "peer-ca",
+		peerServerSecretName: "peer-server",
+		initialCluster:       initialCluster,
+	})
+}
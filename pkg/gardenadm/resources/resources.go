@@ -0,0 +1,283 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package resources
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/afero"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubernetesyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+
+	gardencorev1 "github.com/gardener/gardener/pkg/apis/core/v1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	seedmanagementv1alpha1 "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+)
+
+const (
+	// DefaultDirectory is the default directory used for storing the resources.
+	DefaultDirectory = "gardener-resources"
+
+	// FileNameCloudProfile is the name of the file containing the CloudProfile.
+	FileNameCloudProfile = "cloudprofile.yaml"
+	// FileNameShoot is the name of the file containing the Shoot.
+	FileNameShoot = "shoot.yaml"
+	// FileNameGardenlet is the name of the file containing the Gardenlet.
+	FileNameGardenlet = "gardenlet.yaml"
+	// DirectoryControllerRegistrations is the name of the directory containing the ControllerRegistrations.
+	DirectoryControllerRegistrations = "controllerregistrations"
+	// DirectoryControllerDeployments is the name of the directory containing the ControllerDeployments.
+	DirectoryControllerDeployments = "controllerdeployments"
+	// DirectorySecrets is the name of the directory containing the Secrets.
+	DirectorySecrets = "secrets"
+
+	// RedactedValue is the value used for redacted secret data.
+	RedactedValue = "<redacted>"
+
+	permissionsDirectory = 0755
+	permissionsFile      = 0640
+	permissionsSecret    = 0600
+)
+
+// Resources contains the resources of a garden cluster which are required for bootstrapping an autonomous shoot
+// cluster.
+type Resources struct {
+	// CloudProfile is the CloudProfile referenced by the Shoot.
+	CloudProfile *gardencorev1beta1.CloudProfile
+	// Shoot is the Shoot describing the autonomous shoot cluster.
+	Shoot *gardencorev1beta1.Shoot
+	// Gardenlet is the Gardenlet describing the gardenlet deployed into the autonomous shoot cluster when connecting
+	// it to a garden.
+	Gardenlet *seedmanagementv1alpha1.Gardenlet
+	// ControllerRegistrations is the list of ControllerRegistrations.
+	ControllerRegistrations []*gardencorev1beta1.ControllerRegistration
+	// ControllerDeployments is the list of ControllerDeployments.
+	ControllerDeployments []*gardencorev1.ControllerDeployment
+	// Secrets is the list of Secrets referenced by the Shoot.
+	Secrets []*corev1.Secret
+}
+
+// Objects returns all resources as a flat list.
+func (r *Resources) Objects() []client.Object {
+	var objects []client.Object
+
+	if r.CloudProfile != nil {
+		objects = append(objects, r.CloudProfile)
+	}
+	if r.Shoot != nil {
+		objects = append(objects, r.Shoot)
+	}
+	if r.Gardenlet != nil {
+		objects = append(objects, r.Gardenlet)
+	}
+	for _, obj := range r.ControllerRegistrations {
+		objects = append(objects, obj)
+	}
+	for _, obj := range r.ControllerDeployments {
+		objects = append(objects, obj)
+	}
+	for _, obj := range r.Secrets {
+		objects = append(objects, obj)
+	}
+
+	return objects
+}
+
+// Write writes the resources into the given directory. Existing files are overwritten.
+func (r *Resources) Write(fs afero.Afero, dir string) error {
+	if err := fs.MkdirAll(dir, permissionsDirectory); err != nil {
+		return fmt.Errorf("failed creating directory %s: %w", dir, err)
+	}
+
+	if r.CloudProfile != nil {
+		if err := writeObject(fs, filepath.Join(dir, FileNameCloudProfile), r.CloudProfile, permissionsFile); err != nil {
+			return err
+		}
+	}
+
+	if r.Shoot != nil {
+		if err := writeObject(fs, filepath.Join(dir, FileNameShoot), r.Shoot, permissionsFile); err != nil {
+			return err
+		}
+	}
+
+	if r.Gardenlet != nil {
+		if err := writeObject(fs, filepath.Join(dir, FileNameGardenlet), r.Gardenlet, permissionsFile); err != nil {
+			return err
+		}
+	}
+
+	for _, controllerRegistration := range r.ControllerRegistrations {
+		if err := writeObjectToDirectory(fs, filepath.Join(dir, DirectoryControllerRegistrations), controllerRegistration, permissionsFile); err != nil {
+			return err
+		}
+	}
+
+	for _, controllerDeployment := range r.ControllerDeployments {
+		if err := writeObjectToDirectory(fs, filepath.Join(dir, DirectoryControllerDeployments), controllerDeployment, permissionsFile); err != nil {
+			return err
+		}
+	}
+
+	for _, secret := range r.Secrets {
+		if err := writeObjectToDirectory(fs, filepath.Join(dir, DirectorySecrets), secret, permissionsSecret); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func writeObjectToDirectory(fs afero.Afero, dir string, obj client.Object, perm os.FileMode) error {
+	if err := fs.MkdirAll(dir, permissionsDirectory); err != nil {
+		return fmt.Errorf("failed creating directory %s: %w", dir, err)
+	}
+
+	return writeObject(fs, filepath.Join(dir, obj.GetName()+".yaml"), obj, perm)
+}
+
+func writeObject(fs afero.Afero, path string, obj client.Object, perm os.FileMode) error {
+	data, err := Encode(obj)
+	if err != nil {
+		return fmt.Errorf("failed encoding %T %s: %w", obj, client.ObjectKeyFromObject(obj), err)
+	}
+
+	if err := fs.WriteFile(path, data, perm); err != nil {
+		return fmt.Errorf("failed writing file %s: %w", path, err)
+	}
+
+	return nil
+}
+
+// Encode encodes the given object to YAML. The apiVersion and kind fields are populated based on the garden scheme.
+func Encode(obj client.Object) ([]byte, error) {
+	gvk, err := apiutil.GVKForObject(obj, kubernetes.GardenScheme)
+	if err != nil {
+		return nil, err
+	}
+
+	return runtime.Encode(kubernetes.GardenCodec.EncoderForVersion(kubernetes.GardenSerializer, gvk.GroupVersion()), obj)
+}
+
+// Read reads all YAML files in the given directory (recursively) and sorts the contained objects into the
+// Resources struct. Files may contain multiple YAML documents. Objects of unknown kinds are ignored.
+func Read(fs afero.Afero, dir string) (*Resources, error) {
+	r := &Resources{}
+
+	if err := fs.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if info.IsDir() || (!strings.HasSuffix(path, ".yaml") && !strings.HasSuffix(path, ".yml")) {
+			return nil
+		}
+
+		data, err := fs.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("failed reading file %s: %w", path, err)
+		}
+
+		if err := r.decode(data); err != nil {
+			return fmt.Errorf("failed decoding file %s: %w", path, err)
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func (r *Resources) decode(data []byte) error {
+	reader := kubernetesyaml.NewYAMLReader(bufio.NewReader(bytes.NewReader(data)))
+
+	for {
+		document, err := reader.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+
+		if len(bytes.TrimSpace(document)) == 0 {
+			continue
+		}
+
+		obj, _, err := kubernetes.GardenCodec.UniversalDeserializer().Decode(document, nil, nil)
+		if err != nil {
+			if runtime.IsNotRegisteredError(err) {
+				continue
+			}
+			return err
+		}
+
+		switch o := obj.(type) {
+		case *gardencorev1beta1.CloudProfile:
+			if r.CloudProfile != nil {
+				return fmt.Errorf("found more than one CloudProfile (%s, %s)", r.CloudProfile.Name, o.Name)
+			}
+			r.CloudProfile = o
+		case *gardencorev1beta1.Shoot:
+			if r.Shoot != nil {
+				return fmt.Errorf("found more than one Shoot (%s, %s)", r.Shoot.Name, o.Name)
+			}
+			r.Shoot = o
+		case *seedmanagementv1alpha1.Gardenlet:
+			if r.Gardenlet != nil {
+				return fmt.Errorf("found more than one Gardenlet (%s, %s)", r.Gardenlet.Name, o.Name)
+			}
+			r.Gardenlet = o
+		case *gardencorev1beta1.ControllerRegistration:
+			r.ControllerRegistrations = append(r.ControllerRegistrations, o)
+		case *gardencorev1.ControllerDeployment:
+			r.ControllerDeployments = append(r.ControllerDeployments, o)
+		case *corev1.Secret:
+			r.Secrets = append(r.Secrets, o)
+		}
+	}
+}
+
+// CleanObject removes all fields from the object metadata which are managed by the API server.
+func CleanObject(obj client.Object) {
+	obj.SetUID("")
+	obj.SetResourceVersion("")
+	obj.SetGeneration(0)
+	obj.SetCreationTimestamp(metav1.Time{})
+	obj.SetDeletionTimestamp(nil)
+	obj.SetDeletionGracePeriodSeconds(nil)
+	obj.SetManagedFields(nil)
+	obj.SetSelfLink("")
+	obj.SetOwnerReferences(nil)
+}
+
+// RedactSecret replaces all values of the secret data with a placeholder. The keys are kept so that users know
+// which values must be provided.
+func RedactSecret(secret *corev1.Secret) {
+	stringData := make(map[string]string, len(secret.Data)+len(secret.StringData))
+	for key := range secret.Data {
+		stringData[key] = RedactedValue
+	}
+	for key := range secret.StringData {
+		stringData[key] = RedactedValue
+	}
+
+	secret.Data = nil
+	secret.StringData = stringData
+}
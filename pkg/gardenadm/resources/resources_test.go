@@ -0,0 +1,160 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package resources_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+
+	gardencorev1 "github.com/gardener/gardener/pkg/apis/core/v1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	seedmanagementv1alpha1 "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1"
+	. "github.com/gardener/gardener/pkg/gardenadm/resources"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("Resources", func() {
+	var (
+		fs  afero.Afero
+		dir = "/resources"
+
+		cloudProfile           *gardencorev1beta1.CloudProfile
+		shoot                  *gardencorev1beta1.Shoot
+		gardenlet              *seedmanagementv1alpha1.Gardenlet
+		controllerRegistration *gardencorev1beta1.ControllerRegistration
+		controllerDeployment   *gardencorev1.ControllerDeployment
+		secret                 *corev1.Secret
+	)
+
+	BeforeEach(func() {
+		fs = afero.Afero{Fs: afero.NewMemMapFs()}
+
+		cloudProfile = &gardencorev1beta1.CloudProfile{
+			ObjectMeta: metav1.ObjectMeta{Name: "local"},
+			Spec:       gardencorev1beta1.CloudProfileSpec{Type: "local"},
+		}
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "garden-bar"},
+			Spec:       gardencorev1beta1.ShootSpec{CloudProfileName: ptr.To("local")},
+		}
+		gardenlet = &seedmanagementv1alpha1.Gardenlet{
+			ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "garden"},
+		}
+		controllerRegistration = &gardencorev1beta1.ControllerRegistration{
+			ObjectMeta: metav1.ObjectMeta{Name: "provider-local"},
+		}
+		controllerDeployment = &gardencorev1.ControllerDeployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "provider-local"},
+		}
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "credentials", Namespace: "garden-bar"},
+			StringData: map[string]string{"token": RedactedValue},
+		}
+	})
+
+	Describe("#Write and #Read", func() {
+		It("should write the resources and read them back", func() {
+			r := &Resources{
+				CloudProfile:            cloudProfile,
+				Shoot:                   shoot,
+				Gardenlet:               gardenlet,
+				ControllerRegistrations: []*gardencorev1beta1.ControllerRegistration{controllerRegistration},
+				ControllerDeployments:   []*gardencorev1.ControllerDeployment{controllerDeployment},
+				Secrets:                 []*corev1.Secret{secret},
+			}
+
+			Expect(r.Write(fs, dir)).To(Succeed())
+
+			Expect(fs.Exists(dir + "/cloudprofile.yaml")).To(BeTrue())
+			Expect(fs.Exists(dir + "/shoot.yaml")).To(BeTrue())
+			Expect(fs.Exists(dir + "/gardenlet.yaml")).To(BeTrue())
+			Expect(fs.Exists(dir + "/controllerregistrations/provider-local.yaml")).To(BeTrue())
+			Expect(fs.Exists(dir + "/controllerdeployments/provider-local.yaml")).To(BeTrue())
+			Expect(fs.Exists(dir + "/secrets/credentials.yaml")).To(BeTrue())
+
+			content, err := fs.ReadFile(dir + "/shoot.yaml")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(content)).To(ContainSubstring("apiVersion: core.gardener.cloud/v1beta1"))
+			Expect(string(content)).To(ContainSubstring("kind: Shoot"))
+
+			read, err := Read(fs, dir)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(read.CloudProfile).To(DeepDerivativeEqual(cloudProfile))
+			Expect(read.Shoot).To(DeepDerivativeEqual(shoot))
+			Expect(read.Gardenlet).To(DeepDerivativeEqual(gardenlet))
+			Expect(read.ControllerRegistrations).To(ConsistOf(DeepDerivativeEqual(controllerRegistration)))
+			Expect(read.ControllerDeployments).To(ConsistOf(DeepDerivativeEqual(controllerDeployment)))
+			Expect(read.Secrets).To(ConsistOf(DeepDerivativeEqual(secret)))
+		})
+
+		It("should read multiple documents from one file and ignore unknown kinds", func() {
+			Expect(fs.WriteFile(dir+"/all.yaml", []byte(`apiVersion: core.gardener.cloud/v1beta1
+kind: CloudProfile
+metadata:
+  name: local
+---
+apiVersion: foo.example.com/v1
+kind: Unknown
+metadata:
+  name: bar
+---
+apiVersion: core.gardener.cloud/v1beta1
+kind: Shoot
+metadata:
+  name: foo
+  namespace: garden-bar
+`), 0600)).To(Succeed())
+
+			read, err := Read(fs, dir)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(read.CloudProfile.Name).To(Equal("local"))
+			Expect(read.Shoot.Name).To(Equal("foo"))
+		})
+
+		It("should fail if more than one shoot is found", func() {
+			Expect((&Resources{Shoot: shoot}).Write(fs, dir+"/a")).To(Succeed())
+			Expect((&Resources{Shoot: shoot}).Write(fs, dir+"/b")).To(Succeed())
+
+			_, err := Read(fs, dir)
+			Expect(err).To(MatchError(ContainSubstring("found more than one Shoot")))
+		})
+	})
+
+	Describe("#CleanObject", func() {
+		It("should remove fields managed by the API server", func() {
+			shoot.UID = types.UID("uid")
+			shoot.ResourceVersion = "42"
+			shoot.Generation = 3
+			shoot.CreationTimestamp = metav1.Now()
+			shoot.ManagedFields = []metav1.ManagedFieldsEntry{{Manager: "foo"}}
+			shoot.Labels = map[string]string{"foo": "bar"}
+
+			CleanObject(shoot)
+
+			Expect(shoot.ObjectMeta).To(Equal(metav1.ObjectMeta{
+				Name:      "foo",
+				Namespace: "garden-bar",
+				Labels:    map[string]string{"foo": "bar"},
+			}))
+		})
+	})
+
+	Describe("#RedactSecret", func() {
+		It("should replace all values with a placeholder", func() {
+			secret.Data = map[string][]byte{"foo": []byte("bar")}
+			secret.StringData = map[string]string{"baz": "secret"}
+
+			RedactSecret(secret)
+
+			Expect(secret.Data).To(BeNil())
+			Expect(secret.StringData).To(Equal(map[string]string{"foo": RedactedValue, "baz": RedactedValue}))
+		})
+	})
+})
@@ -0,0 +1,297 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package staticpod
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/spf13/afero"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	extensionsv1alpha1helper "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1/helper"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+)
+
+const (
+	// DirectoryManifests is the directory from which the kubelet reads static pod manifests.
+	DirectoryManifests = "/etc/kubernetes/manifests"
+	// DirectoryVolumes is the directory into which the contents of Secret and ConfigMap volumes are written.
+	DirectoryVolumes = "/var/lib/gardenadm/volumes"
+	// DirectoryData is the directory which is used as replacement for persistent volumes.
+	DirectoryData = "/var/lib/gardenadm/data"
+
+	// PriorityClassName is the name of the PriorityClass used for all static pods.
+	PriorityClassName = "system-node-critical"
+
+	defaultModeSecret    int32 = 0600
+	defaultModeConfigMap int32 = 0644
+	modeManifest         int32 = 0600
+)
+
+// Translate translates the given object into a static pod manifest file and the files for the contents of the
+// volumes it references. Supported objects are Deployments, StatefulSets and Pods. Secrets and ConfigMaps referenced
+// in volumes are read with the given client from the namespace of the object.
+func Translate(ctx context.Context, c client.Reader, obj client.Object) ([]extensionsv1alpha1.File, error) {
+	var (
+		template                 corev1.PodTemplateSpec
+		volumeClaimTemplateNames []string
+	)
+
+	switch o := obj.(type) {
+	case *appsv1.Deployment:
+		template = o.Spec.Template
+	case *appsv1.StatefulSet:
+		template = o.Spec.Template
+		for _, volumeClaimTemplate := range o.Spec.VolumeClaimTemplates {
+			volumeClaimTemplateNames = append(volumeClaimTemplateNames, volumeClaimTemplate.Name)
+		}
+	case *corev1.Pod:
+		template = corev1.PodTemplateSpec{ObjectMeta: o.ObjectMeta, Spec: o.Spec}
+	default:
+		return nil, fmt.Errorf("unsupported object type %T", obj)
+	}
+
+	pod := &corev1.Pod{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: corev1.SchemeGroupVersion.String(),
+			Kind:       "Pod",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        obj.GetName(),
+			Namespace:   metav1.NamespaceSystem,
+			Labels:      template.Labels,
+			Annotations: template.Annotations,
+		},
+		Spec: *template.Spec.DeepCopy(),
+	}
+
+	// Persistent volume claims of StatefulSets are referenced in the containers' volume mounts only, hence they are
+	// added as volumes so that they get translated to host paths.
+	for _, name := range volumeClaimTemplateNames {
+		pod.Spec.Volumes = append(pod.Spec.Volumes, corev1.Volume{
+			Name:         name,
+			VolumeSource: corev1.VolumeSource{PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: name}},
+		})
+	}
+
+	files, err := translateVolumes(ctx, c, obj.GetNamespace(), pod)
+	if err != nil {
+		return nil, err
+	}
+
+	adaptPodSpec(&pod.Spec)
+
+	manifest, err := runtime.Encode(kubernetes.SeedCodec.EncoderForVersion(kubernetes.SeedSerializer, corev1.SchemeGroupVersion), pod)
+	if err != nil {
+		return nil, fmt.Errorf("failed encoding static pod manifest for %s: %w", pod.Name, err)
+	}
+
+	return append([]extensionsv1alpha1.File{newFile(filepath.Join(DirectoryManifests, pod.Name+".yaml"), modeManifest, manifest)}, files...), nil
+}
+
+// VolumeDirectory returns the directory on the host into which the contents of the volume with the given name of the
+// static pod with the given name are written.
+func VolumeDirectory(podName, volumeName string) string {
+	return filepath.Join(DirectoryVolumes, podName, volumeName)
+}
+
+// adaptPodSpec adapts the pod spec such that it can run as a static pod. Static pods cannot reference API objects
+// (e.g., ServiceAccounts), and they must run in the host network since they are started before the pod network is
+// available.
+func adaptPodSpec(spec *corev1.PodSpec) {
+	spec.HostNetwork = true
+	spec.DNSPolicy = corev1.DNSDefault
+	spec.PriorityClassName = PriorityClassName
+	spec.Priority = nil
+	spec.ServiceAccountName = ""
+	spec.DeprecatedServiceAccount = ""
+	spec.AutomountServiceAccountToken = ptr.To(false)
+	spec.Affinity = nil
+	spec.TopologySpreadConstraints = nil
+	spec.NodeSelector = nil
+	spec.NodeName = ""
+	spec.SchedulerName = ""
+
+	// The files for the volumes are owned by root on the host, i.e., the containers must not run as a different user
+	// since they would not be able to read them.
+	if spec.SecurityContext != nil {
+		spec.SecurityContext.RunAsUser = nil
+		spec.SecurityContext.RunAsGroup = nil
+		spec.SecurityContext.RunAsNonRoot = nil
+		spec.SecurityContext.FSGroup = nil
+	}
+	for i := range spec.Containers {
+		if securityContext := spec.Containers[i].SecurityContext; securityContext != nil {
+			securityContext.RunAsUser = nil
+			securityContext.RunAsGroup = nil
+			securityContext.RunAsNonRoot = nil
+		}
+	}
+}
+
+func translateVolumes(ctx context.Context, c client.Reader, namespace string, pod *corev1.Pod) ([]extensionsv1alpha1.File, error) {
+	var files []extensionsv1alpha1.File
+
+	for i, volume := range pod.Spec.Volumes {
+		var (
+			dir         = VolumeDirectory(pod.Name, volume.Name)
+			volumeFiles []extensionsv1alpha1.File
+			err         error
+		)
+
+		switch {
+		case volume.HostPath != nil, volume.EmptyDir != nil:
+			continue
+
+		case volume.Secret != nil:
+			volumeFiles, err = filesForSecret(ctx, c, namespace, dir, volume.Secret.SecretName, volume.Secret.Items, ptr.Deref(volume.Secret.DefaultMode, defaultModeSecret), ptr.Deref(volume.Secret.Optional, false))
+
+		case volume.ConfigMap != nil:
+			volumeFiles, err = filesForConfigMap(ctx, c, namespace, dir, volume.ConfigMap.Name, volume.ConfigMap.Items, ptr.Deref(volume.ConfigMap.DefaultMode, defaultModeConfigMap), ptr.Deref(volume.ConfigMap.Optional, false))
+
+		case volume.Projected != nil:
+			for _, source := range volume.Projected.Sources {
+				var sourceFiles []extensionsv1alpha1.File
+
+				switch {
+				case source.Secret != nil:
+					sourceFiles, err = filesForSecret(ctx, c, namespace, dir, source.Secret.Name, source.Secret.Items, ptr.Deref(volume.Projected.DefaultMode, defaultModeSecret), ptr.Deref(source.Secret.Optional, false))
+				case source.ConfigMap != nil:
+					sourceFiles, err = filesForConfigMap(ctx, c, namespace, dir, source.ConfigMap.Name, source.ConfigMap.Items, ptr.Deref(volume.Projected.DefaultMode, defaultModeConfigMap), ptr.Deref(source.ConfigMap.Optional, false))
+				default:
+					// Other sources (e.g., service account tokens) cannot be provided for static pods.
+					continue
+				}
+
+				if err != nil {
+					break
+				}
+				volumeFiles = append(volumeFiles, sourceFiles...)
+			}
+
+		case volume.PersistentVolumeClaim != nil:
+			dir = filepath.Join(DirectoryData, pod.Name, volume.Name)
+			pod.Spec.Volumes[i].VolumeSource = corev1.VolumeSource{HostPath: &corev1.HostPathVolumeSource{Path: dir, Type: ptr.To(corev1.HostPathDirectoryOrCreate)}}
+			continue
+
+		default:
+			return nil, fmt.Errorf("unsupported type of volume %q in pod %s", volume.Name, pod.Name)
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("failed translating volume %q of pod %s: %w", volume.Name, pod.Name, err)
+		}
+
+		files = append(files, volumeFiles...)
+		pod.Spec.Volumes[i].VolumeSource = corev1.VolumeSource{HostPath: &corev1.HostPathVolumeSource{Path: dir, Type: ptr.To(corev1.HostPathDirectoryOrCreate)}}
+	}
+
+	return files, nil
+}
+
+func filesForSecret(ctx context.Context, c client.Reader, namespace, dir, name string, items []corev1.KeyToPath, defaultMode int32, optional bool) ([]extensionsv1alpha1.File, error) {
+	secret := &corev1.Secret{}
+	if err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, secret); err != nil {
+		if apierrors.IsNotFound(err) && optional {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed reading secret %s: %w", name, err)
+	}
+
+	return filesForData(dir, secret.Data, items, defaultMode)
+}
+
+func filesForConfigMap(ctx context.Context, c client.Reader, namespace, dir, name string, items []corev1.KeyToPath, defaultMode int32, optional bool) ([]extensionsv1alpha1.File, error) {
+	configMap := &corev1.ConfigMap{}
+	if err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, configMap); err != nil {
+		if apierrors.IsNotFound(err) && optional {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed reading config map %s: %w", name, err)
+	}
+
+	data := make(map[string][]byte, len(configMap.Data)+len(configMap.BinaryData))
+	for key, value := range configMap.Data {
+		data[key] = []byte(value)
+	}
+	for key, value := range configMap.BinaryData {
+		data[key] = value
+	}
+
+	return filesForData(dir, data, items, defaultMode)
+}
+
+func filesForData(dir string, data map[string][]byte, items []corev1.KeyToPath, defaultMode int32) ([]extensionsv1alpha1.File, error) {
+	var files []extensionsv1alpha1.File
+
+	if len(items) == 0 {
+		for key := range data {
+			items = append(items, corev1.KeyToPath{Key: key, Path: key})
+		}
+		slices.SortFunc(items, func(a, b corev1.KeyToPath) int { return strings.Compare(a.Key, b.Key) })
+	}
+
+	for _, item := range items {
+		value, ok := data[item.Key]
+		if !ok {
+			return nil, fmt.Errorf("key %q not found", item.Key)
+		}
+
+		files = append(files, newFile(filepath.Join(dir, item.Path), ptr.Deref(item.Mode, defaultMode), value))
+	}
+
+	return files, nil
+}
+
+func newFile(path string, mode int32, content []byte) extensionsv1alpha1.File {
+	return extensionsv1alpha1.File{
+		Path:        path,
+		Permissions: ptr.To(uint32(mode)),
+		Content: extensionsv1alpha1.FileContent{
+			Inline: &extensionsv1alpha1.FileContentInline{
+				Encoding: "b64",
+				Data:     base64.StdEncoding.EncodeToString(content),
+			},
+		},
+	}
+}
+
+// WriteFiles writes the given files below the given root directory. Parent directories are created if necessary.
+func WriteFiles(fs afero.Afero, root string, files []extensionsv1alpha1.File) error {
+	for _, file := range files {
+		if file.Content.Inline == nil {
+			return fmt.Errorf("file %s has no inline content", file.Path)
+		}
+
+		content, err := extensionsv1alpha1helper.Decode(file.Content.Inline.Encoding, []byte(file.Content.Inline.Data))
+		if err != nil {
+			return fmt.Errorf("failed decoding content of file %s: %w", file.Path, err)
+		}
+
+		path := filepath.Join(root, file.Path)
+		if err := fs.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			return fmt.Errorf("failed creating directory %s: %w", filepath.Dir(path), err)
+		}
+
+		if err := fs.WriteFile(path, content, os.FileMode(ptr.Deref(file.Permissions, uint32(defaultModeConfigMap)))); err != nil {
+			return fmt.Errorf("failed writing file %s: %w", path, err)
+		}
+	}
+
+	return nil
+}
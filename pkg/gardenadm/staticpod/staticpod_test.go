@@ -0,0 +1,197 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package staticpod_test
+
+import (
+	"context"
+	"encoding/base64"
+	"os"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/gardenadm/staticpod"
+)
+
+var _ = Describe("StaticPod", func() {
+	var (
+		ctx        = context.Background()
+		namespace  = "shoot--foo--bar"
+		fakeClient client.Client
+
+		secret    *corev1.Secret
+		configMap *corev1.ConfigMap
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "secret", Namespace: namespace},
+			Data:       map[string][]byte{"tls.crt": []byte("cert"), "tls.key": []byte("key")},
+		}
+		configMap = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "config", Namespace: namespace},
+			Data:       map[string]string{"config.yaml": "foo: bar"},
+		}
+
+		Expect(fakeClient.Create(ctx, secret)).To(Succeed())
+		Expect(fakeClient.Create(ctx, configMap)).To(Succeed())
+	})
+
+	decodePod := func(file extensionsv1alpha1.File) *corev1.Pod {
+		data, err := base64.StdEncoding.DecodeString(file.Content.Inline.Data)
+		Expect(err).NotTo(HaveOccurred())
+
+		obj, err := runtime.Decode(kubernetes.SeedCodec.UniversalDeserializer(), data)
+		Expect(err).NotTo(HaveOccurred())
+		return obj.(*corev1.Pod)
+	}
+
+	fileContent := func(data string) extensionsv1alpha1.FileContent {
+		return extensionsv1alpha1.FileContent{Inline: &extensionsv1alpha1.FileContentInline{Encoding: "b64", Data: base64.StdEncoding.EncodeToString([]byte(data))}}
+	}
+
+	Describe("#Translate", func() {
+		var podSpec corev1.PodSpec
+
+		BeforeEach(func() {
+			podSpec = corev1.PodSpec{
+				ServiceAccountName: "foo",
+				PriorityClassName:  "gardener-system-500",
+				NodeSelector:       map[string]string{"foo": "bar"},
+				SecurityContext:    &corev1.PodSecurityContext{RunAsUser: ptr.To[int64](65532), RunAsNonRoot: ptr.To(true)},
+				Containers: []corev1.Container{{
+					Name:  "app",
+					Image: "app:latest",
+				}},
+				Volumes: []corev1.Volume{
+					{Name: "tls", VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: "secret", DefaultMode: ptr.To[int32](0640)}}},
+					{Name: "config", VolumeSource: corev1.VolumeSource{ConfigMap: &corev1.ConfigMapVolumeSource{LocalObjectReference: corev1.LocalObjectReference{Name: "config"}}}},
+					{Name: "projected", VolumeSource: corev1.VolumeSource{Projected: &corev1.ProjectedVolumeSource{Sources: []corev1.VolumeProjection{
+						{Secret: &corev1.SecretProjection{LocalObjectReference: corev1.LocalObjectReference{Name: "secret"}, Items: []corev1.KeyToPath{{Key: "tls.crt", Path: "cert"}}}},
+						{ServiceAccountToken: &corev1.ServiceAccountTokenProjection{Path: "token"}},
+					}}}},
+					{Name: "optional", VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: "missing", Optional: ptr.To(true)}}},
+					{Name: "tmp", VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}},
+				},
+			}
+		})
+
+		It("should translate a Deployment", func() {
+			deployment := &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: namespace},
+				Spec: appsv1.DeploymentSpec{
+					Template: corev1.PodTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "app"}},
+						Spec:       podSpec,
+					},
+				},
+			}
+
+			files, err := Translate(ctx, fakeClient, deployment)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(files).To(HaveLen(5))
+			Expect(files[0].Path).To(Equal("/etc/kubernetes/manifests/app.yaml"))
+			Expect(files[1:]).To(ConsistOf(
+				extensionsv1alpha1.File{Path: "/var/lib/gardenadm/volumes/app/tls/tls.crt", Permissions: ptr.To[uint32](0640), Content: fileContent("cert")},
+				extensionsv1alpha1.File{Path: "/var/lib/gardenadm/volumes/app/tls/tls.key", Permissions: ptr.To[uint32](0640), Content: fileContent("key")},
+				extensionsv1alpha1.File{Path: "/var/lib/gardenadm/volumes/app/config/config.yaml", Permissions: ptr.To[uint32](0644), Content: fileContent("foo: bar")},
+				extensionsv1alpha1.File{Path: "/var/lib/gardenadm/volumes/app/projected/cert", Permissions: ptr.To[uint32](0600), Content: fileContent("cert")},
+			))
+
+			pod := decodePod(files[0])
+			Expect(pod.Name).To(Equal("app"))
+			Expect(pod.Namespace).To(Equal("kube-system"))
+			Expect(pod.Labels).To(Equal(map[string]string{"app": "app"}))
+			Expect(pod.Spec.HostNetwork).To(BeTrue())
+			Expect(pod.Spec.PriorityClassName).To(Equal("system-node-critical"))
+			Expect(pod.Spec.ServiceAccountName).To(BeEmpty())
+			Expect(pod.Spec.AutomountServiceAccountToken).To(Equal(ptr.To(false)))
+			Expect(pod.Spec.NodeSelector).To(BeEmpty())
+			Expect(pod.Spec.SecurityContext.RunAsUser).To(BeNil())
+			Expect(pod.Spec.SecurityContext.RunAsNonRoot).To(BeNil())
+
+			hostPath := func(path string) corev1.VolumeSource {
+				return corev1.VolumeSource{HostPath: &corev1.HostPathVolumeSource{Path: path, Type: ptr.To(corev1.HostPathDirectoryOrCreate)}}
+			}
+			Expect(pod.Spec.Volumes).To(Equal([]corev1.Volume{
+				{Name: "tls", VolumeSource: hostPath("/var/lib/gardenadm/volumes/app/tls")},
+				{Name: "config", VolumeSource: hostPath("/var/lib/gardenadm/volumes/app/config")},
+				{Name: "projected", VolumeSource: hostPath("/var/lib/gardenadm/volumes/app/projected")},
+				{Name: "optional", VolumeSource: hostPath("/var/lib/gardenadm/volumes/app/optional")},
+				{Name: "tmp", VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}},
+			}))
+		})
+
+		It("should translate the volume claim templates of a StatefulSet to host paths", func() {
+			statefulSet := &appsv1.StatefulSet{
+				ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: namespace},
+				Spec: appsv1.StatefulSetSpec{
+					Template:             corev1.PodTemplateSpec{Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "db"}}}},
+					VolumeClaimTemplates: []corev1.PersistentVolumeClaim{{ObjectMeta: metav1.ObjectMeta{Name: "data"}}},
+				},
+			}
+
+			files, err := Translate(ctx, fakeClient, statefulSet)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(files).To(HaveLen(1))
+
+			pod := decodePod(files[0])
+			Expect(pod.Spec.Volumes).To(ConsistOf(corev1.Volume{
+				Name:         "data",
+				VolumeSource: corev1.VolumeSource{HostPath: &corev1.HostPathVolumeSource{Path: "/var/lib/gardenadm/data/db/data", Type: ptr.To(corev1.HostPathDirectoryOrCreate)}},
+			}))
+		})
+
+		It("should fail if a referenced secret does not exist", func() {
+			podSpec.Volumes = append(podSpec.Volumes, corev1.Volume{Name: "missing", VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: "missing"}}})
+
+			_, err := Translate(ctx, fakeClient, &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: namespace}, Spec: podSpec})
+			Expect(err).To(MatchError(ContainSubstring(`failed translating volume "missing" of pod app`)))
+		})
+
+		It("should fail if a referenced key does not exist", func() {
+			podSpec.Volumes[0].Secret.Items = []corev1.KeyToPath{{Key: "ca.crt", Path: "ca.crt"}}
+
+			_, err := Translate(ctx, fakeClient, &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: namespace}, Spec: podSpec})
+			Expect(err).To(MatchError(ContainSubstring(`key "ca.crt" not found`)))
+		})
+
+		It("should fail for unsupported objects", func() {
+			_, err := Translate(ctx, fakeClient, &corev1.Service{})
+			Expect(err).To(MatchError(ContainSubstring("unsupported object type *v1.Service")))
+		})
+	})
+
+	Describe("#WriteFiles", func() {
+		It("should write the files below the root directory", func() {
+			fs := afero.Afero{Fs: afero.NewMemMapFs()}
+
+			Expect(WriteFiles(fs, "/root", []extensionsv1alpha1.File{
+				{Path: "/etc/foo/bar.yaml", Permissions: ptr.To[uint32](0600), Content: fileContent("bar")},
+			})).To(Succeed())
+
+			content, err := fs.ReadFile("/root/etc/foo/bar.yaml")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(content)).To(Equal("bar"))
+
+			info, err := fs.Stat("/root/etc/foo/bar.yaml")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(info.Mode().Perm()).To(Equal(os.FileMode(0600)))
+		})
+	})
+})
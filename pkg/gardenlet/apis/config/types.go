@@ -67,6 +67,8 @@ type GardenletConfiguration struct {
 	Monitoring *MonitoringConfig
 	// NodeToleration contains optional settings for default tolerations.
 	NodeToleration *NodeToleration
+	// OCIChartCache contains optional settings for the cache of Helm charts pulled from OCI registries.
+	OCIChartCache *OCIChartCache
 }
 
 // GardenClientConnection specifies the kubeconfig file and the client connection settings
@@ -148,6 +150,8 @@ type GardenletControllerConfiguration struct {
 	ShootCare *ShootCareControllerConfiguration
 	// ShootState defines the configuration of the ShootState controller.
 	ShootState *ShootStateControllerConfiguration
+	// ShootActivity defines the configuration of the ShootActivity controller.
+	ShootActivity *ShootActivityControllerConfiguration
 	// NetworkPolicy defines the configuration of the NetworkPolicy controller.
 	NetworkPolicy *NetworkPolicyControllerConfiguration
 	// ManagedSeed defines the configuration of the ManagedSeed controller.
@@ -193,6 +197,16 @@ type ControllerInstallationControllerConfiguration struct {
 	// ConcurrentSyncs is the number of workers used for the controller to work on
 	// events.
 	ConcurrentSyncs *int
+	// SignatureVerification configures the verification of the signatures of OCI Helm charts referenced by
+	// ControllerDeployments. If set, only charts carrying a valid signature are installed.
+	SignatureVerification *OCISignatureVerification
+}
+
+// OCISignatureVerification contains the configuration for verifying cosign signatures of OCI artifacts.
+type OCISignatureVerification struct {
+	// PublicKeys is a list of PEM-encoded public keys. An artifact is accepted if it carries a cosign signature which can
+	// be verified with at least one of the keys.
+	PublicKeys []string
 }
 
 // ControllerInstallationCareControllerConfiguration defines the configuration of the ControllerInstallationCare
@@ -297,6 +311,21 @@ type ShootStateControllerConfiguration struct {
 	SyncPeriod *metav1.Duration
 }
 
+// ShootActivityControllerConfiguration defines the configuration of the ShootActivity controller.
+type ShootActivityControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on events.
+	ConcurrentSyncs *int
+	// SyncPeriod is the duration how often the activity of Shoots which are configured to be hibernated when idle is
+	// checked.
+	SyncPeriod *metav1.Duration
+	// APIRequestsQuery is the PromQL query evaluated against the Prometheus of a Shoot to detect API requests of
+	// non-system users. A result greater than zero indicates activity.
+	APIRequestsQuery *string
+	// WorkloadsQuery is the PromQL query evaluated against the Prometheus of a Shoot to detect running non-system
+	// workloads. A result greater than zero indicates activity.
+	WorkloadsQuery *string
+}
+
 // StaleExtensionHealthChecks defines the configuration of the check for stale extension health checks.
 type StaleExtensionHealthChecks struct {
 	// Enabled specifies whether the check for stale extensions health checks is enabled.
@@ -577,3 +606,13 @@ type NodeToleration struct {
 	// should be added to pods not already tolerating this taint.
 	DefaultUnreachableTolerationSeconds *int64
 }
+
+// OCIChartCache contains settings for the cache of Helm charts pulled from OCI registries.
+type OCIChartCache struct {
+	// MaxSize is the maximum total size of the cached charts. When it is exceeded, the least recently used charts are
+	// evicted.
+	MaxSize *resource.Quantity
+	// Directory is the path of a directory in which the cached charts are persisted, so that they survive restarts. If
+	// not set, the charts are only cached in memory.
+	Directory *string
+}
@@ -164,6 +164,9 @@ func SetDefaults_GardenletControllerConfiguration(obj *GardenletControllerConfig
 	if obj.ShootState == nil {
 		obj.ShootState = &ShootStateControllerConfiguration{}
 	}
+	if obj.ShootActivity == nil {
+		obj.ShootActivity = &ShootActivityControllerConfiguration{}
+	}
 	if obj.NetworkPolicy == nil {
 		obj.NetworkPolicy = &NetworkPolicyControllerConfiguration{}
 	}
@@ -383,6 +386,22 @@ func SetDefaults_ShootStateControllerConfiguration(obj *ShootStateControllerConf
 	}
 }
 
+// SetDefaults_ShootActivityControllerConfiguration sets defaults for the shoot activity controller.
+func SetDefaults_ShootActivityControllerConfiguration(obj *ShootActivityControllerConfiguration) {
+	if obj.ConcurrentSyncs == nil {
+		obj.ConcurrentSyncs = ptr.To(5)
+	}
+	if obj.SyncPeriod == nil {
+		obj.SyncPeriod = &metav1.Duration{Duration: 5 * time.Minute}
+	}
+	if obj.APIRequestsQuery == nil {
+		obj.APIRequestsQuery = ptr.To(DefaultShootActivityAPIRequestsQuery)
+	}
+	if obj.WorkloadsQuery == nil {
+		obj.WorkloadsQuery = ptr.To(DefaultShootActivityWorkloadsQuery)
+	}
+}
+
 // SetDefaults_NetworkPolicyControllerConfiguration sets defaults for the network policy controller.
 func SetDefaults_NetworkPolicyControllerConfiguration(obj *NetworkPolicyControllerConfiguration) {
 	if obj.ConcurrentSyncs == nil {
@@ -46,6 +46,7 @@ var _ = Describe("Defaults", func() {
 			Expect(obj.Controllers.ShootCare).NotTo(BeNil())
 			Expect(obj.Controllers.SeedCare).NotTo(BeNil())
 			Expect(obj.Controllers.ShootState).NotTo(BeNil())
+			Expect(obj.Controllers.ShootActivity).NotTo(BeNil())
 			Expect(obj.Controllers.ManagedSeed).NotTo(BeNil())
 			Expect(obj.LeaderElection).NotTo(BeNil())
 			Expect(obj.LogLevel).To(Equal(logger.InfoLevel))
@@ -463,6 +464,35 @@ var _ = Describe("Defaults", func() {
 		})
 	})
 
+	Describe("ShootActivityControllerConfiguration defaulting", func() {
+		It("should default the shoot activity controller configuration", func() {
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.Controllers.ShootActivity.ConcurrentSyncs).To(PointTo(Equal(5)))
+			Expect(obj.Controllers.ShootActivity.SyncPeriod).To(PointTo(Equal(metav1.Duration{Duration: 5 * time.Minute})))
+			Expect(obj.Controllers.ShootActivity.APIRequestsQuery).To(PointTo(Equal(DefaultShootActivityAPIRequestsQuery)))
+			Expect(obj.Controllers.ShootActivity.WorkloadsQuery).To(PointTo(Equal(DefaultShootActivityWorkloadsQuery)))
+		})
+
+		It("should not overwrite already set values for the shoot activity controller configuration", func() {
+			obj.Controllers = &GardenletControllerConfiguration{
+				ShootActivity: &ShootActivityControllerConfiguration{
+					ConcurrentSyncs:  ptr.To(10),
+					SyncPeriod:       &metav1.Duration{Duration: time.Minute},
+					APIRequestsQuery: ptr.To("vector(0)"),
+					WorkloadsQuery:   ptr.To("vector(1)"),
+				},
+			}
+
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.Controllers.ShootActivity.ConcurrentSyncs).To(PointTo(Equal(10)))
+			Expect(obj.Controllers.ShootActivity.SyncPeriod).To(PointTo(Equal(metav1.Duration{Duration: time.Minute})))
+			Expect(obj.Controllers.ShootActivity.APIRequestsQuery).To(PointTo(Equal("vector(0)")))
+			Expect(obj.Controllers.ShootActivity.WorkloadsQuery).To(PointTo(Equal("vector(1)")))
+		})
+	})
+
 	Describe("NetworkPolicyControllerConfiguration defaulting", func() {
 		It("should default the network policy controller configuration", func() {
 			SetObjectDefaults_GardenletConfiguration(obj)
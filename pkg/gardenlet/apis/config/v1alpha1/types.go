@@ -84,6 +84,9 @@ type GardenletConfiguration struct {
 	// NodeToleration contains optional settings for default tolerations.
 	// +optional
 	NodeToleration *NodeToleration `json:"nodeToleration,omitempty"`
+	// OCIChartCache contains optional settings for the cache of Helm charts pulled from OCI registries.
+	// +optional
+	OCIChartCache *OCIChartCache `json:"ociChartCache,omitempty"`
 }
 
 // GardenClientConnection specifies the kubeconfig file and the client connection settings
@@ -185,6 +188,9 @@ type GardenletControllerConfiguration struct {
 	// ShootState defines the configuration of the ShootState controller.
 	// +optional
 	ShootState *ShootStateControllerConfiguration `json:"shootState,omitempty"`
+	// ShootActivity defines the configuration of the ShootActivity controller.
+	// +optional
+	ShootActivity *ShootActivityControllerConfiguration `json:"shootActivity,omitempty"`
 	// NetworkPolicy defines the configuration of the NetworkPolicy controller
 	// +optional
 	NetworkPolicy *NetworkPolicyControllerConfiguration `json:"networkPolicy,omitempty"`
@@ -241,6 +247,17 @@ type ControllerInstallationControllerConfiguration struct {
 	// events.
 	// +optional
 	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
+	// SignatureVerification configures the verification of the signatures of OCI Helm charts referenced by
+	// ControllerDeployments. If set, only charts carrying a valid signature are installed.
+	// +optional
+	SignatureVerification *OCISignatureVerification `json:"signatureVerification,omitempty"`
+}
+
+// OCISignatureVerification contains the configuration for verifying cosign signatures of OCI artifacts.
+type OCISignatureVerification struct {
+	// PublicKeys is a list of PEM-encoded public keys. An artifact is accepted if it carries a cosign signature which can
+	// be verified with at least one of the keys.
+	PublicKeys []string `json:"publicKeys"`
 }
 
 // ControllerInstallationCareControllerConfiguration defines the configuration of the ControllerInstallationCare
@@ -368,6 +385,25 @@ type ShootStateControllerConfiguration struct {
 	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
 }
 
+// ShootActivityControllerConfiguration defines the configuration of the ShootActivity controller.
+type ShootActivityControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on events.
+	// +optional
+	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
+	// SyncPeriod is the duration how often the activity of Shoots which are configured to be hibernated when idle is
+	// checked.
+	// +optional
+	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+	// APIRequestsQuery is the PromQL query evaluated against the Prometheus of a Shoot to detect API requests of
+	// non-system users. A result greater than zero indicates activity.
+	// +optional
+	APIRequestsQuery *string `json:"apiRequestsQuery,omitempty"`
+	// WorkloadsQuery is the PromQL query evaluated against the Prometheus of a Shoot to detect running non-system
+	// workloads. A result greater than zero indicates activity.
+	// +optional
+	WorkloadsQuery *string `json:"workloadsQuery,omitempty"`
+}
+
 // StaleExtensionHealthChecks defines the configuration of the check for stale extension health checks.
 type StaleExtensionHealthChecks struct {
 	// Enabled specifies whether the check for stale extensions health checks is enabled.
@@ -715,6 +751,18 @@ const (
 	// DefaultControllerConcurrentSyncs is a default value for concurrent syncs for controllers.
 	DefaultControllerConcurrentSyncs = 20
 
+	// DefaultShootActivityAPIRequestsQuery is the default PromQL query for detecting API requests of non-system users.
+	// Requests of non-system users are classified by the `global-default` and `service-accounts` flow schemas of the API
+	// Priority and Fairness feature. Requests of users with the `system:masters` group, e.g., via an admin kubeconfig, are
+	// classified by the `exempt` flow schema, which also contains the requests of the kube-apiserver to itself (e.g., for
+	// renewing its identity lease and re-establishing the watches of its informers). Hence, requests in the `exempt` flow
+	// schema are only considered if they exceed 300 requests per kube-apiserver instance within 15m.
+	DefaultShootActivityAPIRequestsQuery = `(sum(increase(apiserver_flowcontrol_dispatched_requests_total{job="kube-apiserver",flow_schema=~"global-default|service-accounts"}[15m])) or vector(0))` +
+		` + clamp_min((sum(increase(apiserver_flowcontrol_dispatched_requests_total{job="kube-apiserver",flow_schema="exempt"}[15m])) or vector(0))` +
+		` - 300 * (sum(up{job="kube-apiserver"}) or vector(1)), 0)`
+	// DefaultShootActivityWorkloadsQuery is the default PromQL query for detecting running non-system workloads.
+	DefaultShootActivityWorkloadsQuery = `sum(kube_pod_status_phase{job="kube-state-metrics",type="shoot",phase="Running",namespace!~"kube-system|kube-public|kube-node-lease"})`
+
 	// LogLevelDebug is the debug log level, i.e. the most verbose.
 	LogLevelDebug = "debug"
 	// LogLevelInfo is the default log level.
@@ -745,3 +793,16 @@ type NodeToleration struct {
 	// +optional
 	DefaultUnreachableTolerationSeconds *int64 `json:"defaultUnreachableTolerationSeconds,omitempty"`
 }
+
+// OCIChartCache contains settings for the cache of Helm charts pulled from OCI registries.
+type OCIChartCache struct {
+	// MaxSize is the maximum total size of the cached charts. When it is exceeded, the least recently used charts are
+	// evicted.
+	// Defaults to 256Mi.
+	// +optional
+	MaxSize *resource.Quantity `json:"maxSize,omitempty"`
+	// Directory is the path of a directory in which the cached charts are persisted, so that they survive restarts. If
+	// not set, the charts are only cached in memory.
+	// +optional
+	Directory *string `json:"directory,omitempty"`
+}
@@ -281,6 +281,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*OCIChartCache)(nil), (*config.OCIChartCache)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_OCIChartCache_To_config_OCIChartCache(a.(*OCIChartCache), b.(*config.OCIChartCache), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.OCIChartCache)(nil), (*OCIChartCache)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_OCIChartCache_To_v1alpha1_OCIChartCache(a.(*config.OCIChartCache), b.(*OCIChartCache), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*OCISignatureVerification)(nil), (*config.OCISignatureVerification)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_OCISignatureVerification_To_config_OCISignatureVerification(a.(*OCISignatureVerification), b.(*config.OCISignatureVerification), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.OCISignatureVerification)(nil), (*OCISignatureVerification)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_OCISignatureVerification_To_v1alpha1_OCISignatureVerification(a.(*config.OCISignatureVerification), b.(*OCISignatureVerification), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*RemoteWriteMonitoringConfig)(nil), (*config.RemoteWriteMonitoringConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_RemoteWriteMonitoringConfig_To_config_RemoteWriteMonitoringConfig(a.(*RemoteWriteMonitoringConfig), b.(*config.RemoteWriteMonitoringConfig), scope)
 	}); err != nil {
@@ -381,6 +401,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ShootActivityControllerConfiguration)(nil), (*config.ShootActivityControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootActivityControllerConfiguration_To_config_ShootActivityControllerConfiguration(a.(*ShootActivityControllerConfiguration), b.(*config.ShootActivityControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ShootActivityControllerConfiguration)(nil), (*ShootActivityControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ShootActivityControllerConfiguration_To_v1alpha1_ShootActivityControllerConfiguration(a.(*config.ShootActivityControllerConfiguration), b.(*ShootActivityControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ShootCareControllerConfiguration)(nil), (*config.ShootCareControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ShootCareControllerConfiguration_To_config_ShootCareControllerConfiguration(a.(*ShootCareControllerConfiguration), b.(*config.ShootCareControllerConfiguration), scope)
 	}); err != nil {
@@ -652,6 +682,7 @@ func Convert_config_ControllerInstallationCareControllerConfiguration_To_v1alpha
 
 func autoConvert_v1alpha1_ControllerInstallationControllerConfiguration_To_config_ControllerInstallationControllerConfiguration(in *ControllerInstallationControllerConfiguration, out *config.ControllerInstallationControllerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	out.SignatureVerification = (*config.OCISignatureVerification)(unsafe.Pointer(in.SignatureVerification))
 	return nil
 }
 
@@ -662,6 +693,7 @@ func Convert_v1alpha1_ControllerInstallationControllerConfiguration_To_config_Co
 
 func autoConvert_config_ControllerInstallationControllerConfiguration_To_v1alpha1_ControllerInstallationControllerConfiguration(in *config.ControllerInstallationControllerConfiguration, out *ControllerInstallationControllerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	out.SignatureVerification = (*OCISignatureVerification)(unsafe.Pointer(in.SignatureVerification))
 	return nil
 }
 
@@ -933,6 +965,7 @@ func autoConvert_v1alpha1_GardenletConfiguration_To_config_GardenletConfiguratio
 	out.ExposureClassHandlers = *(*[]config.ExposureClassHandler)(unsafe.Pointer(&in.ExposureClassHandlers))
 	out.Monitoring = (*config.MonitoringConfig)(unsafe.Pointer(in.Monitoring))
 	out.NodeToleration = (*config.NodeToleration)(unsafe.Pointer(in.NodeToleration))
+	out.OCIChartCache = (*config.OCIChartCache)(unsafe.Pointer(in.OCIChartCache))
 	return nil
 }
 
@@ -1010,6 +1043,7 @@ func autoConvert_config_GardenletConfiguration_To_v1alpha1_GardenletConfiguratio
 	out.ExposureClassHandlers = *(*[]ExposureClassHandler)(unsafe.Pointer(&in.ExposureClassHandlers))
 	out.Monitoring = (*MonitoringConfig)(unsafe.Pointer(in.Monitoring))
 	out.NodeToleration = (*NodeToleration)(unsafe.Pointer(in.NodeToleration))
+	out.OCIChartCache = (*OCIChartCache)(unsafe.Pointer(in.OCIChartCache))
 	return nil
 }
 
@@ -1031,6 +1065,7 @@ func autoConvert_v1alpha1_GardenletControllerConfiguration_To_config_GardenletCo
 	out.Shoot = (*config.ShootControllerConfiguration)(unsafe.Pointer(in.Shoot))
 	out.ShootCare = (*config.ShootCareControllerConfiguration)(unsafe.Pointer(in.ShootCare))
 	out.ShootState = (*config.ShootStateControllerConfiguration)(unsafe.Pointer(in.ShootState))
+	out.ShootActivity = (*config.ShootActivityControllerConfiguration)(unsafe.Pointer(in.ShootActivity))
 	out.NetworkPolicy = (*config.NetworkPolicyControllerConfiguration)(unsafe.Pointer(in.NetworkPolicy))
 	out.ManagedSeed = (*config.ManagedSeedControllerConfiguration)(unsafe.Pointer(in.ManagedSeed))
 	out.TokenRequestorServiceAccount = (*config.TokenRequestorServiceAccountControllerConfiguration)(unsafe.Pointer(in.TokenRequestorServiceAccount))
@@ -1057,6 +1092,7 @@ func autoConvert_config_GardenletControllerConfiguration_To_v1alpha1_GardenletCo
 	out.Shoot = (*ShootControllerConfiguration)(unsafe.Pointer(in.Shoot))
 	out.ShootCare = (*ShootCareControllerConfiguration)(unsafe.Pointer(in.ShootCare))
 	out.ShootState = (*ShootStateControllerConfiguration)(unsafe.Pointer(in.ShootState))
+	out.ShootActivity = (*ShootActivityControllerConfiguration)(unsafe.Pointer(in.ShootActivity))
 	out.NetworkPolicy = (*NetworkPolicyControllerConfiguration)(unsafe.Pointer(in.NetworkPolicy))
 	out.ManagedSeed = (*ManagedSeedControllerConfiguration)(unsafe.Pointer(in.ManagedSeed))
 	out.TokenRequestorServiceAccount = (*TokenRequestorServiceAccountControllerConfiguration)(unsafe.Pointer(in.TokenRequestorServiceAccount))
@@ -1252,6 +1288,48 @@ func Convert_config_NodeToleration_To_v1alpha1_NodeToleration(in *config.NodeTol
 	return autoConvert_config_NodeToleration_To_v1alpha1_NodeToleration(in, out, s)
 }
 
+func autoConvert_v1alpha1_OCIChartCache_To_config_OCIChartCache(in *OCIChartCache, out *config.OCIChartCache, s conversion.Scope) error {
+	out.MaxSize = (*resource.Quantity)(unsafe.Pointer(in.MaxSize))
+	out.Directory = (*string)(unsafe.Pointer(in.Directory))
+	return nil
+}
+
+// Convert_v1alpha1_OCIChartCache_To_config_OCIChartCache is an autogenerated conversion function.
+func Convert_v1alpha1_OCIChartCache_To_config_OCIChartCache(in *OCIChartCache, out *config.OCIChartCache, s conversion.Scope) error {
+	return autoConvert_v1alpha1_OCIChartCache_To_config_OCIChartCache(in, out, s)
+}
+
+func autoConvert_config_OCIChartCache_To_v1alpha1_OCIChartCache(in *config.OCIChartCache, out *OCIChartCache, s conversion.Scope) error {
+	out.MaxSize = (*resource.Quantity)(unsafe.Pointer(in.MaxSize))
+	out.Directory = (*string)(unsafe.Pointer(in.Directory))
+	return nil
+}
+
+// Convert_config_OCIChartCache_To_v1alpha1_OCIChartCache is an autogenerated conversion function.
+func Convert_config_OCIChartCache_To_v1alpha1_OCIChartCache(in *config.OCIChartCache, out *OCIChartCache, s conversion.Scope) error {
+	return autoConvert_config_OCIChartCache_To_v1alpha1_OCIChartCache(in, out, s)
+}
+
+func autoConvert_v1alpha1_OCISignatureVerification_To_config_OCISignatureVerification(in *OCISignatureVerification, out *config.OCISignatureVerification, s conversion.Scope) error {
+	out.PublicKeys = *(*[]string)(unsafe.Pointer(&in.PublicKeys))
+	return nil
+}
+
+// Convert_v1alpha1_OCISignatureVerification_To_config_OCISignatureVerification is an autogenerated conversion function.
+func Convert_v1alpha1_OCISignatureVerification_To_config_OCISignatureVerification(in *OCISignatureVerification, out *config.OCISignatureVerification, s conversion.Scope) error {
+	return autoConvert_v1alpha1_OCISignatureVerification_To_config_OCISignatureVerification(in, out, s)
+}
+
+func autoConvert_config_OCISignatureVerification_To_v1alpha1_OCISignatureVerification(in *config.OCISignatureVerification, out *OCISignatureVerification, s conversion.Scope) error {
+	out.PublicKeys = *(*[]string)(unsafe.Pointer(&in.PublicKeys))
+	return nil
+}
+
+// Convert_config_OCISignatureVerification_To_v1alpha1_OCISignatureVerification is an autogenerated conversion function.
+func Convert_config_OCISignatureVerification_To_v1alpha1_OCISignatureVerification(in *config.OCISignatureVerification, out *OCISignatureVerification, s conversion.Scope) error {
+	return autoConvert_config_OCISignatureVerification_To_v1alpha1_OCISignatureVerification(in, out, s)
+}
+
 func autoConvert_v1alpha1_RemoteWriteMonitoringConfig_To_config_RemoteWriteMonitoringConfig(in *RemoteWriteMonitoringConfig, out *config.RemoteWriteMonitoringConfig, s conversion.Scope) error {
 	out.URL = in.URL
 	out.Keep = *(*[]string)(unsafe.Pointer(&in.Keep))
@@ -1480,6 +1558,32 @@ func Convert_config_ServerConfiguration_To_v1alpha1_ServerConfiguration(in *conf
 	return autoConvert_config_ServerConfiguration_To_v1alpha1_ServerConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_ShootActivityControllerConfiguration_To_config_ShootActivityControllerConfiguration(in *ShootActivityControllerConfiguration, out *config.ShootActivityControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	out.APIRequestsQuery = (*string)(unsafe.Pointer(in.APIRequestsQuery))
+	out.WorkloadsQuery = (*string)(unsafe.Pointer(in.WorkloadsQuery))
+	return nil
+}
+
+// Convert_v1alpha1_ShootActivityControllerConfiguration_To_config_ShootActivityControllerConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_ShootActivityControllerConfiguration_To_config_ShootActivityControllerConfiguration(in *ShootActivityControllerConfiguration, out *config.ShootActivityControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootActivityControllerConfiguration_To_config_ShootActivityControllerConfiguration(in, out, s)
+}
+
+func autoConvert_config_ShootActivityControllerConfiguration_To_v1alpha1_ShootActivityControllerConfiguration(in *config.ShootActivityControllerConfiguration, out *ShootActivityControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	out.APIRequestsQuery = (*string)(unsafe.Pointer(in.APIRequestsQuery))
+	out.WorkloadsQuery = (*string)(unsafe.Pointer(in.WorkloadsQuery))
+	return nil
+}
+
+// Convert_config_ShootActivityControllerConfiguration_To_v1alpha1_ShootActivityControllerConfiguration is an autogenerated conversion function.
+func Convert_config_ShootActivityControllerConfiguration_To_v1alpha1_ShootActivityControllerConfiguration(in *config.ShootActivityControllerConfiguration, out *ShootActivityControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_config_ShootActivityControllerConfiguration_To_v1alpha1_ShootActivityControllerConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_ShootCareControllerConfiguration_To_config_ShootCareControllerConfiguration(in *ShootCareControllerConfiguration, out *config.ShootCareControllerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
 	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
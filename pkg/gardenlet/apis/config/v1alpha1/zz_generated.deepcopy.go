@@ -182,6 +182,11 @@ func (in *ControllerInstallationControllerConfiguration) DeepCopyInto(out *Contr
 		*out = new(int)
 		**out = **in
 	}
+	if in.SignatureVerification != nil {
+		in, out := &in.SignatureVerification, &out.SignatureVerification
+		*out = new(OCISignatureVerification)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -501,6 +506,11 @@ func (in *GardenletConfiguration) DeepCopyInto(out *GardenletConfiguration) {
 		*out = new(NodeToleration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.OCIChartCache != nil {
+		in, out := &in.OCIChartCache, &out.OCIChartCache
+		*out = new(OCIChartCache)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -585,6 +595,11 @@ func (in *GardenletControllerConfiguration) DeepCopyInto(out *GardenletControlle
 		*out = new(ShootStateControllerConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ShootActivity != nil {
+		in, out := &in.ShootActivity, &out.ShootActivity
+		*out = new(ShootActivityControllerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.NetworkPolicy != nil {
 		in, out := &in.NetworkPolicy, &out.NetworkPolicy
 		*out = new(NetworkPolicyControllerConfiguration)
@@ -850,6 +865,53 @@ func (in *NodeToleration) DeepCopy() *NodeToleration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OCIChartCache) DeepCopyInto(out *OCIChartCache) {
+	*out = *in
+	if in.MaxSize != nil {
+		in, out := &in.MaxSize, &out.MaxSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.Directory != nil {
+		in, out := &in.Directory, &out.Directory
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OCIChartCache.
+func (in *OCIChartCache) DeepCopy() *OCIChartCache {
+	if in == nil {
+		return nil
+	}
+	out := new(OCIChartCache)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OCISignatureVerification) DeepCopyInto(out *OCISignatureVerification) {
+	*out = *in
+	if in.PublicKeys != nil {
+		in, out := &in.PublicKeys, &out.PublicKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OCISignatureVerification.
+func (in *OCISignatureVerification) DeepCopy() *OCISignatureVerification {
+	if in == nil {
+		return nil
+	}
+	out := new(OCISignatureVerification)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RemoteWriteMonitoringConfig) DeepCopyInto(out *RemoteWriteMonitoringConfig) {
 	*out = *in
@@ -1093,6 +1155,42 @@ func (in *ServerConfiguration) DeepCopy() *ServerConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootActivityControllerConfiguration) DeepCopyInto(out *ShootActivityControllerConfiguration) {
+	*out = *in
+	if in.ConcurrentSyncs != nil {
+		in, out := &in.ConcurrentSyncs, &out.ConcurrentSyncs
+		*out = new(int)
+		**out = **in
+	}
+	if in.SyncPeriod != nil {
+		in, out := &in.SyncPeriod, &out.SyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.APIRequestsQuery != nil {
+		in, out := &in.APIRequestsQuery, &out.APIRequestsQuery
+		*out = new(string)
+		**out = **in
+	}
+	if in.WorkloadsQuery != nil {
+		in, out := &in.WorkloadsQuery, &out.WorkloadsQuery
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootActivityControllerConfiguration.
+func (in *ShootActivityControllerConfiguration) DeepCopy() *ShootActivityControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootActivityControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootCareControllerConfiguration) DeepCopyInto(out *ShootCareControllerConfiguration) {
 	*out = *in
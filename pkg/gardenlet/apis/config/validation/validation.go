@@ -5,6 +5,8 @@
 package validation
 
 import (
+	"crypto/x509"
+	"encoding/pem"
 	"fmt"
 	"net"
 	"time"
@@ -53,12 +55,18 @@ func ValidateGardenletConfiguration(cfg *config.GardenletConfiguration, fldPath
 		if cfg.Controllers.Bastion != nil {
 			allErrs = append(allErrs, validateBastionControllerConfiguration(cfg.Controllers.Bastion, fldPath.Child("controllers", "bastion"))...)
 		}
+		if cfg.Controllers.ControllerInstallation != nil {
+			allErrs = append(allErrs, validateControllerInstallationControllerConfiguration(cfg.Controllers.ControllerInstallation, fldPath.Child("controllers", "controllerInstallation"))...)
+		}
 		if cfg.Controllers.Shoot != nil {
 			allErrs = append(allErrs, validateShootControllerConfiguration(cfg.Controllers.Shoot, fldPath.Child("controllers", "shoot"))...)
 		}
 		if cfg.Controllers.ShootCare != nil {
 			allErrs = append(allErrs, validateShootCareControllerConfiguration(cfg.Controllers.ShootCare, fldPath.Child("controllers", "shootCare"))...)
 		}
+		if cfg.Controllers.ShootActivity != nil {
+			allErrs = append(allErrs, validateShootActivityControllerConfiguration(cfg.Controllers.ShootActivity, fldPath.Child("controllers", "shootActivity"))...)
+		}
 		if cfg.Controllers.ManagedSeed != nil {
 			allErrs = append(allErrs, validateManagedSeedControllerConfiguration(cfg.Controllers.ManagedSeed, fldPath.Child("controllers", "managedSeed"))...)
 		}
@@ -130,6 +138,27 @@ func ValidateGardenletConfiguration(cfg *config.GardenletConfiguration, fldPath
 		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(ptr.Deref(nodeTolerationCfg.DefaultUnreachableTolerationSeconds, 0), nodeTolerationConfigPath.Child("defaultUnreachableTolerationSeconds"))...)
 	}
 
+	allErrs = append(allErrs, ValidateOCIChartCache(cfg.OCIChartCache, fldPath.Child("ociChartCache"))...)
+
+	return allErrs
+}
+
+// ValidateOCIChartCache validates the configuration of the cache for Helm charts pulled from OCI registries.
+func ValidateOCIChartCache(cfg *config.OCIChartCache, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if cfg == nil {
+		return allErrs
+	}
+
+	if cfg.MaxSize != nil && cfg.MaxSize.Sign() <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxSize"), cfg.MaxSize.String(), "must be greater than 0"))
+	}
+
+	if cfg.Directory != nil && len(*cfg.Directory) == 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("directory"), *cfg.Directory, "must not be empty"))
+	}
+
 	return allErrs
 }
 
@@ -203,6 +232,28 @@ func validateShootCareControllerConfiguration(cfg *config.ShootCareControllerCon
 	return allErrs
 }
 
+func validateShootActivityControllerConfiguration(cfg *config.ShootActivityControllerConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if cfg.ConcurrentSyncs != nil {
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(*cfg.ConcurrentSyncs), fldPath.Child("concurrentSyncs"))...)
+	}
+
+	if cfg.SyncPeriod != nil && cfg.SyncPeriod.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("syncPeriod"), cfg.SyncPeriod.Duration.String(), "must be positive"))
+	}
+
+	if cfg.APIRequestsQuery != nil && len(*cfg.APIRequestsQuery) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("apiRequestsQuery"), "must provide a query"))
+	}
+
+	if cfg.WorkloadsQuery != nil && len(*cfg.WorkloadsQuery) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("workloadsQuery"), "must provide a query"))
+	}
+
+	return allErrs
+}
+
 func validateManagedSeedControllerConfiguration(cfg *config.ManagedSeedControllerConfiguration, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -270,3 +321,28 @@ func validateBastionControllerConfiguration(cfg *config.BastionControllerConfigu
 
 	return allErrs
 }
+
+func validateControllerInstallationControllerConfiguration(cfg *config.ControllerInstallationControllerConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if cfg.SignatureVerification != nil {
+		fldPath := fldPath.Child("signatureVerification", "publicKeys")
+
+		if len(cfg.SignatureVerification.PublicKeys) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath, "must provide at least one public key"))
+		}
+
+		for i, publicKey := range cfg.SignatureVerification.PublicKeys {
+			block, _ := pem.Decode([]byte(publicKey))
+			if block == nil {
+				allErrs = append(allErrs, field.Invalid(fldPath.Index(i), publicKey, "must be a PEM-encoded public key"))
+				continue
+			}
+			if _, err := x509.ParsePKIXPublicKey(block.Bytes); err != nil {
+				allErrs = append(allErrs, field.Invalid(fldPath.Index(i), publicKey, fmt.Sprintf("failed parsing public key: %s", err)))
+			}
+		}
+	}
+
+	return allErrs
+}
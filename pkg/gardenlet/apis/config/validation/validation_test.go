@@ -297,6 +297,38 @@ var _ = Describe("GardenletConfiguration", func() {
 			})
 		})
 
+		Context("shoot activity controller", func() {
+			It("should forbid invalid configuration", func() {
+				cfg.Controllers.ShootActivity = &config.ShootActivityControllerConfiguration{
+					ConcurrentSyncs:  ptr.To(-1),
+					SyncPeriod:       &metav1.Duration{},
+					APIRequestsQuery: ptr.To(""),
+					WorkloadsQuery:   ptr.To(""),
+				}
+
+				errorList := ValidateGardenletConfiguration(cfg, nil, false)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.shootActivity.concurrentSyncs"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.shootActivity.syncPeriod"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("controllers.shootActivity.apiRequestsQuery"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("controllers.shootActivity.workloadsQuery"),
+					})),
+				))
+			})
+		})
+
 		Context("managed seed controller", func() {
 			It("should forbid invalid configuration", func() {
 				invalidConcurrentSyncs := -1
@@ -381,6 +413,54 @@ var _ = Describe("GardenletConfiguration", func() {
 			})
 		})
 
+		Context("controller installation controller", func() {
+			const publicKey = `-----BEGIN PUBLIC KEY-----
+MFkwEwYHKoZIzj0CAQYIKoZIzj0DAQcDQgAEmFm+/GhqbLOffubOtOD+gSOlgcXZ
+ikvbr6fgQeQhwidyRmLoXkdg2tetxVx08yWebff07RLrPFqxgZBhZeAopQ==
+-----END PUBLIC KEY-----`
+
+			BeforeEach(func() {
+				cfg.Controllers.ControllerInstallation = &config.ControllerInstallationControllerConfiguration{
+					SignatureVerification: &config.OCISignatureVerification{PublicKeys: []string{publicKey}},
+				}
+			})
+
+			It("should allow valid configuration", func() {
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(BeEmpty())
+			})
+
+			It("should require at least one public key", func() {
+				cfg.Controllers.ControllerInstallation.SignatureVerification.PublicKeys = nil
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("controllers.controllerInstallation.signatureVerification.publicKeys"),
+					})),
+				))
+			})
+
+			It("should forbid invalid public keys", func() {
+				cfg.Controllers.ControllerInstallation.SignatureVerification.PublicKeys = append(cfg.Controllers.ControllerInstallation.SignatureVerification.PublicKeys,
+					"foo",
+					"-----BEGIN PUBLIC KEY-----\nZm9v\n-----END PUBLIC KEY-----",
+				)
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("controllers.controllerInstallation.signatureVerification.publicKeys[1]"),
+						"Detail": Equal("must be a PEM-encoded public key"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("controllers.controllerInstallation.signatureVerification.publicKeys[2]"),
+						"Detail": ContainSubstring("failed parsing public key"),
+					})),
+				))
+			})
+		})
+
 		Context("network policy controller", func() {
 			BeforeEach(func() {
 				cfg.Controllers.NetworkPolicy = &config.NetworkPolicyControllerConfiguration{}
@@ -632,6 +712,35 @@ var _ = Describe("GardenletConfiguration", func() {
 				)
 			})
 		})
+
+		Context("ociChartCache", func() {
+			It("should pass with valid cache options", func() {
+				cfg.OCIChartCache = &config.OCIChartCache{
+					MaxSize:   ptr.To(resource.MustParse("1Gi")),
+					Directory: ptr.To("/var/cache/charts"),
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(BeEmpty())
+			})
+
+			It("should fail with invalid cache options", func() {
+				cfg.OCIChartCache = &config.OCIChartCache{
+					MaxSize:   ptr.To(resource.MustParse("0")),
+					Directory: ptr.To(""),
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("ociChartCache.maxSize"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("ociChartCache.directory"),
+					})),
+				))
+			})
+		})
 	})
 
 	Describe("#ValidateGardenletConfigurationUpdate", func() {
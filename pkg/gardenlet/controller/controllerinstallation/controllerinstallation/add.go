@@ -6,6 +6,7 @@ package controllerinstallation
 
 import (
 	"context"
+	"fmt"
 	"reflect"
 
 	"k8s.io/utils/clock"
@@ -22,6 +23,8 @@ import (
 
 	gardencorev1 "github.com/gardener/gardener/pkg/apis/core/v1"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	"github.com/gardener/gardener/pkg/utils/oci"
 )
 
@@ -40,7 +43,23 @@ func (r *Reconciler) AddToManager(ctx context.Context, mgr manager.Manager, gard
 		r.Clock = clock.RealClock{}
 	}
 	if r.HelmRegistry == nil {
-		helmRegistry, err := oci.NewHelmRegistry()
+		// Pull secrets labeled with `gardener.cloud/role=helm-pull-secret` in the garden namespace are synced to the seed
+		// namespace in the garden cluster by gardener-controller-manager. Other secrets synced to the seed namespace must
+		// not be used as pull secrets.
+		opts := []oci.HelmRegistryOption{
+			oci.WithPullSecrets(gardenCluster.GetAPIReader(), gardenerutils.ComputeGardenNamespace(r.Config.SeedConfig.Name)),
+			oci.WithPullSecretRole(v1beta1constants.GardenRoleHelmPullSecret),
+		}
+
+		if signatureVerification := r.Config.Controllers.ControllerInstallation.SignatureVerification; signatureVerification != nil {
+			verifier, err := oci.NewCosignVerifier(signatureVerification.PublicKeys)
+			if err != nil {
+				return fmt.Errorf("failed creating signature verifier: %w", err)
+			}
+			opts = append(opts, oci.WithVerifier(verifier))
+		}
+
+		helmRegistry, err := oci.NewHelmRegistry(opts...)
 		if err != nil {
 			return err
 		}
@@ -7,6 +7,7 @@ package controllerinstallation
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
@@ -253,7 +254,11 @@ func (r *Reconciler) reconcile(
 		var err error
 		archive, err = r.HelmRegistry.Pull(seedCtx, helmDeployment.OCIRepository)
 		if err != nil {
-			conditionValid = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionValid, gardencorev1beta1.ConditionFalse, "OCIChartCannotBePulled", fmt.Sprintf("chart pulling process failed: %+v", err))
+			reason := "OCIChartCannotBePulled"
+			if errors.Is(err, oci.ErrSignatureVerificationFailed) {
+				reason = "OCIChartSignatureInvalid"
+			}
+			conditionValid = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionValid, gardencorev1beta1.ConditionFalse, reason, fmt.Sprintf("chart pulling process failed: %+v", err))
 			return reconcile.Result{}, err
 		}
 	}
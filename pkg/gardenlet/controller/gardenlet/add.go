@@ -22,6 +22,7 @@ import (
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/controller/gardenletdeployer"
 	predicateutils "github.com/gardener/gardener/pkg/controllerutils/predicate"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	"github.com/gardener/gardener/pkg/utils/oci"
 )
 
@@ -54,7 +55,10 @@ func (r *Reconciler) AddToManager(
 	}
 	if r.HelmRegistry == nil {
 		var err error
-		r.HelmRegistry, err = oci.NewHelmRegistry()
+		r.HelmRegistry, err = oci.NewHelmRegistry(
+			oci.WithPullSecrets(gardenCluster.GetAPIReader(), gardenerutils.ComputeGardenNamespace(r.Config.SeedConfig.Name)),
+			oci.WithPullSecretRole(v1beta1constants.GardenRoleHelmPullSecret),
+		)
 		if err != nil {
 			return fmt.Errorf("failed creating new Helm registry: %w", err)
 		}
@@ -0,0 +1,94 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package activity
+
+import (
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/cluster"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+)
+
+// ControllerName is the name of this controller.
+const ControllerName = "shoot-activity"
+
+// AddToManager adds Reconciler to the given manager.
+func (r *Reconciler) AddToManager(mgr manager.Manager, gardenCluster cluster.Cluster) error {
+	if r.GardenClient == nil {
+		r.GardenClient = gardenCluster.GetClient()
+	}
+	if r.Clock == nil {
+		r.Clock = clock.RealClock{}
+	}
+	if r.QueryPrometheus == nil {
+		r.QueryPrometheus = QueryShootPrometheus
+	}
+
+	return builder.
+		ControllerManagedBy(mgr).
+		Named(ControllerName).
+		WithOptions(controller.Options{MaxConcurrentReconciles: ptr.Deref(r.Config.ConcurrentSyncs, 0)}).
+		WatchesRawSource(
+			source.Kind[client.Object](gardenCluster.GetCache(),
+				&gardencorev1beta1.Shoot{},
+				&handler.EnqueueRequestForObject{},
+				r.ShootPredicate()),
+		).
+		Complete(r)
+}
+
+// ShootPredicate returns true for all create events of Shoots which are configured to be hibernated when idle. For
+// update events, it only returns true if the idle configuration or the hibernation state of the Shoot has changed.
+func (r *Reconciler) ShootPredicate() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc: func(e event.CreateEvent) bool {
+			shoot, ok := e.Object.(*gardencorev1beta1.Shoot)
+			if !ok {
+				return false
+			}
+
+			return getIdle(shoot) != nil || shoot.Status.LastActivityTime != nil
+		},
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			shoot, ok := e.ObjectNew.(*gardencorev1beta1.Shoot)
+			if !ok {
+				return false
+			}
+
+			oldShoot, ok := e.ObjectOld.(*gardencorev1beta1.Shoot)
+			if !ok {
+				return false
+			}
+
+			if getIdle(shoot) == nil && getIdle(oldShoot) == nil {
+				return false
+			}
+
+			return !equality.Semantic.DeepEqual(getIdle(oldShoot), getIdle(shoot)) ||
+				v1beta1helper.HibernationIsEnabled(oldShoot) != v1beta1helper.HibernationIsEnabled(shoot) ||
+				oldShoot.Status.IsHibernated != shoot.Status.IsHibernated
+		},
+		DeleteFunc:  func(event.DeleteEvent) bool { return false },
+		GenericFunc: func(event.GenericEvent) bool { return false },
+	}
+}
+
+func getIdle(shoot *gardencorev1beta1.Shoot) *gardencorev1beta1.HibernationIdle {
+	if shoot.Spec.Hibernation == nil {
+		return nil
+	}
+	return shoot.Spec.Hibernation.Idle
+}
@@ -0,0 +1,139 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package activity_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/shoot/activity"
+)
+
+var _ = Describe("Add", func() {
+	var (
+		reconciler *Reconciler
+		shoot      *gardencorev1beta1.Shoot
+	)
+
+	BeforeEach(func() {
+		reconciler = &Reconciler{}
+		shoot = &gardencorev1beta1.Shoot{
+			Spec: gardencorev1beta1.ShootSpec{
+				Hibernation: &gardencorev1beta1.Hibernation{
+					Idle: &gardencorev1beta1.HibernationIdle{Timeout: metav1.Duration{Duration: time.Hour}},
+				},
+			},
+		}
+	})
+
+	Describe("#ShootPredicate", func() {
+		var p predicate.Predicate
+
+		BeforeEach(func() {
+			p = reconciler.ShootPredicate()
+		})
+
+		Describe("#Create", func() {
+			It("should return false because object is no shoot", func() {
+				Expect(p.Create(event.CreateEvent{})).To(BeFalse())
+			})
+
+			It("should return false because shoot is not configured to be hibernated when idle", func() {
+				Expect(p.Create(event.CreateEvent{Object: &gardencorev1beta1.Shoot{}})).To(BeFalse())
+			})
+
+			It("should return true because shoot is configured to be hibernated when idle", func() {
+				Expect(p.Create(event.CreateEvent{Object: shoot})).To(BeTrue())
+			})
+
+			It("should return true because shoot has a last activity time", func() {
+				shoot.Spec.Hibernation = nil
+				shoot.Status.LastActivityTime = &metav1.Time{Time: time.Now()}
+
+				Expect(p.Create(event.CreateEvent{Object: shoot})).To(BeTrue())
+			})
+		})
+
+		Describe("#Update", func() {
+			It("should return false because new object is no shoot", func() {
+				Expect(p.Update(event.UpdateEvent{})).To(BeFalse())
+			})
+
+			It("should return false because old object is no shoot", func() {
+				Expect(p.Update(event.UpdateEvent{ObjectNew: shoot})).To(BeFalse())
+			})
+
+			It("should return false because neither shoot is configured to be hibernated when idle", func() {
+				shoot.Spec.Hibernation = nil
+				oldShoot := shoot.DeepCopy()
+				shoot.Status.IsHibernated = true
+
+				Expect(p.Update(event.UpdateEvent{ObjectNew: shoot, ObjectOld: oldShoot})).To(BeFalse())
+			})
+
+			It("should return false because nothing relevant changed", func() {
+				oldShoot := shoot.DeepCopy()
+				shoot.Status.LastActivityTime = &metav1.Time{Time: time.Now()}
+
+				Expect(p.Update(event.UpdateEvent{ObjectNew: shoot, ObjectOld: oldShoot})).To(BeFalse())
+			})
+
+			It("should return true because idle configuration was added", func() {
+				oldShoot := shoot.DeepCopy()
+				oldShoot.Spec.Hibernation = nil
+
+				Expect(p.Update(event.UpdateEvent{ObjectNew: shoot, ObjectOld: oldShoot})).To(BeTrue())
+			})
+
+			It("should return true because idle configuration was removed", func() {
+				oldShoot := shoot.DeepCopy()
+				shoot.Spec.Hibernation.Idle = nil
+
+				Expect(p.Update(event.UpdateEvent{ObjectNew: shoot, ObjectOld: oldShoot})).To(BeTrue())
+			})
+
+			It("should return true because idle timeout changed", func() {
+				oldShoot := shoot.DeepCopy()
+				shoot.Spec.Hibernation.Idle.Timeout.Duration = 2 * time.Hour
+
+				Expect(p.Update(event.UpdateEvent{ObjectNew: shoot, ObjectOld: oldShoot})).To(BeTrue())
+			})
+
+			It("should return true because hibernation was enabled", func() {
+				oldShoot := shoot.DeepCopy()
+				shoot.Spec.Hibernation.Enabled = ptr.To(true)
+
+				Expect(p.Update(event.UpdateEvent{ObjectNew: shoot, ObjectOld: oldShoot})).To(BeTrue())
+			})
+
+			It("should return true because shoot woke up", func() {
+				shoot.Status.IsHibernated = true
+				oldShoot := shoot.DeepCopy()
+				shoot.Status.IsHibernated = false
+
+				Expect(p.Update(event.UpdateEvent{ObjectNew: shoot, ObjectOld: oldShoot})).To(BeTrue())
+			})
+		})
+
+		Describe("#Delete", func() {
+			It("should return false", func() {
+				Expect(p.Delete(event.DeleteEvent{})).To(BeFalse())
+			})
+		})
+
+		Describe("#Generic", func() {
+			It("should return false", func() {
+				Expect(p.Generic(event.GenericEvent{})).To(BeFalse())
+			})
+		})
+	})
+})
@@ -0,0 +1,53 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package activity
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	prometheusapi "github.com/prometheus/client_golang/api"
+	prometheusv1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/common/model"
+
+	shootprometheus "github.com/gardener/gardener/pkg/component/observability/monitoring/prometheus/shoot"
+)
+
+// prometheusServicePort is the port of the service of the Prometheus of a Shoot.
+const prometheusServicePort = 80
+
+// QueryShootPrometheus evaluates the given PromQL query against the Prometheus of the Shoot running in the given
+// namespace of the seed cluster. Vector results are summed up.
+func QueryShootPrometheus(ctx context.Context, namespace, query string) (float64, error) {
+	client, err := prometheusapi.NewClient(prometheusapi.Config{
+		Address: fmt.Sprintf("http://prometheus-%s.%s:%d", shootprometheus.Label, namespace, prometheusServicePort),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed creating Prometheus client: %w", err)
+	}
+
+	result, _, err := prometheusv1.NewAPI(client).Query(ctx, query, time.Now())
+	if err != nil {
+		return 0, fmt.Errorf("failed evaluating query: %w", err)
+	}
+
+	return resultValue(result)
+}
+
+func resultValue(result model.Value) (float64, error) {
+	switch v := result.(type) {
+	case model.Vector:
+		var sum float64
+		for _, sample := range v {
+			sum += float64(sample.Value)
+		}
+		return sum, nil
+	case *model.Scalar:
+		return float64(v.Value), nil
+	default:
+		return 0, fmt.Errorf("unsupported result type %q", result.Type())
+	}
+}
@@ -0,0 +1,126 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package activity
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
+)
+
+// QueryFunc evaluates the given PromQL query against the Prometheus of the Shoot running in the given namespace of the
+// seed cluster and returns the (summed up) result.
+type QueryFunc func(ctx context.Context, namespace, query string) (float64, error)
+
+// Reconciler observes the activity in Shoot clusters which are configured to be hibernated when idle and maintains
+// the last time at which activity was observed in the Shoot status.
+type Reconciler struct {
+	GardenClient    client.Client
+	Config          config.ShootActivityControllerConfiguration
+	Clock           clock.Clock
+	SeedName        string
+	QueryPrometheus QueryFunc
+}
+
+// Reconcile observes the activity in Shoot clusters and updates the last activity time in the Shoot status.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	ctx, cancel := controllerutils.GetMainReconciliationContext(ctx, controllerutils.DefaultReconciliationTimeout)
+	defer cancel()
+
+	shoot := &gardencorev1beta1.Shoot{}
+	if err := r.GardenClient.Get(ctx, request.NamespacedName, shoot); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	// if shoot got deleted or is no longer managed by this gardenlet (e.g., due to migration to another seed) then don't requeue
+	if shoot.DeletionTimestamp != nil || ptr.Deref(shoot.Spec.SeedName, "") != r.SeedName {
+		return reconcile.Result{}, nil
+	}
+
+	if getIdle(shoot) == nil || v1beta1helper.HibernationIsEnabled(shoot) || shoot.Status.IsHibernated {
+		// The activity is only observed for awake Shoots which are configured to be hibernated when idle. When the
+		// Shoot wakes up again, the activity is tracked from scratch.
+		log.V(1).Info("Shoot is not configured to be hibernated when idle or is hibernated, stop observing its activity")
+		return reconcile.Result{}, r.patchLastActivityTime(ctx, shoot, nil)
+	}
+
+	requeueAfter := r.Config.SyncPeriod.Duration
+
+	if shoot.Status.TechnicalID == "" || !shootCreatedSuccessfully(shoot.Status) {
+		log.Info("Requeuing because shoot was not yet successfully created", "requeueAfter", requeueAfter)
+		return reconcile.Result{RequeueAfter: requeueAfter}, nil
+	}
+
+	active := r.isActive(ctx, log, shoot.Status.TechnicalID)
+	if active || shoot.Status.LastActivityTime == nil {
+		log.V(1).Info("Updating last activity time", "active", active)
+		if err := r.patchLastActivityTime(ctx, shoot, &metav1.Time{Time: r.Clock.Now().UTC()}); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
+	return reconcile.Result{RequeueAfter: requeueAfter}, nil
+}
+
+func (r *Reconciler) isActive(ctx context.Context, log logr.Logger, namespace string) bool {
+	for _, query := range []string{ptr.Deref(r.Config.APIRequestsQuery, ""), ptr.Deref(r.Config.WorkloadsQuery, "")} {
+		if query == "" {
+			continue
+		}
+
+		result, err := r.QueryPrometheus(ctx, namespace, query)
+		if err != nil {
+			// Without a reliable signal the Shoot must not be hibernated, hence, it is considered active.
+			log.Error(err, "Failed querying Prometheus, considering Shoot as active", "query", query)
+			return true
+		}
+
+		if result > 0 {
+			log.V(1).Info("Observed activity", "query", query, "result", result)
+			return true
+		}
+	}
+
+	return false
+}
+
+func (r *Reconciler) patchLastActivityTime(ctx context.Context, shoot *gardencorev1beta1.Shoot, lastActivityTime *metav1.Time) error {
+	if lastActivityTime == nil && shoot.Status.LastActivityTime == nil {
+		return nil
+	}
+
+	patch := client.MergeFrom(shoot.DeepCopy())
+	shoot.Status.LastActivityTime = lastActivityTime
+	if err := r.GardenClient.Status().Patch(ctx, shoot, patch); err != nil {
+		return fmt.Errorf("failed patching last activity time of shoot: %w", err)
+	}
+
+	return nil
+}
+
+func shootCreatedSuccessfully(status gardencorev1beta1.ShootStatus) bool {
+	return status.LastOperation != nil &&
+		((status.LastOperation.Type == gardencorev1beta1.LastOperationTypeCreate && status.LastOperation.State == gardencorev1beta1.LastOperationStateSucceeded) ||
+			status.LastOperation.Type != gardencorev1beta1.LastOperationTypeCreate)
+}
@@ -0,0 +1,204 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package activity_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/shoot/activity"
+)
+
+var _ = Describe("Reconciler", func() {
+	const (
+		seedName    = "seed"
+		technicalID = "shoot--foo--bar"
+
+		apiRequestsQuery = "api-requests"
+		workloadsQuery   = "workloads"
+	)
+
+	var (
+		ctx          = context.TODO()
+		gardenClient client.Client
+		fakeClock    *testclock.FakeClock
+
+		reconciler *Reconciler
+		shoot      *gardencorev1beta1.Shoot
+		request    reconcile.Request
+
+		queryResults map[string]float64
+		queryErr     error
+		queriedNS    []string
+
+		syncPeriod = 5 * time.Minute
+	)
+
+	BeforeEach(func() {
+		gardenClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).WithStatusSubresource(&gardencorev1beta1.Shoot{}).Build()
+		fakeClock = testclock.NewFakeClock(time.Date(2024, 6, 1, 10, 0, 0, 0, time.UTC))
+
+		queryResults = map[string]float64{}
+		queryErr = nil
+		queriedNS = nil
+
+		reconciler = &Reconciler{
+			GardenClient: gardenClient,
+			Config: config.ShootActivityControllerConfiguration{
+				SyncPeriod:       &metav1.Duration{Duration: syncPeriod},
+				APIRequestsQuery: ptr.To(apiRequestsQuery),
+				WorkloadsQuery:   ptr.To(workloadsQuery),
+			},
+			Clock:    fakeClock,
+			SeedName: seedName,
+			QueryPrometheus: func(_ context.Context, namespace, query string) (float64, error) {
+				queriedNS = append(queriedNS, namespace)
+				return queryResults[query], queryErr
+			},
+		}
+
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: "garden-foo"},
+			Spec: gardencorev1beta1.ShootSpec{
+				SeedName: ptr.To(seedName),
+				Hibernation: &gardencorev1beta1.Hibernation{
+					Idle: &gardencorev1beta1.HibernationIdle{Timeout: metav1.Duration{Duration: time.Hour}},
+				},
+			},
+			Status: gardencorev1beta1.ShootStatus{
+				TechnicalID: technicalID,
+				LastOperation: &gardencorev1beta1.LastOperation{
+					Type:  gardencorev1beta1.LastOperationTypeReconcile,
+					State: gardencorev1beta1.LastOperationStateSucceeded,
+				},
+			},
+		}
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(shoot)}
+	})
+
+	JustBeforeEach(func() {
+		Expect(gardenClient.Create(ctx, shoot)).To(Succeed())
+	})
+
+	lastActivityTime := func() *metav1.Time {
+		ExpectWithOffset(1, gardenClient.Get(ctx, request.NamespacedName, shoot)).To(Succeed())
+		return shoot.Status.LastActivityTime
+	}
+
+	It("should do nothing if the shoot is gone", func() {
+		Expect(gardenClient.Delete(ctx, shoot)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+		Expect(queriedNS).To(BeEmpty())
+	})
+
+	Context("shoot belongs to another seed", func() {
+		BeforeEach(func() {
+			shoot.Spec.SeedName = ptr.To("other-seed")
+		})
+
+		It("should do nothing", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+			Expect(queriedNS).To(BeEmpty())
+			Expect(lastActivityTime()).To(BeNil())
+		})
+	})
+
+	Context("shoot is not configured to be hibernated when idle", func() {
+		BeforeEach(func() {
+			shoot.Spec.Hibernation = nil
+			shoot.Status.LastActivityTime = &metav1.Time{Time: fakeClock.Now().Add(-time.Hour)}
+		})
+
+		It("should remove the last activity time", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+			Expect(queriedNS).To(BeEmpty())
+			Expect(lastActivityTime()).To(BeNil())
+		})
+	})
+
+	Context("shoot is hibernated", func() {
+		BeforeEach(func() {
+			shoot.Spec.Hibernation.Enabled = ptr.To(true)
+			shoot.Status.IsHibernated = true
+			shoot.Status.LastActivityTime = &metav1.Time{Time: fakeClock.Now().Add(-time.Hour)}
+		})
+
+		It("should remove the last activity time", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+			Expect(queriedNS).To(BeEmpty())
+			Expect(lastActivityTime()).To(BeNil())
+		})
+	})
+
+	Context("shoot was not yet created successfully", func() {
+		BeforeEach(func() {
+			shoot.Status.LastOperation = &gardencorev1beta1.LastOperation{
+				Type:  gardencorev1beta1.LastOperationTypeCreate,
+				State: gardencorev1beta1.LastOperationStateProcessing,
+			}
+		})
+
+		It("should requeue", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+			Expect(queriedNS).To(BeEmpty())
+			Expect(lastActivityTime()).To(BeNil())
+		})
+	})
+
+	It("should initialize the last activity time if no activity was observed", func() {
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+		Expect(queriedNS).To(ConsistOf(technicalID, technicalID))
+		Expect(lastActivityTime().Time.UTC()).To(Equal(fakeClock.Now()))
+	})
+
+	Context("last activity time is set", func() {
+		var previousActivity time.Time
+
+		BeforeEach(func() {
+			previousActivity = fakeClock.Now().Add(-time.Hour).Truncate(time.Second)
+			shoot.Status.LastActivityTime = &metav1.Time{Time: previousActivity}
+		})
+
+		It("should keep the last activity time if no activity was observed", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+			Expect(lastActivityTime().Time.UTC()).To(Equal(previousActivity))
+		})
+
+		It("should update the last activity time if API requests were observed", func() {
+			queryResults[apiRequestsQuery] = 3
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+			Expect(lastActivityTime().Time.UTC()).To(Equal(fakeClock.Now()))
+		})
+
+		It("should update the last activity time if running workloads were observed", func() {
+			queryResults[workloadsQuery] = 1
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+			Expect(lastActivityTime().Time.UTC()).To(Equal(fakeClock.Now()))
+		})
+
+		It("should consider the shoot active if Prometheus cannot be queried", func() {
+			queryErr = errors.New("fake")
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+			Expect(lastActivityTime().Time.UTC()).To(Equal(fakeClock.Now()))
+		})
+	})
+})
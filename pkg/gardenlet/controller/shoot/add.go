@@ -20,6 +20,8 @@ import (
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/client/kubernetes/clientmap"
 	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
+	gardenlethelper "github.com/gardener/gardener/pkg/gardenlet/apis/config/helper"
+	"github.com/gardener/gardener/pkg/gardenlet/controller/shoot/activity"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/shoot/care"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/shoot/shoot"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/shoot/state"
@@ -82,5 +84,16 @@ func AddToManager(
 		}
 	}
 
+	// The activity of shoots is observed via their Prometheus instances which are only deployed if monitoring is
+	// enabled.
+	if gardenlethelper.IsMonitoringEnabled(&cfg) && ptr.Deref(cfg.Controllers.ShootActivity.ConcurrentSyncs, 0) > 0 {
+		if err := (&activity.Reconciler{
+			Config:   *cfg.Controllers.ShootActivity,
+			SeedName: cfg.SeedConfig.Name,
+		}).AddToManager(mgr, gardenCluster); err != nil {
+			return fmt.Errorf("failed adding activity reconciler: %w", err)
+		}
+	}
+
 	return nil
 }
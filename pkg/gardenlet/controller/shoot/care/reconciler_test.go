@@ -210,6 +210,7 @@ var _ = Describe("Shoot Care Control", func() {
 				DeferCleanup(test.WithVars(
 					&NewOperation, operationFunc,
 					&NewGarbageCollector, nopGarbageCollectorFunc(),
+					&NewWorkerPoolObserver, workerPoolObserverFunc(nil),
 				))
 				reconciler = &Reconciler{
 					GardenClient:   gardenClient,
@@ -268,6 +269,46 @@ var _ = Describe("Shoot Care Control", func() {
 				})
 			})
 
+			Context("when worker pools are observed", func() {
+				var workerPools = []gardencorev1beta1.WorkerPoolStatus{{Name: "worker", Machines: 3}}
+
+				BeforeEach(func() {
+					DeferCleanup(test.WithVars(
+						&NewHealthCheck, healthCheckFunc(func(_ ShootConditions) []gardencorev1beta1.Condition { return nil }),
+						&NewConstraintCheck, constraintCheckFunc(func(_ ShootConstraints) []gardencorev1beta1.Condition { return nil }),
+					))
+				})
+
+				JustBeforeEach(func() {
+					DeferCleanup(test.WithVars(
+						&NewWorkerPoolObserver, workerPoolObserverFunc(workerPools),
+					))
+				})
+
+				It("should set the observed worker pools", func() {
+					Expect(reconciler.Reconcile(ctx, req)).To(Equal(reconcile.Result{RequeueAfter: careSyncPeriod}))
+
+					updatedShoot := &gardencorev1beta1.Shoot{}
+					Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), updatedShoot)).To(Succeed())
+					Expect(updatedShoot.Status.WorkerPools).To(Equal(workerPools))
+				})
+
+				It("should remove the worker pools if none are observed anymore", func() {
+					DeferCleanup(test.WithVars(
+						&NewWorkerPoolObserver, workerPoolObserverFunc(nil),
+					))
+
+					shoot.Status.WorkerPools = []gardencorev1beta1.WorkerPoolStatus{{Name: "worker", Machines: 3}}
+					Expect(gardenClient.Status().Update(ctx, shoot)).To(Succeed())
+
+					Expect(reconciler.Reconcile(ctx, req)).To(Equal(reconcile.Result{RequeueAfter: careSyncPeriod}))
+
+					updatedShoot := &gardencorev1beta1.Shoot{}
+					Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), updatedShoot)).To(Succeed())
+					Expect(updatedShoot.Status.WorkerPools).To(BeEmpty())
+				})
+			})
+
 			Context("when conditions / constraints are returned unchanged", func() {
 				BeforeEach(func() {
 					DeferCleanup(test.WithVars(
@@ -568,6 +609,18 @@ func nopGarbageCollectorFunc() NewGarbageCollectorFunc {
 	}
 }
 
+type resultingWorkerPools []gardencorev1beta1.WorkerPoolStatus
+
+func (w resultingWorkerPools) Observe(_ context.Context) []gardencorev1beta1.WorkerPoolStatus {
+	return w
+}
+
+func workerPoolObserverFunc(workerPools []gardencorev1beta1.WorkerPoolStatus) NewWorkerPoolObserverFunc {
+	return func(_ logr.Logger, _ *gardencorev1beta1.Shoot, _ client.Client, _ string) WorkerPoolObserver {
+		return resultingWorkerPools(workerPools)
+	}
+}
+
 func consistOfConditionsInUnknownStatus(message string, isWorkerless bool) types.GomegaMatcher {
 	var expectedLength = 4
 	matcher := And(
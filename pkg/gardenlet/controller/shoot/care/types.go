@@ -123,6 +123,19 @@ var defaultNewWebhookRemediator = func(log logr.Logger, shoot *gardencorev1beta1
 	return NewWebhookRemediation(log, shoot, init)
 }
 
+// WorkerPoolObserver is an interface used to observe the machines of the worker pools of a Shoot.
+type WorkerPoolObserver interface {
+	Observe(ctx context.Context) []gardencorev1beta1.WorkerPoolStatus
+}
+
+// NewWorkerPoolObserverFunc is a function used to create a new instance to observe the worker pools of a Shoot.
+type NewWorkerPoolObserverFunc func(log logr.Logger, shoot *gardencorev1beta1.Shoot, seedClient client.Client, namespace string) WorkerPoolObserver
+
+// defaultNewWorkerPoolObserver is the default function to create a new instance to observe the worker pools of a Shoot.
+var defaultNewWorkerPoolObserver = func(log logr.Logger, shoot *gardencorev1beta1.Shoot, seedClient client.Client, namespace string) WorkerPoolObserver {
+	return NewWorkerPoolObservation(log, shoot, seedClient, namespace)
+}
+
 // NewOperationFunc is a function used to create a new `operation.Operation` instance.
 type NewOperationFunc func(
 	ctx context.Context,
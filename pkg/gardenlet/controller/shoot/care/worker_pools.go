@@ -0,0 +1,69 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package care
+
+import (
+	"context"
+
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	"github.com/go-logr/logr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+)
+
+// WorkerPoolObservation contains required information for observing the machines of the worker pools of a Shoot.
+type WorkerPoolObservation struct {
+	seedClient client.Client
+	namespace  string
+	shoot      *gardencorev1beta1.Shoot
+	log        logr.Logger
+}
+
+// NewWorkerPoolObservation creates a new worker pool observation instance. The machines are observed in the given
+// control plane namespace of the Shoot in the seed cluster.
+func NewWorkerPoolObservation(log logr.Logger, shoot *gardencorev1beta1.Shoot, seedClient client.Client, namespace string) *WorkerPoolObservation {
+	return &WorkerPoolObservation{
+		seedClient: seedClient,
+		namespace:  namespace,
+		shoot:      shoot,
+		log:        log,
+	}
+}
+
+// Observe counts the machines of each worker pool of the Shoot cluster. The machines are assigned to the worker pools
+// based on the labels of their node templates, which are maintained by Gardener and cannot be changed by the owner of
+// the Shoot, as opposed to the labels of the nodes. It returns nil if the Shoot is workerless or hibernated. If the
+// machines cannot be listed, the previously observed worker pools are returned.
+func (w *WorkerPoolObservation) Observe(ctx context.Context) []gardencorev1beta1.WorkerPoolStatus {
+	if v1beta1helper.IsWorkerless(w.shoot) || (v1beta1helper.HibernationIsEnabled(w.shoot) && w.shoot.Status.IsHibernated) {
+		return nil
+	}
+
+	machineList := &machinev1alpha1.MachineList{}
+	if err := w.seedClient.List(ctx, machineList, client.InNamespace(w.namespace)); err != nil {
+		w.log.Error(err, "Error during worker pool observation")
+		return w.shoot.Status.WorkerPools
+	}
+
+	machinesPerPool := make(map[string]int32, len(w.shoot.Spec.Provider.Workers))
+	for _, machine := range machineList.Items {
+		if pool, ok := machine.Spec.NodeTemplateSpec.Labels[v1beta1constants.LabelWorkerPool]; ok {
+			machinesPerPool[pool]++
+		}
+	}
+
+	workerPools := make([]gardencorev1beta1.WorkerPoolStatus, 0, len(w.shoot.Spec.Provider.Workers))
+	for _, worker := range w.shoot.Spec.Provider.Workers {
+		workerPools = append(workerPools, gardencorev1beta1.WorkerPoolStatus{
+			Name:     worker.Name,
+			Machines: machinesPerPool[worker.Name],
+		})
+	}
+
+	return workerPools
+}
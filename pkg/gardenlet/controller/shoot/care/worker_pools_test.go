@@ -0,0 +1,93 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package care_test
+
+import (
+	"context"
+	"fmt"
+
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/shoot/care"
+)
+
+var _ = Describe("WorkerPoolObservation", func() {
+	const namespace = "shoot--foo--bar"
+
+	var (
+		ctx            = context.Background()
+		fakeSeedClient client.Client
+
+		shoot       *gardencorev1beta1.Shoot
+		observation *WorkerPoolObservation
+	)
+
+	BeforeEach(func() {
+		fakeSeedClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+
+		shoot = &gardencorev1beta1.Shoot{
+			Spec: gardencorev1beta1.ShootSpec{
+				Provider: gardencorev1beta1.Provider{
+					Workers: []gardencorev1beta1.Worker{{Name: "pool1"}, {Name: "pool2"}},
+				},
+			},
+			Status: gardencorev1beta1.ShootStatus{
+				WorkerPools: []gardencorev1beta1.WorkerPoolStatus{{Name: "pool1", Machines: 5}},
+			},
+		}
+	})
+
+	JustBeforeEach(func() {
+		observation = NewWorkerPoolObservation(logr.Discard(), shoot, fakeSeedClient, namespace)
+	})
+
+	Describe("#Observe", func() {
+		It("should count the machines per worker pool", func() {
+			for i, pool := range []string{"pool1", "pool1", "pool2", "pool3"} {
+				Expect(fakeSeedClient.Create(ctx, &machinev1alpha1.Machine{
+					ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("machine%d", i), Namespace: namespace},
+					Spec: machinev1alpha1.MachineSpec{NodeTemplateSpec: machinev1alpha1.NodeTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"worker.gardener.cloud/pool": pool}},
+					}},
+				})).To(Succeed())
+			}
+			Expect(fakeSeedClient.Create(ctx, &machinev1alpha1.Machine{ObjectMeta: metav1.ObjectMeta{Name: "unmanaged", Namespace: namespace}})).To(Succeed())
+			Expect(fakeSeedClient.Create(ctx, &machinev1alpha1.Machine{
+				ObjectMeta: metav1.ObjectMeta{Name: "other-namespace", Namespace: "shoot--foo--baz"},
+				Spec: machinev1alpha1.MachineSpec{NodeTemplateSpec: machinev1alpha1.NodeTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"worker.gardener.cloud/pool": "pool2"}},
+				}},
+			})).To(Succeed())
+
+			Expect(observation.Observe(ctx)).To(Equal([]gardencorev1beta1.WorkerPoolStatus{
+				{Name: "pool1", Machines: 2},
+				{Name: "pool2", Machines: 1},
+			}))
+		})
+
+		It("should return nil if the shoot is hibernated", func() {
+			shoot.Spec.Hibernation = &gardencorev1beta1.Hibernation{Enabled: ptr.To(true)}
+			shoot.Status.IsHibernated = true
+
+			Expect(observation.Observe(ctx)).To(BeNil())
+		})
+
+		It("should return the previously observed worker pools if the machines cannot be listed", func() {
+			observation = NewWorkerPoolObservation(logr.Discard(), shoot, fakeclient.NewClientBuilder().WithScheme(runtime.NewScheme()).Build(), namespace)
+
+			Expect(observation.Observe(ctx)).To(Equal(shoot.Status.WorkerPools))
+		})
+	})
+})
@@ -989,6 +989,13 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 		return v1beta1helper.NewWrappedLastErrors(v1beta1helper.FormatLastErrDescription(err), err)
 	}
 
+	// The secrets inventory is only used for reporting expiring certificates, hence failing to publish it must not fail
+	// the reconciliation. It is published again with the next reconciliation.
+	o.Logger.Info("Publishing secrets inventory")
+	if err := botanist.PublishSecretsInventory(ctx); err != nil {
+		o.Logger.Error(err, "Failed to publish secrets inventory")
+	}
+
 	if !r.ShootStateControllerEnabled && botanist.IsRestorePhase() {
 		o.Logger.Info("Deleting Shoot State after successful restoration")
 		if err := shootstate.Delete(ctx, botanist.GardenClient, botanist.Shoot.GetInfo()); err != nil {
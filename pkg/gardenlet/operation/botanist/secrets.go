@@ -421,6 +421,28 @@ func (b *Botanist) syncShootConfigMapToGarden(
 	return err
 }
 
+// PublishSecretsInventory publishes the summary of all certificate authorities and certificates managed in the shoot
+// namespace in the seed to the `<shoot-name>.secrets-inventory` ConfigMap in the project namespace in the garden.
+func (b *Botanist) PublishSecretsInventory(ctx context.Context) error {
+	certificates, err := secretsmanager.ListCertificates(ctx, b.SeedClientSet.Client(), b.Shoot.SeedNamespace)
+	if err != nil {
+		return err
+	}
+
+	data, err := gardenerutils.NewSecretsInventory(b.Shoot.GetInfo(), certificates).ConfigMapData()
+	if err != nil {
+		return err
+	}
+
+	return b.syncShootConfigMapToGarden(
+		ctx,
+		gardenerutils.ShootProjectConfigMapSuffixSecretsInventory,
+		map[string]string{v1beta1constants.GardenRole: v1beta1constants.GardenRoleSecretsInventory},
+		nil,
+		data,
+	)
+}
+
 func (b *Botanist) deleteSSHKeypair(ctx context.Context) error {
 	return b.deleteShootCredentialFromGarden(ctx, gardenerutils.ShootProjectSecretSuffixSSHKeypair, gardenerutils.ShootProjectSecretSuffixOldSSHKeypair)
 }
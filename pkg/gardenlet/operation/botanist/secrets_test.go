@@ -29,6 +29,7 @@ import (
 	seedpkg "github.com/gardener/gardener/pkg/gardenlet/operation/seed"
 	shootpkg "github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
 	"github.com/gardener/gardener/pkg/utils"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
 	fakesecretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager/fake"
 	. "github.com/gardener/gardener/pkg/utils/test/matchers"
@@ -431,6 +432,28 @@ var _ = Describe("Secrets", func() {
 			})
 		})
 	})
+
+	Describe("#PublishSecretsInventory", func() {
+		It("should publish the managed certificate authorities to the garden", func() {
+			Expect(botanist.InitializeSecretsManagement(ctx)).To(Succeed())
+			Expect(botanist.PublishSecretsInventory(ctx)).To(Succeed())
+
+			gardenConfigMap := &corev1.ConfigMap{}
+			Expect(gardenClient.Get(ctx, client.ObjectKey{Namespace: gardenNamespace, Name: shootName + ".secrets-inventory"}, gardenConfigMap)).To(Succeed())
+			Expect(gardenConfigMap.Labels).To(HaveKeyWithValue("gardener.cloud/role", "secrets-inventory"))
+			Expect(gardenConfigMap.OwnerReferences).To(ConsistOf(HaveField("Name", shootName)))
+
+			inventory, err := gardenerutils.SecretsInventoryFromConfigMap(gardenConfigMap)
+			Expect(err).NotTo(HaveOccurred())
+
+			var caNames []string
+			for _, certificate := range inventory.Certificates {
+				Expect(certificate.CA).To(BeTrue())
+				caNames = append(caNames, certificate.Name)
+			}
+			Expect(caNames).To(ContainElements(caSecretNames))
+		})
+	})
 })
 
 func verifyCASecret(name string, secret *corev1.Secret, dataMatcher gomegatypes.GomegaMatcher) {
@@ -40,6 +40,8 @@ type OperatorConfiguration struct {
 	Controllers ControllerConfiguration
 	// NodeToleration contains optional settings for default tolerations.
 	NodeToleration *NodeTolerationConfiguration
+	// OCIChartCache contains optional settings for the cache of Helm charts pulled from OCI registries.
+	OCIChartCache *gardenletconfig.OCIChartCache
 }
 
 // ConditionThreshold defines the threshold of the given condition type.
@@ -43,6 +43,9 @@ type OperatorConfiguration struct {
 	// NodeToleration contains optional settings for default tolerations.
 	// +optional
 	NodeToleration *NodeTolerationConfiguration `json:"nodeToleration,omitempty"`
+	// OCIChartCache contains optional settings for the cache of Helm charts pulled from OCI registries.
+	// +optional
+	OCIChartCache *gardenletv1alpha1.OCIChartCache `json:"ociChartCache,omitempty"`
 }
 
 // ConditionThreshold defines the threshold of the given condition type.
@@ -423,6 +423,7 @@ func autoConvert_v1alpha1_OperatorConfiguration_To_config_OperatorConfiguration(
 		return err
 	}
 	out.NodeToleration = (*config.NodeTolerationConfiguration)(unsafe.Pointer(in.NodeToleration))
+	out.OCIChartCache = (*apisconfig.OCIChartCache)(unsafe.Pointer(in.OCIChartCache))
 	return nil
 }
 
@@ -460,6 +461,7 @@ func autoConvert_config_OperatorConfiguration_To_v1alpha1_OperatorConfiguration(
 		return err
 	}
 	out.NodeToleration = (*NodeTolerationConfiguration)(unsafe.Pointer(in.NodeToleration))
+	out.OCIChartCache = (*configv1alpha1.OCIChartCache)(unsafe.Pointer(in.OCIChartCache))
 	return nil
 }
 
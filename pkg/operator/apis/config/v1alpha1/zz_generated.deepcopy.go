@@ -256,6 +256,11 @@ func (in *OperatorConfiguration) DeepCopyInto(out *OperatorConfiguration) {
 		*out = new(NodeTolerationConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.OCIChartCache != nil {
+		in, out := &in.OCIChartCache, &out.OCIChartCache
+		*out = new(configv1alpha1.OCIChartCache)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
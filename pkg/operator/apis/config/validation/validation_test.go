@@ -11,10 +11,12 @@ import (
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
 	gomegatypes "github.com/onsi/gomega/types"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/ptr"
 
+	gardenletconfig "github.com/gardener/gardener/pkg/gardenlet/apis/config"
 	"github.com/gardener/gardener/pkg/operator/apis/config"
 	. "github.com/gardener/gardener/pkg/operator/apis/config/validation"
 )
@@ -212,4 +214,23 @@ var _ = Describe("#ValidateOperatorConfiguration", func() {
 			)
 		})
 	})
+
+	Context("OCI chart cache", func() {
+		It("should pass with valid cache options", func() {
+			conf.OCIChartCache = &gardenletconfig.OCIChartCache{MaxSize: ptr.To(resource.MustParse("1Gi"))}
+
+			Expect(ValidateOperatorConfiguration(conf)).To(BeEmpty())
+		})
+
+		It("should fail with invalid cache options", func() {
+			conf.OCIChartCache = &gardenletconfig.OCIChartCache{MaxSize: ptr.To(resource.MustParse("-1"))}
+
+			Expect(ValidateOperatorConfiguration(conf)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("ociChartCache.maxSize"),
+				})),
+			))
+		})
+	})
 })
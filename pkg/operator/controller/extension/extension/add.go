@@ -56,17 +56,17 @@ func (r *Reconciler) AddToManager(ctx context.Context, mgr manager.Manager, gard
 	r.clock = clock.RealClock{}
 	r.recorder = mgr.GetEventRecorderFor(ControllerName + "-controller")
 
+	if r.GardenNamespace == "" {
+		r.GardenNamespace = v1beta1constants.GardenNamespace
+	}
+
 	if r.HelmRegistry == nil {
-		r.HelmRegistry, err = oci.NewHelmRegistry()
+		r.HelmRegistry, err = oci.NewHelmRegistry(oci.WithPullSecrets(mgr.GetAPIReader(), r.GardenNamespace))
 		if err != nil {
 			return fmt.Errorf("failed creating Helm registry: %w", err)
 		}
 	}
 
-	if r.GardenNamespace == "" {
-		r.GardenNamespace = v1beta1constants.GardenNamespace
-	}
-
 	r.admission = admission.New(r.runtimeClientSet, r.recorder, r.GardenNamespace, r.HelmRegistry)
 	r.controllerRegistration = controllerregistration.New(r.runtimeClientSet.Client(), r.recorder, r.GardenNamespace)
 	r.runtime = extensionruntime.New(r.runtimeClientSet, r.recorder, r.GardenNamespace, r.HelmRegistry)
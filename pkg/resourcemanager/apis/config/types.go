@@ -92,6 +92,8 @@ type ResourceManagerControllerConfiguration struct {
 	Health HealthControllerConfig
 	// CSRApprover is the configuration for the csr-approver controller.
 	CSRApprover CSRApproverControllerConfig
+	// DriftDetection is the configuration for the drift-detection controller.
+	DriftDetection DriftDetectionControllerConfig
 	// ManagedResource is the configuration for the managed resource controller.
 	ManagedResource ManagedResourceControllerConfig
 	// NetworkPolicy is the configuration for the networkpolicy controller.
@@ -116,6 +118,19 @@ type CSRApproverControllerConfig struct {
 	MachineNamespace string
 }
 
+// DriftDetectionControllerConfig is the configuration for the drift-detection controller.
+type DriftDetectionControllerConfig struct {
+	// Enabled defines whether this controller is enabled.
+	Enabled bool
+	// ConcurrentSyncs is the number of concurrent worker routines for this controller.
+	ConcurrentSyncs *int
+	// SyncPeriod is the duration how often the controller performs its reconciliation.
+	SyncPeriod *metav1.Duration
+	// IgnoredFieldManagers is a list of field managers whose modifications of objects managed by ManagedResources are
+	// not considered as drift.
+	IgnoredFieldManagers []string
+}
+
 // GarbageCollectorControllerConfig is the configuration for the garbage-collector controller.
 type GarbageCollectorControllerConfig struct {
 	// Enabled defines whether this controller is enabled.
@@ -130,6 +145,29 @@ type HealthControllerConfig struct {
 	ConcurrentSyncs *int
 	// SyncPeriod is the duration how often the controller performs its reconciliation.
 	SyncPeriod *metav1.Duration
+	// HealthRules are rules for checking the health of custom resources, i.e., of objects whose kind is not registered
+	// in the scheme of the target client.
+	HealthRules []HealthRule
+}
+
+// HealthRule defines how the health of objects of a certain kind is checked.
+type HealthRule struct {
+	// Group is the API group of the objects. It is empty for the core API group.
+	Group string
+	// Kind is the kind of the objects.
+	Kind string
+	// Expressions are CEL expressions which must all evaluate to true for an object to be considered healthy. The
+	// object is available as variable `self`. If no expressions are given, the object is considered healthy if its
+	// `Ready` condition is `True`, it is not `Stalled`, and its `status.observedGeneration` is up-to-date.
+	Expressions []HealthRuleExpression
+}
+
+// HealthRuleExpression is a CEL expression for checking the health of an object.
+type HealthRuleExpression struct {
+	// Expression is a CEL expression evaluating to a bool.
+	Expression string
+	// Message is reported if the expression evaluates to false. Defaults to the expression.
+	Message string
 }
 
 // ManagedResourceControllerConfig is the configuration for the managed resource controller.
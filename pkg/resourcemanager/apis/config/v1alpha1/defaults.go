@@ -98,6 +98,24 @@ func SetDefaults_CSRApproverControllerConfig(obj *CSRApproverControllerConfig) {
 	}
 }
 
+// SetDefaults_DriftDetectionControllerConfig sets defaults for the DriftDetectionControllerConfig object.
+func SetDefaults_DriftDetectionControllerConfig(obj *DriftDetectionControllerConfig) {
+	if !obj.Enabled {
+		return
+	}
+
+	if obj.ConcurrentSyncs == nil {
+		obj.ConcurrentSyncs = ptr.To(5)
+	}
+	if obj.SyncPeriod == nil {
+		obj.SyncPeriod = &metav1.Duration{Duration: 5 * time.Minute}
+	}
+	if obj.IgnoredFieldManagers == nil {
+		// the kube-controller-manager updates annotations of some objects, e.g., the revision annotations of Deployments
+		obj.IgnoredFieldManagers = []string{"kube-controller-manager"}
+	}
+}
+
 // SetDefaults_GarbageCollectorControllerConfig sets defaults for the GarbageCollectorControllerConfig object.
 func SetDefaults_GarbageCollectorControllerConfig(obj *GarbageCollectorControllerConfig) {
 	if obj.Enabled && obj.SyncPeriod == nil {
@@ -232,6 +232,45 @@ var _ = Describe("ResourceManager defaulting", func() {
 		})
 	})
 
+	Describe("DriftDetectionControllerConfig defaulting", func() {
+		It("should not default the DriftDetectionControllerConfig because it is disabled", func() {
+			obj.Controllers.DriftDetection = DriftDetectionControllerConfig{}
+
+			SetObjectDefaults_ResourceManagerConfiguration(obj)
+
+			Expect(obj.Controllers.DriftDetection.ConcurrentSyncs).To(BeNil())
+			Expect(obj.Controllers.DriftDetection.SyncPeriod).To(BeNil())
+			Expect(obj.Controllers.DriftDetection.IgnoredFieldManagers).To(BeNil())
+		})
+
+		It("should default the DriftDetectionControllerConfig because it is enabled", func() {
+			obj.Controllers.DriftDetection = DriftDetectionControllerConfig{
+				Enabled: true,
+			}
+
+			SetObjectDefaults_ResourceManagerConfiguration(obj)
+
+			Expect(obj.Controllers.DriftDetection.ConcurrentSyncs).To(PointTo(Equal(5)))
+			Expect(obj.Controllers.DriftDetection.SyncPeriod).To(PointTo(Equal(metav1.Duration{Duration: 5 * time.Minute})))
+			Expect(obj.Controllers.DriftDetection.IgnoredFieldManagers).To(ConsistOf("kube-controller-manager"))
+		})
+
+		It("should not overwrite already set values for DriftDetectionControllerConfig", func() {
+			obj.Controllers.DriftDetection = DriftDetectionControllerConfig{
+				Enabled:              true,
+				ConcurrentSyncs:      ptr.To(2),
+				SyncPeriod:           &metav1.Duration{Duration: time.Minute},
+				IgnoredFieldManagers: []string{},
+			}
+
+			SetObjectDefaults_ResourceManagerConfiguration(obj)
+
+			Expect(obj.Controllers.DriftDetection.ConcurrentSyncs).To(PointTo(Equal(2)))
+			Expect(obj.Controllers.DriftDetection.SyncPeriod).To(PointTo(Equal(metav1.Duration{Duration: time.Minute})))
+			Expect(obj.Controllers.DriftDetection.IgnoredFieldManagers).To(BeEmpty())
+		})
+	})
+
 	Describe("GarbageCollectorControllerConfig defaulting", func() {
 		It("should not default the GarbageCollectorControllerConfig because it is disabled", func() {
 			obj.Controllers.GarbageCollector = GarbageCollectorControllerConfig{}
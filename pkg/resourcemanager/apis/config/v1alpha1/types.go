@@ -101,6 +101,8 @@ type ResourceManagerControllerConfiguration struct {
 	Health HealthControllerConfig `json:"health"`
 	// CSRApprover is the configuration for the csr-approver controller.
 	CSRApprover CSRApproverControllerConfig `json:"csrApprover"`
+	// DriftDetection is the configuration for the drift-detection controller.
+	DriftDetection DriftDetectionControllerConfig `json:"driftDetection"`
 	// ManagedResource is the configuration for the managed resource controller.
 	ManagedResource ManagedResourceControllerConfig `json:"managedResource"`
 	// NetworkPolicy is the configuration for the networkpolicy controller.
@@ -126,6 +128,22 @@ type CSRApproverControllerConfig struct {
 	MachineNamespace string `json:"machineNamespace"`
 }
 
+// DriftDetectionControllerConfig is the configuration for the drift-detection controller.
+type DriftDetectionControllerConfig struct {
+	// Enabled defines whether this controller is enabled.
+	Enabled bool `json:"enabled"`
+	// ConcurrentSyncs is the number of concurrent worker routines for this controller.
+	// +optional
+	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
+	// SyncPeriod is the duration how often the controller performs its reconciliation.
+	// +optional
+	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+	// IgnoredFieldManagers is a list of field managers whose modifications of objects managed by ManagedResources are
+	// not considered as drift. Defaults to `kube-controller-manager`.
+	// +optional
+	IgnoredFieldManagers []string `json:"ignoredFieldManagers,omitempty"`
+}
+
 // GarbageCollectorControllerConfig is the configuration for the garbage-collector controller.
 type GarbageCollectorControllerConfig struct {
 	// Enabled defines whether this controller is enabled.
@@ -143,6 +161,33 @@ type HealthControllerConfig struct {
 	// SyncPeriod is the duration how often the controller performs its reconciliation.
 	// +optional
 	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+	// HealthRules are rules for checking the health of custom resources, i.e., of objects whose kind is not registered
+	// in the scheme of the target client.
+	// +optional
+	HealthRules []HealthRule `json:"healthRules,omitempty"`
+}
+
+// HealthRule defines how the health of objects of a certain kind is checked.
+type HealthRule struct {
+	// Group is the API group of the objects. It is empty for the core API group.
+	// +optional
+	Group string `json:"group,omitempty"`
+	// Kind is the kind of the objects.
+	Kind string `json:"kind"`
+	// Expressions are CEL expressions which must all evaluate to true for an object to be considered healthy. The
+	// object is available as variable `self`. If no expressions are given, the object is considered healthy if its
+	// `Ready` condition is `True`, it is not `Stalled`, and its `status.observedGeneration` is up-to-date.
+	// +optional
+	Expressions []HealthRuleExpression `json:"expressions,omitempty"`
+}
+
+// HealthRuleExpression is a CEL expression for checking the health of an object.
+type HealthRuleExpression struct {
+	// Expression is a CEL expression evaluating to a bool.
+	Expression string `json:"expression"`
+	// Message is reported if the expression evaluates to false. Defaults to the expression.
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
 // ManagedResourceControllerConfig is the configuration for the managed resource controller.
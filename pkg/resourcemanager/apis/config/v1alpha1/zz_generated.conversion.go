@@ -58,6 +58,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*DriftDetectionControllerConfig)(nil), (*config.DriftDetectionControllerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DriftDetectionControllerConfig_To_config_DriftDetectionControllerConfig(a.(*DriftDetectionControllerConfig), b.(*config.DriftDetectionControllerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.DriftDetectionControllerConfig)(nil), (*DriftDetectionControllerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_DriftDetectionControllerConfig_To_v1alpha1_DriftDetectionControllerConfig(a.(*config.DriftDetectionControllerConfig), b.(*DriftDetectionControllerConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*EndpointSliceHintsWebhookConfig)(nil), (*config.EndpointSliceHintsWebhookConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_EndpointSliceHintsWebhookConfig_To_config_EndpointSliceHintsWebhookConfig(a.(*EndpointSliceHintsWebhookConfig), b.(*config.EndpointSliceHintsWebhookConfig), scope)
 	}); err != nil {
@@ -108,6 +118,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*HealthRule)(nil), (*config.HealthRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_HealthRule_To_config_HealthRule(a.(*HealthRule), b.(*config.HealthRule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.HealthRule)(nil), (*HealthRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_HealthRule_To_v1alpha1_HealthRule(a.(*config.HealthRule), b.(*HealthRule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*HealthRuleExpression)(nil), (*config.HealthRuleExpression)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_HealthRuleExpression_To_config_HealthRuleExpression(a.(*HealthRuleExpression), b.(*config.HealthRuleExpression), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.HealthRuleExpression)(nil), (*HealthRuleExpression)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_HealthRuleExpression_To_v1alpha1_HealthRuleExpression(a.(*config.HealthRuleExpression), b.(*HealthRuleExpression), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*HighAvailabilityConfigWebhookConfig)(nil), (*config.HighAvailabilityConfigWebhookConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_HighAvailabilityConfigWebhookConfig_To_config_HighAvailabilityConfigWebhookConfig(a.(*HighAvailabilityConfigWebhookConfig), b.(*config.HighAvailabilityConfigWebhookConfig), scope)
 	}); err != nil {
@@ -403,6 +433,32 @@ func Convert_config_ClientConnection_To_v1alpha1_ClientConnection(in *config.Cli
 	return autoConvert_config_ClientConnection_To_v1alpha1_ClientConnection(in, out, s)
 }
 
+func autoConvert_v1alpha1_DriftDetectionControllerConfig_To_config_DriftDetectionControllerConfig(in *DriftDetectionControllerConfig, out *config.DriftDetectionControllerConfig, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	out.IgnoredFieldManagers = *(*[]string)(unsafe.Pointer(&in.IgnoredFieldManagers))
+	return nil
+}
+
+// Convert_v1alpha1_DriftDetectionControllerConfig_To_config_DriftDetectionControllerConfig is an autogenerated conversion function.
+func Convert_v1alpha1_DriftDetectionControllerConfig_To_config_DriftDetectionControllerConfig(in *DriftDetectionControllerConfig, out *config.DriftDetectionControllerConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DriftDetectionControllerConfig_To_config_DriftDetectionControllerConfig(in, out, s)
+}
+
+func autoConvert_config_DriftDetectionControllerConfig_To_v1alpha1_DriftDetectionControllerConfig(in *config.DriftDetectionControllerConfig, out *DriftDetectionControllerConfig, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	out.IgnoredFieldManagers = *(*[]string)(unsafe.Pointer(&in.IgnoredFieldManagers))
+	return nil
+}
+
+// Convert_config_DriftDetectionControllerConfig_To_v1alpha1_DriftDetectionControllerConfig is an autogenerated conversion function.
+func Convert_config_DriftDetectionControllerConfig_To_v1alpha1_DriftDetectionControllerConfig(in *config.DriftDetectionControllerConfig, out *DriftDetectionControllerConfig, s conversion.Scope) error {
+	return autoConvert_config_DriftDetectionControllerConfig_To_v1alpha1_DriftDetectionControllerConfig(in, out, s)
+}
+
 func autoConvert_v1alpha1_EndpointSliceHintsWebhookConfig_To_config_EndpointSliceHintsWebhookConfig(in *EndpointSliceHintsWebhookConfig, out *config.EndpointSliceHintsWebhookConfig, s conversion.Scope) error {
 	out.Enabled = in.Enabled
 	return nil
@@ -498,6 +554,7 @@ func Convert_config_HTTPSServer_To_v1alpha1_HTTPSServer(in *config.HTTPSServer,
 func autoConvert_v1alpha1_HealthControllerConfig_To_config_HealthControllerConfig(in *HealthControllerConfig, out *config.HealthControllerConfig, s conversion.Scope) error {
 	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
 	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	out.HealthRules = *(*[]config.HealthRule)(unsafe.Pointer(&in.HealthRules))
 	return nil
 }
 
@@ -509,6 +566,7 @@ func Convert_v1alpha1_HealthControllerConfig_To_config_HealthControllerConfig(in
 func autoConvert_config_HealthControllerConfig_To_v1alpha1_HealthControllerConfig(in *config.HealthControllerConfig, out *HealthControllerConfig, s conversion.Scope) error {
 	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
 	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	out.HealthRules = *(*[]HealthRule)(unsafe.Pointer(&in.HealthRules))
 	return nil
 }
 
@@ -517,6 +575,52 @@ func Convert_config_HealthControllerConfig_To_v1alpha1_HealthControllerConfig(in
 	return autoConvert_config_HealthControllerConfig_To_v1alpha1_HealthControllerConfig(in, out, s)
 }
 
+func autoConvert_v1alpha1_HealthRule_To_config_HealthRule(in *HealthRule, out *config.HealthRule, s conversion.Scope) error {
+	out.Group = in.Group
+	out.Kind = in.Kind
+	out.Expressions = *(*[]config.HealthRuleExpression)(unsafe.Pointer(&in.Expressions))
+	return nil
+}
+
+// Convert_v1alpha1_HealthRule_To_config_HealthRule is an autogenerated conversion function.
+func Convert_v1alpha1_HealthRule_To_config_HealthRule(in *HealthRule, out *config.HealthRule, s conversion.Scope) error {
+	return autoConvert_v1alpha1_HealthRule_To_config_HealthRule(in, out, s)
+}
+
+func autoConvert_config_HealthRule_To_v1alpha1_HealthRule(in *config.HealthRule, out *HealthRule, s conversion.Scope) error {
+	out.Group = in.Group
+	out.Kind = in.Kind
+	out.Expressions = *(*[]HealthRuleExpression)(unsafe.Pointer(&in.Expressions))
+	return nil
+}
+
+// Convert_config_HealthRule_To_v1alpha1_HealthRule is an autogenerated conversion function.
+func Convert_config_HealthRule_To_v1alpha1_HealthRule(in *config.HealthRule, out *HealthRule, s conversion.Scope) error {
+	return autoConvert_config_HealthRule_To_v1alpha1_HealthRule(in, out, s)
+}
+
+func autoConvert_v1alpha1_HealthRuleExpression_To_config_HealthRuleExpression(in *HealthRuleExpression, out *config.HealthRuleExpression, s conversion.Scope) error {
+	out.Expression = in.Expression
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_v1alpha1_HealthRuleExpression_To_config_HealthRuleExpression is an autogenerated conversion function.
+func Convert_v1alpha1_HealthRuleExpression_To_config_HealthRuleExpression(in *HealthRuleExpression, out *config.HealthRuleExpression, s conversion.Scope) error {
+	return autoConvert_v1alpha1_HealthRuleExpression_To_config_HealthRuleExpression(in, out, s)
+}
+
+func autoConvert_config_HealthRuleExpression_To_v1alpha1_HealthRuleExpression(in *config.HealthRuleExpression, out *HealthRuleExpression, s conversion.Scope) error {
+	out.Expression = in.Expression
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_config_HealthRuleExpression_To_v1alpha1_HealthRuleExpression is an autogenerated conversion function.
+func Convert_config_HealthRuleExpression_To_v1alpha1_HealthRuleExpression(in *config.HealthRuleExpression, out *HealthRuleExpression, s conversion.Scope) error {
+	return autoConvert_config_HealthRuleExpression_To_v1alpha1_HealthRuleExpression(in, out, s)
+}
+
 func autoConvert_v1alpha1_HighAvailabilityConfigWebhookConfig_To_config_HighAvailabilityConfigWebhookConfig(in *HighAvailabilityConfigWebhookConfig, out *config.HighAvailabilityConfigWebhookConfig, s conversion.Scope) error {
 	out.Enabled = in.Enabled
 	out.DefaultNotReadyTolerationSeconds = (*int64)(unsafe.Pointer(in.DefaultNotReadyTolerationSeconds))
@@ -871,6 +975,9 @@ func autoConvert_v1alpha1_ResourceManagerControllerConfiguration_To_config_Resou
 	if err := Convert_v1alpha1_CSRApproverControllerConfig_To_config_CSRApproverControllerConfig(&in.CSRApprover, &out.CSRApprover, s); err != nil {
 		return err
 	}
+	if err := Convert_v1alpha1_DriftDetectionControllerConfig_To_config_DriftDetectionControllerConfig(&in.DriftDetection, &out.DriftDetection, s); err != nil {
+		return err
+	}
 	if err := Convert_v1alpha1_ManagedResourceControllerConfig_To_config_ManagedResourceControllerConfig(&in.ManagedResource, &out.ManagedResource, s); err != nil {
 		return err
 	}
@@ -909,6 +1016,9 @@ func autoConvert_config_ResourceManagerControllerConfiguration_To_v1alpha1_Resou
 	if err := Convert_config_CSRApproverControllerConfig_To_v1alpha1_CSRApproverControllerConfig(&in.CSRApprover, &out.CSRApprover, s); err != nil {
 		return err
 	}
+	if err := Convert_config_DriftDetectionControllerConfig_To_v1alpha1_DriftDetectionControllerConfig(&in.DriftDetection, &out.DriftDetection, s); err != nil {
+		return err
+	}
 	if err := Convert_config_ManagedResourceControllerConfig_To_v1alpha1_ManagedResourceControllerConfig(&in.ManagedResource, &out.ManagedResource, s); err != nil {
 		return err
 	}
@@ -80,6 +80,37 @@ func (in *ClientConnection) DeepCopy() *ClientConnection {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DriftDetectionControllerConfig) DeepCopyInto(out *DriftDetectionControllerConfig) {
+	*out = *in
+	if in.ConcurrentSyncs != nil {
+		in, out := &in.ConcurrentSyncs, &out.ConcurrentSyncs
+		*out = new(int)
+		**out = **in
+	}
+	if in.SyncPeriod != nil {
+		in, out := &in.SyncPeriod, &out.SyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.IgnoredFieldManagers != nil {
+		in, out := &in.IgnoredFieldManagers, &out.IgnoredFieldManagers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DriftDetectionControllerConfig.
+func (in *DriftDetectionControllerConfig) DeepCopy() *DriftDetectionControllerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(DriftDetectionControllerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EndpointSliceHintsWebhookConfig) DeepCopyInto(out *EndpointSliceHintsWebhookConfig) {
 	*out = *in
@@ -164,6 +195,13 @@ func (in *HealthControllerConfig) DeepCopyInto(out *HealthControllerConfig) {
 		*out = new(v1.Duration)
 		**out = **in
 	}
+	if in.HealthRules != nil {
+		in, out := &in.HealthRules, &out.HealthRules
+		*out = make([]HealthRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -177,6 +215,43 @@ func (in *HealthControllerConfig) DeepCopy() *HealthControllerConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HealthRule) DeepCopyInto(out *HealthRule) {
+	*out = *in
+	if in.Expressions != nil {
+		in, out := &in.Expressions, &out.Expressions
+		*out = make([]HealthRuleExpression, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthRule.
+func (in *HealthRule) DeepCopy() *HealthRule {
+	if in == nil {
+		return nil
+	}
+	out := new(HealthRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HealthRuleExpression) DeepCopyInto(out *HealthRuleExpression) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthRuleExpression.
+func (in *HealthRuleExpression) DeepCopy() *HealthRuleExpression {
+	if in == nil {
+		return nil
+	}
+	out := new(HealthRuleExpression)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HighAvailabilityConfigWebhookConfig) DeepCopyInto(out *HighAvailabilityConfigWebhookConfig) {
 	*out = *in
@@ -487,6 +562,7 @@ func (in *ResourceManagerControllerConfiguration) DeepCopyInto(out *ResourceMana
 	in.GarbageCollector.DeepCopyInto(&out.GarbageCollector)
 	in.Health.DeepCopyInto(&out.Health)
 	in.CSRApprover.DeepCopyInto(&out.CSRApprover)
+	in.DriftDetection.DeepCopyInto(&out.DriftDetection)
 	in.ManagedResource.DeepCopyInto(&out.ManagedResource)
 	in.NetworkPolicy.DeepCopyInto(&out.NetworkPolicy)
 	in.NodeCriticalComponents.DeepCopyInto(&out.NodeCriticalComponents)
@@ -37,6 +37,7 @@ func SetObjectDefaults_ResourceManagerConfiguration(in *ResourceManagerConfigura
 	SetDefaults_GarbageCollectorControllerConfig(&in.Controllers.GarbageCollector)
 	SetDefaults_HealthControllerConfig(&in.Controllers.Health)
 	SetDefaults_CSRApproverControllerConfig(&in.Controllers.CSRApprover)
+	SetDefaults_DriftDetectionControllerConfig(&in.Controllers.DriftDetection)
 	SetDefaults_ManagedResourceControllerConfig(&in.Controllers.ManagedResource)
 	SetDefaults_NetworkPolicyControllerConfig(&in.Controllers.NetworkPolicy)
 	SetDefaults_NodeCriticalComponentsControllerConfig(&in.Controllers.NodeCriticalComponents)
@@ -94,12 +94,18 @@ func validateResourceManagerControllerConfiguration(conf config.ResourceManagerC
 		allErrs = append(allErrs, validateConcurrentSyncs(conf.CSRApprover.ConcurrentSyncs, fldPath.Child("csrApprover"))...)
 	}
 
+	if conf.DriftDetection.Enabled {
+		allErrs = append(allErrs, validateConcurrentSyncs(conf.DriftDetection.ConcurrentSyncs, fldPath.Child("driftDetection"))...)
+		allErrs = append(allErrs, validateSyncPeriod(conf.DriftDetection.SyncPeriod, fldPath.Child("driftDetection"))...)
+	}
+
 	if conf.GarbageCollector.Enabled {
 		allErrs = append(allErrs, validateSyncPeriod(conf.GarbageCollector.SyncPeriod, fldPath.Child("garbageCollector"))...)
 	}
 
 	allErrs = append(allErrs, validateConcurrentSyncs(conf.Health.ConcurrentSyncs, fldPath.Child("health"))...)
 	allErrs = append(allErrs, validateSyncPeriod(conf.Health.SyncPeriod, fldPath.Child("health"))...)
+	allErrs = append(allErrs, validateHealthRules(conf.Health.HealthRules, fldPath.Child("health", "healthRules"))...)
 
 	allErrs = append(allErrs, validateManagedResourceControllerConfiguration(conf.ManagedResource, fldPath.Child("managedResources"))...)
 
@@ -127,6 +133,35 @@ func validateManagedResourceControllerConfiguration(conf config.ManagedResourceC
 	return allErrs
 }
 
+func validateHealthRules(rules []config.HealthRule, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs    = field.ErrorList{}
+		groupKinds = sets.New[string]()
+	)
+
+	for i, rule := range rules {
+		idxPath := fldPath.Index(i)
+
+		if len(rule.Kind) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("kind"), "must specify kind"))
+		}
+
+		groupKind := rule.Kind + "." + rule.Group
+		if groupKinds.Has(groupKind) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, groupKind))
+		}
+		groupKinds.Insert(groupKind)
+
+		for j, expression := range rule.Expressions {
+			if len(expression.Expression) == 0 {
+				allErrs = append(allErrs, field.Required(idxPath.Child("expressions").Index(j).Child("expression"), "must specify expression"))
+			}
+		}
+	}
+
+	return allErrs
+}
+
 func validateNodeAgentReconciliationDelayControllerConfiguration(conf config.NodeAgentReconciliationDelayControllerConfig, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
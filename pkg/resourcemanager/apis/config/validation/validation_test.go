@@ -186,6 +186,29 @@ var _ = Describe("Validation", func() {
 				})
 			})
 
+			Context("drift detection", func() {
+				It("should return errors because concurrent syncs and sync period are not set", func() {
+					conf.Controllers.DriftDetection.Enabled = true
+
+					Expect(ValidateResourceManagerConfiguration(conf)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("controllers.driftDetection.concurrentSyncs"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("controllers.driftDetection.syncPeriod"),
+						})),
+					))
+				})
+
+				It("should not return errors if the controller is disabled", func() {
+					conf.Controllers.DriftDetection.Enabled = false
+
+					Expect(ValidateResourceManagerConfiguration(conf)).To(BeEmpty())
+				})
+			})
+
 			Context("garbage collector", func() {
 				It("should return errors because sync period is nil", func() {
 					conf.Controllers.GarbageCollector.Enabled = true
@@ -247,6 +270,38 @@ var _ = Describe("Validation", func() {
 						})),
 					))
 				})
+
+				It("should allow valid health rules", func() {
+					conf.Controllers.Health.HealthRules = []config.HealthRule{
+						{Group: "example.com", Kind: "Foo"},
+						{Group: "example.com", Kind: "Bar", Expressions: []config.HealthRuleExpression{{Expression: "self.status.ready"}}},
+					}
+
+					Expect(ValidateResourceManagerConfiguration(conf)).To(BeEmpty())
+				})
+
+				It("should return errors because health rules are invalid", func() {
+					conf.Controllers.Health.HealthRules = []config.HealthRule{
+						{Group: "example.com", Kind: "Foo"},
+						{Group: "example.com"},
+						{Group: "example.com", Kind: "Foo", Expressions: []config.HealthRuleExpression{{Message: "not ready"}}},
+					}
+
+					Expect(ValidateResourceManagerConfiguration(conf)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeRequired),
+							"Field": Equal("controllers.health.healthRules[1].kind"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeDuplicate),
+							"Field": Equal("controllers.health.healthRules[2]"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeRequired),
+							"Field": Equal("controllers.health.healthRules[2].expressions[0].expression"),
+						})),
+					))
+				})
 			})
 
 			Context("managed resources", func() {
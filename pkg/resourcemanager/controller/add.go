@@ -20,8 +20,10 @@ import (
 	"github.com/gardener/gardener/pkg/controller/tokenrequestor"
 	"github.com/gardener/gardener/pkg/resourcemanager/apis/config"
 	"github.com/gardener/gardener/pkg/resourcemanager/controller/csrapprover"
+	"github.com/gardener/gardener/pkg/resourcemanager/controller/drift"
 	"github.com/gardener/gardener/pkg/resourcemanager/controller/garbagecollector"
 	"github.com/gardener/gardener/pkg/resourcemanager/controller/health"
+	healthutils "github.com/gardener/gardener/pkg/resourcemanager/controller/health/utils"
 	"github.com/gardener/gardener/pkg/resourcemanager/controller/managedresource"
 	"github.com/gardener/gardener/pkg/resourcemanager/controller/networkpolicy"
 	"github.com/gardener/gardener/pkg/resourcemanager/controller/node"
@@ -58,11 +60,31 @@ func AddToManager(ctx context.Context, mgr manager.Manager, sourceCluster, targe
 		return fmt.Errorf("failed adding health controller: %w", err)
 	}
 
+	healthRules, err := healthutils.NewHealthRules(cfg.Controllers.Health.HealthRules)
+	if err != nil {
+		return fmt.Errorf("failed compiling health rules: %w", err)
+	}
+
+	var driftDetector *drift.Detector
+	if cfg.Controllers.DriftDetection.Enabled {
+		driftDetector = drift.NewDetector(cfg.Controllers.DriftDetection.IgnoredFieldManagers)
+
+		if err := (&drift.Reconciler{
+			Config:      cfg.Controllers.DriftDetection,
+			ClassFilter: resourcemanagerpredicate.NewClassFilter(*cfg.Controllers.ResourceClass),
+			Detector:    driftDetector,
+		}).AddToManager(ctx, mgr, sourceCluster, targetCluster, *cfg.Controllers.ClusterID); err != nil {
+			return fmt.Errorf("failed adding drift detection controller: %w", err)
+		}
+	}
+
 	if err := (&managedresource.Reconciler{
 		Config:                    cfg.Controllers.ManagedResource,
 		ClassFilter:               resourcemanagerpredicate.NewClassFilter(*cfg.Controllers.ResourceClass),
 		ClusterID:                 *cfg.Controllers.ClusterID,
 		GarbageCollectorActivated: cfg.Controllers.GarbageCollector.Enabled,
+		HealthRules:               healthRules,
+		DriftDetector:             driftDetector,
 	}).AddToManager(ctx, mgr, sourceCluster, targetCluster); err != nil {
 		return fmt.Errorf("failed adding managed resource controller: %w", err)
 	}
@@ -0,0 +1,122 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package drift
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/cluster"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/controllerutils/mapper"
+	"github.com/gardener/gardener/pkg/resourcemanager/controller/health/utils"
+	resourcemanagerpredicate "github.com/gardener/gardener/pkg/resourcemanager/predicate"
+)
+
+// ControllerName is the name of the controller.
+const ControllerName = "drift-detection"
+
+// AddToManager adds Reconciler to the given manager.
+func (r *Reconciler) AddToManager(ctx context.Context, mgr manager.Manager, sourceCluster, targetCluster cluster.Cluster, clusterID string) error {
+	if r.SourceClient == nil {
+		r.SourceClient = sourceCluster.GetClient()
+	}
+	if r.TargetClient == nil {
+		r.TargetClient = targetCluster.GetClient()
+	}
+	if r.TargetScheme == nil {
+		r.TargetScheme = targetCluster.GetScheme()
+	}
+	if r.Clock == nil {
+		r.Clock = clock.RealClock{}
+	}
+	if r.Detector == nil {
+		r.Detector = NewDetector(r.Config.IgnoredFieldManagers)
+	}
+
+	c, err := builder.
+		ControllerManagedBy(mgr).
+		Named(ControllerName).
+		For(&resourcesv1alpha1.ManagedResource{}, builder.WithPredicates(
+			predicate.Or(
+				resourcemanagerpredicate.ClassChangedPredicate(),
+				// start drift detection immediately after MR has been reconciled
+				resourcemanagerpredicate.ConditionStatusChanged(resourcesv1alpha1.ResourcesApplied, resourcemanagerpredicate.DefaultConditionChange),
+				resourcemanagerpredicate.NoLongerIgnored(),
+			),
+			resourcemanagerpredicate.NotIgnored(),
+			r.ClassFilter,
+		)).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: ptr.Deref(r.Config.ConcurrentSyncs, 0),
+		}).
+		Build(r)
+	if err != nil {
+		return err
+	}
+
+	lock := sync.RWMutex{}
+	watchedObjectGVKs := make(map[schema.GroupVersionKind]struct{})
+	r.ensureWatchForGVK = func(gvk schema.GroupVersionKind, obj client.Object) error {
+		// fast-check: have we already added watch for this GVK?
+		lock.RLock()
+		if _, ok := watchedObjectGVKs[gvk]; ok {
+			lock.RUnlock()
+			return nil
+		}
+		lock.RUnlock()
+
+		// slow-check: two goroutines might concurrently call this func. If neither exited early, the first one added
+		// the watch and the second one should return now.
+		lock.Lock()
+		defer lock.Unlock()
+		if _, ok := watchedObjectGVKs[gvk]; ok {
+			return nil
+		}
+
+		_, metadataOnly := obj.(*metav1.PartialObjectMetadata)
+		c.GetLogger().Info("Adding new watch for GroupVersionKind", "groupVersionKind", gvk, "metadataOnly", metadataOnly)
+
+		if err := c.Watch(
+			source.Kind[client.Object](targetCluster.GetCache(), obj,
+				mapper.EnqueueRequestsFrom(ctx, mgr.GetCache(), utils.MapToOriginManagedResource(clusterID), mapper.UpdateWithNew, c.GetLogger()),
+				ManagedFieldsChanged()),
+		); err != nil {
+			return fmt.Errorf("error starting watch for GVK %s: %w", gvk.String(), err)
+		}
+
+		watchedObjectGVKs[gvk] = struct{}{}
+		return nil
+	}
+
+	return nil
+}
+
+// ManagedFieldsChanged returns a predicate that filters for update events that change the managed fields of the
+// object, i.e., events that indicate a modification of the object by any field manager.
+func ManagedFieldsChanged() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc: func(_ event.CreateEvent) bool { return false },
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			return !apiequality.Semantic.DeepEqual(e.ObjectOld.GetManagedFields(), e.ObjectNew.GetManagedFields())
+		},
+		DeleteFunc:  func(_ event.DeleteEvent) bool { return false },
+		GenericFunc: func(_ event.GenericEvent) bool { return false },
+	}
+}
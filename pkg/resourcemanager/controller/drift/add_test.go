@@ -0,0 +1,53 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package drift_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	. "github.com/gardener/gardener/pkg/resourcemanager/controller/drift"
+)
+
+var _ = Describe("Add", func() {
+	Describe("#ManagedFieldsChanged", func() {
+		var (
+			p      predicate.Predicate
+			oldObj *corev1.ConfigMap
+			newObj *corev1.ConfigMap
+		)
+
+		BeforeEach(func() {
+			p = ManagedFieldsChanged()
+			oldObj = &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{
+				Name:          "foo",
+				ManagedFields: []metav1.ManagedFieldsEntry{{Manager: "gardener-resource-manager"}},
+			}}
+			newObj = oldObj.DeepCopy()
+		})
+
+		It("should return false for create, delete and generic events", func() {
+			Expect(p.Create(event.CreateEvent{Object: newObj})).To(BeFalse())
+			Expect(p.Delete(event.DeleteEvent{Object: newObj})).To(BeFalse())
+			Expect(p.Generic(event.GenericEvent{Object: newObj})).To(BeFalse())
+		})
+
+		It("should return false if the managed fields did not change", func() {
+			newObj.Data = map[string]string{"foo": "bar"}
+
+			Expect(p.Update(event.UpdateEvent{ObjectOld: oldObj, ObjectNew: newObj})).To(BeFalse())
+		})
+
+		It("should return true if the managed fields changed", func() {
+			newObj.ManagedFields = append(newObj.ManagedFields, metav1.ManagedFieldsEntry{Manager: "kubectl-edit"})
+
+			Expect(p.Update(event.UpdateEvent{ObjectOld: oldObj, ObjectNew: newObj})).To(BeTrue())
+		})
+	})
+})
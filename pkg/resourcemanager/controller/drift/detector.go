@@ -0,0 +1,137 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package drift
+
+import (
+	"bytes"
+	"slices"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/structured-merge-diff/v4/fieldpath"
+
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+)
+
+// FieldManager is the field manager used by the resource manager when applying the objects of ManagedResources.
+const FieldManager = "gardener-resource-manager"
+
+// Modification is a modification of an object by another field manager than the resource manager.
+type Modification struct {
+	// Manager is the name of the field manager which modified the object.
+	Manager string
+	// Operation is the type of the operation which modified the object.
+	Operation metav1.ManagedFieldsOperationType
+	// Time is the time of the modification.
+	Time metav1.Time
+	// Fields are the paths of the fields owned by the field manager.
+	Fields []string
+}
+
+// Detector detects modifications of objects managed by ManagedResources by other field managers (drift).
+type Detector struct {
+	ignoredFieldManagers sets.Set[string]
+}
+
+// NewDetector returns a new Detector which does not consider modifications by the given field managers as drift.
+func NewDetector(ignoredFieldManagers []string) *Detector {
+	return &Detector{ignoredFieldManagers: sets.New(ignoredFieldManagers...)}
+}
+
+// Detect returns the modifications of the given object by other field managers which happened after the resource
+// manager wrote the object the last time. The modifications are determined from the managed fields of the object,
+// modifications of subresources (e.g., `status` or `scale`) and of the `status` field are not considered.
+// For objects with the `Revert` drift policy, only modifications of fields which are also managed by the resource
+// manager are considered. Other fields are not reverted, i.e., the resource manager does not write the object, hence
+// such modifications would be reported forever.
+// If the object has never been written by the resource manager, nothing is returned.
+func (d *Detector) Detect(obj metav1.Object) []Modification {
+	var (
+		lastWritten   *metav1.Time
+		managedFields = &fieldpath.Set{}
+	)
+
+	for _, entry := range obj.GetManagedFields() {
+		if entry.Manager != FieldManager || entry.Subresource != "" {
+			continue
+		}
+
+		if entry.Time != nil && (lastWritten == nil || entry.Time.After(lastWritten.Time)) {
+			lastWritten = entry.Time
+		}
+		managedFields = managedFields.Union(fieldSet(entry.FieldsV1))
+	}
+
+	if lastWritten == nil {
+		return nil
+	}
+
+	revert := Policy(obj) == resourcesv1alpha1.DriftPolicyRevert
+
+	var modifications []Modification
+	for _, entry := range obj.GetManagedFields() {
+		if entry.Manager == FieldManager || d.ignoredFieldManagers.Has(entry.Manager) || entry.Subresource != "" ||
+			entry.Time == nil || !entry.Time.After(lastWritten.Time) {
+			continue
+		}
+
+		fields := fieldSet(entry.FieldsV1)
+		if revert {
+			fields = fields.Intersection(managedFields)
+		}
+
+		if paths := fieldPaths(fields); len(paths) > 0 {
+			modifications = append(modifications, Modification{
+				Manager:   entry.Manager,
+				Operation: entry.Operation,
+				Time:      *entry.Time,
+				Fields:    paths,
+			})
+		}
+	}
+
+	slices.SortStableFunc(modifications, func(a, b Modification) int { return a.Time.Compare(b.Time.Time) })
+	return modifications
+}
+
+// HasReportedDrift returns true if the given object has the `Report` drift policy and was modified by other field
+// managers, i.e., the drift must not be reverted.
+func (d *Detector) HasReportedDrift(obj metav1.Object) bool {
+	return Policy(obj) == resourcesv1alpha1.DriftPolicyReport && len(d.Detect(obj)) > 0
+}
+
+// Policy returns the drift policy of the given object. Unknown values fall back to the default `Revert` policy.
+func Policy(obj metav1.Object) string {
+	if obj.GetAnnotations()[resourcesv1alpha1.DriftPolicy] == resourcesv1alpha1.DriftPolicyReport {
+		return resourcesv1alpha1.DriftPolicyReport
+	}
+	return resourcesv1alpha1.DriftPolicyRevert
+}
+
+// fieldSet returns the set of fields contained in the given managed fields.
+func fieldSet(fields *metav1.FieldsV1) *fieldpath.Set {
+	set := &fieldpath.Set{}
+	if fields == nil {
+		return set
+	}
+
+	if err := set.FromJSON(bytes.NewReader(fields.Raw)); err != nil {
+		return &fieldpath.Set{}
+	}
+	return set
+}
+
+// fieldPaths returns the paths of all leaf fields contained in the given set, excluding the `status` field.
+func fieldPaths(set *fieldpath.Set) []string {
+	var paths []string
+	set.Leaves().Iterate(func(path fieldpath.Path) {
+		if p := path.String(); !strings.HasPrefix(p, ".status.") {
+			paths = append(paths, p)
+		}
+	})
+
+	return paths
+}
@@ -0,0 +1,148 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package drift_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	. "github.com/gardener/gardener/pkg/resourcemanager/controller/drift"
+)
+
+var _ = Describe("Detector", func() {
+	var (
+		detector  *Detector
+		configMap *corev1.ConfigMap
+
+		now = time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	)
+
+	entry := func(manager string, operation metav1.ManagedFieldsOperationType, t time.Time, fields string) metav1.ManagedFieldsEntry {
+		return metav1.ManagedFieldsEntry{
+			Manager:    manager,
+			Operation:  operation,
+			APIVersion: "v1",
+			Time:       &metav1.Time{Time: t},
+			FieldsType: "FieldsV1",
+			FieldsV1:   &metav1.FieldsV1{Raw: []byte(fields)},
+		}
+	}
+
+	BeforeEach(func() {
+		detector = NewDetector([]string{"kube-controller-manager"})
+		configMap = &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{
+			Name:      "foo",
+			Namespace: "default",
+			ManagedFields: []metav1.ManagedFieldsEntry{
+				entry("gardener-resource-manager", metav1.ManagedFieldsOperationUpdate, now, `{"f:data":{".":{},"f:foo":{}}}`),
+			},
+		}}
+	})
+
+	Describe("#Detect", func() {
+		It("should not detect drift if the object was only modified by the resource manager", func() {
+			Expect(detector.Detect(configMap)).To(BeEmpty())
+		})
+
+		It("should not detect drift if the object was never written by the resource manager", func() {
+			configMap.ManagedFields = []metav1.ManagedFieldsEntry{
+				entry("kubectl-edit", metav1.ManagedFieldsOperationUpdate, now, `{"f:data":{"f:foo":{}}}`),
+			}
+
+			Expect(detector.Detect(configMap)).To(BeEmpty())
+		})
+
+		It("should not detect drift for modifications before the last write of the resource manager", func() {
+			configMap.ManagedFields = append(configMap.ManagedFields,
+				entry("kubectl-edit", metav1.ManagedFieldsOperationUpdate, now.Add(-time.Minute), `{"f:data":{"f:bar":{}}}`),
+				entry("kubectl-edit", metav1.ManagedFieldsOperationUpdate, now, `{"f:data":{"f:baz":{}}}`),
+			)
+
+			Expect(detector.Detect(configMap)).To(BeEmpty())
+		})
+
+		It("should detect modifications by other field managers after the last write of the resource manager", func() {
+			metav1.SetMetaDataAnnotation(&configMap.ObjectMeta, "resources.gardener.cloud/drift-policy", "Report")
+			configMap.ManagedFields = append(configMap.ManagedFields,
+				entry("kubectl", metav1.ManagedFieldsOperationApply, now.Add(2*time.Minute), `{"f:metadata":{"f:labels":{"f:bar":{}}}}`),
+				entry("kubectl-edit", metav1.ManagedFieldsOperationUpdate, now.Add(time.Minute), `{"f:data":{"f:foo":{}}}`),
+			)
+
+			Expect(detector.Detect(configMap)).To(Equal([]Modification{
+				{Manager: "kubectl-edit", Operation: metav1.ManagedFieldsOperationUpdate, Time: metav1.Time{Time: now.Add(time.Minute)}, Fields: []string{".data.foo"}},
+				{Manager: "kubectl", Operation: metav1.ManagedFieldsOperationApply, Time: metav1.Time{Time: now.Add(2 * time.Minute)}, Fields: []string{".metadata.labels.bar"}},
+			}))
+		})
+
+		It("should only detect modifications of fields managed by the resource manager for the Revert policy", func() {
+			configMap.ManagedFields = append(configMap.ManagedFields,
+				entry("kubectl", metav1.ManagedFieldsOperationApply, now.Add(2*time.Minute), `{"f:data":{"f:foo":{}},"f:metadata":{"f:labels":{"f:bar":{}}}}`),
+				entry("kubectl-edit", metav1.ManagedFieldsOperationUpdate, now.Add(time.Minute), `{"f:data":{"f:bar":{}}}`),
+			)
+
+			Expect(detector.Detect(configMap)).To(Equal([]Modification{
+				{Manager: "kubectl", Operation: metav1.ManagedFieldsOperationApply, Time: metav1.Time{Time: now.Add(2 * time.Minute)}, Fields: []string{".data.foo"}},
+			}))
+		})
+
+		It("should ignore modifications by ignored field managers, of subresources and of the status", func() {
+			statusEntry := entry("kubelet", metav1.ManagedFieldsOperationUpdate, now.Add(time.Minute), `{"f:status":{"f:phase":{}}}`)
+			scaleEntry := entry("hpa", metav1.ManagedFieldsOperationUpdate, now.Add(time.Minute), `{"f:spec":{"f:replicas":{}}}`)
+			scaleEntry.Subresource = "scale"
+
+			configMap.ManagedFields = append(configMap.ManagedFields,
+				entry("kube-controller-manager", metav1.ManagedFieldsOperationUpdate, now.Add(time.Minute), `{"f:metadata":{"f:annotations":{"f:foo":{}}}}`),
+				statusEntry,
+				scaleEntry,
+			)
+
+			Expect(detector.Detect(configMap)).To(BeEmpty())
+		})
+	})
+
+	Describe("#HasReportedDrift", func() {
+		BeforeEach(func() {
+			configMap.ManagedFields = append(configMap.ManagedFields,
+				entry("kubectl-edit", metav1.ManagedFieldsOperationUpdate, now.Add(time.Minute), `{"f:data":{"f:foo":{}}}`),
+			)
+		})
+
+		It("should return true for drifted objects with the Report policy", func() {
+			metav1.SetMetaDataAnnotation(&configMap.ObjectMeta, "resources.gardener.cloud/drift-policy", "Report")
+
+			Expect(detector.HasReportedDrift(configMap)).To(BeTrue())
+		})
+
+		It("should return false for drifted objects with the Revert policy", func() {
+			Expect(detector.HasReportedDrift(configMap)).To(BeFalse())
+		})
+
+		It("should return false for objects with the Report policy without drift", func() {
+			metav1.SetMetaDataAnnotation(&configMap.ObjectMeta, "resources.gardener.cloud/drift-policy", "Report")
+			configMap.ManagedFields = configMap.ManagedFields[:1]
+
+			Expect(detector.HasReportedDrift(configMap)).To(BeFalse())
+		})
+	})
+
+	Describe("#Policy", func() {
+		It("should return the policy of the object", func() {
+			metav1.SetMetaDataAnnotation(&configMap.ObjectMeta, "resources.gardener.cloud/drift-policy", "Report")
+
+			Expect(Policy(configMap)).To(Equal("Report"))
+		})
+
+		It("should default to the Revert policy", func() {
+			Expect(Policy(configMap)).To(Equal("Revert"))
+
+			metav1.SetMetaDataAnnotation(&configMap.ObjectMeta, "resources.gardener.cloud/drift-policy", "foo")
+			Expect(Policy(configMap)).To(Equal("Revert"))
+		})
+	})
+})
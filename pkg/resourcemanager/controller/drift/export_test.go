@@ -0,0 +1,21 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package drift
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Metrics exported for testing.
+
+var (
+	DriftedObjects = driftedObjects
+	DriftsTotal    = driftsTotal
+)
+
+func (r *Reconciler) SetEnsureWatchForGVK(f func(schema.GroupVersionKind, client.Object) error) {
+	r.ensureWatchForGVK = f
+}
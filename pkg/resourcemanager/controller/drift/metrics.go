@@ -0,0 +1,46 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package drift
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	runtimemetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const metricsNamespace = "gardener_resource_manager"
+
+var (
+	factory = promauto.With(runtimemetrics.Registry)
+
+	// driftedObjects defines the gauge managedresource_drifted_objects.
+	driftedObjects = factory.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "managedresource_drifted_objects",
+			Help:      "Number of objects of a ManagedResource which have been modified by other field managers.",
+		},
+		[]string{
+			"namespace",
+			"name",
+		},
+	)
+
+	// driftsTotal defines the counter managedresource_drifts_total. The field managers are chosen by the users of the
+	// target cluster, hence they are only reported in the DriftDetected condition to keep the cardinality bounded.
+	driftsTotal = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "managedresource_drifts_total",
+			Help:      "Total number of detected modifications of objects of a ManagedResource by other field managers.",
+		},
+		[]string{
+			"namespace",
+			"name",
+			"kind",
+			"policy",
+		},
+	)
+)
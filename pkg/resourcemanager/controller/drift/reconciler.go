@@ -0,0 +1,276 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package drift
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	"github.com/gardener/gardener/pkg/resourcemanager/apis/config"
+	"github.com/gardener/gardener/pkg/resourcemanager/controller/health/utils"
+	resourcemanagerpredicate "github.com/gardener/gardener/pkg/resourcemanager/predicate"
+)
+
+const (
+	// maxReportedObjects is the maximum number of drifted objects which are listed in the condition message.
+	maxReportedObjects = 10
+	// maxReportedFields is the maximum number of fields which are listed per modification in the condition message.
+	maxReportedFields = 5
+)
+
+// Reconciler detects modifications of resources managed as part of ManagedResources by other field managers.
+type Reconciler struct {
+	SourceClient client.Client
+	TargetClient client.Client
+	TargetScheme *runtime.Scheme
+	Config       config.DriftDetectionControllerConfig
+	Clock        clock.Clock
+	ClassFilter  *resourcemanagerpredicate.ClassFilter
+	Detector     *Detector
+
+	// ensureWatchForGVK ensures that the controller is watching the given object to reconcile corresponding
+	// ManagedResources on modifications.
+	ensureWatchForGVK func(gvk schema.GroupVersionKind, obj client.Object) error
+
+	lock sync.Mutex
+	// recordedModifications contains the modifications per ManagedResource which have already been counted in the
+	// metrics.
+	recordedModifications map[types.NamespacedName]sets.Set[string]
+}
+
+// objectDrift contains the modifications of a managed object by other field managers.
+type objectDrift struct {
+	ref           resourcesv1alpha1.ObjectReference
+	policy        string
+	modifications []Modification
+}
+
+// Reconcile detects drift of the resources of ManagedResources.
+func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	var cancel context.CancelFunc
+	ctx, cancel = controllerutils.GetMainReconciliationContext(ctx, r.Config.SyncPeriod.Duration)
+	defer cancel()
+
+	mr := &resourcesv1alpha1.ManagedResource{}
+	if err := r.SourceClient.Get(ctx, req.NamespacedName, mr); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			r.forget(req.NamespacedName)
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	if utils.IsIgnored(mr) {
+		log.Info("Skipping drift detection since ManagedResource is ignored")
+		r.forget(req.NamespacedName)
+		return reconcile.Result{}, nil
+	}
+
+	// Check responsibility
+	if responsible := r.ClassFilter.Responsible(mr); !responsible {
+		log.Info("Stopping drift detection as the responsibility changed")
+		r.forget(req.NamespacedName)
+		return reconcile.Result{}, nil
+	}
+
+	if !mr.DeletionTimestamp.IsZero() {
+		log.Info("Stopping drift detection for ManagedResource as it is marked for deletion")
+		r.forget(req.NamespacedName)
+		return reconcile.Result{}, nil
+	}
+
+	if v1beta1helper.GetCondition(mr.Status.Conditions, resourcesv1alpha1.ResourcesApplied) == nil {
+		log.Info("Skipping drift detection for ManagedResource as the resources were not applied yet")
+		return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+	}
+
+	return r.detectDrift(ctx, log, mr)
+}
+
+func (r *Reconciler) detectDrift(ctx context.Context, log logr.Logger, mr *resourcesv1alpha1.ManagedResource) (reconcile.Result, error) {
+	log.V(1).Info("Starting ManagedResource drift detection")
+	// don't block workers if calls timeout for some reason
+	detectionCtx, cancel := controllerutils.GetChildReconciliationContext(ctx, r.Config.SyncPeriod.Duration)
+	defer cancel()
+
+	var drifts []objectDrift
+
+	for _, ref := range mr.Status.Resources {
+		var (
+			objectGVK = ref.GroupVersionKind()
+			objectKey = client.ObjectKey{Namespace: ref.Namespace, Name: ref.Name}
+		)
+
+		obj, err := newObjectForDriftDetection(r.TargetScheme, objectGVK)
+		if err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed to construct new object for reference: %w", err)
+		}
+
+		// ensure watch is started for object
+		if err := r.ensureWatchForGVK(objectGVK, obj); err != nil {
+			return reconcile.Result{}, err
+		}
+
+		if err := r.TargetClient.Get(detectionCtx, objectKey, obj); err != nil {
+			if apierrors.IsNotFound(err) || meta.IsNoMatchError(err) {
+				// missing objects are already handled by the health controller, skip
+				continue
+			}
+			return reconcile.Result{}, err
+		}
+
+		if modifications := r.Detector.Detect(obj); len(modifications) > 0 {
+			drifts = append(drifts, objectDrift{ref: ref, policy: Policy(obj), modifications: modifications})
+		}
+	}
+
+	r.recordMetrics(client.ObjectKeyFromObject(mr), drifts)
+
+	b, err := v1beta1helper.NewConditionBuilder(resourcesv1alpha1.DriftDetected)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	b.WithClock(r.Clock)
+
+	if oldCondition := v1beta1helper.GetCondition(mr.Status.Conditions, resourcesv1alpha1.DriftDetected); oldCondition != nil {
+		b.WithOldCondition(*oldCondition)
+	}
+
+	if len(drifts) == 0 {
+		b.WithStatus(gardencorev1beta1.ConditionFalse).WithReason(resourcesv1alpha1.ConditionNoDriftDetected).
+			WithMessage("No resources have been modified by other field managers.")
+	} else {
+		reason := resourcesv1alpha1.ConditionDriftReported
+		for _, drift := range drifts {
+			if drift.policy == resourcesv1alpha1.DriftPolicyRevert {
+				reason = resourcesv1alpha1.ConditionDriftReverting
+				break
+			}
+		}
+
+		b.WithStatus(gardencorev1beta1.ConditionTrue).WithReason(reason).WithMessage(driftMessage(drifts))
+	}
+
+	conditionDriftDetected, needsUpdate := b.Build()
+	if needsUpdate {
+		log.Info("Drift of ManagedResource changed", "status", conditionDriftDetected.Status, "reason", conditionDriftDetected.Reason, "driftedObjects", len(drifts))
+		mr.Status.Conditions = v1beta1helper.MergeConditions(mr.Status.Conditions, conditionDriftDetected)
+		if err := r.SourceClient.Status().Update(ctx, mr); err != nil {
+			return reconcile.Result{}, fmt.Errorf("could not update the ManagedResource status: %w", err)
+		}
+	}
+
+	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+}
+
+// recordMetrics updates the metrics for the given drifted objects of a ManagedResource. Each modification is only
+// counted once, i.e., when it is detected for the first time.
+func (r *Reconciler) recordMetrics(key types.NamespacedName, drifts []objectDrift) {
+	driftedObjects.WithLabelValues(key.Namespace, key.Name).Set(float64(len(drifts)))
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.recordedModifications == nil {
+		r.recordedModifications = make(map[types.NamespacedName]sets.Set[string])
+	}
+
+	var (
+		recorded = r.recordedModifications[key]
+		current  = sets.New[string]()
+	)
+
+	for _, drift := range drifts {
+		for _, modification := range drift.modifications {
+			id := strings.Join([]string{objectToString(drift.ref), modification.Manager, string(modification.Operation), modification.Time.UTC().Format(time.RFC3339)}, "/")
+			current.Insert(id)
+
+			if !recorded.Has(id) {
+				driftsTotal.WithLabelValues(key.Namespace, key.Name, drift.ref.Kind, drift.policy).Inc()
+			}
+		}
+	}
+
+	r.recordedModifications[key] = current
+}
+
+// forget removes the metrics and the recorded modifications of the given ManagedResource.
+func (r *Reconciler) forget(key types.NamespacedName) {
+	driftedObjects.DeleteLabelValues(key.Namespace, key.Name)
+	driftsTotal.DeletePartialMatch(prometheus.Labels{"namespace": key.Namespace, "name": key.Name})
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	delete(r.recordedModifications, key)
+}
+
+func driftMessage(drifts []objectDrift) string {
+	lines := []string{fmt.Sprintf("%d resource(s) have been modified by other field managers:", len(drifts))}
+
+	for i, drift := range drifts {
+		if i == maxReportedObjects {
+			lines = append(lines, fmt.Sprintf("- ... and %d more resource(s)", len(drifts)-i))
+			break
+		}
+
+		for _, modification := range drift.modifications {
+			fields := modification.Fields
+			if len(fields) > maxReportedFields {
+				fields = append(fields[:maxReportedFields:maxReportedFields], fmt.Sprintf("... and %d more field(s)", len(modification.Fields)-maxReportedFields))
+			}
+
+			lines = append(lines, fmt.Sprintf("- %s (drift policy %s): %s modified by field manager %q (%s) at %s",
+				objectToString(drift.ref), drift.policy, strings.Join(fields, ", "), modification.Manager, modification.Operation, modification.Time.UTC().Format(time.RFC3339)))
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func objectToString(ref resourcesv1alpha1.ObjectReference) string {
+	return fmt.Sprintf("%s/%s/%s/%s", ref.APIVersion, ref.Kind, ref.Namespace, ref.Name)
+}
+
+func newObjectForDriftDetection(scheme *runtime.Scheme, gvk schema.GroupVersionKind) (client.Object, error) {
+	// Create a typed object if GVK is registered in scheme, so that the watches of the health controller are shared.
+	// Otherwise, the drift is detected based on the managed fields which are part of the metadata, hence, we can use
+	// metadata-only requests/watches instead of watching the entire object.
+	typedObject, err := scheme.New(gvk)
+	if err != nil {
+		if !runtime.IsNotRegisteredError(err) {
+			return nil, err
+		}
+
+		obj := &metav1.PartialObjectMetadata{}
+		obj.SetGroupVersionKind(gvk)
+		return obj, nil
+	}
+
+	return typedObject.(client.Object), nil
+}
@@ -0,0 +1,238 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package drift_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/resourcemanager/apis/config"
+	. "github.com/gardener/gardener/pkg/resourcemanager/controller/drift"
+	resourcemanagerpredicate "github.com/gardener/gardener/pkg/resourcemanager/predicate"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx          = context.Background()
+		sourceClient client.Client
+		targetClient client.Client
+		fakeClock    *testclock.FakeClock
+		reconciler   *Reconciler
+
+		watchedGVKs []schema.GroupVersionKind
+
+		mr        *resourcesv1alpha1.ManagedResource
+		configMap *corev1.ConfigMap
+		request   reconcile.Request
+
+		lastWritten = time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	)
+
+	modifiedBy := func(manager string, fields string) metav1.ManagedFieldsEntry {
+		return metav1.ManagedFieldsEntry{
+			Manager:    manager,
+			Operation:  metav1.ManagedFieldsOperationUpdate,
+			APIVersion: "v1",
+			Time:       &metav1.Time{Time: lastWritten.Add(time.Minute)},
+			FieldsType: "FieldsV1",
+			FieldsV1:   &metav1.FieldsV1{Raw: []byte(fields)},
+		}
+	}
+
+	getCondition := func() *gardencorev1beta1.Condition {
+		ExpectWithOffset(1, sourceClient.Get(ctx, client.ObjectKeyFromObject(mr), mr)).To(Succeed())
+		return v1beta1helper.GetCondition(mr.Status.Conditions, resourcesv1alpha1.DriftDetected)
+	}
+
+	BeforeEach(func() {
+		sourceClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithStatusSubresource(&resourcesv1alpha1.ManagedResource{}).Build()
+		targetClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.ShootScheme).Build()
+		fakeClock = testclock.NewFakeClock(time.Date(2024, 1, 1, 13, 0, 0, 0, time.UTC))
+		watchedGVKs = nil
+
+		reconciler = &Reconciler{
+			SourceClient: sourceClient,
+			TargetClient: targetClient,
+			TargetScheme: kubernetes.ShootScheme,
+			Config:       config.DriftDetectionControllerConfig{SyncPeriod: &metav1.Duration{Duration: time.Minute}},
+			Clock:        fakeClock,
+			ClassFilter:  resourcemanagerpredicate.NewClassFilter(""),
+			Detector:     NewDetector(nil),
+		}
+		reconciler.SetEnsureWatchForGVK(func(gvk schema.GroupVersionKind, _ client.Object) error {
+			watchedGVKs = append(watchedGVKs, gvk)
+			return nil
+		})
+
+		mr = &resourcesv1alpha1.ManagedResource{
+			ObjectMeta: metav1.ObjectMeta{Name: "mr", Namespace: "garden"},
+			Status: resourcesv1alpha1.ManagedResourceStatus{
+				Conditions: []gardencorev1beta1.Condition{{Type: resourcesv1alpha1.ResourcesApplied, Status: gardencorev1beta1.ConditionTrue}},
+				Resources: []resourcesv1alpha1.ObjectReference{
+					{ObjectReference: corev1.ObjectReference{APIVersion: "v1", Kind: "ConfigMap", Name: "foo", Namespace: "default"}},
+					{ObjectReference: corev1.ObjectReference{APIVersion: "v1", Kind: "Secret", Name: "missing", Namespace: "default"}},
+				},
+			},
+		}
+		Expect(sourceClient.Create(ctx, mr)).To(Succeed())
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(mr)}
+
+		configMap = &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{
+			Name:      "foo",
+			Namespace: "default",
+			ManagedFields: []metav1.ManagedFieldsEntry{{
+				Manager:    "gardener-resource-manager",
+				Operation:  metav1.ManagedFieldsOperationUpdate,
+				APIVersion: "v1",
+				Time:       &metav1.Time{Time: lastWritten},
+				FieldsType: "FieldsV1",
+				FieldsV1:   &metav1.FieldsV1{Raw: []byte(`{"f:data":{".":{},"f:foo":{}}}`)},
+			}},
+		}}
+	})
+
+	AfterEach(func() {
+		DriftedObjects.Reset()
+		DriftsTotal.Reset()
+	})
+
+	It("should report that there is no drift", func() {
+		Expect(targetClient.Create(ctx, configMap)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+
+		condition := getCondition()
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionFalse))
+		Expect(condition.Reason).To(Equal("NoDriftDetected"))
+		Expect(condition.Message).To(Equal("No resources have been modified by other field managers."))
+
+		Expect(watchedGVKs).To(ConsistOf(corev1.SchemeGroupVersion.WithKind("ConfigMap"), corev1.SchemeGroupVersion.WithKind("Secret")))
+		Expect(testutil.ToFloat64(DriftedObjects.WithLabelValues("garden", "mr"))).To(BeZero())
+	})
+
+	It("should report drift which is reverted", func() {
+		configMap.ManagedFields = append(configMap.ManagedFields, modifiedBy("kubectl-edit", `{"f:data":{"f:foo":{}}}`))
+		Expect(targetClient.Create(ctx, configMap)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+
+		condition := getCondition()
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionTrue))
+		Expect(condition.Reason).To(Equal("DriftReverting"))
+		Expect(condition.Message).To(Equal("1 resource(s) have been modified by other field managers:\n" +
+			`- v1/ConfigMap/default/foo (drift policy Revert): .data.foo modified by field manager "kubectl-edit" (Update) at 2024-01-01T12:01:00Z`))
+
+		Expect(testutil.ToFloat64(DriftedObjects.WithLabelValues("garden", "mr"))).To(Equal(float64(1)))
+		Expect(testutil.ToFloat64(DriftsTotal.WithLabelValues("garden", "mr", "ConfigMap", "Revert"))).To(Equal(float64(1)))
+	})
+
+	It("should report drift which is not reverted", func() {
+		metav1.SetMetaDataAnnotation(&configMap.ObjectMeta, "resources.gardener.cloud/drift-policy", "Report")
+		configMap.ManagedFields = append(configMap.ManagedFields, modifiedBy("kubectl-edit", `{"f:data":{"f:foo":{}}}`))
+		Expect(targetClient.Create(ctx, configMap)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+
+		condition := getCondition()
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionTrue))
+		Expect(condition.Reason).To(Equal("DriftReported"))
+		Expect(condition.Message).To(ContainSubstring(`v1/ConfigMap/default/foo (drift policy Report)`))
+	})
+
+	It("should not report modifications of fields which are not reverted", func() {
+		configMap.ManagedFields = append(configMap.ManagedFields, modifiedBy("kubectl-edit", `{"f:data":{"f:bar":{}}}`))
+		Expect(targetClient.Create(ctx, configMap)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+
+		condition := getCondition()
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionFalse))
+		Expect(condition.Reason).To(Equal("NoDriftDetected"))
+	})
+
+	It("should limit the number of reported fields", func() {
+		metav1.SetMetaDataAnnotation(&configMap.ObjectMeta, "resources.gardener.cloud/drift-policy", "Report")
+		var fields string
+		for i := 0; i < 7; i++ {
+			fields += fmt.Sprintf(`"f:key%d":{},`, i)
+		}
+		configMap.ManagedFields = append(configMap.ManagedFields, modifiedBy("kubectl-edit", `{"f:data":{`+fields[:len(fields)-1]+`}}`))
+		Expect(targetClient.Create(ctx, configMap)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+
+		Expect(getCondition().Message).To(ContainSubstring(`.data.key0, .data.key1, .data.key2, .data.key3, .data.key4, ... and 2 more field(s) modified by field manager "kubectl-edit"`))
+	})
+
+	It("should count each modification only once", func() {
+		configMap.ManagedFields = append(configMap.ManagedFields, modifiedBy("kubectl-edit", `{"f:data":{"f:foo":{}}}`))
+		Expect(targetClient.Create(ctx, configMap)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+
+		Expect(testutil.ToFloat64(DriftsTotal.WithLabelValues("garden", "mr", "ConfigMap", "Revert"))).To(Equal(float64(1)))
+	})
+
+	It("should keep the condition if the drift did not change", func() {
+		configMap.ManagedFields = append(configMap.ManagedFields, modifiedBy("kubectl-edit", `{"f:data":{"f:foo":{}}}`))
+		Expect(targetClient.Create(ctx, configMap)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+		lastUpdateTime := getCondition().LastUpdateTime
+
+		fakeClock.Step(time.Hour)
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+		Expect(getCondition().LastUpdateTime).To(Equal(lastUpdateTime))
+	})
+
+	It("should skip the drift detection if the resources were not applied yet", func() {
+		mr.Status.Conditions = nil
+		Expect(sourceClient.Status().Update(ctx, mr)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+		Expect(getCondition()).To(BeNil())
+	})
+
+	It("should skip the drift detection and remove the metrics if the ManagedResource is ignored", func() {
+		DriftedObjects.WithLabelValues("garden", "mr").Set(1)
+
+		metav1.SetMetaDataAnnotation(&mr.ObjectMeta, "resources.gardener.cloud/ignore", "true")
+		Expect(sourceClient.Update(ctx, mr)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+		Expect(getCondition()).To(BeNil())
+		Expect(testutil.CollectAndCount(DriftedObjects)).To(BeZero())
+	})
+
+	It("should skip the drift detection if the ManagedResource is not handled by this class", func() {
+		mr.Spec.Class = ptr.To("other")
+		Expect(sourceClient.Update(ctx, mr)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+		Expect(getCondition()).To(BeNil())
+	})
+})
@@ -43,12 +43,22 @@ func (r *Reconciler) AddToManager(ctx context.Context, mgr manager.Manager, sour
 	if r.TargetClient == nil {
 		r.TargetClient = targetCluster.GetClient()
 	}
+	if r.TargetReader == nil {
+		r.TargetReader = targetCluster.GetAPIReader()
+	}
 	if r.TargetScheme == nil {
 		r.TargetScheme = targetCluster.GetScheme()
 	}
 	if r.Clock == nil {
 		r.Clock = clock.RealClock{}
 	}
+	if r.HealthRules == nil {
+		healthRules, err := utils.NewHealthRules(r.Config.HealthRules)
+		if err != nil {
+			return fmt.Errorf("failed compiling health rules: %w", err)
+		}
+		r.HealthRules = healthRules
+	}
 
 	c, err := builder.
 		ControllerManagedBy(mgr).
@@ -100,7 +110,7 @@ func (r *Reconciler) AddToManager(ctx context.Context, mgr manager.Manager, sour
 		if err := c.Watch(
 			source.Kind[client.Object](targetCluster.GetCache(), obj,
 				mapper.EnqueueRequestsFrom(ctx, mgr.GetCache(), utils.MapToOriginManagedResource(clusterID), mapper.UpdateWithNew, c.GetLogger()),
-				utils.HealthStatusChanged(c.GetLogger())),
+				utils.HealthStatusChanged(c.GetLogger(), r.HealthRules)),
 		); err != nil {
 			return fmt.Errorf("error starting watch for GVK %s: %w", gvk.String(), err)
 		}
@@ -13,6 +13,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/utils/clock"
@@ -33,10 +34,12 @@ import (
 type Reconciler struct {
 	SourceClient client.Client
 	TargetClient client.Client
+	TargetReader client.Reader
 	TargetScheme *runtime.Scheme
 	Config       config.HealthControllerConfig
 	Clock        clock.Clock
 	ClassFilter  *resourcemanagerpredicate.ClassFilter
+	HealthRules  *utils.HealthRules
 
 	// ensureWatchForGVK ensures that the controller is watching the given object to reconcile corresponding
 	// ManagedResources on health status changes.
@@ -107,7 +110,7 @@ func (r *Reconciler) executeHealthChecks(ctx context.Context, log logr.Logger, m
 			objectLog = log.WithValues("object", objectKey, "objectGVK", objectGVK)
 		)
 
-		obj, err := newObjectForHealthCheck(objectLog, r.TargetScheme, objectGVK)
+		obj, err := newObjectForHealthCheck(objectLog, r.TargetScheme, r.HealthRules, objectGVK)
 		if err != nil {
 			return reconcile.Result{}, fmt.Errorf("failed to construct new object for reference: %w", err)
 		}
@@ -140,7 +143,18 @@ func (r *Reconciler) executeHealthChecks(ctx context.Context, log logr.Logger, m
 			return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
 		}
 
-		if checked, err := utils.CheckHealth(obj); err != nil {
+		if _, ok := obj.(*metav1.PartialObjectMetadata); ok && obj.GetAnnotations()[resourcesv1alpha1.HealthCheckExpression] != "" {
+			// The health check expression is evaluated on the full object, hence read it as unstructured object. It is
+			// read directly from the API server to avoid starting an informer for the full objects of this kind.
+			unstructuredObj := &unstructured.Unstructured{}
+			unstructuredObj.SetGroupVersionKind(objectGVK)
+			if err := r.TargetReader.Get(healthCheckCtx, objectKey, unstructuredObj); err != nil {
+				return reconcile.Result{}, err
+			}
+			obj = unstructuredObj
+		}
+
+		if checked, err := r.HealthRules.CheckHealth(obj); err != nil {
 			var (
 				reason  = ref.Kind + "Unhealthy"
 				message = fmt.Sprintf("%s %q is unhealthy: %v", ref.Kind, objectKey.String(), err)
@@ -186,10 +200,10 @@ func (r *Reconciler) executeHealthChecks(ctx context.Context, log logr.Logger, m
 	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
 }
 
-func newObjectForHealthCheck(log logr.Logger, scheme *runtime.Scheme, gvk schema.GroupVersionKind) (client.Object, error) {
+func newObjectForHealthCheck(log logr.Logger, scheme *runtime.Scheme, healthRules *utils.HealthRules, gvk schema.GroupVersionKind) (client.Object, error) {
 	// Create a typed object if GVK is registered in scheme. This object will be fully watched in the target cluster.
 	// If we don't know the GVK, we definitely don't have a dedicated health check for it.
-	// I.e., we only care about whether the object is present or not.
+	// Unless there is a health rule for it, we only care about whether the object is present or not.
 	// Hence, we can use metadata-only requests/watches instead of watching the entire object, which saves bandwidth and
 	// memory.
 	// If the target cache is disabled, no watches will be started.
@@ -199,6 +213,13 @@ func newObjectForHealthCheck(log logr.Logger, scheme *runtime.Scheme, gvk schema
 			return nil, err
 		}
 
+		if healthRules.HasRule(gvk.GroupKind()) {
+			// There is a health rule for the GVK which needs the full object, hence it is watched as unstructured object.
+			obj := &unstructured.Unstructured{}
+			obj.SetGroupVersionKind(gvk)
+			return obj, nil
+		}
+
 		log.V(1).Info("Falling back to metadata-only object for health checks (not registered in the target scheme)", "groupVersionKind", gvk, "err", err.Error())
 		obj := &metav1.PartialObjectMetadata{}
 		obj.SetGroupVersionKind(gvk)
@@ -8,6 +8,7 @@ import (
 	"context"
 
 	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
@@ -19,7 +20,9 @@ import (
 )
 
 // HealthStatusChanged returns a predicate that filters for events that indicate a change in the object's health status.
-func HealthStatusChanged(log logr.Logger) predicate.Predicate {
+// The given health rules are used for checking the health of objects which are not covered by the dedicated health
+// checks.
+func HealthStatusChanged(log logr.Logger, healthRules *HealthRules) predicate.Predicate {
 	return predicate.Funcs{
 		CreateFunc: func(e event.CreateEvent) bool {
 			return e.Object.GetAnnotations()[resourcesv1alpha1.SkipHealthCheck] != "true"
@@ -33,8 +36,14 @@ func HealthStatusChanged(log logr.Logger) predicate.Predicate {
 				return true
 			}
 
+			if _, ok := e.ObjectNew.(*metav1.PartialObjectMetadata); ok && e.ObjectNew.GetAnnotations()[resourcesv1alpha1.HealthCheckExpression] != "" {
+				// the health status of metadata-only objects with a health check expression cannot be determined
+				// from the watched metadata, enqueue
+				return true
+			}
+
 			var oldHealthy, newHealthy bool
-			checked, oldErr := CheckHealth(e.ObjectOld)
+			checked, oldErr := healthRules.CheckHealth(e.ObjectOld)
 			if !checked {
 				if oldErr != nil {
 					log.Error(oldErr, "Error determining health status of old object", "object", e.ObjectOld)
@@ -43,7 +52,7 @@ func HealthStatusChanged(log logr.Logger) predicate.Predicate {
 			}
 			oldHealthy = oldErr != nil
 
-			checked, newErr := CheckHealth(e.ObjectNew)
+			checked, newErr := healthRules.CheckHealth(e.ObjectNew)
 			if !checked {
 				if newErr != nil {
 					log.Error(newErr, "Error determining health status of new object", "object", e.ObjectNew)
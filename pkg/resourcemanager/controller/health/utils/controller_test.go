@@ -13,6 +13,7 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	logzap "sigs.k8s.io/controller-runtime/pkg/log/zap"
@@ -20,6 +21,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	"github.com/gardener/gardener/pkg/logger"
+	"github.com/gardener/gardener/pkg/resourcemanager/apis/config"
 	. "github.com/gardener/gardener/pkg/resourcemanager/controller/health/utils"
 )
 
@@ -31,7 +33,7 @@ var _ = Describe("HealthStatusChanged", func() {
 
 	BeforeEach(func() {
 		log = logger.MustNewZapLogger(logger.DebugLevel, logger.FormatJSON, logzap.WriteTo(GinkgoWriter))
-		p = HealthStatusChanged(log)
+		p = HealthStatusChanged(log, nil)
 	})
 
 	Context("metadata-only events", func() {
@@ -64,6 +66,13 @@ var _ = Describe("HealthStatusChanged", func() {
 			Expect(p.Update(event.UpdateEvent{ObjectOld: objOld, ObjectNew: obj})).To(BeFalse())
 		})
 
+		It("should return true for Update if the object has a health check expression", func() {
+			objOld := obj.DeepCopy()
+			obj.SetResourceVersion("2")
+			metav1.SetMetaDataAnnotation(&obj.ObjectMeta, "resources.gardener.cloud/health-check-expression", "self.status.ready")
+			Expect(p.Update(event.UpdateEvent{ObjectOld: objOld, ObjectNew: obj})).To(BeTrue())
+		})
+
 		It("should ignore Generic", func() {
 			Expect(p.Generic(event.GenericEvent{Object: obj})).To(BeFalse())
 		})
@@ -151,6 +160,43 @@ var _ = Describe("HealthStatusChanged", func() {
 		})
 	})
 
+	Context("unstructured events with health rules", func() {
+		var (
+			healthy, unhealthy *unstructured.Unstructured
+		)
+
+		BeforeEach(func() {
+			healthRules, err := NewHealthRules([]config.HealthRule{{Group: "example.com", Kind: "Foo"}})
+			Expect(err).NotTo(HaveOccurred())
+			p = HealthStatusChanged(log, healthRules)
+
+			healthy = &unstructured.Unstructured{Object: map[string]any{
+				"apiVersion": "example.com/v1",
+				"kind":       "Foo",
+				"metadata":   map[string]any{"resourceVersion": "1"},
+				"status":     map[string]any{"conditions": []any{map[string]any{"type": "Ready", "status": "True"}}},
+			}}
+			unhealthy = &unstructured.Unstructured{Object: map[string]any{
+				"apiVersion": "example.com/v1",
+				"kind":       "Foo",
+				"metadata":   map[string]any{"resourceVersion": "2"},
+				"status":     map[string]any{"conditions": []any{map[string]any{"type": "Ready", "status": "False"}}},
+			}}
+		})
+
+		It("should return true for Update, if the health status has changed", func() {
+			Expect(p.Update(event.UpdateEvent{ObjectOld: healthy, ObjectNew: unhealthy})).To(BeTrue())
+			Expect(p.Update(event.UpdateEvent{ObjectOld: unhealthy, ObjectNew: healthy})).To(BeTrue())
+		})
+
+		It("should ignore Update, if the health status has not changed", func() {
+			healthyOld := healthy.DeepCopy()
+			healthyOld.SetResourceVersion("0")
+
+			Expect(p.Update(event.UpdateEvent{ObjectOld: healthyOld, ObjectNew: healthy})).To(BeFalse())
+		})
+	})
+
 	Describe("#MapToOriginManagedResource", func() {
 		var (
 			ctx = context.TODO()
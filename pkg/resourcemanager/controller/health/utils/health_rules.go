@@ -0,0 +1,232 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/google/cel-go/cel"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/lru"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/resourcemanager/apis/config"
+)
+
+const (
+	// healthRuleCostLimit limits the cost of evaluating a single health rule expression.
+	healthRuleCostLimit = 1000000
+	// maxAnnotationExpressionLength limits the length of expressions set with the
+	// resources.gardener.cloud/health-check-expression annotation.
+	maxAnnotationExpressionLength = 1024
+	// annotationExpressionCacheSize is the maximum number of compiled expressions of annotations which are cached.
+	annotationExpressionCacheSize = 256
+)
+
+// HealthRules checks the health of objects which are not covered by the dedicated health checks (see CheckHealth)
+// based on CEL expressions. Expressions are either configured per GroupKind or set on the objects with the
+// resources.gardener.cloud/health-check-expression annotation.
+type HealthRules struct {
+	env   *cel.Env
+	rules map[schema.GroupKind][]*compiledExpression
+
+	// annotationExpressions caches the compiled expressions of annotations. The annotations are controlled by the
+	// owners of the objects, hence the cache is bounded.
+	annotationExpressions *lru.Cache
+}
+
+type compiledExpression struct {
+	program cel.Program
+	message string
+}
+
+// NewHealthRules compiles the given health rules.
+func NewHealthRules(rules []config.HealthRule) (*HealthRules, error) {
+	env, err := cel.NewEnv(cel.Variable("self", cel.DynType))
+	if err != nil {
+		return nil, fmt.Errorf("failed creating CEL environment: %w", err)
+	}
+
+	h := &HealthRules{
+		env:                   env,
+		rules:                 make(map[schema.GroupKind][]*compiledExpression, len(rules)),
+		annotationExpressions: lru.New(annotationExpressionCacheSize),
+	}
+
+	for _, rule := range rules {
+		gk := schema.GroupKind{Group: rule.Group, Kind: rule.Kind}
+		expressions := make([]*compiledExpression, 0, len(rule.Expressions))
+
+		for _, expression := range rule.Expressions {
+			compiled, err := h.compile(expression.Expression, expression.Message)
+			if err != nil {
+				return nil, fmt.Errorf("failed compiling health rule for %s: %w", gk.String(), err)
+			}
+			expressions = append(expressions, compiled)
+		}
+
+		h.rules[gk] = expressions
+	}
+
+	return h, nil
+}
+
+// HasRule returns true if there is a health rule for the given GroupKind.
+func (h *HealthRules) HasRule(gk schema.GroupKind) bool {
+	if h == nil {
+		return false
+	}
+	_, ok := h.rules[gk]
+	return ok
+}
+
+// CheckHealth checks whether the given object is healthy. Objects covered by the dedicated health checks are checked
+// by them. Other objects are checked with the expression from their resources.gardener.cloud/health-check-expression
+// annotation or with the health rule configured for their GroupKind.
+// Like CheckHealth, it returns a bool indicating whether the object was actually checked and an error if any health
+// check failed. If the health check could not be executed, false and the error are returned.
+func (h *HealthRules) CheckHealth(obj client.Object) (bool, error) {
+	if checked, err := CheckHealth(obj); checked || err != nil || h == nil {
+		return checked, err
+	}
+
+	if obj.GetAnnotations()[resourcesv1alpha1.SkipHealthCheck] == "true" {
+		return false, nil
+	}
+
+	var expressions []*compiledExpression
+	if expression, ok := obj.GetAnnotations()[resourcesv1alpha1.HealthCheckExpression]; ok {
+		compiled, err := h.compileAnnotationExpression(expression)
+		if err != nil {
+			return false, err
+		}
+		expressions = []*compiledExpression{compiled}
+	} else {
+		var ok bool
+		if expressions, ok = h.rules[obj.GetObjectKind().GroupVersionKind().GroupKind()]; !ok {
+			return false, nil
+		}
+	}
+
+	if _, ok := obj.(*metav1.PartialObjectMetadata); ok {
+		// the content of the object is required for evaluating the health rule
+		return false, nil
+	}
+
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return false, fmt.Errorf("failed converting object to unstructured: %w", err)
+	}
+
+	if len(expressions) == 0 {
+		return true, checkStatusConventions(&unstructured.Unstructured{Object: content})
+	}
+
+	for _, expression := range expressions {
+		result, _, err := expression.program.Eval(map[string]any{"self": content})
+		if err != nil {
+			return false, fmt.Errorf("failed evaluating health check expression %q: %w", expression.message, err)
+		}
+
+		healthy, ok := result.Value().(bool)
+		if !ok {
+			return false, fmt.Errorf("health check expression %q evaluated to %s instead of bool", expression.message, result.Type())
+		}
+		if !healthy {
+			return true, errors.New(expression.message)
+		}
+	}
+
+	return true, nil
+}
+
+func (h *HealthRules) compileAnnotationExpression(expression string) (*compiledExpression, error) {
+	if len(expression) > maxAnnotationExpressionLength {
+		return nil, fmt.Errorf("expression of annotation %s must not be longer than %d characters", resourcesv1alpha1.HealthCheckExpression, maxAnnotationExpressionLength)
+	}
+
+	if compiled, ok := h.annotationExpressions.Get(expression); ok {
+		return compiled.(*compiledExpression), nil
+	}
+
+	compiled, err := h.compile(expression, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed compiling expression of annotation %s: %w", resourcesv1alpha1.HealthCheckExpression, err)
+	}
+
+	h.annotationExpressions.Add(expression, compiled)
+	return compiled, nil
+}
+
+func (h *HealthRules) compile(expression, message string) (*compiledExpression, error) {
+	ast, issues := h.env.Compile(expression)
+	if issues != nil && issues.Err() != nil {
+		return nil, issues.Err()
+	}
+
+	if ast.OutputType() != cel.BoolType && ast.OutputType() != cel.DynType {
+		return nil, fmt.Errorf("expression %q must evaluate to bool but evaluates to %s", expression, ast.OutputType())
+	}
+
+	program, err := h.env.Program(ast, cel.CostLimit(healthRuleCostLimit))
+	if err != nil {
+		return nil, err
+	}
+
+	if message == "" {
+		message = fmt.Sprintf("health check expression %q evaluated to false", expression)
+	}
+
+	return &compiledExpression{program: program, message: message}, nil
+}
+
+// checkStatusConventions checks the health of the given object based on the commonly used status conventions (see
+// https://github.com/kubernetes-sigs/cli-utils/tree/master/pkg/kstatus): the object is healthy if its
+// status.observedGeneration is up-to-date, its `Ready` condition is `True`, and its `Stalled` condition is not `True`.
+func checkStatusConventions(obj *unstructured.Unstructured) error {
+	observedGeneration, found, err := unstructured.NestedInt64(obj.Object, "status", "observedGeneration")
+	if err != nil {
+		return err
+	}
+	if found && observedGeneration < obj.GetGeneration() {
+		return fmt.Errorf("observed generation outdated (%d/%d)", observedGeneration, obj.GetGeneration())
+	}
+
+	conditions, _, err := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	if err != nil {
+		return err
+	}
+
+	var ready map[string]any
+	for _, c := range conditions {
+		condition, ok := c.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		switch condition["type"] {
+		case "Ready":
+			ready = condition
+		case "Stalled":
+			if condition["status"] == string(metav1.ConditionTrue) {
+				return fmt.Errorf("condition %q has status %s due to %v: %v", "Stalled", metav1.ConditionTrue, condition["reason"], condition["message"])
+			}
+		}
+	}
+
+	if ready == nil {
+		return fmt.Errorf("condition %q is missing", "Ready")
+	}
+	if ready["status"] != string(metav1.ConditionTrue) {
+		return fmt.Errorf("condition %q has invalid status %v (expected %s) due to %v: %v", "Ready", ready["status"], metav1.ConditionTrue, ready["reason"], ready["message"])
+	}
+
+	return nil
+}
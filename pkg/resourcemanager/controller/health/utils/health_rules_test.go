@@ -0,0 +1,260 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils_test
+
+import (
+	"fmt"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/gardener/gardener/pkg/resourcemanager/apis/config"
+	. "github.com/gardener/gardener/pkg/resourcemanager/controller/health/utils"
+)
+
+var _ = Describe("HealthRules", func() {
+	var (
+		rules       []config.HealthRule
+		healthRules *HealthRules
+		obj         *unstructured.Unstructured
+	)
+
+	BeforeEach(func() {
+		rules = []config.HealthRule{
+			{Group: "example.com", Kind: "Default"},
+			{Group: "example.com", Kind: "Custom", Expressions: []config.HealthRuleExpression{
+				{Expression: "self.status.phase == 'Running'", Message: "phase is not Running"},
+				{Expression: "self.status.replicas >= 2"},
+			}},
+		}
+
+		obj = &unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": "example.com/v1",
+			"kind":       "Custom",
+			"metadata":   map[string]any{"name": "foo", "generation": int64(2)},
+			"status":     map[string]any{"phase": "Running", "replicas": int64(2)},
+		}}
+	})
+
+	JustBeforeEach(func() {
+		var err error
+		healthRules, err = NewHealthRules(rules)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	Describe("#NewHealthRules", func() {
+		It("should fail if an expression cannot be compiled", func() {
+			_, err := NewHealthRules([]config.HealthRule{{Kind: "Foo", Expressions: []config.HealthRuleExpression{{Expression: "self.status.("}}}})
+			Expect(err).To(MatchError(ContainSubstring("failed compiling health rule for Foo")))
+		})
+
+		It("should fail if an expression does not evaluate to bool", func() {
+			_, err := NewHealthRules([]config.HealthRule{{Kind: "Foo", Expressions: []config.HealthRuleExpression{{Expression: "'foo'"}}}})
+			Expect(err).To(MatchError(ContainSubstring("must evaluate to bool")))
+		})
+	})
+
+	Describe("#HasRule", func() {
+		It("should return whether a rule exists for the GroupKind", func() {
+			Expect(healthRules.HasRule(schema.GroupKind{Group: "example.com", Kind: "Default"})).To(BeTrue())
+			Expect(healthRules.HasRule(schema.GroupKind{Group: "example.com", Kind: "Other"})).To(BeFalse())
+		})
+	})
+
+	Describe("#CheckHealth", func() {
+		It("should use the dedicated health checks", func() {
+			checked, err := healthRules.CheckHealth(&appsv1.Deployment{})
+			Expect(checked).To(BeTrue())
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should not check objects without rule", func() {
+			obj.SetKind("Other")
+
+			checked, err := healthRules.CheckHealth(obj)
+			Expect(checked).To(BeFalse())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should not check objects with the skip-health-check annotation", func() {
+			obj.SetAnnotations(map[string]string{"resources.gardener.cloud/skip-health-check": "true"})
+			obj.Object["status"] = map[string]any{}
+
+			checked, err := healthRules.CheckHealth(obj)
+			Expect(checked).To(BeFalse())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should not check metadata-only objects", func() {
+			metadataOnly := &metav1.PartialObjectMetadata{}
+			metadataOnly.SetGroupVersionKind(schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "Custom"})
+
+			checked, err := healthRules.CheckHealth(metadataOnly)
+			Expect(checked).To(BeFalse())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("configured expressions", func() {
+			It("should consider the object healthy if all expressions evaluate to true", func() {
+				checked, err := healthRules.CheckHealth(obj)
+				Expect(checked).To(BeTrue())
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should report the message of the failed expression", func() {
+				obj.Object["status"] = map[string]any{"phase": "Pending", "replicas": int64(2)}
+
+				checked, err := healthRules.CheckHealth(obj)
+				Expect(checked).To(BeTrue())
+				Expect(err).To(MatchError("phase is not Running"))
+			})
+
+			It("should report the expression if no message is configured", func() {
+				obj.Object["status"] = map[string]any{"phase": "Running", "replicas": int64(1)}
+
+				checked, err := healthRules.CheckHealth(obj)
+				Expect(checked).To(BeTrue())
+				Expect(err).To(MatchError(`health check expression "self.status.replicas >= 2" evaluated to false`))
+			})
+
+			It("should return an error if the expression cannot be evaluated", func() {
+				obj.Object["status"] = map[string]any{"phase": "Running"}
+
+				checked, err := healthRules.CheckHealth(obj)
+				Expect(checked).To(BeFalse())
+				Expect(err).To(MatchError(ContainSubstring("failed evaluating health check expression")))
+			})
+		})
+
+		Context("annotation expression", func() {
+			BeforeEach(func() {
+				obj.SetKind("Other")
+				obj.SetAnnotations(map[string]string{"resources.gardener.cloud/health-check-expression": "self.status.phase == 'Running'"})
+			})
+
+			It("should consider the object healthy if the expression evaluates to true", func() {
+				checked, err := healthRules.CheckHealth(obj)
+				Expect(checked).To(BeTrue())
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should consider the object unhealthy if the expression evaluates to false", func() {
+				obj.Object["status"] = map[string]any{"phase": "Pending"}
+
+				checked, err := healthRules.CheckHealth(obj)
+				Expect(checked).To(BeTrue())
+				Expect(err).To(MatchError(`health check expression "self.status.phase == 'Running'" evaluated to false`))
+			})
+
+			It("should take precedence over the configured rule", func() {
+				obj.SetKind("Custom")
+				obj.Object["status"] = map[string]any{"phase": "Running"}
+
+				checked, err := healthRules.CheckHealth(obj)
+				Expect(checked).To(BeTrue())
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should return an error if the expression cannot be compiled", func() {
+				obj.SetAnnotations(map[string]string{"resources.gardener.cloud/health-check-expression": "self.status.("})
+
+				checked, err := healthRules.CheckHealth(obj)
+				Expect(checked).To(BeFalse())
+				Expect(err).To(MatchError(ContainSubstring("failed compiling expression of annotation")))
+			})
+
+			It("should return an error if the expression is too long", func() {
+				obj.SetAnnotations(map[string]string{"resources.gardener.cloud/health-check-expression": "true" + strings.Repeat(" && true", 128)})
+
+				checked, err := healthRules.CheckHealth(obj)
+				Expect(checked).To(BeFalse())
+				Expect(err).To(MatchError(ContainSubstring("must not be longer than 1024 characters")))
+			})
+
+			It("should keep evaluating expressions when more expressions are used than cached", func() {
+				for i := range 300 {
+					obj.SetAnnotations(map[string]string{"resources.gardener.cloud/health-check-expression": fmt.Sprintf("self.status.phase == 'Running' || %d == 0", i)})
+
+					checked, err := healthRules.CheckHealth(obj)
+					Expect(checked).To(BeTrue())
+					Expect(err).NotTo(HaveOccurred())
+				}
+			})
+
+			It("should check typed objects not covered by the dedicated health checks", func() {
+				configMap := &corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{"resources.gardener.cloud/health-check-expression": "self.data.ready == 'true'"}},
+					Data:       map[string]string{"ready": "false"},
+				}
+
+				checked, err := healthRules.CheckHealth(configMap)
+				Expect(checked).To(BeTrue())
+				Expect(err).To(HaveOccurred())
+			})
+		})
+
+		Context("status conventions", func() {
+			BeforeEach(func() {
+				obj.SetKind("Default")
+				obj.Object["status"] = map[string]any{
+					"observedGeneration": int64(2),
+					"conditions": []any{
+						map[string]any{"type": "Ready", "status": "True"},
+						map[string]any{"type": "Stalled", "status": "False"},
+					},
+				}
+			})
+
+			It("should consider the object healthy", func() {
+				checked, err := healthRules.CheckHealth(obj)
+				Expect(checked).To(BeTrue())
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should consider the object unhealthy if the observed generation is outdated", func() {
+				obj.SetGeneration(3)
+
+				checked, err := healthRules.CheckHealth(obj)
+				Expect(checked).To(BeTrue())
+				Expect(err).To(MatchError("observed generation outdated (2/3)"))
+			})
+
+			It("should consider the object unhealthy if the Ready condition is missing", func() {
+				Expect(unstructured.SetNestedSlice(obj.Object, []any{}, "status", "conditions")).To(Succeed())
+
+				checked, err := healthRules.CheckHealth(obj)
+				Expect(checked).To(BeTrue())
+				Expect(err).To(MatchError(`condition "Ready" is missing`))
+			})
+
+			It("should consider the object unhealthy if the Ready condition is not True", func() {
+				Expect(unstructured.SetNestedSlice(obj.Object, []any{
+					map[string]any{"type": "Ready", "status": "False", "reason": "Reconciling", "message": "in progress"},
+				}, "status", "conditions")).To(Succeed())
+
+				checked, err := healthRules.CheckHealth(obj)
+				Expect(checked).To(BeTrue())
+				Expect(err).To(MatchError(`condition "Ready" has invalid status False (expected True) due to Reconciling: in progress`))
+			})
+
+			It("should consider the object unhealthy if it is stalled", func() {
+				Expect(unstructured.SetNestedSlice(obj.Object, []any{
+					map[string]any{"type": "Ready", "status": "True"},
+					map[string]any{"type": "Stalled", "status": "True", "reason": "Failed", "message": "invalid spec"},
+				}, "status", "conditions")).To(Succeed())
+
+				checked, err := healthRules.CheckHealth(obj)
+				Expect(checked).To(BeTrue())
+				Expect(err).To(MatchError(`condition "Stalled" has status True due to Failed: invalid spec`))
+			})
+		})
+	})
+})
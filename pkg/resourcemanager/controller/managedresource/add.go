@@ -26,6 +26,7 @@ import (
 	"github.com/gardener/gardener/pkg/controllerutils/mapper"
 	predicateutils "github.com/gardener/gardener/pkg/controllerutils/predicate"
 	reconcilerutils "github.com/gardener/gardener/pkg/controllerutils/reconciler"
+	"github.com/gardener/gardener/pkg/resourcemanager/controller/drift"
 	resourcemanagerpredicate "github.com/gardener/gardener/pkg/resourcemanager/predicate"
 )
 
@@ -38,7 +39,9 @@ func (r *Reconciler) AddToManager(ctx context.Context, mgr manager.Manager, sour
 		r.SourceClient = sourceCluster.GetClient()
 	}
 	if r.TargetClient == nil {
-		r.TargetClient = targetCluster.GetClient()
+		// use an explicit field manager, so that modifications by other field managers can be detected independent of
+		// the user agent
+		r.TargetClient = client.WithFieldOwner(targetCluster.GetClient(), drift.FieldManager)
 	}
 	if r.TargetScheme == nil {
 		r.TargetScheme = targetCluster.GetScheme()
@@ -52,6 +55,9 @@ func (r *Reconciler) AddToManager(ctx context.Context, mgr manager.Manager, sour
 	if r.RequeueAfterOnDeletionPending == nil {
 		r.RequeueAfterOnDeletionPending = ptr.To(5 * time.Second)
 	}
+	if r.RequeueAfterOnApplyPhasePending == nil {
+		r.RequeueAfterOnApplyPhasePending = ptr.To(5 * time.Second)
+	}
 
 	c, err := builder.
 		ControllerManagedBy(mgr).
@@ -61,7 +67,10 @@ func (r *Reconciler) AddToManager(ctx context.Context, mgr manager.Manager, sour
 			predicate.Or(
 				predicate.GenerationChangedPredicate{},
 				resourcemanagerpredicate.HasOperationAnnotation(),
+				resourcemanagerpredicate.PreviewChanged(),
 				resourcemanagerpredicate.ConditionStatusChanged(resourcesv1alpha1.ResourcesHealthy, resourcemanagerpredicate.ConditionChangedToUnhealthy),
+				// revert drift immediately after it has been detected
+				resourcemanagerpredicate.ConditionStatusChanged(resourcesv1alpha1.DriftDetected, resourcemanagerpredicate.DriftToRevert),
 				resourcemanagerpredicate.NoLongerIgnored(),
 				// we need to reconcile once if the ManagedResource got marked as ignored in order to update the conditions
 				resourcemanagerpredicate.GotMarkedAsIgnored(),
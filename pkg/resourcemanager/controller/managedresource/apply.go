@@ -0,0 +1,229 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package managedresource
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/util/csaupgrade"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/resourcemanager/controller/drift"
+)
+
+// fieldOwner is the field manager used for applying resources with server-side apply. It equals the field manager
+// used for update requests, so that the ownership of fields set by earlier update requests can be migrated to the apply
+// field manager.
+const fieldOwner = client.FieldOwner(drift.FieldManager)
+
+func isServerSideApply(mr *resourcesv1alpha1.ManagedResource) bool {
+	return ptr.Deref(mr.Spec.ApplyMode, resourcesv1alpha1.ApplyModeUpdate) == resourcesv1alpha1.ApplyModeServerSideApply
+}
+
+// applyServerSide applies the given object with server-side apply. The labels to inject, the origin and the
+// description annotations are added to the object before, i.e., it is mutated to the applied configuration and contains
+// the applied object after a successful apply. Like the merge for update requests, the replicas of existing objects
+// which are scaled horizontally or annotated accordingly and the resources of annotated existing objects are preserved.
+// Conflicts with other field managers are not forced unless client.ForceOwnership is passed. Existing objects are not
+// applied if keepExisting (optional) returns true for them.
+// It returns the object as it existed before (nil if it did not exist) and whether the object was created or updated.
+func applyServerSide(ctx context.Context, c client.Client, scheme *runtime.Scheme, origin string, obj *unstructured.Unstructured, labelsToInject map[string]string, scaledHorizontally bool, keepExisting func(*unstructured.Unstructured) bool, opts ...client.PatchOption) (*unstructured.Unstructured, controllerutil.OperationResult, error) {
+	existing, err := getExisting(ctx, c, scheme, obj)
+	if err != nil {
+		return nil, controllerutil.OperationResultNone, err
+	}
+
+	if existing != nil {
+		// if the ignore annotation is set to true, do nothing (ignore the resource)
+		if ignore(obj) || (keepExisting != nil && keepExisting(existing)) {
+			return existing, controllerutil.OperationResultNone, nil
+		}
+
+		if err := migrateManagedFields(ctx, c, existing); err != nil {
+			return existing, controllerutil.OperationResultNone, fmt.Errorf("failed migrating managed fields to server-side apply: %w", err)
+		}
+
+		if err := preserveFields(obj, existing, scaledHorizontally); err != nil {
+			return existing, controllerutil.OperationResultNone, fmt.Errorf("failed preserving fields of existing object: %w", err)
+		}
+	}
+
+	if err := injectLabels(obj, labelsToInject); err != nil {
+		return existing, controllerutil.OperationResultNone, fmt.Errorf("error injecting labels: %w", err)
+	}
+
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[descriptionAnnotation] = descriptionAnnotationText
+	annotations[resourcesv1alpha1.OriginAnnotation] = origin
+	obj.SetAnnotations(annotations)
+
+	obj.SetResourceVersion("")
+	obj.SetManagedFields(nil)
+
+	result := controllerutil.OperationResultCreated
+	if existing != nil {
+		result = controllerutil.OperationResultUpdated
+	}
+
+	if err := c.Patch(ctx, obj, client.Apply, append([]client.PatchOption{fieldOwner}, opts...)...); err != nil {
+		return existing, result, err
+	}
+
+	if existing != nil && existing.GetResourceVersion() == obj.GetResourceVersion() {
+		result = controllerutil.OperationResultNone
+	}
+	return existing, result, nil
+}
+
+// getExisting returns the current state of the given object or nil if it does not exist. Like
+// controllerutils.TypedCreateOrUpdate, it reads typed objects if possible to make use of the cache.
+func getExisting(ctx context.Context, c client.Client, scheme *runtime.Scheme, obj *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	var current client.Object = &unstructured.Unstructured{}
+	current.GetObjectKind().SetGroupVersionKind(obj.GroupVersionKind())
+
+	if typed, err := scheme.New(obj.GroupVersionKind()); err == nil {
+		if typedObj, ok := typed.(client.Object); ok {
+			current = typedObj
+		}
+	}
+
+	if err := c.Get(ctx, client.ObjectKeyFromObject(obj), current); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	if existing, ok := current.(*unstructured.Unstructured); ok {
+		return existing, nil
+	}
+
+	existing := &unstructured.Unstructured{}
+	if err := scheme.Convert(current, existing, nil); err != nil {
+		return nil, err
+	}
+	return existing, nil
+}
+
+// preserveFields sets the replicas and the resources of the given object to the values of the existing object if they
+// are to be preserved. The values are applied instead of dropping the fields from the applied configuration, because
+// fields which were applied before and are only owned by the resource manager would be removed otherwise. Applying the
+// existing values does not conflict with other field managers, e.g., the HPA controller.
+func preserveFields(obj, existing *unstructured.Unstructured, scaledHorizontally bool) error {
+	annotations := obj.GetAnnotations()
+
+	if scaledHorizontally || annotations[resourcesv1alpha1.PreserveReplicas] == "true" {
+		if err := copyNestedField(obj.Object, existing.Object, "spec", "replicas"); err != nil {
+			return err
+		}
+	}
+
+	if annotations[resourcesv1alpha1.PreserveResources] == "true" {
+		containersPath := []string{"spec", "template", "spec", "containers"}
+		if obj.GetKind() == "CronJob" {
+			containersPath = []string{"spec", "jobTemplate", "spec", "template", "spec", "containers"}
+		}
+
+		if err := preserveContainerResources(obj, existing, containersPath); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// preserveContainerResources sets the CPU and memory requests and limits of the containers at the given path to the
+// values of the containers with the same name of the existing object.
+func preserveContainerResources(obj, existing *unstructured.Unstructured, path []string) error {
+	containers, found, err := unstructured.NestedSlice(obj.Object, path...)
+	if err != nil || !found {
+		return err
+	}
+	existingContainers, _, err := unstructured.NestedSlice(existing.Object, path...)
+	if err != nil {
+		return err
+	}
+
+	for _, c := range containers {
+		container, ok := c.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		for _, ec := range existingContainers {
+			existingContainer, ok := ec.(map[string]any)
+			if !ok || existingContainer["name"] != container["name"] {
+				continue
+			}
+
+			for _, kind := range []string{"requests", "limits"} {
+				for _, resourceName := range []string{string(corev1.ResourceCPU), string(corev1.ResourceMemory)} {
+					if err := copyNestedField(container, existingContainer, "resources", kind, resourceName); err != nil {
+						return err
+					}
+				}
+			}
+		}
+	}
+
+	return unstructured.SetNestedSlice(obj.Object, containers, path...)
+}
+
+// copyNestedField sets the field at the given path of obj to the value of existing. It does nothing if the field does
+// not exist in existing.
+func copyNestedField(obj, existing map[string]any, fields ...string) error {
+	value, found, err := unstructured.NestedFieldCopy(existing, fields...)
+	if err != nil || !found {
+		return err
+	}
+	return unstructured.SetNestedField(obj, value, fields...)
+}
+
+// migrateManagedFields transfers the ownership of fields set by earlier update requests of the resource manager to the
+// apply field manager. Without this migration, fields which are removed from the desired state would not be removed
+// from the object but would stay owned by the update field manager.
+func migrateManagedFields(ctx context.Context, c client.Client, obj *unstructured.Unstructured) error {
+	patch, err := csaupgrade.UpgradeManagedFieldsPatch(obj, sets.New(string(fieldOwner)), string(fieldOwner))
+	if err != nil || patch == nil {
+		return err
+	}
+
+	return c.Patch(ctx, obj, client.RawPatch(types.JSONPatchType, patch))
+}
+
+func isFieldManagerConflict(err error) bool {
+	_, ok := apierrors.StatusCause(err, metav1.CauseTypeFieldManagerConflict)
+	return ok
+}
+
+// applyConflictError is returned if resources could not be applied with server-side apply because of conflicts with
+// other field managers.
+type applyConflictError struct {
+	errs []error
+}
+
+func (e *applyConflictError) Error() string {
+	msgs := make([]string, 0, len(e.errs))
+	for _, err := range e.errs {
+		msgs = append(msgs, err.Error())
+	}
+
+	return fmt.Sprintf("%d resource(s) could not be applied because of conflicts with other field managers, remove the conflicting fields from the resources or from the other field managers: %s", len(e.errs), strings.Join(msgs, "; "))
+}
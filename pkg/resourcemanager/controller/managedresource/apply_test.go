@@ -0,0 +1,402 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package managedresource
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/resourcemanager/apis/config"
+	"github.com/gardener/gardener/pkg/resourcemanager/controller/drift"
+)
+
+var _ = Describe("apply", func() {
+	var (
+		ctx        = context.Background()
+		fakeClient client.Client
+
+		applyErr     error
+		applyOptions []*client.PatchOptions
+		appliedNames []string
+	)
+
+	BeforeEach(func() {
+		applyErr = nil
+		applyOptions = nil
+		appliedNames = nil
+
+		// The fake client does not support apply patches, hence, they are emulated with create and update requests.
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.ShootScheme).WithInterceptorFuncs(interceptor.Funcs{
+			Patch: func(ctx context.Context, c client.WithWatch, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+				if patch.Type() != types.ApplyPatchType {
+					return c.Patch(ctx, obj, patch, opts...)
+				}
+
+				patchOptions := &client.PatchOptions{}
+				patchOptions.ApplyOptions(opts)
+				applyOptions = append(applyOptions, patchOptions)
+				appliedNames = append(appliedNames, obj.GetName())
+
+				if applyErr != nil {
+					return applyErr
+				}
+
+				current := &unstructured.Unstructured{}
+				current.SetGroupVersionKind(obj.GetObjectKind().GroupVersionKind())
+				if err := c.Get(ctx, client.ObjectKeyFromObject(obj), current); err != nil {
+					if !apierrors.IsNotFound(err) {
+						return err
+					}
+					return c.Create(ctx, obj)
+				}
+
+				obj.SetResourceVersion(current.GetResourceVersion())
+				return c.Update(ctx, obj)
+			},
+		}).Build()
+	})
+
+	Describe("#applyServerSide", func() {
+		It("should create the object with the injected labels and annotations", func() {
+			obj := newUnstructured("ConfigMap", "foo", map[string]any{"foo": "bar"})
+
+			existing, result, err := applyServerSide(ctx, fakeClient, kubernetes.ShootScheme, "origin", obj, map[string]string{"foo": "bar"}, false, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(existing).To(BeNil())
+			Expect(result).To(Equal(controllerutil.OperationResultCreated))
+
+			Expect(applyOptions).To(ConsistOf(&client.PatchOptions{FieldManager: "gardener-resource-manager"}))
+
+			configMap := &corev1.ConfigMap{}
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Name: "foo", Namespace: "default"}, configMap)).To(Succeed())
+			Expect(configMap.Data).To(Equal(map[string]string{"foo": "bar"}))
+			Expect(configMap.Labels).To(Equal(map[string]string{"foo": "bar"}))
+			Expect(configMap.Annotations).To(Equal(map[string]string{
+				"resources.gardener.cloud/description": descriptionAnnotationText,
+				"resources.gardener.cloud/origin":      "origin",
+			}))
+		})
+
+		It("should update the existing object", func() {
+			Expect(fakeClient.Create(ctx, &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "default"}, Data: map[string]string{"foo": "bar"}})).To(Succeed())
+			obj := newUnstructured("ConfigMap", "foo", map[string]any{"foo": "baz"})
+
+			existing, result, err := applyServerSide(ctx, fakeClient, kubernetes.ShootScheme, "origin", obj, nil, false, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(existing.Object).To(HaveKeyWithValue("data", map[string]any{"foo": "bar"}))
+			Expect(result).To(Equal(controllerutil.OperationResultUpdated))
+
+			configMap := &corev1.ConfigMap{}
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Name: "foo", Namespace: "default"}, configMap)).To(Succeed())
+			Expect(configMap.Data).To(Equal(map[string]string{"foo": "baz"}))
+		})
+
+		It("should pass the given options", func() {
+			obj := newUnstructured("ConfigMap", "foo", nil)
+
+			_, _, err := applyServerSide(ctx, fakeClient, kubernetes.ShootScheme, "origin", obj, nil, false, nil, client.ForceOwnership)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(applyOptions).To(ConsistOf(&client.PatchOptions{FieldManager: "gardener-resource-manager", Force: ptr.To(true)}))
+		})
+
+		It("should not apply existing objects with the ignore annotation", func() {
+			Expect(fakeClient.Create(ctx, &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "default"}, Data: map[string]string{"foo": "bar"}})).To(Succeed())
+			obj := newUnstructured("ConfigMap", "foo", map[string]any{"foo": "baz"})
+			obj.SetAnnotations(map[string]string{"resources.gardener.cloud/ignore": "true"})
+
+			_, result, err := applyServerSide(ctx, fakeClient, kubernetes.ShootScheme, "origin", obj, nil, false, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(controllerutil.OperationResultNone))
+
+			Expect(applyOptions).To(BeEmpty())
+		})
+
+		It("should migrate the managed fields of earlier update requests", func() {
+			Expect(fakeClient.Create(ctx, &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "foo",
+					Namespace: "default",
+					ManagedFields: []metav1.ManagedFieldsEntry{{
+						Manager:    "gardener-resource-manager",
+						Operation:  metav1.ManagedFieldsOperationUpdate,
+						APIVersion: "v1",
+						FieldsType: "FieldsV1",
+						FieldsV1:   &metav1.FieldsV1{Raw: []byte(`{"f:data":{"f:foo":{}}}`)},
+					}},
+				},
+				Data: map[string]string{"foo": "bar"},
+			})).To(Succeed())
+
+			applyErr = apierrors.NewInternalError(errors.New("fake"))
+
+			_, _, err := applyServerSide(ctx, fakeClient, kubernetes.ShootScheme, "origin", newUnstructured("ConfigMap", "foo", nil), nil, false, nil)
+			Expect(err).To(HaveOccurred())
+
+			configMap := &corev1.ConfigMap{}
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Name: "foo", Namespace: "default"}, configMap)).To(Succeed())
+			Expect(configMap.ManagedFields).To(ConsistOf(HaveField("Operation", metav1.ManagedFieldsOperationApply)))
+		})
+
+		It("should preserve the replicas of horizontally scaled objects", func() {
+			Expect(fakeClient.Create(ctx, newDeployment(3, "200m"))).To(Succeed())
+			obj := newUnstructuredDeployment(1, "100m")
+
+			_, _, err := applyServerSide(ctx, fakeClient, kubernetes.ShootScheme, "origin", obj, nil, true, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			deployment := &appsv1.Deployment{}
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Name: "foo", Namespace: "default"}, deployment)).To(Succeed())
+			Expect(deployment.Spec.Replicas).To(PointTo(Equal(int32(3))))
+			Expect(deployment.Spec.Template.Spec.Containers[0].Resources.Requests.Cpu().String()).To(Equal("100m"))
+		})
+
+		It("should preserve the replicas and resources of annotated objects", func() {
+			Expect(fakeClient.Create(ctx, newDeployment(3, "200m"))).To(Succeed())
+			obj := newUnstructuredDeployment(1, "100m")
+			obj.SetAnnotations(map[string]string{
+				"resources.gardener.cloud/preserve-replicas":  "true",
+				"resources.gardener.cloud/preserve-resources": "true",
+			})
+
+			_, _, err := applyServerSide(ctx, fakeClient, kubernetes.ShootScheme, "origin", obj, nil, false, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			deployment := &appsv1.Deployment{}
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Name: "foo", Namespace: "default"}, deployment)).To(Succeed())
+			Expect(deployment.Spec.Replicas).To(PointTo(Equal(int32(3))))
+			Expect(deployment.Spec.Template.Spec.Containers[0].Resources.Requests.Cpu().String()).To(Equal("200m"))
+		})
+
+		It("should apply the desired replicas when creating horizontally scaled objects", func() {
+			obj := newUnstructuredDeployment(1, "100m")
+
+			_, _, err := applyServerSide(ctx, fakeClient, kubernetes.ShootScheme, "origin", obj, nil, true, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			deployment := &appsv1.Deployment{}
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Name: "foo", Namespace: "default"}, deployment)).To(Succeed())
+			Expect(deployment.Spec.Replicas).To(PointTo(Equal(int32(1))))
+		})
+	})
+
+	Describe("#applyNewResources", func() {
+		var reconciler *Reconciler
+
+		BeforeEach(func() {
+			reconciler = &Reconciler{
+				TargetClient: fakeClient,
+				TargetScheme: kubernetes.ShootScheme,
+				Config:       config.ManagedResourceControllerConfig{},
+			}
+		})
+
+		It("should apply all resources and report conflicts with other field managers", func() {
+			applyErr = apierrors.NewApplyConflict([]metav1.StatusCause{{
+				Type:    metav1.CauseTypeFieldManagerConflict,
+				Message: `conflict with "kubectl"`,
+				Field:   ".data.foo",
+			}}, `Apply failed with 1 conflict: conflict with "kubectl": .data.foo`)
+
+			err := reconciler.applyNewResources(ctx, logr.Discard(), "origin", []object{
+				{obj: newUnstructured("ConfigMap", "bar", map[string]any{"foo": "bar"})},
+				{obj: newUnstructured("ConfigMap", "foo", map[string]any{"foo": "bar"})},
+			}, nil, nil, true)
+
+			var conflictErr *applyConflictError
+			Expect(err).To(BeAssignableToTypeOf(conflictErr))
+			Expect(err).To(MatchError(ContainSubstring(`2 resource(s) could not be applied because of conflicts with other field managers`)))
+			Expect(err).To(MatchError(ContainSubstring(`object "v1/ConfigMap/default/foo": Apply failed with 1 conflict: conflict with "kubectl": .data.foo`)))
+			Expect(appliedNames).To(Equal([]string{"bar", "foo"}))
+		})
+
+		It("should not continue on other errors", func() {
+			applyErr = apierrors.NewInternalError(errors.New("fake"))
+
+			err := reconciler.applyNewResources(ctx, logr.Discard(), "origin", []object{
+				{obj: newUnstructured("ConfigMap", "bar", map[string]any{"foo": "bar"})},
+				{obj: newUnstructured("ConfigMap", "foo", map[string]any{"foo": "bar"})},
+			}, nil, nil, true)
+
+			Expect(err).To(MatchError(ContainSubstring(`error during apply of object "v1/ConfigMap/default/bar"`)))
+			Expect(appliedNames).To(Equal([]string{"bar"}))
+		})
+
+		It("should not overwrite the replicas owned by an HPA", func() {
+			Expect(fakeClient.Create(ctx, &autoscalingv1.HorizontalPodAutoscaler{
+				ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "default"},
+				Spec: autoscalingv1.HorizontalPodAutoscalerSpec{
+					ScaleTargetRef: autoscalingv1.CrossVersionObjectReference{APIVersion: "apps/v1", Kind: "Deployment", Name: "foo"},
+					MaxReplicas:    5,
+				},
+			})).To(Succeed())
+			deployment := newDeployment(3, "200m")
+			deployment.ManagedFields = []metav1.ManagedFieldsEntry{{
+				Manager:    "kube-controller-manager",
+				Operation:  metav1.ManagedFieldsOperationUpdate,
+				APIVersion: "apps/v1",
+				FieldsType: "FieldsV1",
+				FieldsV1:   &metav1.FieldsV1{Raw: []byte(`{"f:spec":{"f:replicas":{}}}`)},
+			}}
+			Expect(fakeClient.Create(ctx, deployment)).To(Succeed())
+
+			Expect(reconciler.applyNewResources(ctx, logr.Discard(), "origin", []object{
+				{obj: newUnstructuredDeployment(1, "100m")},
+			}, nil, nil, true)).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(deployment), deployment)).To(Succeed())
+			Expect(deployment.Spec.Replicas).To(PointTo(Equal(int32(3))))
+		})
+
+		Context("drift with the Report policy", func() {
+			var (
+				lastWritten = time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+				configMap   *corev1.ConfigMap
+			)
+
+			desiredObject := func(data string) object {
+				obj := newUnstructured("ConfigMap", "foo", map[string]any{"foo": data})
+				obj.SetAnnotations(map[string]string{"resources.gardener.cloud/drift-policy": "Report"})
+				return object{obj: obj}
+			}
+
+			BeforeEach(func() {
+				reconciler.DriftDetector = drift.NewDetector(nil)
+
+				// the checksum of the desired state is added to the object when computing the function
+				desired := desiredObject("bar")
+				Expect(reconciler.keepReportedDriftFunc(desired, nil)).NotTo(BeNil())
+
+				configMap = &corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:        "foo",
+						Namespace:   "default",
+						Annotations: desired.obj.GetAnnotations(),
+						ManagedFields: []metav1.ManagedFieldsEntry{
+							{
+								Manager:    "gardener-resource-manager",
+								Operation:  metav1.ManagedFieldsOperationUpdate,
+								APIVersion: "v1",
+								Time:       &metav1.Time{Time: lastWritten},
+								FieldsType: "FieldsV1",
+								FieldsV1:   &metav1.FieldsV1{Raw: []byte(`{"f:data":{".":{},"f:foo":{}}}`)},
+							},
+							{
+								Manager:    "kubectl-edit",
+								Operation:  metav1.ManagedFieldsOperationUpdate,
+								APIVersion: "v1",
+								Time:       &metav1.Time{Time: lastWritten.Add(time.Minute)},
+								FieldsType: "FieldsV1",
+								FieldsV1:   &metav1.FieldsV1{Raw: []byte(`{"f:data":{"f:foo":{}}}`)},
+							},
+						},
+					},
+					Data: map[string]string{"foo": "drifted"},
+				}
+				Expect(fakeClient.Create(ctx, configMap)).To(Succeed())
+			})
+
+			DescribeTable("should keep the drift if the desired state of the object did not change",
+				func(serverSideApply bool) {
+					Expect(reconciler.applyNewResources(ctx, logr.Discard(), "origin", []object{desiredObject("bar")}, nil, nil, serverSideApply)).To(Succeed())
+
+					Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(configMap), configMap)).To(Succeed())
+					Expect(configMap.Data).To(Equal(map[string]string{"foo": "drifted"}))
+					Expect(appliedNames).To(BeEmpty())
+				},
+				Entry("update", false),
+				Entry("server-side apply", true),
+			)
+
+			DescribeTable("should revert the drift if the desired state of the object changed",
+				func(serverSideApply bool) {
+					checksum := configMap.Annotations["resources.gardener.cloud/desired-state-checksum"]
+
+					Expect(reconciler.applyNewResources(ctx, logr.Discard(), "origin", []object{desiredObject("baz")}, nil, nil, serverSideApply)).To(Succeed())
+
+					Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(configMap), configMap)).To(Succeed())
+					Expect(configMap.Data).To(Equal(map[string]string{"foo": "baz"}))
+					Expect(configMap.Annotations).To(HaveKeyWithValue("resources.gardener.cloud/desired-state-checksum", Not(Or(BeEmpty(), Equal(checksum)))))
+				},
+				Entry("update", false),
+				Entry("server-side apply", true),
+			)
+
+			DescribeTable("should revert the drift if the labels to inject changed",
+				func(serverSideApply bool) {
+					Expect(reconciler.applyNewResources(ctx, logr.Discard(), "origin", []object{desiredObject("bar")}, map[string]string{"foo": "bar"}, nil, serverSideApply)).To(Succeed())
+
+					Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(configMap), configMap)).To(Succeed())
+					Expect(configMap.Data).To(Equal(map[string]string{"foo": "bar"}))
+				},
+				Entry("update", false),
+				Entry("server-side apply", true),
+			)
+
+			It("should not add the checksum to objects with the Revert policy", func() {
+				obj := newUnstructured("ConfigMap", "foo", nil)
+
+				Expect(reconciler.keepReportedDriftFunc(object{obj: obj}, nil)).To(BeNil())
+				Expect(obj.GetAnnotations()).To(BeEmpty())
+			})
+		})
+	})
+})
+
+func newDeployment(replicas int32, cpu string) *appsv1.Deployment {
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "default"},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: ptr.To(replicas),
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{
+						Name:      "foo",
+						Resources: corev1.ResourceRequirements{Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse(cpu)}},
+					}},
+				},
+			},
+		},
+	}
+}
+
+func newUnstructuredDeployment(replicas int64, cpu string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata":   map[string]any{"name": "foo", "namespace": "default"},
+		"spec": map[string]any{
+			"replicas": replicas,
+			"template": map[string]any{
+				"spec": map[string]any{
+					"containers": []any{map[string]any{
+						"name":      "foo",
+						"resources": map[string]any{"requests": map[string]any{"cpu": cpu}},
+					}},
+				},
+			},
+		},
+	}}
+}
@@ -27,6 +27,9 @@ const (
 	descriptionAnnotation     = "resources.gardener.cloud/description"
 	descriptionAnnotationText = `DO NOT EDIT - This resource is managed by gardener-resource-manager.
 Any modifications are discarded and the resource is returned to the original state.`
+	// desiredStateChecksumAnnotation contains the checksum of the desired state of objects with the `Report` drift
+	// policy. Their drift is kept as long as the checksum does not change.
+	desiredStateChecksumAnnotation = "resources.gardener.cloud/desired-state-checksum"
 )
 
 // merge merges the values of the `desired` object into the `current` object while preserving `current`'s important
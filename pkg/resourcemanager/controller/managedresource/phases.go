@@ -0,0 +1,142 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package managedresource
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+)
+
+// applyPhase is a group of objects which are applied together.
+type applyPhase struct {
+	number  int
+	objects []object
+}
+
+// groupByApplyPhase groups the given objects by the value of their resources.gardener.cloud/apply-phase annotation.
+// The phases are returned in ascending order.
+func groupByApplyPhase(objects []object) ([]applyPhase, error) {
+	objectsByPhase := make(map[int][]object)
+
+	for _, obj := range objects {
+		phase, err := applyPhaseOf(obj.obj)
+		if err != nil {
+			return nil, err
+		}
+
+		objectsByPhase[phase] = append(objectsByPhase[phase], obj)
+	}
+
+	phases := make([]applyPhase, 0, len(objectsByPhase))
+	for number, objs := range objectsByPhase {
+		phases = append(phases, applyPhase{number: number, objects: objs})
+	}
+	slices.SortFunc(phases, func(a, b applyPhase) int { return cmp.Compare(a.number, b.number) })
+
+	return phases, nil
+}
+
+// applyPhaseOf returns the value of the resources.gardener.cloud/apply-phase annotation of the given object. Objects
+// without the annotation belong to phase 0.
+func applyPhaseOf(obj *unstructured.Unstructured) (int, error) {
+	value, ok := obj.GetAnnotations()[resourcesv1alpha1.ApplyPhase]
+	if !ok {
+		return 0, nil
+	}
+
+	phase, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value %q for annotation %s of object %q, must be an integer", value, resourcesv1alpha1.ApplyPhase, unstructuredToString(obj))
+	}
+	return phase, nil
+}
+
+// withoutPendingObjects returns the given object references without the references of the objects which are not
+// applied yet because their apply phase is not earlier than the given pending phase. Objects which exist from earlier
+// reconciliations are kept, so that they are still deleted together with the ManagedResource.
+func withoutPendingObjects(references []resourcesv1alpha1.ObjectReference, objects []object, pendingPhase int) ([]resourcesv1alpha1.ObjectReference, error) {
+	pendingObjectKeys := sets.New[string]()
+	for _, obj := range objects {
+		phase, err := applyPhaseOf(obj.obj)
+		if err != nil {
+			return nil, err
+		}
+
+		if phase >= pendingPhase && obj.oldInformation.Name == "" {
+			pendingObjectKeys.Insert(unstructuredToString(obj.obj))
+		}
+	}
+
+	return slices.DeleteFunc(slices.Clone(references), func(ref resourcesv1alpha1.ObjectReference) bool {
+		return pendingObjectKeys.Has(objectKey(ref.APIVersion, ref.Kind, ref.Namespace, ref.Name))
+	}), nil
+}
+
+// checkApplyPhaseHealthy checks whether all objects of the given phase exist and are healthy. It returns an error for
+// the first object which is not healthy. Like in the health controller, objects with the
+// resources.gardener.cloud/skip-health-check annotation are not checked.
+func (r *Reconciler) checkApplyPhaseHealthy(ctx context.Context, phase applyPhase) error {
+	for _, obj := range sortByKind(phase.objects) {
+		if skipHealthCheck(obj.obj) {
+			continue
+		}
+
+		var current client.Object = &unstructured.Unstructured{}
+		current.GetObjectKind().SetGroupVersionKind(obj.obj.GroupVersionKind())
+
+		// use typed objects if possible, the dedicated health checks are only executed for them
+		if typed, err := r.TargetScheme.New(obj.obj.GroupVersionKind()); err == nil {
+			if typedObj, ok := typed.(client.Object); ok {
+				current = typedObj
+			}
+		}
+
+		if err := r.TargetClient.Get(ctx, client.ObjectKeyFromObject(obj.obj), current); err != nil {
+			if apierrors.IsNotFound(err) {
+				return fmt.Errorf("object %q of apply phase %d is not found", unstructuredToString(obj.obj), phase.number)
+			}
+			return fmt.Errorf("error getting object %q of apply phase %d: %w", unstructuredToString(obj.obj), phase.number, err)
+		}
+
+		if skipHealthCheck(current) {
+			continue
+		}
+
+		if _, err := r.HealthRules.CheckHealth(current); err != nil {
+			return fmt.Errorf("object %q of apply phase %d is unhealthy: %w", unstructuredToString(obj.obj), phase.number, err)
+		}
+	}
+
+	return nil
+}
+
+// applyPhasePendingError is returned if the objects of an apply phase are not applied yet because the objects of the
+// previous phase are not healthy yet.
+type applyPhasePendingError struct {
+	phase int
+	err   error
+}
+
+func (e *applyPhasePendingError) Error() string {
+	return fmt.Sprintf("waiting for the objects of the previous apply phase to become healthy before applying phase %d: %s", e.phase, e.err)
+}
+
+func (e *applyPhasePendingError) Unwrap() error {
+	return e.err
+}
+
+func skipHealthCheck(obj client.Object) bool {
+	return obj.GetAnnotations()[resourcesv1alpha1.SkipHealthCheck] == "true"
+}
@@ -0,0 +1,171 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package managedresource
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/resourcemanager/apis/config"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("phases", func() {
+	objectReference := func(name string) resourcesv1alpha1.ObjectReference {
+		return resourcesv1alpha1.ObjectReference{ObjectReference: corev1.ObjectReference{APIVersion: "v1", Kind: "ConfigMap", Namespace: "default", Name: name}}
+	}
+
+	withPhase := func(obj *unstructured.Unstructured, phase string) *unstructured.Unstructured {
+		obj.SetAnnotations(map[string]string{"resources.gardener.cloud/apply-phase": phase})
+		return obj
+	}
+
+	Describe("#groupByApplyPhase", func() {
+		It("should group the objects by their phase in ascending order", func() {
+			var (
+				obj1 = object{obj: withPhase(newUnstructured("ConfigMap", "obj1", nil), "2")}
+				obj2 = object{obj: newUnstructured("ConfigMap", "obj2", nil)}
+				obj3 = object{obj: withPhase(newUnstructured("ConfigMap", "obj3", nil), "-1")}
+				obj4 = object{obj: withPhase(newUnstructured("ConfigMap", "obj4", nil), "2")}
+				obj5 = object{obj: withPhase(newUnstructured("ConfigMap", "obj5", nil), "0")}
+			)
+
+			Expect(groupByApplyPhase([]object{obj1, obj2, obj3, obj4, obj5})).To(Equal([]applyPhase{
+				{number: -1, objects: []object{obj3}},
+				{number: 0, objects: []object{obj2, obj5}},
+				{number: 2, objects: []object{obj1, obj4}},
+			}))
+		})
+
+		It("should return nothing if there are no objects", func() {
+			Expect(groupByApplyPhase(nil)).To(BeEmpty())
+		})
+
+		It("should fail if the phase is not an integer", func() {
+			_, err := groupByApplyPhase([]object{{obj: withPhase(newUnstructured("ConfigMap", "obj", nil), "first")}})
+			Expect(err).To(MatchError(`invalid value "first" for annotation resources.gardener.cloud/apply-phase of object "v1/ConfigMap/default/obj", must be an integer`))
+		})
+	})
+
+	Describe("#withoutPendingObjects", func() {
+		var (
+			obj1 = object{obj: newUnstructured("ConfigMap", "obj1", nil)}
+			obj2 = object{obj: withPhase(newUnstructured("ConfigMap", "obj2", nil), "1")}
+			obj3 = object{obj: withPhase(newUnstructured("ConfigMap", "obj3", nil), "2")}
+			obj4 = object{obj: withPhase(newUnstructured("ConfigMap", "obj4", nil), "2"), oldInformation: objectReference("obj4")}
+
+			references = []resourcesv1alpha1.ObjectReference{objectReference("obj1"), objectReference("obj2"), objectReference("obj3"), objectReference("obj4")}
+		)
+
+		It("should remove the references of objects of pending phases which do not exist yet", func() {
+			Expect(withoutPendingObjects(references, []object{obj1, obj2, obj3, obj4}, 1)).To(Equal([]resourcesv1alpha1.ObjectReference{
+				objectReference("obj1"), objectReference("obj4"),
+			}))
+			Expect(references).To(HaveLen(4))
+		})
+
+		It("should keep all references if the last phase is pending", func() {
+			Expect(withoutPendingObjects(references, []object{obj1, obj2, obj3, obj4}, 3)).To(Equal(references))
+		})
+	})
+
+	Describe("#applyNewResources", func() {
+		var (
+			ctx          = context.Background()
+			targetClient client.Client
+			reconciler   *Reconciler
+
+			deployment *unstructured.Unstructured
+			configMap  *unstructured.Unstructured
+		)
+
+		BeforeEach(func() {
+			targetClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.ShootScheme).WithStatusSubresource(&appsv1.Deployment{}).Build()
+			reconciler = &Reconciler{
+				TargetClient: targetClient,
+				TargetScheme: kubernetes.ShootScheme,
+				Config:       config.ManagedResourceControllerConfig{},
+			}
+
+			deployment = &unstructured.Unstructured{Object: map[string]any{
+				"apiVersion": "apps/v1",
+				"kind":       "Deployment",
+				"metadata":   map[string]any{"name": "webhook", "namespace": "default"},
+				"spec":       map[string]any{"replicas": int64(1)},
+			}}
+			configMap = withPhase(newUnstructured("ConfigMap", "config", map[string]any{"foo": "bar"}), "1")
+		})
+
+		It("should not apply the next phase if the objects of the previous phase are not healthy", func() {
+			err := reconciler.applyNewResources(ctx, logr.Discard(), "origin", []object{{obj: configMap}, {obj: deployment}}, nil, nil, false)
+
+			var phasePendingErr *applyPhasePendingError
+			Expect(err).To(BeAssignableToTypeOf(phasePendingErr))
+			Expect(err).To(MatchError(ContainSubstring(`before applying phase 1: object "apps/v1/Deployment/default/webhook" of apply phase 0 is unhealthy`)))
+
+			Expect(targetClient.Get(ctx, client.ObjectKey{Name: "webhook", Namespace: "default"}, &appsv1.Deployment{})).To(Succeed())
+			Expect(targetClient.Get(ctx, client.ObjectKey{Name: "config", Namespace: "default"}, &corev1.ConfigMap{})).To(BeNotFoundError())
+		})
+
+		It("should apply the next phase once the objects of the previous phase are healthy", func() {
+			Expect(reconciler.applyNewResources(ctx, logr.Discard(), "origin", []object{{obj: configMap.DeepCopy()}, {obj: deployment.DeepCopy()}}, nil, nil, false)).NotTo(Succeed())
+
+			existing := &appsv1.Deployment{}
+			Expect(targetClient.Get(ctx, client.ObjectKey{Name: "webhook", Namespace: "default"}, existing)).To(Succeed())
+			existing.Status = appsv1.DeploymentStatus{
+				ObservedGeneration: existing.Generation,
+				Replicas:           1,
+				UpdatedReplicas:    1,
+				AvailableReplicas:  1,
+				Conditions: []appsv1.DeploymentCondition{
+					{Type: appsv1.DeploymentAvailable, Status: corev1.ConditionTrue},
+					{Type: appsv1.DeploymentProgressing, Status: corev1.ConditionTrue},
+				},
+			}
+			Expect(targetClient.Status().Update(ctx, existing)).To(Succeed())
+
+			Expect(reconciler.applyNewResources(ctx, logr.Discard(), "origin", []object{{obj: configMap.DeepCopy()}, {obj: deployment.DeepCopy()}}, nil, nil, false)).To(Succeed())
+			Expect(targetClient.Get(ctx, client.ObjectKey{Name: "config", Namespace: "default"}, &corev1.ConfigMap{})).To(Succeed())
+		})
+
+		It("should not apply the next phase if an object of the previous phase does not exist", func() {
+			reconciler.TargetClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.ShootScheme).WithObjects(&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "existing", Namespace: "default"}}).Build()
+
+			Expect(reconciler.checkApplyPhaseHealthy(ctx, applyPhase{number: 0, objects: []object{{obj: newUnstructured("ConfigMap", "missing", nil)}}})).To(MatchError(
+				`object "v1/ConfigMap/default/missing" of apply phase 0 is not found`,
+			))
+			Expect(reconciler.checkApplyPhaseHealthy(ctx, applyPhase{number: 0, objects: []object{{obj: newUnstructured("ConfigMap", "existing", nil)}}})).To(Succeed())
+		})
+
+		It("should consider objects with the skip-health-check annotation healthy", func() {
+			deployment.SetAnnotations(map[string]string{"resources.gardener.cloud/skip-health-check": "true"})
+
+			Expect(reconciler.applyNewResources(ctx, logr.Discard(), "origin", []object{{obj: configMap}, {obj: deployment}}, nil, nil, false)).To(Succeed())
+			Expect(targetClient.Get(ctx, client.ObjectKey{Name: "config", Namespace: "default"}, &corev1.ConfigMap{})).To(Succeed())
+		})
+
+		It("should not check objects with the skip-health-check annotation", func() {
+			unhealthyDeployment := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "webhook", Namespace: "default", Annotations: map[string]string{"resources.gardener.cloud/skip-health-check": "true"}}}
+			Expect(targetClient.Create(ctx, unhealthyDeployment)).To(Succeed())
+
+			Expect(reconciler.checkApplyPhaseHealthy(ctx, applyPhase{number: 0, objects: []object{{obj: deployment}}})).To(Succeed())
+
+			missingConfigMap := newUnstructured("ConfigMap", "missing", nil)
+			missingConfigMap.SetAnnotations(map[string]string{"resources.gardener.cloud/skip-health-check": "true"})
+			Expect(reconciler.checkApplyPhaseHealthy(ctx, applyPhase{number: 0, objects: []object{{obj: missingConfigMap}}})).To(Succeed())
+		})
+	})
+})
@@ -0,0 +1,298 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package managedresource
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/controllerutils"
+)
+
+const (
+	// maxFieldChanges is the maximum number of field changes reported per object in the preview.
+	maxFieldChanges = 50
+	// maxFieldValueLength is the maximum length of the values of a field change reported in the preview.
+	maxFieldValueLength = 256
+)
+
+// ignoredFieldPaths are paths of fields which are maintained by the API server or other controllers and hence not
+// considered when computing the field changes of an object.
+var ignoredFieldPaths = sets.New(
+	"metadata.creationTimestamp",
+	"metadata.generation",
+	"metadata.managedFields",
+	"metadata.resourceVersion",
+	"metadata.uid",
+	"status",
+)
+
+func isPreview(mr *resourcesv1alpha1.ManagedResource) bool {
+	return keyExistsAndValueTrue(mr.GetAnnotations(), resourcesv1alpha1.Preview)
+}
+
+// preview computes the changes that would be made to the target cluster when applying the new resources and deleting
+// the old resources. The changes are computed with server-side dry-run requests and reported in the status of the
+// ManagedResource, i.e., nothing is changed in the target cluster.
+func (r *Reconciler) preview(
+	ctx context.Context,
+	log logr.Logger,
+	mr *resourcesv1alpha1.ManagedResource,
+	origin string,
+	newResourcesObjects []object,
+	existingResourcesIndex *objectIndex,
+	equivalences Equivalences,
+	secretsDataChecksum string,
+) (
+	reconcile.Result,
+	error,
+) {
+	log.Info("Computing preview of changes since ManagedResource is in preview mode")
+
+	labelsToInject := mergeMaps(mr.Spec.InjectLabels, map[string]string{resourcesv1alpha1.ManagedBy: *r.Config.ManagedByLabelValue})
+
+	changes, err := r.previewNewResources(ctx, origin, newResourcesObjects, labelsToInject, equivalences, isServerSideApply(mr))
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("could not compute preview of new resources: %w", err)
+	}
+
+	deletions, err := r.previewOldResources(ctx, existingResourcesIndex)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("could not compute preview of old resources: %w", err)
+	}
+	changes = append(changes, deletions...)
+
+	oldStatus := mr.Status.DeepCopy()
+
+	mr.Status.Preview = &resourcesv1alpha1.ManagedResourcePreview{
+		SecretsDataChecksum: secretsDataChecksum,
+		LastUpdateTime:      metav1.NewTime(r.Clock.Now()),
+		Changes:             changes,
+	}
+	if oldPreview := oldStatus.Preview; oldPreview != nil && oldPreview.SecretsDataChecksum == secretsDataChecksum && apiequality.Semantic.DeepEqual(oldPreview.Changes, changes) {
+		mr.Status.Preview.LastUpdateTime = oldPreview.LastUpdateTime
+	}
+
+	if len(changes) > 0 {
+		conditionResourcesApplied := v1beta1helper.GetOrInitConditionWithClock(r.Clock, mr.Status.Conditions, resourcesv1alpha1.ResourcesApplied)
+		conditionResourcesApplied = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionResourcesApplied, gardencorev1beta1.ConditionProgressing, resourcesv1alpha1.ConditionChangesPreviewed,
+			fmt.Sprintf("%d object(s) would be changed, see .status.preview for details. Remove the %s annotation to apply the changes.", len(changes), resourcesv1alpha1.Preview))
+		mr.Status.Conditions = v1beta1helper.MergeConditions(mr.Status.Conditions, conditionResourcesApplied)
+	}
+
+	if !apiequality.Semantic.DeepEqual(oldStatus, &mr.Status) {
+		if err := r.SourceClient.Status().Update(ctx, mr); err != nil {
+			return reconcile.Result{}, fmt.Errorf("could not update the ManagedResource status: %w", err)
+		}
+	}
+
+	log.Info("Finished computing preview of changes", "changes", len(changes))
+	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+}
+
+func (r *Reconciler) previewNewResources(ctx context.Context, origin string, newResourcesObjects []object, labelsToInject map[string]string, equivalences Equivalences, serverSideApply bool) ([]resourcesv1alpha1.ObjectChange, error) {
+	horizontallyScaledObjects, err := computeHorizontallyScaledObjectKeys(ctx, r.TargetClient)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute all HPA target ref object keys: %w", err)
+	}
+
+	var (
+		changes      []resourcesv1alpha1.ObjectChange
+		dryRunClient = client.NewDryRunClient(r.TargetClient)
+	)
+
+	for _, obj := range sortByKind(newResourcesObjects) {
+		var (
+			current            = obj.obj.DeepCopy()
+			existing           *unstructured.Unstructured
+			scaledHorizontally = isScaled(obj.obj, horizontallyScaledObjects, equivalences)
+			mutate             = mutateFunc(origin, obj, current, labelsToInject, scaledHorizontally, nil)
+
+			operationResult controllerutil.OperationResult
+			err             error
+		)
+
+		if serverSideApply {
+			// Conflicts with other field managers are forced, so that the conflicting fields are reported as changes.
+			existing, operationResult, err = applyServerSide(ctx, dryRunClient, r.TargetScheme, origin, current, labelsToInject, scaledHorizontally, nil, client.ForceOwnership)
+			if err == nil && existing != nil && !ignore(current) {
+				// The resourceVersion is not increased by dry-run requests, hence, the changes are determined by comparing
+				// the existing with the applied object.
+				operationResult = controllerutil.OperationResultUpdated
+			}
+		} else {
+			operationResult, err = controllerutils.TypedCreateOrUpdate(ctx, dryRunClient, r.TargetScheme, current, ptr.Deref(r.Config.AlwaysUpdate, false), func() error {
+				existing = current.DeepCopy()
+				return mutate()
+			})
+		}
+		if err != nil {
+			if meta.IsNoMatchError(err) {
+				// The kind is not yet known to the target cluster, e.g. because its CustomResourceDefinition is part of
+				// the ManagedResource as well and has not been created yet.
+				changes = append(changes, newObjectChange(obj.obj, resourcesv1alpha1.ObjectChangeActionCreate))
+				continue
+			}
+			return nil, fmt.Errorf("error during dry-run apply of object %q: %w", unstructuredToString(obj.obj), err)
+		}
+
+		switch operationResult {
+		case controllerutil.OperationResultCreated:
+			changes = append(changes, newObjectChange(obj.obj, resourcesv1alpha1.ObjectChangeActionCreate))
+		case controllerutil.OperationResultUpdated:
+			fields := computeFieldChanges(existing.Object, current.Object)
+			if len(fields) == 0 {
+				continue
+			}
+			if isSecret(obj.obj) {
+				// The status of the ManagedResource is not confidential, hence, only the paths of the changed fields of
+				// secrets are reported.
+				redactFieldValues(fields)
+			}
+
+			change := newObjectChange(obj.obj, resourcesv1alpha1.ObjectChangeActionUpdate)
+			change.Fields = fields
+			changes = append(changes, change)
+		}
+	}
+
+	return changes, nil
+}
+
+func (r *Reconciler) previewOldResources(ctx context.Context, index *objectIndex) ([]resourcesv1alpha1.ObjectChange, error) {
+	var changes []resourcesv1alpha1.ObjectChange
+
+	for _, ref := range index.Objects() {
+		if index.Found(ref) {
+			continue
+		}
+
+		obj := &unstructured.Unstructured{}
+		obj.SetAPIVersion(ref.APIVersion)
+		obj.SetKind(ref.Kind)
+		obj.SetNamespace(ref.Namespace)
+		obj.SetName(ref.Name)
+
+		if err := r.TargetClient.Get(ctx, client.ObjectKey{Namespace: ref.Namespace, Name: ref.Name}, obj); err != nil {
+			if !apierrors.IsNotFound(err) && !meta.IsNoMatchError(err) {
+				return nil, fmt.Errorf("error getting object %q: %w", unstructuredToString(obj), err)
+			}
+			continue
+		}
+
+		if keepObject(obj) || (r.GarbageCollectorActivated && isGarbageCollectableResource(obj)) {
+			continue
+		}
+
+		changes = append(changes, newObjectChange(obj, resourcesv1alpha1.ObjectChangeActionDelete))
+	}
+
+	return changes, nil
+}
+
+func newObjectChange(obj *unstructured.Unstructured, action resourcesv1alpha1.ObjectChangeAction) resourcesv1alpha1.ObjectChange {
+	change := resourcesv1alpha1.ObjectChange{Action: action}
+	change.APIVersion = obj.GetAPIVersion()
+	change.Kind = obj.GetKind()
+	change.Namespace = obj.GetNamespace()
+	change.Name = obj.GetName()
+	return change
+}
+
+// computeFieldChanges computes the changes of the fields between the old and the new object. At most maxFieldChanges
+// changes are returned.
+func computeFieldChanges(oldObj, newObj map[string]any) []resourcesv1alpha1.FieldChange {
+	var changes []resourcesv1alpha1.FieldChange
+	diffValues("", oldObj, newObj, &changes)
+	return changes
+}
+
+// redactFieldValues removes the old and new values of the given field changes.
+func redactFieldValues(changes []resourcesv1alpha1.FieldChange) {
+	for i := range changes {
+		changes[i].Old, changes[i].New = "", ""
+	}
+}
+
+func isSecret(obj *unstructured.Unstructured) bool {
+	return obj.GroupVersionKind().GroupKind() == corev1.SchemeGroupVersion.WithKind("Secret").GroupKind()
+}
+
+func diffValues(path string, oldValue, newValue any, changes *[]resourcesv1alpha1.FieldChange) {
+	if len(*changes) >= maxFieldChanges || ignoredFieldPaths.Has(path) || apiequality.Semantic.DeepEqual(oldValue, newValue) {
+		return
+	}
+
+	switch oldTyped := oldValue.(type) {
+	case map[string]any:
+		if newTyped, ok := newValue.(map[string]any); ok {
+			keys := sets.KeySet(oldTyped).Union(sets.KeySet(newTyped)).UnsortedList()
+			slices.Sort(keys)
+
+			for _, key := range keys {
+				diffValues(fieldPath(path, key), oldTyped[key], newTyped[key], changes)
+			}
+			return
+		}
+	case []any:
+		if newTyped, ok := newValue.([]any); ok && len(oldTyped) == len(newTyped) {
+			for i := range oldTyped {
+				diffValues(fmt.Sprintf("%s[%d]", path, i), oldTyped[i], newTyped[i], changes)
+			}
+			return
+		}
+	}
+
+	*changes = append(*changes, resourcesv1alpha1.FieldChange{
+		Path: path,
+		Old:  encodeFieldValue(oldValue),
+		New:  encodeFieldValue(newValue),
+	})
+}
+
+func fieldPath(path, key string) string {
+	if strings.ContainsAny(key, "./") {
+		return fmt.Sprintf("%s[%s]", path, key)
+	}
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+func encodeFieldValue(value any) string {
+	if value == nil {
+		return ""
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+
+	if len(data) > maxFieldValueLength {
+		return string(data[:maxFieldValueLength]) + "..."
+	}
+	return string(data)
+}
@@ -0,0 +1,253 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package managedresource
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/resourcemanager/apis/config"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("preview", func() {
+	Describe("#computeFieldChanges", func() {
+		It("should return nothing for equal objects", func() {
+			obj := map[string]any{"spec": map[string]any{"replicas": int64(1)}}
+
+			Expect(computeFieldChanges(obj, obj)).To(BeEmpty())
+		})
+
+		It("should compute added, changed and removed fields", func() {
+			oldObj := map[string]any{
+				"metadata": map[string]any{
+					"resourceVersion": "1",
+					"annotations":     map[string]any{"resources.gardener.cloud/origin": "foo"},
+				},
+				"spec": map[string]any{
+					"replicas":   int64(1),
+					"paused":     true,
+					"containers": []any{map[string]any{"name": "foo", "image": "foo:v1"}},
+				},
+				"status": map[string]any{"replicas": int64(1)},
+			}
+			newObj := map[string]any{
+				"metadata": map[string]any{
+					"resourceVersion": "2",
+					"annotations":     map[string]any{"resources.gardener.cloud/origin": "bar"},
+				},
+				"spec": map[string]any{
+					"replicas":        int64(2),
+					"containers":      []any{map[string]any{"name": "foo", "image": "foo:v2"}},
+					"minReadySeconds": int64(5),
+				},
+				"status": map[string]any{"replicas": int64(2)},
+			}
+
+			Expect(computeFieldChanges(oldObj, newObj)).To(Equal([]resourcesv1alpha1.FieldChange{
+				{Path: "metadata.annotations[resources.gardener.cloud/origin]", Old: `"foo"`, New: `"bar"`},
+				{Path: "spec.containers[0].image", Old: `"foo:v1"`, New: `"foo:v2"`},
+				{Path: "spec.minReadySeconds", New: "5"},
+				{Path: "spec.paused", Old: "true"},
+				{Path: "spec.replicas", Old: "1", New: "2"},
+			}))
+		})
+
+		It("should report lists with a different length as a whole", func() {
+			oldObj := map[string]any{"spec": map[string]any{"args": []any{"a"}}}
+			newObj := map[string]any{"spec": map[string]any{"args": []any{"a", "b"}}}
+
+			Expect(computeFieldChanges(oldObj, newObj)).To(Equal([]resourcesv1alpha1.FieldChange{
+				{Path: "spec.args", Old: `["a"]`, New: `["a","b"]`},
+			}))
+		})
+
+		It("should truncate long values", func() {
+			oldObj := map[string]any{"data": map[string]any{"foo": ""}}
+			newObj := map[string]any{"data": map[string]any{"foo": strings.Repeat("a", 300)}}
+
+			changes := computeFieldChanges(oldObj, newObj)
+			Expect(changes).To(HaveLen(1))
+			Expect(changes[0].New).To(HaveLen(maxFieldValueLength + 3))
+			Expect(changes[0].New).To(HaveSuffix("..."))
+		})
+
+		It("should limit the number of changes", func() {
+			oldObj, newObj := map[string]any{}, map[string]any{}
+			for i := 0; i < maxFieldChanges+10; i++ {
+				newObj[strings.Repeat("a", i+1)] = "foo"
+			}
+
+			Expect(computeFieldChanges(oldObj, newObj)).To(HaveLen(maxFieldChanges))
+		})
+	})
+
+	Describe("#preview", func() {
+		var (
+			ctx          = context.Background()
+			sourceClient client.Client
+			targetClient client.Client
+			fakeClock    *testclock.FakeClock
+			reconciler   *Reconciler
+
+			mr                *resourcesv1alpha1.ManagedResource
+			existingConfigMap *corev1.ConfigMap
+			oldSecret         *corev1.Secret
+		)
+
+		BeforeEach(func() {
+			sourceClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithStatusSubresource(&resourcesv1alpha1.ManagedResource{}).Build()
+			targetClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.ShootScheme).Build()
+			fakeClock = testclock.NewFakeClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+
+			reconciler = &Reconciler{
+				SourceClient: sourceClient,
+				TargetClient: targetClient,
+				TargetScheme: kubernetes.ShootScheme,
+				Config:       config.ManagedResourceControllerConfig{ManagedByLabelValue: ptr.To("gardener"), SyncPeriod: &metav1.Duration{Duration: time.Minute}},
+				Clock:        fakeClock,
+			}
+
+			mr = &resourcesv1alpha1.ManagedResource{ObjectMeta: metav1.ObjectMeta{Name: "mr", Namespace: "garden"}}
+			Expect(sourceClient.Create(ctx, mr)).To(Succeed())
+
+			existingConfigMap = &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "existing", Namespace: "default"},
+				Data:       map[string]string{"foo": "bar"},
+			}
+			oldSecret = &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "old", Namespace: "default"}}
+			Expect(targetClient.Create(ctx, existingConfigMap)).To(Succeed())
+			Expect(targetClient.Create(ctx, oldSecret)).To(Succeed())
+		})
+
+		It("should report the changes without applying them", func() {
+			var (
+				newConfigMap = newUnstructured("ConfigMap", "new", map[string]any{"foo": "bar"})
+				changedMap   = newUnstructured("ConfigMap", "existing", map[string]any{"foo": "baz"})
+				index        = NewObjectIndex([]resourcesv1alpha1.ObjectReference{
+					{ObjectReference: corev1.ObjectReference{APIVersion: "v1", Kind: "ConfigMap", Name: "existing", Namespace: "default"}},
+					{ObjectReference: corev1.ObjectReference{APIVersion: "v1", Kind: "Secret", Name: "old", Namespace: "default"}},
+				}, nil)
+			)
+
+			// mark the existing object as found, i.e. it is still part of the ManagedResource
+			_, _ = index.Lookup(resourcesv1alpha1.ObjectReference{ObjectReference: corev1.ObjectReference{APIVersion: "v1", Kind: "ConfigMap", Name: "existing", Namespace: "default"}})
+
+			_, err := reconciler.preview(ctx, logr.Discard(), mr, "origin", []object{{obj: newConfigMap}, {obj: changedMap}}, index, nil, "checksum")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(sourceClient.Get(ctx, client.ObjectKeyFromObject(mr), mr)).To(Succeed())
+			Expect(mr.Status.Preview).NotTo(BeNil())
+			Expect(mr.Status.Preview.SecretsDataChecksum).To(Equal("checksum"))
+			Expect(mr.Status.Preview.LastUpdateTime.Time.UTC()).To(Equal(fakeClock.Now()))
+			Expect(mr.Status.Preview.Changes).To(HaveLen(3))
+			Expect(mr.Status.Preview.Changes[0].Action).To(Equal(resourcesv1alpha1.ObjectChangeActionUpdate))
+			Expect(mr.Status.Preview.Changes[0].Name).To(Equal("existing"))
+			Expect(mr.Status.Preview.Changes[0].Fields).To(ContainElement(resourcesv1alpha1.FieldChange{Path: "data.foo", Old: `"bar"`, New: `"baz"`}))
+			Expect(mr.Status.Preview.Changes[1].Action).To(Equal(resourcesv1alpha1.ObjectChangeActionCreate))
+			Expect(mr.Status.Preview.Changes[1].Name).To(Equal("new"))
+			Expect(mr.Status.Preview.Changes[2]).To(Equal(resourcesv1alpha1.ObjectChange{
+				ObjectReference: corev1.ObjectReference{APIVersion: "v1", Kind: "Secret", Name: "old", Namespace: "default"},
+				Action:          resourcesv1alpha1.ObjectChangeActionDelete,
+			}))
+
+			condition := v1beta1helper.GetCondition(mr.Status.Conditions, resourcesv1alpha1.ResourcesApplied)
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionProgressing))
+			Expect(condition.Reason).To(Equal(resourcesv1alpha1.ConditionChangesPreviewed))
+
+			Expect(targetClient.Get(ctx, client.ObjectKeyFromObject(existingConfigMap), existingConfigMap)).To(Succeed())
+			Expect(existingConfigMap.Data).To(Equal(map[string]string{"foo": "bar"}))
+			Expect(targetClient.Get(ctx, client.ObjectKey{Name: "new", Namespace: "default"}, &corev1.ConfigMap{})).To(BeNotFoundError())
+			Expect(targetClient.Get(ctx, client.ObjectKeyFromObject(oldSecret), oldSecret)).To(Succeed())
+		})
+
+		It("should not report the values of changed secret fields", func() {
+			existingSecret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "existing", Namespace: "default"},
+				Data:       map[string][]byte{"password": []byte("old-secret")},
+			}
+			Expect(targetClient.Create(ctx, existingSecret)).To(Succeed())
+
+			var (
+				changedSecret = newUnstructured("Secret", "existing", map[string]any{"password": "bmV3LXNlY3JldA=="})
+				index         = NewObjectIndex(nil, nil)
+			)
+			changedSecret.Object["stringData"] = map[string]any{"token": "new-token"}
+
+			_, err := reconciler.preview(ctx, logr.Discard(), mr, "origin", []object{{obj: changedSecret}}, index, nil, "checksum")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(sourceClient.Get(ctx, client.ObjectKeyFromObject(mr), mr)).To(Succeed())
+			Expect(mr.Status.Preview).NotTo(BeNil())
+			Expect(mr.Status.Preview.Changes).To(HaveLen(1))
+			Expect(mr.Status.Preview.Changes[0].Action).To(Equal(resourcesv1alpha1.ObjectChangeActionUpdate))
+			Expect(mr.Status.Preview.Changes[0].Fields).To(ContainElement(resourcesv1alpha1.FieldChange{Path: "data.password"}))
+			for _, field := range mr.Status.Preview.Changes[0].Fields {
+				Expect(field.Old).To(BeEmpty(), "field %s", field.Path)
+				Expect(field.New).To(BeEmpty(), "field %s", field.Path)
+			}
+		})
+
+		It("should not report objects which are kept", func() {
+			metav1.SetMetaDataAnnotation(&oldSecret.ObjectMeta, "resources.gardener.cloud/keep-object", "true")
+			Expect(targetClient.Update(ctx, oldSecret)).To(Succeed())
+
+			index := NewObjectIndex([]resourcesv1alpha1.ObjectReference{
+				{ObjectReference: corev1.ObjectReference{APIVersion: "v1", Kind: "Secret", Name: "old", Namespace: "default"}},
+			}, nil)
+
+			_, err := reconciler.preview(ctx, logr.Discard(), mr, "origin", nil, index, nil, "checksum")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(sourceClient.Get(ctx, client.ObjectKeyFromObject(mr), mr)).To(Succeed())
+			Expect(mr.Status.Preview).NotTo(BeNil())
+			Expect(mr.Status.Preview.Changes).To(BeEmpty())
+			Expect(v1beta1helper.GetCondition(mr.Status.Conditions, resourcesv1alpha1.ResourcesApplied)).To(BeNil())
+		})
+
+		It("should keep the last update time if the changes did not change", func() {
+			index := NewObjectIndex(nil, nil)
+			newConfigMap := newUnstructured("ConfigMap", "new", map[string]any{"foo": "bar"})
+
+			_, err := reconciler.preview(ctx, logr.Discard(), mr, "origin", []object{{obj: newConfigMap.DeepCopy()}}, index, nil, "checksum")
+			Expect(err).NotTo(HaveOccurred())
+
+			fakeClock.Step(time.Hour)
+
+			Expect(sourceClient.Get(ctx, client.ObjectKeyFromObject(mr), mr)).To(Succeed())
+			_, err = reconciler.preview(ctx, logr.Discard(), mr, "origin", []object{{obj: newConfigMap.DeepCopy()}}, index, nil, "checksum")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(sourceClient.Get(ctx, client.ObjectKeyFromObject(mr), mr)).To(Succeed())
+			Expect(mr.Status.Preview.LastUpdateTime.Time.UTC()).To(Equal(fakeClock.Now().Add(-time.Hour)))
+		})
+	})
+})
+
+func newUnstructured(kind, name string, data map[string]any) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "v1",
+		"kind":       kind,
+		"metadata":   map[string]any{"name": name, "namespace": "default"},
+		"data":       data,
+	}}
+}
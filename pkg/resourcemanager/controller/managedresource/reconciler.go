@@ -49,8 +49,11 @@ import (
 	resourcesv1alpha1helper "github.com/gardener/gardener/pkg/apis/resources/v1alpha1/helper"
 	"github.com/gardener/gardener/pkg/controllerutils"
 	"github.com/gardener/gardener/pkg/resourcemanager/apis/config"
+	"github.com/gardener/gardener/pkg/resourcemanager/controller/drift"
 	"github.com/gardener/gardener/pkg/resourcemanager/controller/garbagecollector/references"
+	healthutils "github.com/gardener/gardener/pkg/resourcemanager/controller/health/utils"
 	resourcemanagerpredicate "github.com/gardener/gardener/pkg/resourcemanager/predicate"
+	"github.com/gardener/gardener/pkg/utils"
 	errorsutils "github.com/gardener/gardener/pkg/utils/errors"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
 	utilclient "github.com/gardener/gardener/pkg/utils/kubernetes/client"
@@ -73,6 +76,14 @@ type Reconciler struct {
 	ClusterID                     string
 	GarbageCollectorActivated     bool
 	RequeueAfterOnDeletionPending *time.Duration
+	// RequeueAfterOnApplyPhasePending is the duration after which a ManagedResource is requeued if the objects of an
+	// apply phase are not applied yet because the objects of the previous phase are not healthy yet.
+	RequeueAfterOnApplyPhasePending *time.Duration
+	// HealthRules are used for checking the health of the objects of an apply phase.
+	HealthRules *healthutils.HealthRules
+	// DriftDetector is used for detecting drift of objects with the `Report` drift policy, which must not be reverted as
+	// long as the desired state does not change. It is nil if drift detection is disabled.
+	DriftDetector *drift.Detector
 }
 
 // Reconcile manages the resources reference by ManagedResources.
@@ -286,6 +297,12 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, mr *resourc
 	// (otherwise, the order will be different on each update)
 	sortObjectReferences(newResourcesObjectReferences)
 
+	if isPreview(mr) {
+		return r.preview(reconcileCtx, log, mr, origin, newResourcesObjects, existingResourcesIndex, equivalences, secretsDataChecksum)
+	}
+	// the preview is only maintained in preview mode, it is removed with the next status update
+	mr.Status.Preview = nil
+
 	// invalidate conditions, if resources have been added/removed from the managed resource
 	if !apiequality.Semantic.DeepEqual(mr.Status.Resources, newResourcesObjectReferences) || mr.Status.SecretsDataChecksum == nil || *mr.Status.SecretsDataChecksum != secretsDataChecksum {
 		conditionResourcesHealthy := v1beta1helper.GetOrInitConditionWithClock(r.Clock, mr.Status.Conditions, resourcesv1alpha1.ResourcesHealthy)
@@ -298,7 +315,7 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, mr *resourc
 		reason := resourcesv1alpha1.ConditionApplyProgressing
 		msg := "The resources are currently being reconciled."
 		switch conditionResourcesApplied.Reason {
-		case resourcesv1alpha1.ConditionApplyFailed, resourcesv1alpha1.ConditionDeletionFailed, resourcesv1alpha1.ConditionDeletionPending:
+		case resourcesv1alpha1.ConditionApplyFailed, resourcesv1alpha1.ConditionApplyConflict, resourcesv1alpha1.ConditionDeletionFailed, resourcesv1alpha1.ConditionDeletionPending:
 			// keep condition reason and message if last reconciliation failed
 			reason = conditionResourcesApplied.Reason
 			msg = conditionResourcesApplied.Message
@@ -347,8 +364,31 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, mr *resourc
 	}
 
 	injectLabels := mergeMaps(mr.Spec.InjectLabels, map[string]string{resourcesv1alpha1.ManagedBy: *r.Config.ManagedByLabelValue})
-	if err := r.applyNewResources(reconcileCtx, log, origin, newResourcesObjects, injectLabels, equivalences); err != nil {
-		conditionResourcesApplied = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionResourcesApplied, gardencorev1beta1.ConditionFalse, resourcesv1alpha1.ConditionApplyFailed, err.Error())
+	if err := r.applyNewResources(reconcileCtx, log, origin, newResourcesObjects, injectLabels, equivalences, isServerSideApply(mr)); err != nil {
+		var phasePendingErr *applyPhasePendingError
+		if errors.As(err, &phasePendingErr) {
+			log.Info("Waiting for objects of previous apply phase to become healthy", "reason", err.Error())
+			conditionResourcesApplied = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionResourcesApplied, gardencorev1beta1.ConditionProgressing, resourcesv1alpha1.ConditionApplyPhasePending, err.Error())
+			// the applied resources are already added to the status, so that they are deleted together with the
+			// ManagedResource, the resources of pending phases are added once they are applied
+			appliedResourcesObjectReferences, err := withoutPendingObjects(newResourcesObjectReferences, newResourcesObjects, phasePendingErr.phase)
+			if err != nil {
+				return reconcile.Result{}, err
+			}
+			if err := updateManagedResourceStatus(ctx, r.SourceClient, mr, &secretsDataChecksum, appliedResourcesObjectReferences, conditionResourcesApplied); err != nil {
+				return reconcile.Result{}, fmt.Errorf("could not update the ManagedResource status: %w", err)
+			}
+
+			return reconcile.Result{RequeueAfter: *r.RequeueAfterOnApplyPhasePending}, nil
+		}
+
+		reason := resourcesv1alpha1.ConditionApplyFailed
+		var conflictErr *applyConflictError
+		if errors.As(err, &conflictErr) {
+			reason = resourcesv1alpha1.ConditionApplyConflict
+		}
+
+		conditionResourcesApplied = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionResourcesApplied, gardencorev1beta1.ConditionFalse, reason, err.Error())
 		if err := updateConditions(ctx, r.SourceClient, mr, conditionResourcesApplied); err != nil {
 			return reconcile.Result{}, fmt.Errorf("could not update the ManagedResource status: %w", err)
 		}
@@ -465,8 +505,11 @@ func (r *Reconciler) updateConditionsForDeletion(ctx context.Context, mr *resour
 	return updateConditions(ctx, r.SourceClient, mr, conditionResourcesHealthy, conditionResourcesProgressing)
 }
 
-func (r *Reconciler) applyNewResources(ctx context.Context, log logr.Logger, origin string, newResourcesObjects []object, labelsToInject map[string]string, equivalences Equivalences) error {
-	newResourcesObjects = sortByKind(newResourcesObjects)
+func (r *Reconciler) applyNewResources(ctx context.Context, log logr.Logger, origin string, newResourcesObjects []object, labelsToInject map[string]string, equivalences Equivalences, serverSideApply bool) error {
+	phases, err := groupByApplyPhase(newResourcesObjects)
+	if err != nil {
+		return err
+	}
 
 	// get all HPA targetRefs to check if we should prevent overwriting replicas.
 	// VPAs don't have to be checked, as they don't update the spec directly and only mutate Pods via a MutatingWebhook
@@ -476,66 +519,131 @@ func (r *Reconciler) applyNewResources(ctx context.Context, log logr.Logger, ori
 		return fmt.Errorf("failed to compute all HPA target ref object keys: %w", err)
 	}
 
-	for _, obj := range newResourcesObjects {
-		var (
-			current            = obj.obj.DeepCopy()
-			resource           = unstructuredToString(obj.obj)
-			scaledHorizontally = isScaled(obj.obj, horizontallyScaledObjects, equivalences)
-		)
+	for i, phase := range phases {
+		if i > 0 {
+			// the resources of a phase are only applied once all resources of the previous phase are healthy
+			if err := r.checkApplyPhaseHealthy(ctx, phases[i-1]); err != nil {
+				return &applyPhasePendingError{phase: phase.number, err: err}
+			}
+		}
 
-		resourceLogger := log.WithValues("resource", resource)
+		var conflicts []error
 
-		resourceLogger.V(1).Info("Applying")
+		for _, obj := range sortByKind(phase.objects) {
+			// must be computed before copying the object, as the checksum of the desired state is added to it
+			keepExisting := r.keepReportedDriftFunc(obj, labelsToInject)
 
-		operationResult, err := controllerutils.TypedCreateOrUpdate(ctx, r.TargetClient, r.TargetScheme, current, ptr.Deref(r.Config.AlwaysUpdate, false), func() error {
-			metadata, err := meta.Accessor(obj.obj)
-			if err != nil {
-				return fmt.Errorf("error getting metadata of object %q: %s", resource, err)
-			}
+			var (
+				current            = obj.obj.DeepCopy()
+				resource           = unstructuredToString(obj.obj)
+				scaledHorizontally = isScaled(obj.obj, horizontallyScaledObjects, equivalences)
+			)
 
-			// if the ignore annotation is set to false, do nothing (ignore the resource)
-			if ignore(metadata) {
-				annotations := current.GetAnnotations()
-				delete(annotations, descriptionAnnotation)
-				current.SetAnnotations(annotations)
-				return nil
-			}
+			resourceLogger := log.WithValues("resource", resource)
 
-			if err := injectLabels(obj.obj, labelsToInject); err != nil {
-				return fmt.Errorf("error injecting labels into object %q: %s", resource, err)
-			}
+			resourceLogger.V(1).Info("Applying")
 
-			return merge(origin, obj.obj, current, obj.forceOverwriteLabels, obj.oldInformation.Labels, obj.forceOverwriteAnnotations, obj.oldInformation.Annotations, scaledHorizontally)
-		})
-		if err != nil {
-			if apierrors.IsConflict(err) {
-				return err
+			var (
+				operationResult controllerutil.OperationResult
+				err             error
+			)
+
+			if serverSideApply {
+				_, operationResult, err = applyServerSide(ctx, r.TargetClient, r.TargetScheme, origin, current, labelsToInject, scaledHorizontally, keepExisting)
+			} else {
+				operationResult, err = controllerutils.TypedCreateOrUpdate(ctx, r.TargetClient, r.TargetScheme, current, ptr.Deref(r.Config.AlwaysUpdate, false), mutateFunc(origin, obj, current, labelsToInject, scaledHorizontally, keepExisting))
 			}
+			if err != nil {
+				if serverSideApply && isFieldManagerConflict(err) {
+					// continue with the other resources, the conflicts are reported after all resources of the phase have been applied
+					resourceLogger.Info("Could not apply resource because of conflicts with other field managers", "err", err.Error())
+					conflicts = append(conflicts, fmt.Errorf("object %q: %w", resource, err))
+					continue
+				}
+
+				if apierrors.IsConflict(err) {
+					return err
+				}
 
-			if apierrors.IsInvalid(err) && operationResult == controllerutil.OperationResultUpdated && deleteOnInvalidUpdate(current, err) {
-				if deleteErr := r.TargetClient.Delete(ctx, current); client.IgnoreNotFound(deleteErr) != nil {
-					return fmt.Errorf("error deleting object %q after 'invalid' update error: %s", resource, deleteErr)
+				if apierrors.IsInvalid(err) && operationResult == controllerutil.OperationResultUpdated && deleteOnInvalidUpdate(current, err) {
+					if deleteErr := r.TargetClient.Delete(ctx, current); client.IgnoreNotFound(deleteErr) != nil {
+						return fmt.Errorf("error deleting object %q after 'invalid' update error: %s", resource, deleteErr)
+					}
+					// return error directly, so that the create after delete will be retried
+					return fmt.Errorf("deleted object %q because of 'invalid' update error, and 'delete-on-invalid-update' annotation on object or the resource is an immutable ConfigMap/Secret: %s", resource, err)
 				}
-				// return error directly, so that the create after delete will be retried
-				return fmt.Errorf("deleted object %q because of 'invalid' update error, and 'delete-on-invalid-update' annotation on object or the resource is an immutable ConfigMap/Secret: %s", resource, err)
+
+				return fmt.Errorf("error during apply of object %q: %s", resource, err)
 			}
 
-			return fmt.Errorf("error during apply of object %q: %s", resource, err)
+			switch operationResult {
+			case controllerutil.OperationResultCreated:
+				resourceLogger.Info("Created resource because it was not existing before")
+			case controllerutil.OperationResultUpdated:
+				resourceLogger.Info("Updated resource because its actual state differed from the desired state")
+			case controllerutil.OperationResultNone:
+				resourceLogger.V(1).Info("Resource was neither created nor updated because its actual state matches with the desired state")
+			}
 		}
 
-		switch operationResult {
-		case controllerutil.OperationResultCreated:
-			resourceLogger.Info("Created resource because it was not existing before")
-		case controllerutil.OperationResultUpdated:
-			resourceLogger.Info("Updated resource because its actual state differed from the desired state")
-		case controllerutil.OperationResultNone:
-			resourceLogger.V(1).Info("Resource was neither created nor updated because its actual state matches with the desired state")
+		if len(conflicts) > 0 {
+			return &applyConflictError{errs: conflicts}
 		}
 	}
 
 	return nil
 }
 
+// keepReportedDriftFunc returns a function which returns true for existing objects whose reported drift must be kept
+// (nil if drift detection is disabled or the object does not have the `Report` drift policy). The drift is kept as long
+// as the desired state of the object does not change. For this purpose, the checksum of the desired state is added to
+// the object, so that it can be compared with the checksum of the existing object.
+func (r *Reconciler) keepReportedDriftFunc(obj object, labelsToInject map[string]string) func(*unstructured.Unstructured) bool {
+	if r.DriftDetector == nil || drift.Policy(obj.obj) != resourcesv1alpha1.DriftPolicyReport {
+		return nil
+	}
+
+	checksum := utils.ComputeChecksum([]any{obj.obj.Object, labelsToInject, obj.forceOverwriteLabels, obj.forceOverwriteAnnotations})
+
+	obj.obj.SetAnnotations(utils.MergeStringMaps(obj.obj.GetAnnotations(), map[string]string{desiredStateChecksumAnnotation: checksum}))
+
+	return func(existing *unstructured.Unstructured) bool {
+		return existing.GetAnnotations()[desiredStateChecksumAnnotation] == checksum && r.DriftDetector.HasReportedDrift(existing)
+	}
+}
+
+// mutateFunc returns a function which merges the desired state of the given object into the current object. Existing
+// objects are not changed if keepExisting (optional) returns true for them.
+func mutateFunc(origin string, obj object, current *unstructured.Unstructured, labelsToInject map[string]string, scaledHorizontally bool, keepExisting func(*unstructured.Unstructured) bool) func() error {
+	return func() error {
+		resource := unstructuredToString(obj.obj)
+
+		metadata, err := meta.Accessor(obj.obj)
+		if err != nil {
+			return fmt.Errorf("error getting metadata of object %q: %s", resource, err)
+		}
+
+		// if the ignore annotation is set to false, do nothing (ignore the resource)
+		if ignore(metadata) {
+			annotations := current.GetAnnotations()
+			delete(annotations, descriptionAnnotation)
+			current.SetAnnotations(annotations)
+			return nil
+		}
+
+		// keep the existing object as it is, e.g., if its drift must not be reverted
+		if keepExisting != nil && keepExisting(current) {
+			return nil
+		}
+
+		if err := injectLabels(obj.obj, labelsToInject); err != nil {
+			return fmt.Errorf("error injecting labels into object %q: %s", resource, err)
+		}
+
+		return merge(origin, obj.obj, current, obj.forceOverwriteLabels, obj.oldInformation.Labels, obj.forceOverwriteAnnotations, obj.oldInformation.Annotations, scaledHorizontally)
+	}
+}
+
 // computeHorizontallyScaledObjectKeys returns a set of object keys (in the form `Group/Kind/Namespace/Name`)
 // to objects that are horizontally scaled by HPA.
 // VPAs are not checked, as they don't update the spec of Deployments/StatefulSets/... and only mutate resource
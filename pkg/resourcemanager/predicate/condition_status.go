@@ -36,6 +36,16 @@ var ConditionChangedToUnhealthy ConditionChangeFn = func(con1, con2 *gardencorev
 		(con2 != nil && con2.Status == gardencorev1beta1.ConditionFalse)
 }
 
+// DriftToRevert compares the given `DriftDetected` conditions and returns `true` if drift which must be reverted has
+// been detected or has changed.
+var DriftToRevert ConditionChangeFn = func(con1, con2 *gardencorev1beta1.Condition) bool {
+	if con2 == nil || con2.Reason != resourcesv1alpha1.ConditionDriftReverting {
+		return false
+	}
+
+	return con1 == nil || con1.Reason != con2.Reason || con1.Message != con2.Message
+}
+
 // ConditionStatusChanged is a predicate that detects changes to the status of a Condition with a given type.
 func ConditionStatusChanged(conditionType gardencorev1beta1.ConditionType, changeFn ConditionChangeFn) predicate.Predicate {
 	return predicate.Funcs{
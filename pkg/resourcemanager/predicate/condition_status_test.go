@@ -183,8 +183,65 @@ var _ = Describe("#ConditionStatusChanged", func() {
 			BeTrue(),
 		),
 	)
+
+	DescribeTable("DriftToRevert",
+		func(old, new *gardencorev1beta1.Condition, matcher types.GomegaMatcher) {
+			managedResourceNew := managedResource.DeepCopy()
+			if old != nil {
+				managedResource.Status.Conditions = []gardencorev1beta1.Condition{*old}
+			}
+			if new != nil {
+				managedResourceNew.Status.Conditions = []gardencorev1beta1.Condition{*new}
+			}
+			updateEvent.ObjectOld = managedResource
+			updateEvent.ObjectNew = managedResourceNew
+
+			predicate := ConditionStatusChanged(conditionType, DriftToRevert)
+
+			Expect(predicate.Update(updateEvent)).To(matcher)
+		},
+		Entry("should match on update (condition added with drift to revert)",
+			nil,
+			driftCondition(gardencorev1beta1.ConditionTrue, "DriftReverting", "foo"),
+			BeTrue(),
+		),
+		Entry("should match on update (drift to revert detected)",
+			driftCondition(gardencorev1beta1.ConditionFalse, "NoDriftDetected", "bar"),
+			driftCondition(gardencorev1beta1.ConditionTrue, "DriftReverting", "foo"),
+			BeTrue(),
+		),
+		Entry("should match on update (drift to revert changed)",
+			driftCondition(gardencorev1beta1.ConditionTrue, "DriftReverting", "bar"),
+			driftCondition(gardencorev1beta1.ConditionTrue, "DriftReverting", "foo"),
+			BeTrue(),
+		),
+		Entry("should not match on update (drift to revert did not change)",
+			driftCondition(gardencorev1beta1.ConditionTrue, "DriftReverting", "foo"),
+			driftCondition(gardencorev1beta1.ConditionTrue, "DriftReverting", "foo"),
+			BeFalse(),
+		),
+		Entry("should not match on update (drift is only reported)",
+			driftCondition(gardencorev1beta1.ConditionFalse, "NoDriftDetected", "bar"),
+			driftCondition(gardencorev1beta1.ConditionTrue, "DriftReported", "foo"),
+			BeFalse(),
+		),
+		Entry("should not match on update (condition removed)",
+			driftCondition(gardencorev1beta1.ConditionTrue, "DriftReverting", "foo"),
+			nil,
+			BeFalse(),
+		),
+	)
 })
 
+func driftCondition(status gardencorev1beta1.ConditionStatus, reason, message string) *gardencorev1beta1.Condition {
+	return &gardencorev1beta1.Condition{
+		Type:    resourcesv1alpha1.ResourcesApplied,
+		Status:  status,
+		Reason:  reason,
+		Message: message,
+	}
+}
+
 func condition(status gardencorev1beta1.ConditionStatus) *gardencorev1beta1.Condition {
 	return &gardencorev1beta1.Condition{
 		Type:   resourcesv1alpha1.ResourcesApplied,
@@ -0,0 +1,61 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package predicate_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	. "github.com/gardener/gardener/pkg/resourcemanager/predicate"
+)
+
+var _ = Describe("#PreviewChanged", func() {
+	var (
+		managedResource *resourcesv1alpha1.ManagedResource
+		predicate       predicate.Predicate
+	)
+
+	BeforeEach(func() {
+		managedResource = &resourcesv1alpha1.ManagedResource{}
+		predicate = PreviewChanged()
+	})
+
+	It("should not match on create", func() {
+		Expect(predicate.Create(event.CreateEvent{Object: managedResource})).To(BeFalse())
+	})
+
+	It("should match if the preview annotation was added", func() {
+		newManagedResource := managedResource.DeepCopy()
+		metav1.SetMetaDataAnnotation(&newManagedResource.ObjectMeta, "resources.gardener.cloud/preview", "true")
+
+		Expect(predicate.Update(event.UpdateEvent{ObjectOld: managedResource, ObjectNew: newManagedResource})).To(BeTrue())
+	})
+
+	It("should match if the preview annotation was removed", func() {
+		metav1.SetMetaDataAnnotation(&managedResource.ObjectMeta, "resources.gardener.cloud/preview", "true")
+		newManagedResource := managedResource.DeepCopy()
+		delete(newManagedResource.Annotations, "resources.gardener.cloud/preview")
+
+		Expect(predicate.Update(event.UpdateEvent{ObjectOld: managedResource, ObjectNew: newManagedResource})).To(BeTrue())
+	})
+
+	It("should not match if the preview annotation did not change", func() {
+		metav1.SetMetaDataAnnotation(&managedResource.ObjectMeta, "resources.gardener.cloud/preview", "true")
+
+		Expect(predicate.Update(event.UpdateEvent{ObjectOld: managedResource, ObjectNew: managedResource.DeepCopy()})).To(BeFalse())
+	})
+
+	It("should not match on delete", func() {
+		Expect(predicate.Delete(event.DeleteEvent{Object: managedResource})).To(BeFalse())
+	})
+
+	It("should not match on generic", func() {
+		Expect(predicate.Generic(event.GenericEvent{Object: managedResource})).To(BeFalse())
+	})
+})
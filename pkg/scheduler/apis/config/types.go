@@ -16,6 +16,9 @@ const (
 	SameRegion CandidateDeterminationStrategy = "SameRegion"
 	// MinimalDistance Strategy determines a seed candidate for a shoot if the cloud profile are identical. Then chooses the seed with the minimal distance to the shoot.
 	MinimalDistance CandidateDeterminationStrategy = "MinimalDistance"
+	// Scored Strategy determines the seed candidates for a shoot with the configured filter plugins. Then chooses the seed
+	// with the highest weighted sum of the scores of the configured score plugins.
+	Scored CandidateDeterminationStrategy = "Scored"
 	// Default Strategy is the default strategy to use when there is no configuration provided
 	Default CandidateDeterminationStrategy = SameRegion
 	// SchedulerDefaultLockObjectNamespace is the default lock namespace for leader election.
@@ -31,11 +34,58 @@ const (
 )
 
 // Strategies defines all currently implemented SeedCandidateDeterminationStrategies
-var Strategies = []CandidateDeterminationStrategy{SameRegion, MinimalDistance}
+var Strategies = []CandidateDeterminationStrategy{SameRegion, MinimalDistance, Scored}
 
 // CandidateDeterminationStrategy defines how seeds for shoots, that do not specify a seed explicitly, are being determined
 type CandidateDeterminationStrategy string
 
+const (
+	// FilterPluginSameProvider filters seeds whose provider type is different from the provider type of the shoot.
+	FilterPluginSameProvider FilterPluginName = "SameProvider"
+	// FilterPluginSameRegion filters seeds whose provider type or region is different from the one of the shoot.
+	FilterPluginSameRegion FilterPluginName = "SameRegion"
+	// FilterPluginTestingPurpose filters seeds whose provider type is different from the provider type of shoots with
+	// purpose testing. Like with the other strategies, the other filter plugins are not applied to such shoots.
+	FilterPluginTestingPurpose FilterPluginName = "TestingPurpose"
+
+	// ScorePluginCapacityHeadroom prefers seeds with a high share of free capacity for shoots (based on the allocatable
+	// shoots in the seed status). Seeds without allocatable shoots are considered to have unlimited capacity.
+	ScorePluginCapacityHeadroom ScorePluginName = "CapacityHeadroom"
+	// ScorePluginRegionDistance prefers seeds with a small distance to the region of the shoot (based on the scheduler
+	// region ConfigMap of the cloud profile, or the Levenshtein distance of the region names otherwise).
+	ScorePluginRegionDistance ScorePluginName = "RegionDistance"
+	// ScorePluginLabelAffinity prefers seeds matching the configured label affinity terms.
+	ScorePluginLabelAffinity ScorePluginName = "LabelAffinity"
+	// ScorePluginProjectSpread prefers seeds hosting a small number of shoots of the same project as the shoot.
+	ScorePluginProjectSpread ScorePluginName = "ProjectSpread"
+)
+
+// FilterPlugins defines all currently implemented filter plugins of the Scored strategy.
+var FilterPlugins = []FilterPluginName{FilterPluginSameProvider, FilterPluginSameRegion, FilterPluginTestingPurpose}
+
+// ScorePlugins defines all currently implemented score plugins of the Scored strategy.
+var ScorePlugins = []ScorePluginName{ScorePluginCapacityHeadroom, ScorePluginRegionDistance, ScorePluginLabelAffinity, ScorePluginProjectSpread}
+
+// FilterPluginName is the name of a filter plugin of the Scored strategy.
+type FilterPluginName string
+
+// ScorePluginName is the name of a score plugin of the Scored strategy.
+type ScorePluginName string
+
+// SeedRebalancingMode is the mode of the seed rebalancing controller.
+type SeedRebalancingMode string
+
+const (
+	// SeedRebalancingModePropose only proposes target seeds for the shoots which should be moved away from their seed.
+	SeedRebalancingModePropose SeedRebalancingMode = "Propose"
+	// SeedRebalancingModeApproved migrates the control planes of the shoots which should be moved away from their seed to
+	// the proposed target seeds during their maintenance time windows.
+	SeedRebalancingModeApproved SeedRebalancingMode = "Approved"
+)
+
+// SeedRebalancingModes defines all currently implemented modes of the seed rebalancing controller.
+var SeedRebalancingModes = []SeedRebalancingMode{SeedRebalancingModePropose, SeedRebalancingModeApproved}
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // SchedulerConfiguration provides the configuration for the Gardener scheduler
@@ -73,6 +123,10 @@ type SchedulerControllerConfiguration struct {
 	// Shoot defines the configuration of the Shoot controller.
 	// +optional
 	Shoot *ShootSchedulerConfiguration
+	// SeedRebalancing defines the configuration of the SeedRebalancing controller. The controller is only enabled if
+	// this field is set.
+	// +optional
+	SeedRebalancing *SeedRebalancingSchedulerConfiguration
 }
 
 // BackupBucketSchedulerConfiguration defines the configuration of the BackupBucket to Seed
@@ -103,6 +157,58 @@ type ShootSchedulerConfiguration struct {
 	ConcurrentSyncs int
 	// Strategy defines how seeds for shoots, that do not specify a seed explicitly, are being determined
 	Strategy CandidateDeterminationStrategy
+	// Scoring configures the filter and score plugins of the Scored strategy.
+	Scoring *ScoringConfiguration
+}
+
+// SeedRebalancingSchedulerConfiguration defines the configuration of the SeedRebalancing controller which moves shoot
+// control planes away from overloaded or draining seeds.
+type SeedRebalancingSchedulerConfiguration struct {
+	// SyncPeriod is the duration how often seeds are checked for rebalancing.
+	SyncPeriod *metav1.Duration
+	// Mode is the mode of the controller. In mode Propose, the target seeds are only proposed for the shoots which should
+	// be moved. In mode Approved, the control planes of these shoots are migrated during their maintenance time windows.
+	Mode SeedRebalancingMode
+	// OverloadThreshold is the percentage of the allocatable shoots of a seed above which the seed is considered
+	// overloaded.
+	OverloadThreshold *int32
+	// MaxConcurrentMigrations is the maximum number of control plane migrations that are running at the same time.
+	MaxConcurrentMigrations *int32
+}
+
+// ScoringConfiguration configures the filter and score plugins of the Scored strategy.
+type ScoringConfiguration struct {
+	// Filters are the filter plugins which are applied to the seed candidates in addition to the filters that are
+	// always applied (e.g., seed selectors, taints and tolerations, capacity).
+	Filters []FilterPlugin
+	// Scores are the score plugins which are used for ranking the seed candidates. Each plugin scores a seed between 0
+	// and 100, and the seed with the highest weighted sum of all scores is chosen. Ties are broken by choosing the seed
+	// with the least shoots deployed.
+	Scores []ScorePlugin
+}
+
+// FilterPlugin configures a filter plugin of the Scored strategy.
+type FilterPlugin struct {
+	// Name is the name of the filter plugin.
+	Name FilterPluginName
+}
+
+// ScorePlugin configures a score plugin of the Scored strategy.
+type ScorePlugin struct {
+	// Name is the name of the score plugin.
+	Name ScorePluginName
+	// Weight is the weight of the score of the plugin.
+	Weight int32
+	// LabelAffinityTerms are the preferred seed labels of the LabelAffinity plugin.
+	LabelAffinityTerms []LabelAffinityTerm
+}
+
+// LabelAffinityTerm is a weighted label selector for seeds.
+type LabelAffinityTerm struct {
+	// Weight is the weight of the term, in the range 1-100.
+	Weight int32
+	// Selector is the label selector which seeds must match to satisfy the term.
+	Selector metav1.LabelSelector
 }
 
 // ServerConfiguration contains details for the HTTP(S) servers.
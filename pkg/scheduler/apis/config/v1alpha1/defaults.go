@@ -5,7 +5,11 @@
 package v1alpha1
 
 import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	componentbaseconfigv1alpha1 "k8s.io/component-base/config/v1alpha1"
+	"k8s.io/utils/ptr"
 )
 
 // SetDefaults_SchedulerConfiguration sets defaults for the configuration of the Gardener scheduler.
@@ -41,11 +45,52 @@ func SetDefaults_SchedulerControllerConfiguration(obj *SchedulerControllerConfig
 		obj.Shoot.Strategy = Default
 	}
 
+	if obj.Shoot.Strategy == Scored && obj.Shoot.Scoring == nil {
+		obj.Shoot.Scoring = &ScoringConfiguration{
+			Filters: []FilterPlugin{
+				{Name: FilterPluginSameProvider},
+				{Name: FilterPluginTestingPurpose},
+			},
+			Scores: []ScorePlugin{
+				{Name: ScorePluginCapacityHeadroom},
+				{Name: ScorePluginRegionDistance},
+				{Name: ScorePluginProjectSpread},
+			},
+		}
+	}
+
 	if obj.Shoot.ConcurrentSyncs == 0 {
 		obj.Shoot.ConcurrentSyncs = 5
 	}
 }
 
+// SetDefaults_SeedRebalancingSchedulerConfiguration sets defaults for the configuration of the SeedRebalancing
+// controller.
+func SetDefaults_SeedRebalancingSchedulerConfiguration(obj *SeedRebalancingSchedulerConfiguration) {
+	if obj.SyncPeriod == nil {
+		obj.SyncPeriod = &metav1.Duration{Duration: 30 * time.Minute}
+	}
+
+	if obj.Mode == "" {
+		obj.Mode = SeedRebalancingModePropose
+	}
+
+	if obj.OverloadThreshold == nil {
+		obj.OverloadThreshold = ptr.To[int32](90)
+	}
+
+	if obj.MaxConcurrentMigrations == nil {
+		obj.MaxConcurrentMigrations = ptr.To[int32](1)
+	}
+}
+
+// SetDefaults_ScorePlugin sets defaults for the score plugins of the Scored strategy.
+func SetDefaults_ScorePlugin(obj *ScorePlugin) {
+	if obj.Weight == 0 {
+		obj.Weight = 1
+	}
+}
+
 // SetDefaults_ClientConnectionConfiguration sets defaults for the garden client connection.
 func SetDefaults_ClientConnectionConfiguration(obj *componentbaseconfigv1alpha1.ClientConnectionConfiguration) {
 	if obj.QPS == 0.0 {
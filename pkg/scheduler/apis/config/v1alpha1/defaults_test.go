@@ -88,6 +88,84 @@ var _ = Describe("Defaults", func() {
 				},
 			}))
 		})
+
+		It("should default the scoring configuration for the Scored strategy", func() {
+			obj.Schedulers.Shoot = &schedulerv1alpha1.ShootSchedulerConfiguration{Strategy: schedulerv1alpha1.Scored}
+
+			schedulerv1alpha1.SetObjectDefaults_SchedulerConfiguration(obj)
+
+			Expect(obj.Schedulers.Shoot.Scoring).To(Equal(&schedulerv1alpha1.ScoringConfiguration{
+				Filters: []schedulerv1alpha1.FilterPlugin{
+					{Name: schedulerv1alpha1.FilterPluginSameProvider},
+					{Name: schedulerv1alpha1.FilterPluginTestingPurpose},
+				},
+				Scores: []schedulerv1alpha1.ScorePlugin{
+					{Name: schedulerv1alpha1.ScorePluginCapacityHeadroom, Weight: 1},
+					{Name: schedulerv1alpha1.ScorePluginRegionDistance, Weight: 1},
+					{Name: schedulerv1alpha1.ScorePluginProjectSpread, Weight: 1},
+				},
+			}))
+		})
+
+		It("should not overwrite an already set scoring configuration for the Scored strategy", func() {
+			obj.Schedulers.Shoot = &schedulerv1alpha1.ShootSchedulerConfiguration{
+				Strategy: schedulerv1alpha1.Scored,
+				Scoring: &schedulerv1alpha1.ScoringConfiguration{
+					Scores: []schedulerv1alpha1.ScorePlugin{
+						{Name: schedulerv1alpha1.ScorePluginCapacityHeadroom},
+						{Name: schedulerv1alpha1.ScorePluginProjectSpread, Weight: 3},
+					},
+				},
+			}
+
+			schedulerv1alpha1.SetObjectDefaults_SchedulerConfiguration(obj)
+
+			Expect(obj.Schedulers.Shoot.Scoring).To(Equal(&schedulerv1alpha1.ScoringConfiguration{
+				Scores: []schedulerv1alpha1.ScorePlugin{
+					{Name: schedulerv1alpha1.ScorePluginCapacityHeadroom, Weight: 1},
+					{Name: schedulerv1alpha1.ScorePluginProjectSpread, Weight: 3},
+				},
+			}))
+		})
+
+		It("should not default the scoring configuration for other strategies", func() {
+			schedulerv1alpha1.SetObjectDefaults_SchedulerConfiguration(obj)
+
+			Expect(obj.Schedulers.Shoot.Scoring).To(BeNil())
+		})
+
+		It("should not enable the seed rebalancing controller", func() {
+			schedulerv1alpha1.SetObjectDefaults_SchedulerConfiguration(obj)
+
+			Expect(obj.Schedulers.SeedRebalancing).To(BeNil())
+		})
+
+		It("should default the seed rebalancing configuration", func() {
+			obj.Schedulers.SeedRebalancing = &schedulerv1alpha1.SeedRebalancingSchedulerConfiguration{}
+
+			schedulerv1alpha1.SetObjectDefaults_SchedulerConfiguration(obj)
+
+			Expect(obj.Schedulers.SeedRebalancing).To(Equal(&schedulerv1alpha1.SeedRebalancingSchedulerConfiguration{
+				SyncPeriod:              &metav1.Duration{Duration: 30 * time.Minute},
+				Mode:                    schedulerv1alpha1.SeedRebalancingModePropose,
+				OverloadThreshold:       ptr.To[int32](90),
+				MaxConcurrentMigrations: ptr.To[int32](1),
+			}))
+		})
+
+		It("should not overwrite already set values for the seed rebalancing configuration", func() {
+			seedRebalancing := &schedulerv1alpha1.SeedRebalancingSchedulerConfiguration{
+				SyncPeriod:              &metav1.Duration{Duration: time.Hour},
+				Mode:                    schedulerv1alpha1.SeedRebalancingModeApproved,
+				OverloadThreshold:       ptr.To[int32](80),
+				MaxConcurrentMigrations: ptr.To[int32](3),
+			}
+			obj.Schedulers.SeedRebalancing = seedRebalancing.DeepCopy()
+
+			schedulerv1alpha1.SetObjectDefaults_SchedulerConfiguration(obj)
+
+			Expect(obj.Schedulers.SeedRebalancing).To(Equal(seedRebalancing))
+		})
 	})
 
 	Describe("ServerConfiguration defaulting", func() {
@@ -13,6 +13,7 @@ import (
 	unsafe "unsafe"
 
 	config "github.com/gardener/gardener/pkg/scheduler/apis/config"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	componentbaseconfig "k8s.io/component-base/config"
@@ -36,6 +37,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*FilterPlugin)(nil), (*config.FilterPlugin)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_FilterPlugin_To_config_FilterPlugin(a.(*FilterPlugin), b.(*config.FilterPlugin), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.FilterPlugin)(nil), (*FilterPlugin)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_FilterPlugin_To_v1alpha1_FilterPlugin(a.(*config.FilterPlugin), b.(*FilterPlugin), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*LabelAffinityTerm)(nil), (*config.LabelAffinityTerm)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_LabelAffinityTerm_To_config_LabelAffinityTerm(a.(*LabelAffinityTerm), b.(*config.LabelAffinityTerm), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.LabelAffinityTerm)(nil), (*LabelAffinityTerm)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_LabelAffinityTerm_To_v1alpha1_LabelAffinityTerm(a.(*config.LabelAffinityTerm), b.(*LabelAffinityTerm), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*SchedulerConfiguration)(nil), (*config.SchedulerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_SchedulerConfiguration_To_config_SchedulerConfiguration(a.(*SchedulerConfiguration), b.(*config.SchedulerConfiguration), scope)
 	}); err != nil {
@@ -56,6 +77,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ScorePlugin)(nil), (*config.ScorePlugin)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ScorePlugin_To_config_ScorePlugin(a.(*ScorePlugin), b.(*config.ScorePlugin), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ScorePlugin)(nil), (*ScorePlugin)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ScorePlugin_To_v1alpha1_ScorePlugin(a.(*config.ScorePlugin), b.(*ScorePlugin), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ScoringConfiguration)(nil), (*config.ScoringConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ScoringConfiguration_To_config_ScoringConfiguration(a.(*ScoringConfiguration), b.(*config.ScoringConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ScoringConfiguration)(nil), (*ScoringConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ScoringConfiguration_To_v1alpha1_ScoringConfiguration(a.(*config.ScoringConfiguration), b.(*ScoringConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SeedRebalancingSchedulerConfiguration)(nil), (*config.SeedRebalancingSchedulerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SeedRebalancingSchedulerConfiguration_To_config_SeedRebalancingSchedulerConfiguration(a.(*SeedRebalancingSchedulerConfiguration), b.(*config.SeedRebalancingSchedulerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.SeedRebalancingSchedulerConfiguration)(nil), (*SeedRebalancingSchedulerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_SeedRebalancingSchedulerConfiguration_To_v1alpha1_SeedRebalancingSchedulerConfiguration(a.(*config.SeedRebalancingSchedulerConfiguration), b.(*SeedRebalancingSchedulerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Server)(nil), (*config.Server)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_Server_To_config_Server(a.(*Server), b.(*config.Server), scope)
 	}); err != nil {
@@ -109,6 +160,48 @@ func Convert_config_BackupBucketSchedulerConfiguration_To_v1alpha1_BackupBucketS
 	return autoConvert_config_BackupBucketSchedulerConfiguration_To_v1alpha1_BackupBucketSchedulerConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_FilterPlugin_To_config_FilterPlugin(in *FilterPlugin, out *config.FilterPlugin, s conversion.Scope) error {
+	out.Name = config.FilterPluginName(in.Name)
+	return nil
+}
+
+// Convert_v1alpha1_FilterPlugin_To_config_FilterPlugin is an autogenerated conversion function.
+func Convert_v1alpha1_FilterPlugin_To_config_FilterPlugin(in *FilterPlugin, out *config.FilterPlugin, s conversion.Scope) error {
+	return autoConvert_v1alpha1_FilterPlugin_To_config_FilterPlugin(in, out, s)
+}
+
+func autoConvert_config_FilterPlugin_To_v1alpha1_FilterPlugin(in *config.FilterPlugin, out *FilterPlugin, s conversion.Scope) error {
+	out.Name = FilterPluginName(in.Name)
+	return nil
+}
+
+// Convert_config_FilterPlugin_To_v1alpha1_FilterPlugin is an autogenerated conversion function.
+func Convert_config_FilterPlugin_To_v1alpha1_FilterPlugin(in *config.FilterPlugin, out *FilterPlugin, s conversion.Scope) error {
+	return autoConvert_config_FilterPlugin_To_v1alpha1_FilterPlugin(in, out, s)
+}
+
+func autoConvert_v1alpha1_LabelAffinityTerm_To_config_LabelAffinityTerm(in *LabelAffinityTerm, out *config.LabelAffinityTerm, s conversion.Scope) error {
+	out.Weight = in.Weight
+	out.Selector = in.Selector
+	return nil
+}
+
+// Convert_v1alpha1_LabelAffinityTerm_To_config_LabelAffinityTerm is an autogenerated conversion function.
+func Convert_v1alpha1_LabelAffinityTerm_To_config_LabelAffinityTerm(in *LabelAffinityTerm, out *config.LabelAffinityTerm, s conversion.Scope) error {
+	return autoConvert_v1alpha1_LabelAffinityTerm_To_config_LabelAffinityTerm(in, out, s)
+}
+
+func autoConvert_config_LabelAffinityTerm_To_v1alpha1_LabelAffinityTerm(in *config.LabelAffinityTerm, out *LabelAffinityTerm, s conversion.Scope) error {
+	out.Weight = in.Weight
+	out.Selector = in.Selector
+	return nil
+}
+
+// Convert_config_LabelAffinityTerm_To_v1alpha1_LabelAffinityTerm is an autogenerated conversion function.
+func Convert_config_LabelAffinityTerm_To_v1alpha1_LabelAffinityTerm(in *config.LabelAffinityTerm, out *LabelAffinityTerm, s conversion.Scope) error {
+	return autoConvert_config_LabelAffinityTerm_To_v1alpha1_LabelAffinityTerm(in, out, s)
+}
+
 func autoConvert_v1alpha1_SchedulerConfiguration_To_config_SchedulerConfiguration(in *SchedulerConfiguration, out *config.SchedulerConfiguration, s conversion.Scope) error {
 	if err := configv1alpha1.Convert_v1alpha1_ClientConnectionConfiguration_To_config_ClientConnectionConfiguration(&in.ClientConnection, &out.ClientConnection, s); err != nil {
 		return err
@@ -190,6 +283,7 @@ func Convert_config_SchedulerConfiguration_To_v1alpha1_SchedulerConfiguration(in
 func autoConvert_v1alpha1_SchedulerControllerConfiguration_To_config_SchedulerControllerConfiguration(in *SchedulerControllerConfiguration, out *config.SchedulerControllerConfiguration, s conversion.Scope) error {
 	out.BackupBucket = (*config.BackupBucketSchedulerConfiguration)(unsafe.Pointer(in.BackupBucket))
 	out.Shoot = (*config.ShootSchedulerConfiguration)(unsafe.Pointer(in.Shoot))
+	out.SeedRebalancing = (*config.SeedRebalancingSchedulerConfiguration)(unsafe.Pointer(in.SeedRebalancing))
 	return nil
 }
 
@@ -201,6 +295,7 @@ func Convert_v1alpha1_SchedulerControllerConfiguration_To_config_SchedulerContro
 func autoConvert_config_SchedulerControllerConfiguration_To_v1alpha1_SchedulerControllerConfiguration(in *config.SchedulerControllerConfiguration, out *SchedulerControllerConfiguration, s conversion.Scope) error {
 	out.BackupBucket = (*BackupBucketSchedulerConfiguration)(unsafe.Pointer(in.BackupBucket))
 	out.Shoot = (*ShootSchedulerConfiguration)(unsafe.Pointer(in.Shoot))
+	out.SeedRebalancing = (*SeedRebalancingSchedulerConfiguration)(unsafe.Pointer(in.SeedRebalancing))
 	return nil
 }
 
@@ -209,6 +304,78 @@ func Convert_config_SchedulerControllerConfiguration_To_v1alpha1_SchedulerContro
 	return autoConvert_config_SchedulerControllerConfiguration_To_v1alpha1_SchedulerControllerConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_ScorePlugin_To_config_ScorePlugin(in *ScorePlugin, out *config.ScorePlugin, s conversion.Scope) error {
+	out.Name = config.ScorePluginName(in.Name)
+	out.Weight = in.Weight
+	out.LabelAffinityTerms = *(*[]config.LabelAffinityTerm)(unsafe.Pointer(&in.LabelAffinityTerms))
+	return nil
+}
+
+// Convert_v1alpha1_ScorePlugin_To_config_ScorePlugin is an autogenerated conversion function.
+func Convert_v1alpha1_ScorePlugin_To_config_ScorePlugin(in *ScorePlugin, out *config.ScorePlugin, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ScorePlugin_To_config_ScorePlugin(in, out, s)
+}
+
+func autoConvert_config_ScorePlugin_To_v1alpha1_ScorePlugin(in *config.ScorePlugin, out *ScorePlugin, s conversion.Scope) error {
+	out.Name = ScorePluginName(in.Name)
+	out.Weight = in.Weight
+	out.LabelAffinityTerms = *(*[]LabelAffinityTerm)(unsafe.Pointer(&in.LabelAffinityTerms))
+	return nil
+}
+
+// Convert_config_ScorePlugin_To_v1alpha1_ScorePlugin is an autogenerated conversion function.
+func Convert_config_ScorePlugin_To_v1alpha1_ScorePlugin(in *config.ScorePlugin, out *ScorePlugin, s conversion.Scope) error {
+	return autoConvert_config_ScorePlugin_To_v1alpha1_ScorePlugin(in, out, s)
+}
+
+func autoConvert_v1alpha1_ScoringConfiguration_To_config_ScoringConfiguration(in *ScoringConfiguration, out *config.ScoringConfiguration, s conversion.Scope) error {
+	out.Filters = *(*[]config.FilterPlugin)(unsafe.Pointer(&in.Filters))
+	out.Scores = *(*[]config.ScorePlugin)(unsafe.Pointer(&in.Scores))
+	return nil
+}
+
+// Convert_v1alpha1_ScoringConfiguration_To_config_ScoringConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_ScoringConfiguration_To_config_ScoringConfiguration(in *ScoringConfiguration, out *config.ScoringConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ScoringConfiguration_To_config_ScoringConfiguration(in, out, s)
+}
+
+func autoConvert_config_ScoringConfiguration_To_v1alpha1_ScoringConfiguration(in *config.ScoringConfiguration, out *ScoringConfiguration, s conversion.Scope) error {
+	out.Filters = *(*[]FilterPlugin)(unsafe.Pointer(&in.Filters))
+	out.Scores = *(*[]ScorePlugin)(unsafe.Pointer(&in.Scores))
+	return nil
+}
+
+// Convert_config_ScoringConfiguration_To_v1alpha1_ScoringConfiguration is an autogenerated conversion function.
+func Convert_config_ScoringConfiguration_To_v1alpha1_ScoringConfiguration(in *config.ScoringConfiguration, out *ScoringConfiguration, s conversion.Scope) error {
+	return autoConvert_config_ScoringConfiguration_To_v1alpha1_ScoringConfiguration(in, out, s)
+}
+
+func autoConvert_v1alpha1_SeedRebalancingSchedulerConfiguration_To_config_SeedRebalancingSchedulerConfiguration(in *SeedRebalancingSchedulerConfiguration, out *config.SeedRebalancingSchedulerConfiguration, s conversion.Scope) error {
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	out.Mode = config.SeedRebalancingMode(in.Mode)
+	out.OverloadThreshold = (*int32)(unsafe.Pointer(in.OverloadThreshold))
+	out.MaxConcurrentMigrations = (*int32)(unsafe.Pointer(in.MaxConcurrentMigrations))
+	return nil
+}
+
+// Convert_v1alpha1_SeedRebalancingSchedulerConfiguration_To_config_SeedRebalancingSchedulerConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_SeedRebalancingSchedulerConfiguration_To_config_SeedRebalancingSchedulerConfiguration(in *SeedRebalancingSchedulerConfiguration, out *config.SeedRebalancingSchedulerConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SeedRebalancingSchedulerConfiguration_To_config_SeedRebalancingSchedulerConfiguration(in, out, s)
+}
+
+func autoConvert_config_SeedRebalancingSchedulerConfiguration_To_v1alpha1_SeedRebalancingSchedulerConfiguration(in *config.SeedRebalancingSchedulerConfiguration, out *SeedRebalancingSchedulerConfiguration, s conversion.Scope) error {
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	out.Mode = SeedRebalancingMode(in.Mode)
+	out.OverloadThreshold = (*int32)(unsafe.Pointer(in.OverloadThreshold))
+	out.MaxConcurrentMigrations = (*int32)(unsafe.Pointer(in.MaxConcurrentMigrations))
+	return nil
+}
+
+// Convert_config_SeedRebalancingSchedulerConfiguration_To_v1alpha1_SeedRebalancingSchedulerConfiguration is an autogenerated conversion function.
+func Convert_config_SeedRebalancingSchedulerConfiguration_To_v1alpha1_SeedRebalancingSchedulerConfiguration(in *config.SeedRebalancingSchedulerConfiguration, out *SeedRebalancingSchedulerConfiguration, s conversion.Scope) error {
+	return autoConvert_config_SeedRebalancingSchedulerConfiguration_To_v1alpha1_SeedRebalancingSchedulerConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_Server_To_config_Server(in *Server, out *config.Server, s conversion.Scope) error {
 	out.BindAddress = in.BindAddress
 	out.Port = in.Port
@@ -256,6 +423,7 @@ func Convert_config_ServerConfiguration_To_v1alpha1_ServerConfiguration(in *conf
 func autoConvert_v1alpha1_ShootSchedulerConfiguration_To_config_ShootSchedulerConfiguration(in *ShootSchedulerConfiguration, out *config.ShootSchedulerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = in.ConcurrentSyncs
 	out.Strategy = config.CandidateDeterminationStrategy(in.Strategy)
+	out.Scoring = (*config.ScoringConfiguration)(unsafe.Pointer(in.Scoring))
 	return nil
 }
 
@@ -267,6 +435,7 @@ func Convert_v1alpha1_ShootSchedulerConfiguration_To_config_ShootSchedulerConfig
 func autoConvert_config_ShootSchedulerConfiguration_To_v1alpha1_ShootSchedulerConfiguration(in *config.ShootSchedulerConfiguration, out *ShootSchedulerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = in.ConcurrentSyncs
 	out.Strategy = CandidateDeterminationStrategy(in.Strategy)
+	out.Scoring = (*ScoringConfiguration)(unsafe.Pointer(in.Scoring))
 	return nil
 }
 
@@ -29,4 +29,15 @@ func SetObjectDefaults_SchedulerConfiguration(in *SchedulerConfiguration) {
 	}
 	SetDefaults_ServerConfiguration(&in.Server)
 	SetDefaults_SchedulerControllerConfiguration(&in.Schedulers)
+	if in.Schedulers.Shoot != nil {
+		if in.Schedulers.Shoot.Scoring != nil {
+			for i := range in.Schedulers.Shoot.Scoring.Scores {
+				a := &in.Schedulers.Shoot.Scoring.Scores[i]
+				SetDefaults_ScorePlugin(a)
+			}
+		}
+	}
+	if in.Schedulers.SeedRebalancing != nil {
+		SetDefaults_SeedRebalancingSchedulerConfiguration(in.Schedulers.SeedRebalancing)
+	}
 }
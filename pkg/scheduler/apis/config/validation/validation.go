@@ -6,6 +6,7 @@ package validation
 
 import (
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
@@ -47,6 +48,39 @@ func validateSchedulerControllerConfiguration(schedulers schedulerconfig.Schedul
 	if schedulers.Shoot != nil {
 		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(schedulers.Shoot.ConcurrentSyncs), fldPath.Child("shoot", "concurrentSyncs"))...)
 		allErrs = append(allErrs, validateStrategy(schedulers.Shoot.Strategy, fldPath.Child("shoot", "strategy"))...)
+
+		if schedulers.Shoot.Scoring != nil {
+			if schedulers.Shoot.Strategy != schedulerconfig.Scored {
+				allErrs = append(allErrs, field.Forbidden(fldPath.Child("shoot", "scoring"), "scoring is only allowed for the Scored strategy"))
+			}
+			allErrs = append(allErrs, validateScoring(schedulers.Shoot.Scoring, fldPath.Child("shoot", "scoring"))...)
+		}
+	}
+
+	if schedulers.SeedRebalancing != nil {
+		allErrs = append(allErrs, validateSeedRebalancing(schedulers.SeedRebalancing, fldPath.Child("seedRebalancing"))...)
+	}
+
+	return allErrs
+}
+
+func validateSeedRebalancing(seedRebalancing *schedulerconfig.SeedRebalancingSchedulerConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if seedRebalancing.SyncPeriod != nil && seedRebalancing.SyncPeriod.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("syncPeriod"), seedRebalancing.SyncPeriod.Duration.String(), "must be positive"))
+	}
+
+	if !sets.New(schedulerconfig.SeedRebalancingModes...).Has(seedRebalancing.Mode) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("mode"), seedRebalancing.Mode, schedulerconfig.SeedRebalancingModes))
+	}
+
+	if threshold := seedRebalancing.OverloadThreshold; threshold != nil && (*threshold < 1 || *threshold > 100) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("overloadThreshold"), *threshold, "must be in the range 1-100"))
+	}
+
+	if maxConcurrentMigrations := seedRebalancing.MaxConcurrentMigrations; maxConcurrentMigrations != nil && *maxConcurrentMigrations <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxConcurrentMigrations"), *maxConcurrentMigrations, "must be positive"))
 	}
 
 	return allErrs
@@ -70,3 +104,65 @@ func validateStrategy(strategy schedulerconfig.CandidateDeterminationStrategy, f
 
 	return allErrs
 }
+
+func validateScoring(scoring *schedulerconfig.ScoringConfiguration, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs              = field.ErrorList{}
+		supportedFilters     = sets.New(schedulerconfig.FilterPlugins...)
+		supportedScores      = sets.New(schedulerconfig.ScorePlugins...)
+		configuredFilters    = sets.New[schedulerconfig.FilterPluginName]()
+		configuredScores     = sets.New[schedulerconfig.ScorePluginName]()
+		supportedFilterNames = sets.List(supportedFilters)
+		supportedScoreNames  = sets.List(supportedScores)
+	)
+
+	for i, filter := range scoring.Filters {
+		idxPath := fldPath.Child("filters").Index(i)
+
+		if !supportedFilters.Has(filter.Name) {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("name"), filter.Name, supportedFilterNames))
+		}
+		if configuredFilters.Has(filter.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), filter.Name))
+		}
+		configuredFilters.Insert(filter.Name)
+	}
+
+	for i, score := range scoring.Scores {
+		idxPath := fldPath.Child("scores").Index(i)
+
+		if !supportedScores.Has(score.Name) {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("name"), score.Name, supportedScoreNames))
+		}
+		if configuredScores.Has(score.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), score.Name))
+		}
+		configuredScores.Insert(score.Name)
+
+		if score.Weight <= 0 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("weight"), score.Weight, "must be positive"))
+		}
+
+		if score.Name != schedulerconfig.ScorePluginLabelAffinity {
+			if len(score.LabelAffinityTerms) > 0 {
+				allErrs = append(allErrs, field.Forbidden(idxPath.Child("labelAffinityTerms"), "label affinity terms are only allowed for the LabelAffinity plugin"))
+			}
+			continue
+		}
+
+		if len(score.LabelAffinityTerms) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("labelAffinityTerms"), "at least one label affinity term is required for the LabelAffinity plugin"))
+		}
+
+		for j, term := range score.LabelAffinityTerms {
+			termPath := idxPath.Child("labelAffinityTerms").Index(j)
+
+			if term.Weight < 1 || term.Weight > 100 {
+				allErrs = append(allErrs, field.Invalid(termPath.Child("weight"), term.Weight, "must be in the range 1-100"))
+			}
+			allErrs = append(allErrs, metav1validation.ValidateLabelSelector(&term.Selector, metav1validation.LabelSelectorValidationOptions{}, termPath.Child("selector"))...)
+		}
+	}
+
+	return allErrs
+}
@@ -5,11 +5,14 @@
 package validation
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 
 	schedulerconfig "github.com/gardener/gardener/pkg/scheduler/apis/config"
 )
@@ -69,6 +72,157 @@ var _ = Describe("gardener-scheduler", func() {
 				}))))
 			})
 
+			Context("Scored strategy", func() {
+				var scoredConfiguration schedulerconfig.SchedulerConfiguration
+
+				BeforeEach(func() {
+					scoredConfiguration = defaultAdmissionConfiguration
+					scoredConfiguration.Schedulers.Shoot.Strategy = schedulerconfig.Scored
+					scoredConfiguration.Schedulers.Shoot.Scoring = &schedulerconfig.ScoringConfiguration{
+						Filters: []schedulerconfig.FilterPlugin{
+							{Name: schedulerconfig.FilterPluginSameProvider},
+						},
+						Scores: []schedulerconfig.ScorePlugin{
+							{Name: schedulerconfig.ScorePluginCapacityHeadroom, Weight: 2},
+							{Name: schedulerconfig.ScorePluginRegionDistance, Weight: 1},
+							{Name: schedulerconfig.ScorePluginProjectSpread, Weight: 1},
+							{Name: schedulerconfig.ScorePluginLabelAffinity, Weight: 1, LabelAffinityTerms: []schedulerconfig.LabelAffinityTerm{{
+								Weight:   10,
+								Selector: metav1.LabelSelector{MatchLabels: map[string]string{"foo": "bar"}},
+							}}},
+						},
+					}
+				})
+
+				It("should pass because the scoring configuration is valid", func() {
+					Expect(ValidateConfiguration(&scoredConfiguration)).To(BeEmpty())
+				})
+
+				It("should pass without scoring configuration", func() {
+					scoredConfiguration.Schedulers.Shoot.Scoring = nil
+
+					Expect(ValidateConfiguration(&scoredConfiguration)).To(BeEmpty())
+				})
+
+				It("should fail because the scoring configuration is set for another strategy", func() {
+					scoredConfiguration.Schedulers.Shoot.Strategy = schedulerconfig.SameRegion
+
+					Expect(ValidateConfiguration(&scoredConfiguration)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("schedulers.shoot.scoring"),
+					}))))
+				})
+
+				It("should fail because of unsupported and duplicate plugins", func() {
+					scoring := scoredConfiguration.Schedulers.Shoot.Scoring
+					scoring.Filters = append(scoring.Filters, schedulerconfig.FilterPlugin{Name: "foo"}, schedulerconfig.FilterPlugin{Name: schedulerconfig.FilterPluginSameProvider})
+					scoring.Scores = append(scoring.Scores, schedulerconfig.ScorePlugin{Name: "bar", Weight: 1}, schedulerconfig.ScorePlugin{Name: schedulerconfig.ScorePluginRegionDistance, Weight: 1})
+
+					Expect(ValidateConfiguration(&scoredConfiguration)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeNotSupported),
+							"Field": Equal("schedulers.shoot.scoring.filters[1].name"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeDuplicate),
+							"Field": Equal("schedulers.shoot.scoring.filters[2].name"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeNotSupported),
+							"Field": Equal("schedulers.shoot.scoring.scores[4].name"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeDuplicate),
+							"Field": Equal("schedulers.shoot.scoring.scores[5].name"),
+						})),
+					))
+				})
+
+				It("should fail because of invalid weights", func() {
+					scoring := scoredConfiguration.Schedulers.Shoot.Scoring
+					scoring.Scores[0].Weight = 0
+					scoring.Scores[3].LabelAffinityTerms[0].Weight = 101
+
+					Expect(ValidateConfiguration(&scoredConfiguration)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("schedulers.shoot.scoring.scores[0].weight"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("schedulers.shoot.scoring.scores[3].labelAffinityTerms[0].weight"),
+						})),
+					))
+				})
+
+				It("should fail because of invalid label affinity terms", func() {
+					scoring := scoredConfiguration.Schedulers.Shoot.Scoring
+					scoring.Scores[0].LabelAffinityTerms = scoring.Scores[3].LabelAffinityTerms
+					scoring.Scores[3].LabelAffinityTerms[0].Selector.MatchLabels = map[string]string{"foo": "%"}
+
+					Expect(ValidateConfiguration(&scoredConfiguration)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeForbidden),
+							"Field": Equal("schedulers.shoot.scoring.scores[0].labelAffinityTerms"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("schedulers.shoot.scoring.scores[3].labelAffinityTerms[0].selector.matchLabels"),
+						})),
+					))
+				})
+
+				It("should fail because the label affinity terms are missing", func() {
+					scoredConfiguration.Schedulers.Shoot.Scoring.Scores[3].LabelAffinityTerms = nil
+
+					Expect(ValidateConfiguration(&scoredConfiguration)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("schedulers.shoot.scoring.scores[3].labelAffinityTerms"),
+					}))))
+				})
+			})
+
+			Context("SeedRebalancing", func() {
+				It("should pass because the seed rebalancing configuration is valid", func() {
+					defaultAdmissionConfiguration.Schedulers.SeedRebalancing = &schedulerconfig.SeedRebalancingSchedulerConfiguration{
+						SyncPeriod:              &metav1.Duration{Duration: time.Hour},
+						Mode:                    schedulerconfig.SeedRebalancingModeApproved,
+						OverloadThreshold:       ptr.To[int32](80),
+						MaxConcurrentMigrations: ptr.To[int32](2),
+					}
+
+					Expect(ValidateConfiguration(&defaultAdmissionConfiguration)).To(BeEmpty())
+				})
+
+				It("should fail because the seed rebalancing configuration is invalid", func() {
+					defaultAdmissionConfiguration.Schedulers.SeedRebalancing = &schedulerconfig.SeedRebalancingSchedulerConfiguration{
+						SyncPeriod:              &metav1.Duration{},
+						Mode:                    "foo",
+						OverloadThreshold:       ptr.To[int32](101),
+						MaxConcurrentMigrations: ptr.To[int32](0),
+					}
+
+					Expect(ValidateConfiguration(&defaultAdmissionConfiguration)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("schedulers.seedRebalancing.syncPeriod"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeNotSupported),
+							"Field": Equal("schedulers.seedRebalancing.mode"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("schedulers.seedRebalancing.overloadThreshold"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("schedulers.seedRebalancing.maxConcurrentMigrations"),
+						})),
+					))
+				})
+			})
+
 			It("should fail because backupBucket concurrentSyncs are negative", func() {
 				invalidConfiguration := defaultAdmissionConfiguration
 				invalidConfiguration.Schedulers.BackupBucket.ConcurrentSyncs = -1
@@ -10,6 +10,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	"github.com/gardener/gardener/pkg/scheduler/apis/config"
+	"github.com/gardener/gardener/pkg/scheduler/controller/seedrebalancing"
 	"github.com/gardener/gardener/pkg/scheduler/controller/shoot"
 )
 
@@ -21,5 +22,17 @@ func AddToManager(mgr manager.Manager, cfg *config.SchedulerConfiguration) error
 		return fmt.Errorf("failed adding Shoot controller: %w", err)
 	}
 
+	if cfg.Schedulers.SeedRebalancing != nil {
+		if err := (&seedrebalancing.Reconciler{
+			Config: cfg.Schedulers.SeedRebalancing,
+			ShootScheduler: &shoot.Reconciler{
+				Client: mgr.GetClient(),
+				Config: cfg.Schedulers.Shoot,
+			},
+		}).AddToManager(mgr); err != nil {
+			return fmt.Errorf("failed adding SeedRebalancing controller: %w", err)
+		}
+	}
+
 	return nil
 }
@@ -0,0 +1,61 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package seedrebalancing_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	. "github.com/gardener/gardener/pkg/scheduler/controller/seedrebalancing"
+)
+
+var _ = Describe("Add", func() {
+	Describe("#SeedPredicate", func() {
+		var (
+			p    predicate.Predicate
+			seed *gardencorev1beta1.Seed
+		)
+
+		BeforeEach(func() {
+			p = (&Reconciler{}).SeedPredicate()
+			seed = &gardencorev1beta1.Seed{ObjectMeta: metav1.ObjectMeta{Name: "seed"}}
+		})
+
+		It("should return true for create events", func() {
+			Expect(p.Create(event.CreateEvent{Object: seed})).To(BeTrue())
+		})
+
+		It("should return false for update events without relevant changes", func() {
+			newSeed := seed.DeepCopy()
+			newSeed.Labels = map[string]string{"foo": "bar"}
+
+			Expect(p.Update(event.UpdateEvent{ObjectOld: seed, ObjectNew: newSeed})).To(BeFalse())
+		})
+
+		It("should return true for update events adding the drain annotation", func() {
+			newSeed := seed.DeepCopy()
+			metav1.SetMetaDataAnnotation(&newSeed.ObjectMeta, v1beta1constants.AnnotationSchedulingDrain, "true")
+
+			Expect(p.Update(event.UpdateEvent{ObjectOld: seed, ObjectNew: newSeed})).To(BeTrue())
+		})
+
+		It("should return true for update events setting the deletion timestamp", func() {
+			newSeed := seed.DeepCopy()
+			newSeed.DeletionTimestamp = &metav1.Time{}
+
+			Expect(p.Update(event.UpdateEvent{ObjectOld: seed, ObjectNew: newSeed})).To(BeTrue())
+		})
+
+		It("should return false for delete and generic events", func() {
+			Expect(p.Delete(event.DeleteEvent{Object: seed})).To(BeFalse())
+			Expect(p.Generic(event.GenericEvent{Object: seed})).To(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,263 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package seedrebalancing
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	"github.com/gardener/gardener/pkg/scheduler/apis/config"
+	"github.com/gardener/gardener/pkg/scheduler/controller/shoot"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	"github.com/gardener/gardener/pkg/utils/timewindow"
+)
+
+const (
+	// EventSeedRebalancingProposed is the reason of the event which is emitted when a target seed was proposed for a
+	// shoot.
+	EventSeedRebalancingProposed = "SeedRebalancingProposed"
+	// EventSeedRebalancingMigrationTriggered is the reason of the event which is emitted when the control plane
+	// migration of a shoot was triggered.
+	EventSeedRebalancingMigrationTriggered = "SeedRebalancingMigrationTriggered"
+	// EventSeedRebalancingFailed is the reason of the event which is emitted when no target seed could be determined for
+	// a shoot or its control plane migration could not be triggered.
+	EventSeedRebalancingFailed = "SeedRebalancingFailed"
+)
+
+// Reconciler checks whether seeds are overloaded or being drained. For the shoots which should be moved away from such
+// seeds, it proposes target seeds and, if approved, triggers the control plane migration during the maintenance time
+// windows of the shoots.
+type Reconciler struct {
+	Client         client.Client
+	APIReader      client.Reader
+	Config         *config.SeedRebalancingSchedulerConfiguration
+	ShootScheduler *shoot.Reconciler
+	Clock          clock.Clock
+	Recorder       record.EventRecorder
+}
+
+// Reconcile checks whether the seed is overloaded or being drained and moves shoot control planes away from it.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	ctx, cancel := controllerutils.GetMainReconciliationContext(ctx, controllerutils.DefaultReconciliationTimeout)
+	defer cancel()
+
+	seed := &gardencorev1beta1.Seed{}
+	if err := r.Client.Get(ctx, request.NamespacedName, seed); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	seedList := &gardencorev1beta1.SeedList{}
+	if err := r.Client.List(ctx, seedList); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed listing seeds: %w", err)
+	}
+
+	// The shoots are read from the API server since the cache might not yet reflect the migrations triggered in previous
+	// reconciliations, i.e., the maximum number of concurrent migrations could be exceeded otherwise.
+	shootList := &gardencorev1beta1.ShootList{}
+	if err := r.APIReader.List(ctx, shootList); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed listing shoots: %w", err)
+	}
+
+	var (
+		assignedShoots      = make(map[string]int)
+		runningMigrations   int
+		shootsOnSeed        []*gardencorev1beta1.Shoot
+		excludedSeeds       = sets.New[string]()
+		requeueAfterSyncing = reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}
+	)
+
+	for i := range shootList.Items {
+		s := &shootList.Items[i]
+		if s.Spec.SeedName == nil {
+			continue
+		}
+		assignedShoots[*s.Spec.SeedName]++
+
+		if isMigrating(s) {
+			runningMigrations++
+			continue
+		}
+		if *s.Spec.SeedName == seed.Name && s.DeletionTimestamp == nil {
+			shootsOnSeed = append(shootsOnSeed, s)
+		}
+	}
+
+	for _, s := range seedList.Items {
+		if reason, _ := r.rebalancingReason(&s, assignedShoots[s.Name]); reason != "" {
+			excludedSeeds.Insert(s.Name)
+		}
+	}
+
+	reason, shootsToMove := r.rebalancingReason(seed, assignedShoots[seed.Name])
+	if reason == "" {
+		return requeueAfterSyncing, r.removeProposals(ctx, shootsOnSeed)
+	}
+
+	log.Info("Seed needs rebalancing", "reason", reason, "shootsToMove", shootsToMove, "mode", r.Config.Mode)
+
+	// Prefer shoots which already have a proposal so that proposals remain stable across reconciliations.
+	slices.SortStableFunc(shootsOnSeed, func(a, b *gardencorev1beta1.Shoot) int {
+		_, aProposed := a.Annotations[v1beta1constants.AnnotationSchedulingProposedSeed]
+		_, bProposed := b.Annotations[v1beta1constants.AnnotationSchedulingProposedSeed]
+		if aProposed != bProposed {
+			if aProposed {
+				return -1
+			}
+			return 1
+		}
+		return strings.Compare(client.ObjectKeyFromObject(a).String(), client.ObjectKeyFromObject(b).String())
+	})
+
+	if shootsToMove < len(shootsOnSeed) {
+		if err := r.removeProposals(ctx, shootsOnSeed[shootsToMove:]); err != nil {
+			return reconcile.Result{}, err
+		}
+		shootsOnSeed = shootsOnSeed[:shootsToMove]
+	}
+
+	for _, s := range shootsOnSeed {
+		shootLog := log.WithValues("shoot", client.ObjectKeyFromObject(s))
+
+		targetSeed, err := r.ShootScheduler.DetermineTargetSeed(ctx, shootLog, s, excludedSeeds)
+		if err != nil {
+			shootLog.Info("Could not determine a target seed", "reason", err.Error())
+			r.Recorder.Eventf(s, corev1.EventTypeWarning, EventSeedRebalancingFailed, "Could not determine a target seed for moving away from seed %q (%s): %s", seed.Name, reason, err)
+			continue
+		}
+
+		if s.Annotations[v1beta1constants.AnnotationSchedulingProposedSeed] != targetSeed.Name {
+			patch := client.MergeFrom(s.DeepCopy())
+			metav1.SetMetaDataAnnotation(&s.ObjectMeta, v1beta1constants.AnnotationSchedulingProposedSeed, targetSeed.Name)
+			if err := r.Client.Patch(ctx, s, patch); err != nil {
+				return reconcile.Result{}, fmt.Errorf("failed proposing target seed for shoot %s: %w", client.ObjectKeyFromObject(s), err)
+			}
+
+			shootLog.Info("Proposed target seed", "targetSeed", targetSeed.Name)
+			r.Recorder.Eventf(s, corev1.EventTypeNormal, EventSeedRebalancingProposed, "Proposed to migrate the control plane from seed %q (%s) to seed %q", seed.Name, reason, targetSeed.Name)
+		}
+
+		if r.Config.Mode != config.SeedRebalancingModeApproved {
+			continue
+		}
+
+		if runningMigrations >= int(ptr.Deref(r.Config.MaxConcurrentMigrations, 1)) {
+			shootLog.Info("Maximum number of concurrent migrations reached, postponing migration", "runningMigrations", runningMigrations)
+			continue
+		}
+
+		if timeWindow := gardenerutils.EffectiveShootMaintenanceTimeWindow(s); !timeWindow.Contains(r.Clock.Now()) {
+			// Requeue at the begin of the next maintenance time window, since it might be shorter than the sync period.
+			durationUntilTimeWindow := durationUntilNextBegin(timeWindow, r.Clock.Now())
+			if durationUntilTimeWindow < requeueAfterSyncing.RequeueAfter {
+				requeueAfterSyncing.RequeueAfter = durationUntilTimeWindow
+			}
+
+			shootLog.V(1).Info("Shoot is not in its maintenance time window, postponing migration", "requeueAfter", durationUntilTimeWindow)
+			continue
+		}
+
+		if err := r.migrate(ctx, shootLog, s, seed.Name, targetSeed.Name, reason); err != nil {
+			return reconcile.Result{}, err
+		}
+		runningMigrations++
+	}
+
+	return requeueAfterSyncing, nil
+}
+
+// rebalancingReason returns the reason why shoots should be moved away from the given seed together with the number of
+// shoots which should be moved. It returns an empty reason if the seed does not need rebalancing.
+func (r *Reconciler) rebalancingReason(seed *gardencorev1beta1.Seed, assignedShoots int) (string, int) {
+	if seed.DeletionTimestamp != nil {
+		return "seed is being deleted", assignedShoots
+	}
+
+	if seed.Annotations[v1beta1constants.AnnotationSchedulingDrain] == "true" {
+		return "seed is being drained", assignedShoots
+	}
+
+	allocatableShoots, ok := seed.Status.Allocatable[gardencorev1beta1.ResourceShoots]
+	if !ok || allocatableShoots.Value() <= 0 {
+		return "", 0
+	}
+
+	threshold := int64(ptr.Deref(r.Config.OverloadThreshold, 100))
+	if limit := allocatableShoots.Value() * threshold / 100; int64(assignedShoots) > limit {
+		return fmt.Sprintf("seed is overloaded, %d/%d allocatable shoots are assigned (threshold %d%%)", assignedShoots, allocatableShoots.Value(), threshold), assignedShoots - int(limit)
+	}
+
+	return "", 0
+}
+
+// migrate triggers the control plane migration of the given shoot by binding it to the target seed.
+func (r *Reconciler) migrate(ctx context.Context, log logr.Logger, s *gardencorev1beta1.Shoot, sourceSeedName, targetSeedName, reason string) error {
+	s.Spec.SeedName = &targetSeedName
+	if err := r.Client.SubResource("binding").Update(ctx, s); err != nil {
+		r.Recorder.Eventf(s, corev1.EventTypeWarning, EventSeedRebalancingFailed, "Failed to migrate the control plane from seed %q to seed %q: %s", sourceSeedName, targetSeedName, err)
+		return fmt.Errorf("failed binding shoot %s to seed %s: %w", client.ObjectKeyFromObject(s), targetSeedName, err)
+	}
+
+	log.Info("Triggered control plane migration", "sourceSeed", sourceSeedName, "targetSeed", targetSeedName)
+	r.Recorder.Eventf(s, corev1.EventTypeNormal, EventSeedRebalancingMigrationTriggered, "Migrating the control plane from seed %q (%s) to seed %q", sourceSeedName, reason, targetSeedName)
+
+	return r.removeProposals(ctx, []*gardencorev1beta1.Shoot{s})
+}
+
+func (r *Reconciler) removeProposals(ctx context.Context, shoots []*gardencorev1beta1.Shoot) error {
+	for _, s := range shoots {
+		if _, ok := s.Annotations[v1beta1constants.AnnotationSchedulingProposedSeed]; !ok {
+			continue
+		}
+
+		patch := client.MergeFrom(s.DeepCopy())
+		delete(s.Annotations, v1beta1constants.AnnotationSchedulingProposedSeed)
+		if err := r.Client.Patch(ctx, s, patch); err != nil {
+			return fmt.Errorf("failed removing proposed target seed from shoot %s: %w", client.ObjectKeyFromObject(s), err)
+		}
+	}
+
+	return nil
+}
+
+// durationUntilNextBegin returns the duration from the given time until the next begin of the given time window.
+func durationUntilNextBegin(timeWindow *timewindow.MaintenanceTimeWindow, now time.Time) time.Duration {
+	now = now.UTC()
+
+	begin := timeWindow.AdjustedBegin(now)
+	if !begin.After(now) {
+		begin = begin.AddDate(0, 0, 1)
+	}
+
+	return begin.Sub(now)
+}
+
+// isMigrating returns true if the control plane of the given shoot is currently migrated to another seed.
+func isMigrating(s *gardencorev1beta1.Shoot) bool {
+	return s.Status.SeedName != nil && s.Spec.SeedName != nil && *s.Status.SeedName != *s.Spec.SeedName
+}
@@ -0,0 +1,269 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package seedrebalancing_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	gomegatypes "github.com/onsi/gomega/types"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/scheduler/apis/config"
+	. "github.com/gardener/gardener/pkg/scheduler/controller/seedrebalancing"
+	"github.com/gardener/gardener/pkg/scheduler/controller/shoot"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx        = logf.IntoContext(context.Background(), logr.Discard())
+		fakeClient client.Client
+		fakeClock  *testclock.FakeClock
+		recorder   *record.FakeRecorder
+		reconciler *Reconciler
+
+		cloudProfile *gardencorev1beta1.CloudProfile
+		seed         *gardencorev1beta1.Seed
+		otherSeed    *gardencorev1beta1.Seed
+		shoot1       *gardencorev1beta1.Shoot
+		shoot2       *gardencorev1beta1.Shoot
+
+		newSeed = func(name string) *gardencorev1beta1.Seed {
+			return &gardencorev1beta1.Seed{
+				ObjectMeta: metav1.ObjectMeta{Name: name},
+				Spec: gardencorev1beta1.SeedSpec{
+					Provider: gardencorev1beta1.SeedProvider{Type: "local", Region: "europe"},
+					Networks: gardencorev1beta1.SeedNetworks{
+						Nodes:    ptr.To("10.10.0.0/16"),
+						Pods:     "10.20.0.0/16",
+						Services: "10.30.0.0/16",
+					},
+					Settings: &gardencorev1beta1.SeedSettings{
+						Scheduling: &gardencorev1beta1.SeedSettingScheduling{Visible: true},
+					},
+				},
+				Status: gardencorev1beta1.SeedStatus{
+					Conditions: []gardencorev1beta1.Condition{
+						{Type: gardencorev1beta1.SeedGardenletReady, Status: gardencorev1beta1.ConditionTrue},
+					},
+					LastOperation: &gardencorev1beta1.LastOperation{},
+				},
+			}
+		}
+
+		newShoot = func(name, seedName string) *gardencorev1beta1.Shoot {
+			return &gardencorev1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "garden-foo"},
+				Spec: gardencorev1beta1.ShootSpec{
+					CloudProfileName: ptr.To("local"),
+					Region:           "europe",
+					Provider:         gardencorev1beta1.Provider{Type: "local"},
+					Networking: &gardencorev1beta1.Networking{
+						Nodes:    ptr.To("10.40.0.0/16"),
+						Pods:     ptr.To("10.50.0.0/16"),
+						Services: ptr.To("10.60.0.0/16"),
+					},
+					Maintenance: &gardencorev1beta1.Maintenance{
+						TimeWindow: &gardencorev1beta1.MaintenanceTimeWindow{Begin: "220000+0000", End: "230000+0000"},
+					},
+					SeedName: &seedName,
+				},
+				Status: gardencorev1beta1.ShootStatus{SeedName: &seedName},
+			}
+		}
+
+		request reconcile.Request
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).WithInterceptorFuncs(interceptor.Funcs{
+			// The fake client does not know the shoots/binding subresource, hence emulate it with a regular update.
+			SubResourceUpdate: func(ctx context.Context, c client.Client, subResourceName string, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+				if subResourceName == "binding" {
+					return c.Update(ctx, obj)
+				}
+				return c.SubResource(subResourceName).Update(ctx, obj, opts...)
+			},
+		}).Build()
+		fakeClock = testclock.NewFakeClock(time.Date(2024, 1, 1, 22, 30, 0, 0, time.UTC))
+		recorder = record.NewFakeRecorder(10)
+
+		reconciler = &Reconciler{
+			Client:    fakeClient,
+			APIReader: fakeClient,
+			Config: &config.SeedRebalancingSchedulerConfiguration{
+				SyncPeriod:              &metav1.Duration{Duration: time.Hour},
+				Mode:                    config.SeedRebalancingModePropose,
+				OverloadThreshold:       ptr.To[int32](50),
+				MaxConcurrentMigrations: ptr.To[int32](1),
+			},
+			ShootScheduler: &shoot.Reconciler{
+				Client: fakeClient,
+				Config: &config.ShootSchedulerConfiguration{Strategy: config.SameRegion},
+			},
+			Clock:    fakeClock,
+			Recorder: recorder,
+		}
+
+		cloudProfile = &gardencorev1beta1.CloudProfile{ObjectMeta: metav1.ObjectMeta{Name: "local"}}
+		seed = newSeed("seed-1")
+		otherSeed = newSeed("seed-2")
+		shoot1 = newShoot("shoot-1", seed.Name)
+		shoot2 = newShoot("shoot-2", seed.Name)
+
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(seed)}
+	})
+
+	JustBeforeEach(func() {
+		for _, obj := range []client.Object{cloudProfile, seed, otherSeed, shoot1, shoot2} {
+			Expect(fakeClient.Create(ctx, obj)).To(Succeed())
+		}
+	})
+
+	expectProposal := func(s *gardencorev1beta1.Shoot, matcher gomegatypes.GomegaMatcher) {
+		ExpectWithOffset(1, fakeClient.Get(ctx, client.ObjectKeyFromObject(s), s)).To(Succeed())
+		ExpectWithOffset(1, s.Annotations).To(matcher)
+	}
+
+	It("should do nothing if the seed is neither overloaded nor being drained", func() {
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+
+		expectProposal(shoot1, Not(HaveKey(v1beta1constants.AnnotationSchedulingProposedSeed)))
+		expectProposal(shoot2, Not(HaveKey(v1beta1constants.AnnotationSchedulingProposedSeed)))
+	})
+
+	Context("seed is being drained", func() {
+		BeforeEach(func() {
+			metav1.SetMetaDataAnnotation(&seed.ObjectMeta, v1beta1constants.AnnotationSchedulingDrain, "true")
+		})
+
+		It("should propose a target seed for all shoots", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+
+			expectProposal(shoot1, HaveKeyWithValue(v1beta1constants.AnnotationSchedulingProposedSeed, otherSeed.Name))
+			expectProposal(shoot2, HaveKeyWithValue(v1beta1constants.AnnotationSchedulingProposedSeed, otherSeed.Name))
+			Expect(shoot1.Spec.SeedName).To(PointTo(Equal(seed.Name)))
+			Expect(recorder.Events).To(Receive(ContainSubstring(EventSeedRebalancingProposed)))
+		})
+
+		It("should report an event if no target seed can be determined", func() {
+			metav1.SetMetaDataAnnotation(&otherSeed.ObjectMeta, v1beta1constants.AnnotationSchedulingDrain, "true")
+			Expect(fakeClient.Update(ctx, otherSeed)).To(Succeed())
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+
+			expectProposal(shoot1, Not(HaveKey(v1beta1constants.AnnotationSchedulingProposedSeed)))
+			Expect(recorder.Events).To(Receive(ContainSubstring(EventSeedRebalancingFailed)))
+		})
+	})
+
+	Context("seed is overloaded", func() {
+		BeforeEach(func() {
+			seed.Status.Allocatable = corev1.ResourceList{gardencorev1beta1.ResourceShoots: resource.MustParse("2")}
+		})
+
+		It("should only propose a target seed for the shoots exceeding the threshold", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+
+			expectProposal(shoot1, HaveKeyWithValue(v1beta1constants.AnnotationSchedulingProposedSeed, otherSeed.Name))
+			expectProposal(shoot2, Not(HaveKey(v1beta1constants.AnnotationSchedulingProposedSeed)))
+		})
+
+		It("should prefer shoots which already have a proposal", func() {
+			metav1.SetMetaDataAnnotation(&shoot2.ObjectMeta, v1beta1constants.AnnotationSchedulingProposedSeed, otherSeed.Name)
+			Expect(fakeClient.Update(ctx, shoot2)).To(Succeed())
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+
+			expectProposal(shoot1, Not(HaveKey(v1beta1constants.AnnotationSchedulingProposedSeed)))
+			expectProposal(shoot2, HaveKeyWithValue(v1beta1constants.AnnotationSchedulingProposedSeed, otherSeed.Name))
+		})
+
+		It("should remove proposals once the seed is no longer overloaded", func() {
+			metav1.SetMetaDataAnnotation(&shoot1.ObjectMeta, v1beta1constants.AnnotationSchedulingProposedSeed, otherSeed.Name)
+			Expect(fakeClient.Update(ctx, shoot1)).To(Succeed())
+			seed.Status.Allocatable = nil
+			Expect(fakeClient.Update(ctx, seed)).To(Succeed())
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+
+			expectProposal(shoot1, Not(HaveKey(v1beta1constants.AnnotationSchedulingProposedSeed)))
+		})
+
+		Context("approved mode", func() {
+			BeforeEach(func() {
+				reconciler.Config.Mode = config.SeedRebalancingModeApproved
+			})
+
+			It("should trigger the control plane migration during the maintenance time window", func() {
+				Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+
+				expectProposal(shoot1, Not(HaveKey(v1beta1constants.AnnotationSchedulingProposedSeed)))
+				Expect(shoot1.Spec.SeedName).To(PointTo(Equal(otherSeed.Name)))
+				Expect(shoot1.Status.SeedName).To(PointTo(Equal(seed.Name)))
+
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot2), shoot2)).To(Succeed())
+				Expect(shoot2.Spec.SeedName).To(PointTo(Equal(seed.Name)))
+			})
+
+			It("should not trigger the control plane migration outside of the maintenance time window", func() {
+				fakeClock.SetTime(time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC))
+
+				Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+
+				expectProposal(shoot1, HaveKeyWithValue(v1beta1constants.AnnotationSchedulingProposedSeed, otherSeed.Name))
+				Expect(shoot1.Spec.SeedName).To(PointTo(Equal(seed.Name)))
+			})
+
+			It("should requeue at the begin of the next maintenance time window if it starts before the next sync", func() {
+				fakeClock.SetTime(time.Date(2024, 1, 1, 21, 45, 0, 0, time.UTC))
+
+				Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: 15 * time.Minute}))
+
+				expectProposal(shoot1, HaveKeyWithValue(v1beta1constants.AnnotationSchedulingProposedSeed, otherSeed.Name))
+				Expect(shoot1.Spec.SeedName).To(PointTo(Equal(seed.Name)))
+			})
+
+			It("should requeue at the begin of the maintenance time window of the next day", func() {
+				reconciler.Config.SyncPeriod = &metav1.Duration{Duration: 48 * time.Hour}
+				fakeClock.SetTime(time.Date(2024, 1, 1, 23, 30, 0, 0, time.UTC))
+
+				Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: 22*time.Hour + 30*time.Minute}))
+			})
+
+			It("should not trigger more migrations than allowed", func() {
+				seed.Status.Allocatable = corev1.ResourceList{gardencorev1beta1.ResourceShoots: resource.MustParse("1")}
+				Expect(fakeClient.Update(ctx, seed)).To(Succeed())
+				reconciler.Config.OverloadThreshold = ptr.To[int32](100)
+
+				migratingShoot := newShoot("shoot-3", otherSeed.Name)
+				migratingShoot.Status.SeedName = ptr.To("seed-3")
+				Expect(fakeClient.Create(ctx, migratingShoot)).To(Succeed())
+
+				Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+
+				expectProposal(shoot1, HaveKeyWithValue(v1beta1constants.AnnotationSchedulingProposedSeed, otherSeed.Name))
+				Expect(shoot1.Spec.SeedName).To(PointTo(Equal(seed.Name)))
+			})
+		})
+	})
+})
@@ -0,0 +1,144 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot
+
+import (
+	"sort"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/scheduler/apis/config"
+)
+
+// Names of the filters which can reject seeds during the seed determination. The filter plugins of the Scored strategy
+// reject seeds under their plugin name.
+const (
+	// FilterUsable rejects seeds which are being deleted, not visible for scheduling or not ready.
+	FilterUsable = "Usable"
+	// FilterCloudProfileSeedSelector rejects seeds not matching the seed selector of the cloud profile.
+	FilterCloudProfileSeedSelector = "CloudProfileSeedSelector"
+	// FilterShootSeedSelector rejects seeds not matching the seed selector of the shoot.
+	FilterShootSeedSelector = "ShootSeedSelector"
+	// FilterProvider rejects seeds whose provider type is not enabled for the shoot's provider type.
+	FilterProvider = "Provider"
+	// FilterZones rejects seeds with less than three zones for shoots with failure tolerance type 'zone'.
+	FilterZones = "Zones"
+	// FilterAccessRestrictions rejects seeds not supporting the access restrictions of the shoot.
+	FilterAccessRestrictions = "AccessRestrictions"
+	// FilterNetworks rejects seeds whose networks are not disjoint with the networks of the shoot.
+	FilterNetworks = "Networks"
+	// FilterTaints rejects seeds whose taints are not tolerated by the shoot.
+	FilterTaints = "Taints"
+	// FilterCapacity rejects seeds without available capacity for shoots.
+	FilterCapacity = "Capacity"
+	// FilterStrategy rejects seeds which are not a candidate according to the seed determination strategy.
+	FilterStrategy = "Strategy"
+)
+
+// Explanation explains how the seed for a shoot was determined.
+type Explanation struct {
+	// Shoot is the namespace and name of the shoot in the format <namespace>/<name>.
+	Shoot string `json:"shoot"`
+	// Strategy is the seed determination strategy.
+	Strategy config.CandidateDeterminationStrategy `json:"strategy"`
+	// Seeds contains the explanation for all seeds.
+	Seeds []SeedExplanation `json:"seeds"`
+	// SeedName is the name of the chosen seed. It is empty if no seed could be determined.
+	SeedName string `json:"seedName,omitempty"`
+	// Error is the reason why no seed could be determined.
+	Error string `json:"error,omitempty"`
+
+	seedIndex map[string]int
+}
+
+// SeedExplanation explains the result of the seed determination for a single seed.
+type SeedExplanation struct {
+	// Name is the name of the seed.
+	Name string `json:"name"`
+	// Shoots is the number of shoots currently scheduled onto the seed.
+	Shoots int `json:"shoots"`
+	// RejectedBy is the name of the filter which rejected the seed.
+	RejectedBy string `json:"rejectedBy,omitempty"`
+	// Reason is the reason why the seed was rejected.
+	Reason string `json:"reason,omitempty"`
+	// Score is the weighted sum of the scores of the seed. It is only set for the Scored strategy.
+	Score *int64 `json:"score,omitempty"`
+	// Rank is the position of the seed in the final ranking of the remaining candidates, starting with 1 for the chosen
+	// seed. It is only set if the seed was not rejected.
+	Rank int `json:"rank,omitempty"`
+}
+
+func newExplanation(shoot *gardencorev1beta1.Shoot, strategy config.CandidateDeterminationStrategy) *Explanation {
+	return &Explanation{
+		Shoot:     client.ObjectKeyFromObject(shoot).String(),
+		Strategy:  strategy,
+		seedIndex: make(map[string]int),
+	}
+}
+
+// The following methods are no-ops for a nil explanation so that they can be called unconditionally during the seed
+// determination.
+
+func (e *Explanation) addSeeds(seeds []gardencorev1beta1.Seed, seedUsage map[string]int) {
+	if e == nil {
+		return
+	}
+
+	for _, seed := range seeds {
+		e.seedIndex[seed.Name] = len(e.Seeds)
+		e.Seeds = append(e.Seeds, SeedExplanation{Name: seed.Name, Shoots: seedUsage[seed.Name]})
+	}
+}
+
+func (e *Explanation) reject(seedName, filter, reason string) {
+	if e == nil {
+		return
+	}
+
+	if i, ok := e.seedIndex[seedName]; ok {
+		e.Seeds[i].RejectedBy = filter
+		e.Seeds[i].Reason = reason
+	}
+}
+
+func (e *Explanation) rank(seedName string, rank int, score *int64) {
+	if e == nil {
+		return
+	}
+
+	if i, ok := e.seedIndex[seedName]; ok {
+		e.Seeds[i].Rank = rank
+		e.Seeds[i].Score = score
+	}
+}
+
+// chooseSeed ranks the given seeds by their score (if given) and the number of shoots deployed, and returns the best
+// one. Seeds with a higher score and, in case of equal scores, seeds with less shoots are preferred. Seeds that are
+// equal in both are kept in their original order.
+func chooseSeed(seeds []gardencorev1beta1.Seed, scores []int64, seedUsage map[string]int, explanation *Explanation) *gardencorev1beta1.Seed {
+	order := make([]int, len(seeds))
+	for i := range order {
+		order[i] = i
+	}
+
+	sort.SliceStable(order, func(a, b int) bool {
+		i, j := order[a], order[b]
+		if scores != nil && scores[i] != scores[j] {
+			return scores[i] > scores[j]
+		}
+		return seedUsage[seeds[i].Name] < seedUsage[seeds[j].Name]
+	})
+
+	for rank, i := range order {
+		var score *int64
+		if scores != nil {
+			score = &scores[i]
+		}
+		explanation.rank(seeds[i].Name, rank+1, score)
+	}
+
+	return &seeds[order[0]]
+}
@@ -18,6 +18,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -123,6 +124,31 @@ func (r *Reconciler) DetermineSeed(
 	*gardencorev1beta1.Seed,
 	error,
 ) {
+	return r.determineSeed(ctx, log, shoot, nil, nil)
+}
+
+// DetermineTargetSeed returns an appropriate Seed cluster for migrating the control plane of an already scheduled
+// shoot. The seeds with the given names are not considered as candidates.
+func (r *Reconciler) DetermineTargetSeed(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot, excludedSeeds sets.Set[string]) (*gardencorev1beta1.Seed, error) {
+	return r.determineSeed(ctx, log, shoot, excludedSeeds, nil)
+}
+
+// ExplainSeedDetermination determines the seed for the given shoot like DetermineSeed and explains the decision. The
+// explanation lists all seeds together with the filter that rejected them and the ranking of the remaining candidates.
+func (r *Reconciler) ExplainSeedDetermination(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot) *Explanation {
+	explanation := newExplanation(shoot, r.Config.Strategy)
+
+	seed, err := r.determineSeed(ctx, log, shoot, nil, explanation)
+	if err != nil {
+		explanation.Error = err.Error()
+	} else {
+		explanation.SeedName = seed.Name
+	}
+
+	return explanation
+}
+
+func (r *Reconciler) determineSeed(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot, excludedSeeds sets.Set[string], explanation *Explanation) (*gardencorev1beta1.Seed, error) {
 	seedList := &gardencorev1beta1.SeedList{}
 	if err := r.Client.List(ctx, seedList); err != nil {
 		return nil, err
@@ -132,7 +158,21 @@ func (r *Reconciler) DetermineSeed(
 		return nil, err
 	}
 
-	shootList := v1beta1helper.ConvertShootList(sl.Items)
+	var (
+		shootList = v1beta1helper.ConvertShootList(sl.Items)
+		seedUsage = v1beta1helper.CalculateSeedUsage(shootList)
+	)
+	explanation.addSeeds(seedList.Items, seedUsage)
+
+	seeds := seedList.Items
+	if excludedSeeds.Len() > 0 {
+		seeds = slices.DeleteFunc(slices.Clone(seeds), func(seed gardencorev1beta1.Seed) bool {
+			return excludedSeeds.Has(seed.Name)
+		})
+		if len(seeds) == 0 {
+			return nil, fmt.Errorf("none of the %d seeds is a candidate, all of them are excluded", len(seedList.Items))
+		}
+	}
 
 	cloudProfile, err := gardenerutils.GetCloudProfile(ctx, r.Client, shoot)
 	if err != nil {
@@ -143,39 +183,53 @@ func (r *Reconciler) DetermineSeed(
 		return nil, err
 	}
 
-	filteredSeeds, err := filterUsableSeeds(seedList.Items)
+	filteredSeeds, err := filterUsableSeeds(seeds, explanation)
 	if err != nil {
 		return nil, err
 	}
-	filteredSeeds, err = filterSeedsMatchingLabelSelector(filteredSeeds, cloudProfile.Spec.SeedSelector, "CloudProfile")
+	filteredSeeds, err = filterSeedsMatchingLabelSelector(filteredSeeds, cloudProfile.Spec.SeedSelector, "CloudProfile", FilterCloudProfileSeedSelector, explanation)
 	if err != nil {
 		return nil, err
 	}
-	filteredSeeds, err = filterSeedsMatchingLabelSelector(filteredSeeds, shoot.Spec.SeedSelector, "Shoot")
+	filteredSeeds, err = filterSeedsMatchingLabelSelector(filteredSeeds, shoot.Spec.SeedSelector, "Shoot", FilterShootSeedSelector, explanation)
 	if err != nil {
 		return nil, err
 	}
-	filteredSeeds, err = filterSeedsMatchingProviders(cloudProfile, shoot, filteredSeeds)
+	filteredSeeds, err = filterSeedsMatchingProviders(cloudProfile, shoot, filteredSeeds, explanation)
 	if err != nil {
 		return nil, err
 	}
-	filteredSeeds, err = filterSeedsForZonalShootControlPlanes(filteredSeeds, shoot)
+	filteredSeeds, err = filterSeedsForZonalShootControlPlanes(filteredSeeds, shoot, explanation)
 	if err != nil {
 		return nil, err
 	}
-	filteredSeeds, err = filterSeedsForAccessRestrictions(filteredSeeds, shoot)
+	filteredSeeds, err = filterSeedsForAccessRestrictions(filteredSeeds, shoot, explanation)
 	if err != nil {
 		return nil, err
 	}
-	filteredSeeds, err = filterCandidates(shoot, shootList, filteredSeeds)
+	filteredSeeds, err = filterCandidates(shoot, seedUsage, filteredSeeds, explanation)
 	if err != nil {
 		return nil, err
 	}
-	filteredSeeds, err = applyStrategy(log, shoot, filteredSeeds, r.Config.Strategy, regionConfig)
+
+	if r.Config.Strategy == config.Scored {
+		candidates, scores, err := applyScoredStrategy(log, shoot, shootList, filteredSeeds, r.Config.Scoring, regionConfig, explanation)
+		if err != nil {
+			return nil, err
+		}
+		return chooseSeed(candidates, scores, seedUsage, explanation), nil
+	}
+
+	candidates, err := applyStrategy(log, shoot, filteredSeeds, r.Config.Strategy, regionConfig)
 	if err != nil {
+		for _, seed := range filteredSeeds {
+			explanation.reject(seed.Name, FilterStrategy, err.Error())
+		}
 		return nil, err
 	}
-	return getSeedWithLeastShootsDeployed(filteredSeeds, shootList)
+	rejectSeedsNotInCandidates(filteredSeeds, candidates, FilterStrategy, strategyRejectionReason(shoot, r.Config.Strategy), explanation)
+
+	return chooseSeed(candidates, nil, seedUsage, explanation), nil
 }
 
 func (r *Reconciler) getRegionConfigMap(ctx context.Context, log logr.Logger, cloudProfile *gardencorev1beta1.CloudProfile) (*corev1.ConfigMap, error) {
@@ -206,17 +260,28 @@ func (r *Reconciler) getRegionConfigMap(ctx context.Context, log logr.Logger, cl
 	return regionConfig, nil
 }
 
-func isUsableSeed(seed *gardencorev1beta1.Seed) bool {
-	return seed.DeletionTimestamp == nil && seed.Spec.Settings.Scheduling.Visible && verifySeedReadiness(seed)
+// unusableSeedReason returns the reason why the given seed cannot be used for scheduling, or an empty string if it is
+// usable.
+func unusableSeedReason(seed *gardencorev1beta1.Seed) string {
+	switch {
+	case seed.DeletionTimestamp != nil:
+		return "seed is being deleted"
+	case !seed.Spec.Settings.Scheduling.Visible:
+		return "seed is not visible for scheduling"
+	default:
+		return seedReadinessReason(seed)
+	}
 }
 
-func filterUsableSeeds(seedList []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+func filterUsableSeeds(seedList []gardencorev1beta1.Seed, explanation *Explanation) ([]gardencorev1beta1.Seed, error) {
 	var matchingSeeds []gardencorev1beta1.Seed
 
 	for _, seed := range seedList {
-		if isUsableSeed(&seed) {
-			matchingSeeds = append(matchingSeeds, seed)
+		if reason := unusableSeedReason(&seed); reason != "" {
+			explanation.reject(seed.Name, FilterUsable, reason)
+			continue
 		}
+		matchingSeeds = append(matchingSeeds, seed)
 	}
 
 	if len(matchingSeeds) == 0 {
@@ -225,7 +290,7 @@ func filterUsableSeeds(seedList []gardencorev1beta1.Seed) ([]gardencorev1beta1.S
 	return matchingSeeds, nil
 }
 
-func filterSeedsMatchingLabelSelector(seedList []gardencorev1beta1.Seed, seedSelector *gardencorev1beta1.SeedSelector, kind string) ([]gardencorev1beta1.Seed, error) {
+func filterSeedsMatchingLabelSelector(seedList []gardencorev1beta1.Seed, seedSelector *gardencorev1beta1.SeedSelector, kind, filter string, explanation *Explanation) ([]gardencorev1beta1.Seed, error) {
 	if seedSelector == nil {
 		return seedList, nil
 	}
@@ -236,9 +301,11 @@ func filterSeedsMatchingLabelSelector(seedList []gardencorev1beta1.Seed, seedSel
 
 	var matchingSeeds []gardencorev1beta1.Seed
 	for _, seed := range seedList {
-		if selector.Matches(labels.Set(seed.Labels)) {
-			matchingSeeds = append(matchingSeeds, seed)
+		if !selector.Matches(labels.Set(seed.Labels)) {
+			explanation.reject(seed.Name, filter, fmt.Sprintf("seed labels do not match the seed selector of the %s (selector: '%s')", kind, selector.String()))
+			continue
 		}
+		matchingSeeds = append(matchingSeeds, seed)
 	}
 
 	if len(matchingSeeds) == 0 {
@@ -247,7 +314,7 @@ func filterSeedsMatchingLabelSelector(seedList []gardencorev1beta1.Seed, seedSel
 	return matchingSeeds, nil
 }
 
-func filterSeedsMatchingProviders(cloudProfile *gardencorev1beta1.CloudProfile, shoot *gardencorev1beta1.Shoot, seedList []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+func filterSeedsMatchingProviders(cloudProfile *gardencorev1beta1.CloudProfile, shoot *gardencorev1beta1.Shoot, seedList []gardencorev1beta1.Seed, explanation *Explanation) ([]gardencorev1beta1.Seed, error) {
 	var possibleProviders []string
 	if cloudProfile.Spec.SeedSelector != nil {
 		possibleProviders = cloudProfile.Spec.SeedSelector.ProviderTypes
@@ -255,9 +322,11 @@ func filterSeedsMatchingProviders(cloudProfile *gardencorev1beta1.CloudProfile,
 
 	var matchingSeeds []gardencorev1beta1.Seed
 	for _, seed := range seedList {
-		if matchProvider(seed.Spec.Provider.Type, shoot.Spec.Provider.Type, possibleProviders) {
-			matchingSeeds = append(matchingSeeds, seed)
+		if !matchProvider(seed.Spec.Provider.Type, shoot.Spec.Provider.Type, possibleProviders) {
+			explanation.reject(seed.Name, FilterProvider, fmt.Sprintf("seed provider %q does not match provider %q of the shoot", seed.Spec.Provider.Type, shoot.Spec.Provider.Type))
+			continue
 		}
+		matchingSeeds = append(matchingSeeds, seed)
 	}
 
 	if len(matchingSeeds) == 0 {
@@ -268,13 +337,15 @@ func filterSeedsMatchingProviders(cloudProfile *gardencorev1beta1.CloudProfile,
 
 // filterSeedsForZonalShootControlPlanes filters seeds with at least three zones in case the shoot's failure tolerance
 // type is 'zone'.
-func filterSeedsForZonalShootControlPlanes(seedList []gardencorev1beta1.Seed, shoot *gardencorev1beta1.Shoot) ([]gardencorev1beta1.Seed, error) {
+func filterSeedsForZonalShootControlPlanes(seedList []gardencorev1beta1.Seed, shoot *gardencorev1beta1.Shoot, explanation *Explanation) ([]gardencorev1beta1.Seed, error) {
 	if v1beta1helper.IsMultiZonalShootControlPlane(shoot) {
 		var seedsWithAtLeastThreeZones []gardencorev1beta1.Seed
 		for _, seed := range seedList {
-			if len(seed.Spec.Provider.Zones) >= 3 {
-				seedsWithAtLeastThreeZones = append(seedsWithAtLeastThreeZones, seed)
+			if len(seed.Spec.Provider.Zones) < 3 {
+				explanation.reject(seed.Name, FilterZones, fmt.Sprintf("seed has %d zone(s), but at least 3 are required for a shoot control plane with failure tolerance type 'zone'", len(seed.Spec.Provider.Zones)))
+				continue
 			}
+			seedsWithAtLeastThreeZones = append(seedsWithAtLeastThreeZones, seed)
 		}
 		if len(seedsWithAtLeastThreeZones) == 0 {
 			return nil, fmt.Errorf("none of the %d seeds has at least 3 zones for hosting a shoot control plane with failure tolerance type 'zone'", len(seedList))
@@ -285,12 +356,14 @@ func filterSeedsForZonalShootControlPlanes(seedList []gardencorev1beta1.Seed, sh
 }
 
 // filterSeedsForAccessRestrictions filters seeds which do not support the access restrictions configured in the shoot.
-func filterSeedsForAccessRestrictions(seedList []gardencorev1beta1.Seed, shoot *gardencorev1beta1.Shoot) ([]gardencorev1beta1.Seed, error) {
+func filterSeedsForAccessRestrictions(seedList []gardencorev1beta1.Seed, shoot *gardencorev1beta1.Shoot, explanation *Explanation) ([]gardencorev1beta1.Seed, error) {
 	var seedsSupportingAccessRestrictions []gardencorev1beta1.Seed
 	for _, seed := range seedList {
-		if v1beta1helper.AccessRestrictionsAreSupported(seed.Spec.AccessRestrictions, shoot.Spec.AccessRestrictions) {
-			seedsSupportingAccessRestrictions = append(seedsSupportingAccessRestrictions, seed)
+		if !v1beta1helper.AccessRestrictionsAreSupported(seed.Spec.AccessRestrictions, shoot.Spec.AccessRestrictions) {
+			explanation.reject(seed.Name, FilterAccessRestrictions, "seed does not support the access restrictions configured in the shoot specification")
+			continue
 		}
+		seedsSupportingAccessRestrictions = append(seedsSupportingAccessRestrictions, seed)
 	}
 
 	if len(seedsSupportingAccessRestrictions) == 0 {
@@ -329,28 +402,30 @@ func applyStrategy(log logr.Logger, shoot *gardencorev1beta1.Shoot, seedList []g
 	return candidates, nil
 }
 
-func filterCandidates(shoot *gardencorev1beta1.Shoot, shootList []*gardencorev1beta1.Shoot, seedList []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+func filterCandidates(shoot *gardencorev1beta1.Shoot, seedUsage map[string]int, seedList []gardencorev1beta1.Seed, explanation *Explanation) ([]gardencorev1beta1.Seed, error) {
 	var (
 		candidates    []gardencorev1beta1.Seed
 		seedNameToErr = make(map[string]error)
-		seedUsage     = v1beta1helper.CalculateSeedUsage(shootList)
 	)
 
 	for _, seed := range seedList {
 		if shoot.Spec.Networking != nil {
 			if disjointed, err := networksAreDisjointed(&seed, shoot); !disjointed {
 				seedNameToErr[seed.Name] = err
+				explanation.reject(seed.Name, FilterNetworks, err.Error())
 				continue
 			}
 		}
 
 		if !v1beta1helper.TaintsAreTolerated(seed.Spec.Taints, shoot.Spec.Tolerations) {
 			seedNameToErr[seed.Name] = errors.New("shoot does not tolerate the seed's taints")
+			explanation.reject(seed.Name, FilterTaints, seedNameToErr[seed.Name].Error())
 			continue
 		}
 
 		if allocatableShoots, ok := seed.Status.Allocatable[gardencorev1beta1.ResourceShoots]; ok && int64(seedUsage[seed.Name]) >= allocatableShoots.Value() {
 			seedNameToErr[seed.Name] = errors.New("seed does not have available capacity for shoots")
+			explanation.reject(seed.Name, FilterCapacity, seedNameToErr[seed.Name].Error())
 			continue
 		}
 
@@ -363,22 +438,29 @@ func filterCandidates(shoot *gardencorev1beta1.Shoot, shootList []*gardencorev1b
 	return candidates, nil
 }
 
-// getSeedWithLeastShootsDeployed finds the best candidate (i.e. the one managing the smallest number of shoots right now).
-func getSeedWithLeastShootsDeployed(seedList []gardencorev1beta1.Seed, shootList []*gardencorev1beta1.Shoot) (*gardencorev1beta1.Seed, error) {
-	var (
-		bestCandidate gardencorev1beta1.Seed
-		min           *int
-		seedUsage     = v1beta1helper.CalculateSeedUsage(shootList)
-	)
+// rejectSeedsNotInCandidates rejects all seeds of the given list which are not part of the given candidates.
+func rejectSeedsNotInCandidates(seedList, candidates []gardencorev1beta1.Seed, filter, reason string, explanation *Explanation) {
+	candidateNames := make(map[string]struct{}, len(candidates))
+	for _, seed := range candidates {
+		candidateNames[seed.Name] = struct{}{}
+	}
 
 	for _, seed := range seedList {
-		if numberOfManagedShoots := seedUsage[seed.Name]; min == nil || numberOfManagedShoots < *min {
-			bestCandidate = seed
-			min = &numberOfManagedShoots
+		if _, ok := candidateNames[seed.Name]; !ok {
+			explanation.reject(seed.Name, filter, reason)
 		}
 	}
+}
 
-	return &bestCandidate, nil
+func strategyRejectionReason(shoot *gardencorev1beta1.Shoot, strategy config.CandidateDeterminationStrategy) string {
+	switch {
+	case shoot.Spec.Purpose != nil && *shoot.Spec.Purpose == gardencorev1beta1.ShootPurposeTesting:
+		return "seed provider does not match the provider of the shoot with purpose 'testing'"
+	case strategy == config.SameRegion:
+		return fmt.Sprintf("seed provider or region does not match the provider and region of the shoot (strategy %s)", strategy)
+	default:
+		return fmt.Sprintf("seed region does not have the minimal distance to the region of the shoot (strategy %s)", strategy)
+	}
 }
 
 func matchProvider(seedProviderType, shootProviderType string, enabledProviderTypes []string) bool {
@@ -432,21 +514,15 @@ func determineCandidatesWithMinimalDistanceStrategy(log logr.Logger, shoot *gard
 func regionConfigMinimalDistance(log logr.Logger, seeds []gardencorev1beta1.Seed, shoot *gardencorev1beta1.Shoot, regionConfig *corev1.ConfigMap) ([]gardencorev1beta1.Seed, error) {
 	var candidates []gardencorev1beta1.Seed
 
-	if regionConfig == nil || regionConfig.Data[shoot.Spec.Region] == "" {
+	regionConfigData, err := parseRegionConfig(regionConfig, shoot.Spec.Region)
+	if err != nil {
+		return nil, err
+	}
+	if regionConfigData == nil {
 		log.Info("Region ConfigMap not provided or Shoot region not available", "region", shoot.Spec.Region)
 		return candidates, nil
 	}
 
-	regionConfigData := make(map[string]int)
-	if err := yaml.Unmarshal([]byte(regionConfig.Data[shoot.Spec.Region]), &regionConfigData); err != nil {
-		return nil, fmt.Errorf("failed to determine seed candidates. Wrong format in region ConfigMap %s/%s, Region %q: %w", regionConfig.Namespace, regionConfig.Name, shoot.Spec.Region, err)
-	}
-
-	// If not configured otherwise, assume that a region has the smallest possible distance to itself.
-	if _, ok := regionConfigData[shoot.Spec.Region]; !ok {
-		regionConfigData[shoot.Spec.Region] = 0
-	}
-
 	minDistance := math.MaxInt32
 	for _, seed := range seeds {
 		dist, ok := regionConfigData[seed.Spec.Provider.Region]
@@ -469,6 +545,26 @@ func regionConfigMinimalDistance(log logr.Logger, seeds []gardencorev1beta1.Seed
 	return candidates, nil
 }
 
+// parseRegionConfig returns the distances of all regions to the given region configured in the region ConfigMap. It
+// returns nil if the region ConfigMap is not provided or does not contain the given region.
+func parseRegionConfig(regionConfig *corev1.ConfigMap, region string) (map[string]int, error) {
+	if regionConfig == nil || regionConfig.Data[region] == "" {
+		return nil, nil
+	}
+
+	regionConfigData := make(map[string]int)
+	if err := yaml.Unmarshal([]byte(regionConfig.Data[region]), &regionConfigData); err != nil {
+		return nil, fmt.Errorf("failed to determine seed candidates. Wrong format in region ConfigMap %s/%s, Region %q: %w", regionConfig.Namespace, regionConfig.Name, region, err)
+	}
+
+	// If not configured otherwise, assume that a region has the smallest possible distance to itself.
+	if _, ok := regionConfigData[region]; !ok {
+		regionConfigData[region] = 0
+	}
+
+	return regionConfigData, nil
+}
+
 func levenshteinMinimalDistance(seeds []gardencorev1beta1.Seed, shoot *gardencorev1beta1.Shoot) []gardencorev1beta1.Seed {
 	var (
 		minDistance   = 1000
@@ -560,19 +656,24 @@ func errorMapToString(seedNameToErr map[string]error) string {
 }
 
 func verifySeedReadiness(seed *gardencorev1beta1.Seed) bool {
+	return seedReadinessReason(seed) == ""
+}
+
+// seedReadinessReason returns the reason why the given seed is not ready, or an empty string if it is ready.
+func seedReadinessReason(seed *gardencorev1beta1.Seed) string {
 	if seed.Status.LastOperation == nil {
-		return false
+		return "seed has not been reconciled yet"
 	}
 
 	if cond := v1beta1helper.GetCondition(seed.Status.Conditions, gardencorev1beta1.SeedGardenletReady); cond == nil || cond.Status != gardencorev1beta1.ConditionTrue {
-		return false
+		return fmt.Sprintf("condition %s is not %s", gardencorev1beta1.SeedGardenletReady, gardencorev1beta1.ConditionTrue)
 	}
 
 	if seed.Spec.Backup != nil {
 		if cond := v1beta1helper.GetCondition(seed.Status.Conditions, gardencorev1beta1.SeedBackupBucketsReady); cond == nil || cond.Status != gardencorev1beta1.ConditionTrue {
-			return false
+			return fmt.Sprintf("condition %s is not %s", gardencorev1beta1.SeedBackupBucketsReady, gardencorev1beta1.ConditionTrue)
 		}
 	}
 
-	return true
+	return ""
 }
@@ -6,6 +6,7 @@ package shoot
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
@@ -15,6 +16,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -600,6 +602,93 @@ var _ = Describe("Scheduler_Control", func() {
 		})
 	})
 
+	Context("SEED DETERMINATION - Shoot does not reference a Seed - find an adequate one using 'Scored' seed determination strategy", func() {
+		var secondSeed *gardencorev1beta1.Seed
+
+		BeforeEach(func() {
+			cloudProfile = cloudProfileBase.DeepCopy()
+			seed = seedBase.DeepCopy()
+			shoot = shootBase.DeepCopy()
+			schedulerConfiguration = *schedulerConfigurationBase.DeepCopy()
+			schedulerConfiguration.Schedulers.Shoot.Strategy = config.Scored
+			schedulerConfiguration.Schedulers.Shoot.Scoring = &config.ScoringConfiguration{
+				Filters: []config.FilterPlugin{{Name: config.FilterPluginSameProvider}},
+				Scores: []config.ScorePlugin{
+					{Name: config.ScorePluginCapacityHeadroom, Weight: 1},
+					{Name: config.ScorePluginRegionDistance, Weight: 1},
+				},
+			}
+
+			secondSeed = seedBase.DeepCopy()
+			secondSeed.Name = "seed-2"
+		})
+
+		It("should find the seed with the highest score", func() {
+			seed.Status.Allocatable = corev1.ResourceList{gardencorev1beta1.ResourceShoots: resource.MustParse("10")}
+			secondSeed.Status.Allocatable = corev1.ResourceList{gardencorev1beta1.ResourceShoots: resource.MustParse("2")}
+
+			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, secondSeed)).To(Succeed())
+
+			for i := range 3 {
+				otherShoot := shootBase.DeepCopy()
+				otherShoot.Name = fmt.Sprintf("other-shoot-%d", i)
+				otherShoot.Spec.SeedName = &seed.Name
+				Expect(fakeGardenClient.Create(ctx, otherShoot)).To(Succeed())
+			}
+
+			// seed-1 has a capacity headroom of 70% while seed-2 still has 100%.
+			bestSeed, err := reconciler.DetermineSeed(ctx, log, shoot)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal(secondSeed.Name))
+		})
+
+		It("should prefer the seed in the nearest region", func() {
+			secondSeed.Spec.Provider.Region = "asia"
+
+			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, secondSeed)).To(Succeed())
+
+			otherShoot := shootBase.DeepCopy()
+			otherShoot.Name = "other-shoot"
+			otherShoot.Spec.SeedName = &seed.Name
+			Expect(fakeGardenClient.Create(ctx, otherShoot)).To(Succeed())
+
+			bestSeed, err := reconciler.DetermineSeed(ctx, log, shoot)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal(seed.Name))
+		})
+
+		It("should pick the candidate with least shoots deployed in case of equal scores", func() {
+			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, secondSeed)).To(Succeed())
+
+			otherShoot := shootBase.DeepCopy()
+			otherShoot.Name = "other-shoot"
+			otherShoot.Spec.SeedName = &seed.Name
+			Expect(fakeGardenClient.Create(ctx, otherShoot)).To(Succeed())
+
+			bestSeed, err := reconciler.DetermineSeed(ctx, log, shoot)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal(secondSeed.Name))
+		})
+
+		It("should fail because no seed passes the filter plugins", func() {
+			schedulerConfiguration.Schedulers.Shoot.Scoring.Filters = append(schedulerConfiguration.Schedulers.Shoot.Scoring.Filters, config.FilterPlugin{Name: config.FilterPluginSameRegion})
+			seed.Spec.Provider.Region = "asia"
+
+			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
+
+			bestSeed, err := reconciler.DetermineSeed(ctx, log, shoot)
+			Expect(err).To(MatchError(`none out of the 1 seeds passed the filter plugin "SameRegion"`))
+			Expect(bestSeed).To(BeNil())
+		})
+	})
+
 	Context("SEED DETERMINATION - Shoot does not reference a Seed - find an adequate one using default seed determination strategy", func() {
 		BeforeEach(func() {
 			cloudProfile = cloudProfileBase.DeepCopy()
@@ -814,6 +903,127 @@ var _ = Describe("Scheduler_Control", func() {
 		})
 	})
 
+	Context("#DetermineTargetSeed", func() {
+		var secondSeed *gardencorev1beta1.Seed
+
+		BeforeEach(func() {
+			cloudProfile = cloudProfileBase.DeepCopy()
+			seed = seedBase.DeepCopy()
+			secondSeed = seedBase.DeepCopy()
+			secondSeed.Name = "seed-2"
+			shoot = shootBase.DeepCopy()
+			shoot.Spec.SeedName = &seed.Name
+			schedulerConfiguration = *schedulerConfigurationBase.DeepCopy()
+		})
+
+		It("should not consider the excluded seeds", func() {
+			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, secondSeed)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, shoot)).To(Succeed())
+
+			targetSeed, err := reconciler.DetermineTargetSeed(ctx, log, shoot, sets.New(seed.Name))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(targetSeed.Name).To(Equal(secondSeed.Name))
+		})
+
+		It("should fail if all seeds are excluded", func() {
+			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, secondSeed)).To(Succeed())
+
+			targetSeed, err := reconciler.DetermineTargetSeed(ctx, log, shoot, sets.New(seed.Name, secondSeed.Name))
+			Expect(err).To(MatchError("none of the 2 seeds is a candidate, all of them are excluded"))
+			Expect(targetSeed).To(BeNil())
+		})
+	})
+
+	Context("#ExplainSeedDetermination", func() {
+		newSeed := func(name string, mutate func(*gardencorev1beta1.Seed)) *gardencorev1beta1.Seed {
+			s := seedBase.DeepCopy()
+			s.Name = name
+			if mutate != nil {
+				mutate(s)
+			}
+			return s
+		}
+
+		BeforeEach(func() {
+			cloudProfile = cloudProfileBase.DeepCopy()
+			shoot = shootBase.DeepCopy()
+			schedulerConfiguration = *schedulerConfigurationBase.DeepCopy()
+		})
+
+		It("should explain why seeds were rejected and how the candidates were ranked", func() {
+			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, newSeed("seed-1", nil))).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, newSeed("seed-2", nil))).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, newSeed("seed-3", func(s *gardencorev1beta1.Seed) { s.Spec.Settings.Scheduling.Visible = false }))).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, newSeed("seed-4", func(s *gardencorev1beta1.Seed) { s.Spec.Provider.Region = "asia" }))).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, newSeed("seed-5", func(s *gardencorev1beta1.Seed) {
+				s.Spec.Taints = []gardencorev1beta1.SeedTaint{{Key: "foo"}}
+			}))).To(Succeed())
+
+			otherShoot := shootBase.DeepCopy()
+			otherShoot.Name = "other-shoot"
+			otherShoot.Spec.SeedName = ptr.To("seed-1")
+			Expect(fakeGardenClient.Create(ctx, otherShoot)).To(Succeed())
+
+			explanation := reconciler.ExplainSeedDetermination(ctx, log, shoot)
+			Expect(explanation.Error).To(BeEmpty())
+			Expect(explanation.SeedName).To(Equal("seed-2"))
+			Expect(explanation.Strategy).To(Equal(config.SameRegion))
+			Expect(explanation.Seeds).To(Equal([]SeedExplanation{
+				{Name: "seed-1", Shoots: 1, Rank: 2},
+				{Name: "seed-2", Rank: 1},
+				{Name: "seed-3", RejectedBy: FilterUsable, Reason: "seed is not visible for scheduling"},
+				{Name: "seed-4", RejectedBy: FilterStrategy, Reason: "seed provider or region does not match the provider and region of the shoot (strategy SameRegion)"},
+				{Name: "seed-5", RejectedBy: FilterTaints, Reason: "shoot does not tolerate the seed's taints"},
+			}))
+		})
+
+		It("should report the scores for the 'Scored' strategy", func() {
+			schedulerConfiguration.Schedulers.Shoot.Strategy = config.Scored
+			schedulerConfiguration.Schedulers.Shoot.Scoring = &config.ScoringConfiguration{
+				Filters: []config.FilterPlugin{{Name: config.FilterPluginSameRegion}},
+				Scores:  []config.ScorePlugin{{Name: config.ScorePluginCapacityHeadroom, Weight: 2}},
+			}
+
+			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, newSeed("seed-1", func(s *gardencorev1beta1.Seed) {
+				s.Status.Allocatable = corev1.ResourceList{gardencorev1beta1.ResourceShoots: resource.MustParse("4")}
+			}))).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, newSeed("seed-2", nil))).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, newSeed("seed-3", func(s *gardencorev1beta1.Seed) { s.Spec.Provider.Region = "asia" }))).To(Succeed())
+
+			otherShoot := shootBase.DeepCopy()
+			otherShoot.Name = "other-shoot"
+			otherShoot.Spec.SeedName = ptr.To("seed-1")
+			Expect(fakeGardenClient.Create(ctx, otherShoot)).To(Succeed())
+
+			explanation := reconciler.ExplainSeedDetermination(ctx, log, shoot)
+			Expect(explanation.Error).To(BeEmpty())
+			Expect(explanation.SeedName).To(Equal("seed-2"))
+			Expect(explanation.Seeds).To(Equal([]SeedExplanation{
+				{Name: "seed-1", Shoots: 1, Score: ptr.To[int64](150), Rank: 2},
+				{Name: "seed-2", Score: ptr.To[int64](200), Rank: 1},
+				{Name: "seed-3", RejectedBy: "SameRegion", Reason: `seed was rejected by the filter plugin "SameRegion"`},
+			}))
+		})
+
+		It("should report the error if no seed could be determined", func() {
+			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, newSeed("seed-1", func(s *gardencorev1beta1.Seed) { s.Status.LastOperation = nil }))).To(Succeed())
+
+			explanation := reconciler.ExplainSeedDetermination(ctx, log, shoot)
+			Expect(explanation.SeedName).To(BeEmpty())
+			Expect(explanation.Error).To(Equal("none of the 1 seeds is valid for scheduling (not deleting, visible and ready)"))
+			Expect(explanation.Seeds).To(Equal([]SeedExplanation{
+				{Name: "seed-1", RejectedBy: FilterUsable, Reason: "seed has not been reconciled yet"},
+			}))
+		})
+	})
+
 	Context("#DetermineBestSeedCandidate", func() {
 		BeforeEach(func() {
 			seed = seedBase.DeepCopy()
@@ -0,0 +1,276 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/scheduler/apis/config"
+)
+
+// maxScore is the maximum score a score plugin can assign to a seed.
+const maxScore int64 = 100
+
+// scoringContext contains the information about the shoot to be scheduled which is used by the filter and score plugins
+// of the Scored strategy.
+type scoringContext struct {
+	log          logr.Logger
+	shoot        *gardencorev1beta1.Shoot
+	shootList    []*gardencorev1beta1.Shoot
+	seedUsage    map[string]int
+	regionConfig *corev1.ConfigMap
+}
+
+// filterPlugin returns true if the given seed is a candidate for the shoot.
+type filterPlugin func(sc *scoringContext, seed *gardencorev1beta1.Seed) bool
+
+// scorePlugin returns a score between 0 and maxScore for each of the given seeds.
+type scorePlugin func(sc *scoringContext, plugin config.ScorePlugin, seeds []gardencorev1beta1.Seed) ([]int64, error)
+
+var (
+	filterPlugins = map[config.FilterPluginName]filterPlugin{
+		config.FilterPluginSameProvider:   filterSameProvider,
+		config.FilterPluginSameRegion:     filterSameRegion,
+		config.FilterPluginTestingPurpose: filterTestingPurpose,
+	}
+
+	scorePlugins = map[config.ScorePluginName]scorePlugin{
+		config.ScorePluginCapacityHeadroom: scoreCapacityHeadroom,
+		config.ScorePluginRegionDistance:   scoreRegionDistance,
+		config.ScorePluginLabelAffinity:    scoreLabelAffinity,
+		config.ScorePluginProjectSpread:    scoreProjectSpread,
+	}
+)
+
+// applyScoredStrategy filters the given seeds with the configured filter plugins and returns the remaining candidates
+// together with the weighted sum of the scores of the configured score plugins.
+func applyScoredStrategy(log logr.Logger, shoot *gardencorev1beta1.Shoot, shootList []*gardencorev1beta1.Shoot, seedList []gardencorev1beta1.Seed, scoring *config.ScoringConfiguration, regionConfig *corev1.ConfigMap, explanation *Explanation) ([]gardencorev1beta1.Seed, []int64, error) {
+	if scoring == nil {
+		scoring = &config.ScoringConfiguration{}
+	}
+
+	sc := &scoringContext{
+		log:          log,
+		shoot:        shoot,
+		shootList:    shootList,
+		seedUsage:    v1beta1helper.CalculateSeedUsage(shootList),
+		regionConfig: regionConfig,
+	}
+
+	filters := scoring.Filters
+	if isTestingShoot(shoot) && slices.ContainsFunc(filters, func(filter config.FilterPlugin) bool { return filter.Name == config.FilterPluginTestingPurpose }) {
+		// Like with the other strategies, shoots with purpose testing are only scheduled to seeds of the same provider
+		// type, but the region does not matter.
+		filters = []config.FilterPlugin{{Name: config.FilterPluginTestingPurpose}}
+	}
+
+	candidates := seedList
+	for _, filter := range filters {
+		filterFn, ok := filterPlugins[filter.Name]
+		if !ok {
+			return nil, nil, fmt.Errorf("unknown filter plugin %q", filter.Name)
+		}
+
+		var matchingSeeds []gardencorev1beta1.Seed
+		for _, seed := range candidates {
+			if !filterFn(sc, &seed) {
+				explanation.reject(seed.Name, string(filter.Name), fmt.Sprintf("seed was rejected by the filter plugin %q", filter.Name))
+				continue
+			}
+			matchingSeeds = append(matchingSeeds, seed)
+		}
+
+		if len(matchingSeeds) == 0 {
+			return nil, nil, fmt.Errorf("none out of the %d seeds passed the filter plugin %q", len(candidates), filter.Name)
+		}
+		candidates = matchingSeeds
+	}
+
+	totalScores := make([]int64, len(candidates))
+	for _, score := range scoring.Scores {
+		scoreFn, ok := scorePlugins[score.Name]
+		if !ok {
+			return nil, nil, fmt.Errorf("unknown score plugin %q", score.Name)
+		}
+
+		scores, err := scoreFn(sc, score, candidates)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed scoring seeds with score plugin %q: %w", score.Name, err)
+		}
+
+		for i := range candidates {
+			totalScores[i] += int64(score.Weight) * scores[i]
+		}
+	}
+
+	seedToScore := make(map[string]int64, len(candidates))
+	for i, seed := range candidates {
+		seedToScore[seed.Name] = totalScores[i]
+	}
+	log.V(1).Info("Scored seed candidates", "scores", seedToScore)
+
+	return candidates, totalScores, nil
+}
+
+func filterSameProvider(sc *scoringContext, seed *gardencorev1beta1.Seed) bool {
+	return seed.Spec.Provider.Type == sc.shoot.Spec.Provider.Type
+}
+
+func filterSameRegion(sc *scoringContext, seed *gardencorev1beta1.Seed) bool {
+	return filterSameProvider(sc, seed) && seed.Spec.Provider.Region == sc.shoot.Spec.Region
+}
+
+func filterTestingPurpose(sc *scoringContext, seed *gardencorev1beta1.Seed) bool {
+	return !isTestingShoot(sc.shoot) || filterSameProvider(sc, seed)
+}
+
+func isTestingShoot(shoot *gardencorev1beta1.Shoot) bool {
+	return shoot.Spec.Purpose != nil && *shoot.Spec.Purpose == gardencorev1beta1.ShootPurposeTesting
+}
+
+// scoreCapacityHeadroom scores seeds by the share of their allocatable shoots which is still available. Seeds without
+// allocatable shoots have unlimited capacity and get the maximum score.
+func scoreCapacityHeadroom(sc *scoringContext, _ config.ScorePlugin, seeds []gardencorev1beta1.Seed) ([]int64, error) {
+	scores := make([]int64, len(seeds))
+
+	for i, seed := range seeds {
+		allocatableShoots, ok := seed.Status.Allocatable[gardencorev1beta1.ResourceShoots]
+		if !ok || allocatableShoots.Value() <= 0 {
+			scores[i] = maxScore
+			continue
+		}
+
+		if available := allocatableShoots.Value() - int64(sc.seedUsage[seed.Name]); available > 0 {
+			scores[i] = available * maxScore / allocatableShoots.Value()
+		}
+	}
+
+	return scores, nil
+}
+
+// scoreRegionDistance scores seeds by the distance of their region to the region of the shoot. The seed with the
+// smallest distance gets the maximum score, the seed with the largest distance gets score 0. The distances are taken
+// from the region ConfigMap if it contains the region of the shoot, otherwise the Levenshtein distance is used.
+func scoreRegionDistance(sc *scoringContext, _ config.ScorePlugin, seeds []gardencorev1beta1.Seed) ([]int64, error) {
+	regionConfigData, err := parseRegionConfig(sc.regionConfig, sc.shoot.Spec.Region)
+	if err != nil {
+		return nil, err
+	}
+
+	distances := make([]int64, len(seeds))
+	for i, seed := range seeds {
+		if regionConfigData == nil {
+			distances[i] = int64(distance(seed.Spec.Provider.Region, sc.shoot.Spec.Region))
+			continue
+		}
+
+		dist, ok := regionConfigData[seed.Spec.Provider.Region]
+		if !ok {
+			sc.log.V(1).Info("Seed region not available in scheduler region ConfigMap for shoot region, assuming maximum distance", "seedName", seed.Name, "shootRegion", sc.shoot.Spec.Region, "seedRegion", seed.Spec.Provider.Region)
+			distances[i] = -1
+			continue
+		}
+		distances[i] = int64(dist)
+	}
+
+	return invertedNormalizedScores(distances), nil
+}
+
+// scoreLabelAffinity scores seeds by the share of the weights of the configured label affinity terms they match.
+func scoreLabelAffinity(_ *scoringContext, plugin config.ScorePlugin, seeds []gardencorev1beta1.Seed) ([]int64, error) {
+	var (
+		scores      = make([]int64, len(seeds))
+		selectors   = make([]labels.Selector, 0, len(plugin.LabelAffinityTerms))
+		totalWeight int64
+	)
+
+	for _, term := range plugin.LabelAffinityTerms {
+		selector, err := metav1.LabelSelectorAsSelector(&term.Selector)
+		if err != nil {
+			return nil, fmt.Errorf("label selector conversion failed: %v: %w", term.Selector, err)
+		}
+		selectors = append(selectors, selector)
+		totalWeight += int64(term.Weight)
+	}
+
+	if totalWeight == 0 {
+		return scores, nil
+	}
+
+	for i, seed := range seeds {
+		var matchingWeight int64
+		for j, selector := range selectors {
+			if selector.Matches(labels.Set(seed.Labels)) {
+				matchingWeight += int64(plugin.LabelAffinityTerms[j].Weight)
+			}
+		}
+		scores[i] = matchingWeight * maxScore / totalWeight
+	}
+
+	return scores, nil
+}
+
+// scoreProjectSpread scores seeds by the number of shoots of the shoot's project they host. The seed with the least
+// shoots of the project gets the maximum score, the seed with the most shoots of the project gets score 0.
+func scoreProjectSpread(sc *scoringContext, _ config.ScorePlugin, seeds []gardencorev1beta1.Seed) ([]int64, error) {
+	seedToProjectShoots := make(map[string]int64)
+	for _, shoot := range sc.shootList {
+		if shoot.Namespace == sc.shoot.Namespace && shoot.Spec.SeedName != nil {
+			seedToProjectShoots[*shoot.Spec.SeedName]++
+		}
+	}
+
+	counts := make([]int64, len(seeds))
+	for i, seed := range seeds {
+		counts[i] = seedToProjectShoots[seed.Name]
+	}
+
+	return invertedNormalizedScores(counts), nil
+}
+
+// invertedNormalizedScores maps the given values to scores such that the smallest value gets the maximum score and the
+// largest value gets score 0. Negative values are considered larger than all other values. If all values are equal,
+// all get the maximum score.
+func invertedNormalizedScores(values []int64) []int64 {
+	var (
+		scores             = make([]int64, len(values))
+		minValue, maxValue int64
+		found              bool
+	)
+
+	for _, value := range values {
+		if value < 0 {
+			continue
+		}
+		if !found || value < minValue {
+			minValue = value
+		}
+		if !found || value > maxValue {
+			maxValue = value
+		}
+		found = true
+	}
+
+	for i, value := range values {
+		switch {
+		case value < 0:
+			scores[i] = 0
+		case maxValue == minValue:
+			scores[i] = maxScore
+		default:
+			scores[i] = (maxValue - value) * maxScore / (maxValue - minValue)
+		}
+	}
+
+	return scores
+}
@@ -0,0 +1,239 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot
+
+import (
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/scheduler/apis/config"
+)
+
+var _ = Describe("Scoring", func() {
+	var (
+		sc    *scoringContext
+		shoot *gardencorev1beta1.Shoot
+		seeds []gardencorev1beta1.Seed
+	)
+
+	newSeed := func(name, providerType, region string) gardencorev1beta1.Seed {
+		return gardencorev1beta1.Seed{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: gardencorev1beta1.SeedSpec{
+				Provider: gardencorev1beta1.SeedProvider{Type: providerType, Region: region},
+			},
+		}
+	}
+
+	newShoot := func(name, namespace, seedName string) *gardencorev1beta1.Shoot {
+		return &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec:       gardencorev1beta1.ShootSpec{SeedName: ptr.To(seedName)},
+		}
+	}
+
+	BeforeEach(func() {
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: "garden-foo"},
+			Spec: gardencorev1beta1.ShootSpec{
+				Provider: gardencorev1beta1.Provider{Type: "local"},
+				Region:   "europe-west1",
+			},
+		}
+		seeds = []gardencorev1beta1.Seed{
+			newSeed("seed-1", "local", "europe-west1"),
+			newSeed("seed-2", "local", "europe-east1"),
+			newSeed("seed-3", "other", "europe-west1"),
+		}
+		sc = &scoringContext{log: logr.Discard(), shoot: shoot}
+	})
+
+	Describe("#filterSameProvider", func() {
+		It("should only accept seeds with the provider type of the shoot", func() {
+			Expect(filterSameProvider(sc, &seeds[0])).To(BeTrue())
+			Expect(filterSameProvider(sc, &seeds[1])).To(BeTrue())
+			Expect(filterSameProvider(sc, &seeds[2])).To(BeFalse())
+		})
+	})
+
+	Describe("#filterSameRegion", func() {
+		It("should only accept seeds with the provider type and region of the shoot", func() {
+			Expect(filterSameRegion(sc, &seeds[0])).To(BeTrue())
+			Expect(filterSameRegion(sc, &seeds[1])).To(BeFalse())
+			Expect(filterSameRegion(sc, &seeds[2])).To(BeFalse())
+		})
+	})
+
+	Describe("#filterTestingPurpose", func() {
+		It("should accept all seeds for shoots without purpose testing", func() {
+			Expect(filterTestingPurpose(sc, &seeds[0])).To(BeTrue())
+			Expect(filterTestingPurpose(sc, &seeds[1])).To(BeTrue())
+			Expect(filterTestingPurpose(sc, &seeds[2])).To(BeTrue())
+		})
+
+		It("should only accept seeds with the provider type of shoots with purpose testing", func() {
+			shoot.Spec.Purpose = ptr.To(gardencorev1beta1.ShootPurposeTesting)
+
+			Expect(filterTestingPurpose(sc, &seeds[0])).To(BeTrue())
+			Expect(filterTestingPurpose(sc, &seeds[1])).To(BeTrue())
+			Expect(filterTestingPurpose(sc, &seeds[2])).To(BeFalse())
+		})
+	})
+
+	Describe("#scoreCapacityHeadroom", func() {
+		It("should score seeds by the share of available allocatable shoots", func() {
+			seeds[0].Status.Allocatable = corev1.ResourceList{gardencorev1beta1.ResourceShoots: resource.MustParse("4")}
+			seeds[1].Status.Allocatable = corev1.ResourceList{gardencorev1beta1.ResourceShoots: resource.MustParse("2")}
+			sc.seedUsage = v1beta1helper.CalculateSeedUsage([]*gardencorev1beta1.Shoot{
+				newShoot("shoot-1", "garden-bar", "seed-1"),
+				newShoot("shoot-2", "garden-bar", "seed-2"),
+				newShoot("shoot-3", "garden-bar", "seed-2"),
+				newShoot("shoot-4", "garden-bar", "seed-3"),
+			})
+
+			Expect(scoreCapacityHeadroom(sc, config.ScorePlugin{}, seeds)).To(Equal([]int64{75, 0, 100}))
+		})
+	})
+
+	Describe("#scoreRegionDistance", func() {
+		It("should score seeds by the Levenshtein distance if no region config is given", func() {
+			Expect(scoreRegionDistance(sc, config.ScorePlugin{}, seeds)).To(Equal([]int64{100, 0, 100}))
+		})
+
+		It("should score seeds by the distances of the region config", func() {
+			seeds = append(seeds, newSeed("seed-4", "local", "asia-east1"), newSeed("seed-5", "local", "us-east1"))
+			sc.regionConfig = &corev1.ConfigMap{
+				Data: map[string]string{
+					"europe-west1": `
+europe-east1: 10
+asia-east1: 40
+`,
+				},
+			}
+
+			Expect(scoreRegionDistance(sc, config.ScorePlugin{}, seeds)).To(Equal([]int64{100, 75, 100, 0, 0}))
+		})
+
+		It("should fail if the region config is invalid", func() {
+			sc.regionConfig = &corev1.ConfigMap{Data: map[string]string{"europe-west1": "foo"}}
+
+			_, err := scoreRegionDistance(sc, config.ScorePlugin{}, seeds)
+			Expect(err).To(MatchError(ContainSubstring("Wrong format in region ConfigMap")))
+		})
+	})
+
+	Describe("#scoreLabelAffinity", func() {
+		It("should score seeds by the share of the weights of the matching terms", func() {
+			seeds[0].Labels = map[string]string{"dedicated": "true", "tier": "gold"}
+			seeds[1].Labels = map[string]string{"tier": "gold"}
+
+			plugin := config.ScorePlugin{
+				Name: config.ScorePluginLabelAffinity,
+				LabelAffinityTerms: []config.LabelAffinityTerm{
+					{Weight: 30, Selector: metav1.LabelSelector{MatchLabels: map[string]string{"dedicated": "true"}}},
+					{Weight: 10, Selector: metav1.LabelSelector{MatchLabels: map[string]string{"tier": "gold"}}},
+				},
+			}
+
+			Expect(scoreLabelAffinity(sc, plugin, seeds)).To(Equal([]int64{100, 25, 0}))
+		})
+	})
+
+	Describe("#scoreProjectSpread", func() {
+		It("should score seeds by the number of shoots of the same project", func() {
+			sc.shootList = []*gardencorev1beta1.Shoot{
+				newShoot("shoot-1", "garden-foo", "seed-1"),
+				newShoot("shoot-2", "garden-foo", "seed-1"),
+				newShoot("shoot-3", "garden-foo", "seed-2"),
+				newShoot("shoot-4", "garden-bar", "seed-3"),
+				newShoot("shoot-5", "garden-bar", "seed-3"),
+			}
+
+			Expect(scoreProjectSpread(sc, config.ScorePlugin{}, seeds)).To(Equal([]int64{0, 50, 100}))
+		})
+	})
+
+	Describe("#applyScoredStrategy", func() {
+		It("should apply the weights of the score plugins", func() {
+			seeds[0].Labels = map[string]string{"dedicated": "true"}
+			shootList := []*gardencorev1beta1.Shoot{
+				newShoot("shoot-1", "garden-foo", "seed-1"),
+			}
+
+			scoring := &config.ScoringConfiguration{
+				Filters: []config.FilterPlugin{{Name: config.FilterPluginSameProvider}},
+				Scores: []config.ScorePlugin{
+					{Name: config.ScorePluginProjectSpread, Weight: 1},
+					{Name: config.ScorePluginLabelAffinity, Weight: 1, LabelAffinityTerms: []config.LabelAffinityTerm{
+						{Weight: 1, Selector: metav1.LabelSelector{MatchLabels: map[string]string{"dedicated": "true"}}},
+					}},
+				},
+			}
+
+			By("Tie between the seeds")
+			candidates, scores, err := applyScoredStrategy(logr.Discard(), shoot, shootList, seeds, scoring, nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(candidates).To(Equal(seeds[:2]))
+			Expect(scores).To(Equal([]int64{100, 100}))
+
+			By("Label affinity has a higher weight")
+			scoring.Scores[1].Weight = 2
+			candidates, scores, err = applyScoredStrategy(logr.Discard(), shoot, shootList, seeds, scoring, nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(candidates).To(Equal(seeds[:2]))
+			Expect(scores).To(Equal([]int64{200, 100}))
+		})
+
+		It("should record the seeds rejected by the filter plugins", func() {
+			explanation := newExplanation(shoot, config.Scored)
+			explanation.addSeeds(seeds, nil)
+
+			_, _, err := applyScoredStrategy(logr.Discard(), shoot, nil, seeds, &config.ScoringConfiguration{Filters: []config.FilterPlugin{{Name: config.FilterPluginSameRegion}}}, nil, explanation)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(explanation.Seeds).To(Equal([]SeedExplanation{
+				{Name: "seed-1"},
+				{Name: "seed-2", RejectedBy: "SameRegion", Reason: `seed was rejected by the filter plugin "SameRegion"`},
+				{Name: "seed-3", RejectedBy: "SameRegion", Reason: `seed was rejected by the filter plugin "SameRegion"`},
+			}))
+		})
+
+		It("should only apply the TestingPurpose filter plugin to shoots with purpose testing", func() {
+			scoring := &config.ScoringConfiguration{Filters: []config.FilterPlugin{{Name: config.FilterPluginSameRegion}, {Name: config.FilterPluginTestingPurpose}}}
+
+			By("Shoot without purpose testing")
+			candidates, _, err := applyScoredStrategy(logr.Discard(), shoot, nil, seeds, scoring, nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(candidates).To(Equal(seeds[:1]))
+
+			By("Shoot with purpose testing")
+			shoot.Spec.Purpose = ptr.To(gardencorev1beta1.ShootPurposeTesting)
+			candidates, _, err = applyScoredStrategy(logr.Discard(), shoot, nil, seeds, scoring, nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(candidates).To(Equal(seeds[:2]))
+		})
+
+		It("should fail for unknown plugins", func() {
+			_, _, err := applyScoredStrategy(logr.Discard(), shoot, nil, seeds, &config.ScoringConfiguration{Scores: []config.ScorePlugin{{Name: "foo"}}}, nil, nil)
+			Expect(err).To(MatchError(`unknown score plugin "foo"`))
+		})
+	})
+
+	Describe("#invertedNormalizedScores", func() {
+		It("should give the maximum score to the smallest value", func() {
+			Expect(invertedNormalizedScores([]int64{2, 4, 6, -1})).To(Equal([]int64{100, 50, 0, 0}))
+		})
+
+		It("should give the maximum score to all values if they are equal", func() {
+			Expect(invertedNormalizedScores([]int64{3, 3})).To(Equal([]int64{100, 100}))
+		})
+	})
+})
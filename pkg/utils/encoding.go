@@ -5,6 +5,7 @@
 package utils
 
 import (
+	"crypto"
 	"crypto/rsa"
 	"crypto/sha256"
 	"crypto/x509"
@@ -12,6 +13,7 @@ import (
 	"encoding/hex"
 	"encoding/pem"
 	"errors"
+	"fmt"
 	"slices"
 	"strconv"
 
@@ -78,6 +80,39 @@ func DecodePrivateKey(bytes []byte) (*rsa.PrivateKey, error) {
 	return x509.ParsePKCS1PrivateKey(block.Bytes)
 }
 
+// DecodeAnyPrivateKey takes a byte slice, decodes it from the PEM format, converts it to a private key object, and
+// returns it. In contrast to DecodePrivateKey, it supports RSA keys in the PKCS1 or PKCS8 format, ECDSA keys in the SEC1
+// or PKCS8 format, and Ed25519 keys in the PKCS8 format. In case an error occurs, it returns the error.
+func DecodeAnyPrivateKey(bytes []byte) (crypto.Signer, error) {
+	block, _ := pem.Decode(bytes)
+	if block == nil {
+		return nil, errors.New("could not decode the PEM-encoded private key")
+	}
+
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+			return key, nil
+		}
+		// EncodePrivateKeyInPKCS8 uses the same PEM block type for RSA keys in the PKCS8 format.
+		return DecodeRSAPrivateKeyFromPKCS8(bytes)
+	case "EC PRIVATE KEY":
+		return x509.ParseECPrivateKey(block.Bytes)
+	case "PRIVATE KEY":
+		key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		signer, ok := key.(crypto.Signer)
+		if !ok {
+			return nil, fmt.Errorf("unsupported private key type %T", key)
+		}
+		return signer, nil
+	}
+
+	return nil, fmt.Errorf("unsupported PEM block type %q", block.Type)
+}
+
 // EncodeCertificate takes a certificate as a byte slice, encodes it to the PEM format, and returns
 // it as byte slice.
 func EncodeCertificate(certificate []byte) []byte {
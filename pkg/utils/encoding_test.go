@@ -5,7 +5,13 @@
 package utils_test
 
 import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
 	"crypto/x509"
+	"encoding/pem"
 	"errors"
 	"strings"
 
@@ -92,4 +98,49 @@ scLaDjVwpKfl9g24hVxCoyVl8Kcuqax5OcXba3h4/dEgq/N2hbpsnQ==
 			BeNil(),
 		),
 	)
+
+	Describe("#DecodeAnyPrivateKey", func() {
+		encode := func(blockType string, bytes []byte) []byte {
+			return pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: bytes})
+		}
+
+		It("should decode RSA keys in the PKCS1 and PKCS8 format", func() {
+			key, err := rsa.GenerateKey(rand.Reader, 2048)
+			Expect(err).NotTo(HaveOccurred())
+			pkcs8, err := EncodePrivateKeyInPKCS8(key)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(DecodeAnyPrivateKey(EncodePrivateKey(key))).To(Equal(key))
+			Expect(DecodeAnyPrivateKey(pkcs8)).To(Equal(key))
+		})
+
+		It("should decode ECDSA keys in the SEC1 and PKCS8 format", func() {
+			key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			Expect(err).NotTo(HaveOccurred())
+			sec1, err := x509.MarshalECPrivateKey(key)
+			Expect(err).NotTo(HaveOccurred())
+			pkcs8, err := x509.MarshalPKCS8PrivateKey(key)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(DecodeAnyPrivateKey(encode("EC PRIVATE KEY", sec1))).To(Equal(key))
+			Expect(DecodeAnyPrivateKey(encode("PRIVATE KEY", pkcs8))).To(Equal(key))
+		})
+
+		It("should decode Ed25519 keys in the PKCS8 format", func() {
+			_, key, err := ed25519.GenerateKey(rand.Reader)
+			Expect(err).NotTo(HaveOccurred())
+			pkcs8, err := x509.MarshalPKCS8PrivateKey(key)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(DecodeAnyPrivateKey(encode("PRIVATE KEY", pkcs8))).To(Equal(key))
+		})
+
+		It("should fail for unsupported data", func() {
+			_, err := DecodeAnyPrivateKey([]byte("foo"))
+			Expect(err).To(MatchError("could not decode the PEM-encoded private key"))
+
+			_, err = DecodeAnyPrivateKey(encode("CERTIFICATE", []byte("foo")))
+			Expect(err).To(MatchError(`unsupported PEM block type "CERTIFICATE"`))
+		})
+	})
 })
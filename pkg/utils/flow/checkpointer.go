@@ -0,0 +1,93 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package flow
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener/pkg/controllerutils"
+)
+
+// Checkpoint maps the IDs of the tasks which succeeded in an execution of a flow to their fingerprints.
+type Checkpoint map[TaskID]string
+
+// Checkpointer persists the progress of a flow execution so that the next execution can skip the tasks which already
+// succeeded and whose inputs have not changed since then, instead of starting from the roots again.
+// Only tasks with a Fingerprint are skipped. A task must only get a fingerprint if later tasks do not depend on
+// in-memory state set by it, since this state is not restored from the checkpoint. For this reason, the shoot flows of
+// gardenlet do not use a Checkpointer yet: many of their tasks initialize state of the Botanist (e.g., the secrets
+// manager or the values of components) which is used by later tasks.
+type Checkpointer interface {
+	// Load returns the checkpoint of the previous execution. It returns an empty checkpoint if there is none.
+	Load(ctx context.Context) (Checkpoint, error)
+	// Save persists the given checkpoint. Saving an empty checkpoint removes a previously persisted one.
+	Save(ctx context.Context, checkpoint Checkpoint) error
+}
+
+// DataKeyCheckpoint is the key in the data of the ConfigMap used by the ConfigMap checkpointer.
+const DataKeyCheckpoint = "checkpoint"
+
+type configMapCheckpointer struct {
+	client client.Client
+	key    client.ObjectKey
+}
+
+// NewConfigMapCheckpointer returns a Checkpointer which persists the checkpoint in the ConfigMap with the given key.
+// The ConfigMap is created when a non-empty checkpoint is saved and deleted when an empty checkpoint is saved, hence it
+// only exists as long as the flow did not succeed. Every flow needs its own ConfigMap.
+func NewConfigMapCheckpointer(c client.Client, key client.ObjectKey) Checkpointer {
+	return &configMapCheckpointer{client: c, key: key}
+}
+
+func (c *configMapCheckpointer) Load(ctx context.Context) (Checkpoint, error) {
+	configMap := &corev1.ConfigMap{}
+	if err := c.client.Get(ctx, c.key, configMap); err != nil {
+		if apierrors.IsNotFound(err) {
+			return Checkpoint{}, nil
+		}
+		return nil, fmt.Errorf("failed reading checkpoint ConfigMap %s: %w", c.key, err)
+	}
+
+	checkpoint := Checkpoint{}
+	if data, ok := configMap.Data[DataKeyCheckpoint]; ok {
+		if err := json.Unmarshal([]byte(data), &checkpoint); err != nil {
+			return nil, fmt.Errorf("failed decoding checkpoint from ConfigMap %s: %w", c.key, err)
+		}
+	}
+
+	return checkpoint, nil
+}
+
+func (c *configMapCheckpointer) Save(ctx context.Context, checkpoint Checkpoint) error {
+	configMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: c.key.Name, Namespace: c.key.Namespace}}
+
+	if len(checkpoint) == 0 {
+		if err := c.client.Delete(ctx, configMap); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed deleting checkpoint ConfigMap %s: %w", c.key, err)
+		}
+		return nil
+	}
+
+	data, err := json.Marshal(checkpoint)
+	if err != nil {
+		return fmt.Errorf("failed encoding checkpoint: %w", err)
+	}
+
+	if _, err := controllerutils.GetAndCreateOrMergePatch(ctx, c.client, configMap, func() error {
+		configMap.Data = map[string]string{DataKeyCheckpoint: string(data)}
+		return nil
+	}); err != nil {
+		return fmt.Errorf("failed saving checkpoint ConfigMap %s: %w", c.key, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,68 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package flow_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	. "github.com/gardener/gardener/pkg/utils/flow"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("Checkpointer", func() {
+	Describe("#NewConfigMapCheckpointer", func() {
+		var (
+			ctx          = context.Background()
+			fakeClient   client.Client
+			key          = client.ObjectKey{Namespace: "shoot--foo--bar", Name: "flow-checkpoint"}
+			checkpointer Checkpointer
+		)
+
+		BeforeEach(func() {
+			fakeClient = fakeclient.NewClientBuilder().Build()
+			checkpointer = NewConfigMapCheckpointer(fakeClient, key)
+		})
+
+		It("should return an empty checkpoint if the ConfigMap does not exist", func() {
+			Expect(checkpointer.Load(ctx)).To(BeEmpty())
+		})
+
+		It("should save and load the checkpoint", func() {
+			Expect(checkpointer.Save(ctx, Checkpoint{"x": "x-1", "y": "y-1"})).To(Succeed())
+
+			configMap := &corev1.ConfigMap{}
+			Expect(fakeClient.Get(ctx, key, configMap)).To(Succeed())
+			Expect(configMap.Data).To(Equal(map[string]string{DataKeyCheckpoint: `{"x":"x-1","y":"y-1"}`}))
+
+			Expect(checkpointer.Save(ctx, Checkpoint{"x": "x-2"})).To(Succeed())
+			Expect(checkpointer.Load(ctx)).To(Equal(Checkpoint{"x": "x-2"}))
+		})
+
+		It("should delete the ConfigMap when saving an empty checkpoint", func() {
+			Expect(checkpointer.Save(ctx, Checkpoint{"x": "x-1"})).To(Succeed())
+			Expect(checkpointer.Save(ctx, Checkpoint{})).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, key, &corev1.ConfigMap{})).To(BeNotFoundError())
+			Expect(checkpointer.Save(ctx, nil)).To(Succeed())
+		})
+
+		It("should fail if the checkpoint cannot be decoded", func() {
+			Expect(fakeClient.Create(ctx, &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace},
+				Data:       map[string]string{DataKeyCheckpoint: "{"},
+			})).To(Succeed())
+
+			_, err := checkpointer.Load(ctx)
+			Expect(err).To(MatchError(ContainSubstring("failed decoding checkpoint")))
+		})
+	})
+})
@@ -13,6 +13,9 @@ import (
 
 	"github.com/go-logr/logr"
 	"github.com/hashicorp/go-multierror"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
 	errorsutils "github.com/gardener/gardener/pkg/utils/errors"
@@ -21,6 +24,8 @@ import (
 const (
 	logKeyFlow = "flow"
 	logKeyTask = "task"
+
+	checkpointTimeout = 30 * time.Second
 )
 
 // ErrorCleaner is called when a task which errored during the previous reconciliation phase completes with success
@@ -66,10 +71,11 @@ func (f *Flow) Len() int {
 // node is a compiled Task that contains the triggered Tasks, the
 // number of triggers the node itself requires and its payload function.
 type node struct {
-	targetIDs TaskIDs
-	required  int
-	fn        TaskFn
-	skip      bool
+	targetIDs   TaskIDs
+	required    int
+	fn          TaskFn
+	skip        bool
+	fingerprint string
 }
 
 func (n *node) String() string {
@@ -97,6 +103,12 @@ type Opts struct {
 	ErrorCleaner func(ctx context.Context, taskID string)
 	// ErrorContext is used to store any error related context.
 	ErrorContext *errorsutils.ErrorContext
+	// Checkpointer is used to persist the succeeded tasks when the flow fails, so that they are not executed again in
+	// the next execution as long as their fingerprints do not change.
+	Checkpointer Checkpointer
+	// TracerProvider is used to emit a trace for the flow execution with one span per task. Use the timeline package to
+	// render the recorded spans as a timeline.
+	TracerProvider trace.TracerProvider
 }
 
 // Run starts an execution of a Flow.
@@ -106,9 +118,10 @@ func (f *Flow) Run(ctx context.Context, opts Opts) error {
 }
 
 type nodeResult struct {
-	TaskID  TaskID
-	Error   error
-	skipped bool
+	TaskID   TaskID
+	Error    error
+	skipped  bool
+	restored bool
 }
 
 // Stats are the statistics of a Flow execution.
@@ -169,6 +182,11 @@ func newExecution(flow *Flow, opts Opts) *execution {
 		log = opts.Log.WithValues(logKeyFlow, flow.name)
 	}
 
+	tracerProvider := opts.TracerProvider
+	if tracerProvider == nil {
+		tracerProvider = noop.NewTracerProvider()
+	}
+
 	return &execution{
 		flow,
 		InitialStats(flow.name, all),
@@ -177,8 +195,15 @@ func newExecution(flow *Flow, opts Opts) *execution {
 		opts.ProgressReporter,
 		opts.ErrorCleaner,
 		opts.ErrorContext,
+		opts.Checkpointer,
+		nil,
 		make(chan *nodeResult),
 		make(map[TaskID]int),
+		NewTaskIDs(),
+		tracerProvider.Tracer(TracerName),
+		nil,
+		make(map[TaskID]trace.SpanContext),
+		make(map[TaskID][]TaskID),
 	}
 }
 
@@ -192,18 +217,33 @@ type execution struct {
 	progressReporter ProgressReporter
 	errorCleaner     ErrorCleaner
 	errorContext     *errorsutils.ErrorContext
+	checkpointer     Checkpointer
+	checkpoint       Checkpoint
 
 	done          chan *nodeResult
 	triggerCounts map[TaskID]int
+	// changedInputs contains the tasks for which at least one dependency was executed in this execution, i.e., whose
+	// inputs might have changed since the checkpoint was saved.
+	changedInputs TaskIDs
+
+	tracer   trace.Tracer
+	flowSpan trace.Span
+	// spanContexts contains the span contexts of the started tasks. Skipped tasks inherit the span context of their
+	// parent.
+	spanContexts map[TaskID]trace.SpanContext
+	// triggeredBy contains the dependencies of the tasks in the order in which they finished.
+	triggeredBy map[TaskID][]TaskID
 }
 
 func (e *execution) runNode(ctx context.Context, id TaskID) {
 	log := e.log.WithValues(logKeyTask, id)
 
 	node := e.flow.nodes[id]
+	parent, spanOpts := e.spanOptions(id)
 	if node.skip {
 		log.V(1).Info("Skipped")
 		e.stats.Skipped.Insert(id)
+		e.spanContexts[id] = parent
 
 		go func() {
 			e.done <- &nodeResult{TaskID: id, Error: nil, skipped: true}
@@ -219,20 +259,38 @@ func (e *execution) runNode(ctx context.Context, id TaskID) {
 	e.stats.Pending.Delete(id)
 	e.stats.Running.Insert(id)
 
+	spanCtx, span := e.tracer.Start(trace.ContextWithSpanContext(ctx, parent), string(id), spanOpts...)
+	e.spanContexts[id] = span.SpanContext()
+
+	if e.canRestore(id, node) {
+		log.Info("Succeeded in previous execution, restored from checkpoint")
+		span.SetAttributes(AttributeKeyRestored.Bool(true))
+		span.End()
+
+		go func() {
+			e.done <- &nodeResult{TaskID: id, Error: nil, restored: true}
+		}()
+
+		return
+	}
+
 	go func() {
 		start := time.Now().UTC()
 
 		log.V(1).Info("Started")
-		err := node.fn(ctx)
+		err := node.fn(spanCtx)
 		end := time.Now().UTC()
 		log.V(1).Info("Finished", "duration", end.Sub(start))
 
 		if err != nil {
 			log.Error(err, "Error")
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
 			err = fmt.Errorf("task %q failed: %w", id, err)
 		} else {
 			log.Info("Succeeded")
 		}
+		span.End()
 
 		e.done <- &nodeResult{TaskID: id, Error: err}
 	}()
@@ -248,9 +306,23 @@ func (e *execution) updateFailure(id TaskID) {
 	e.stats.Failed.Insert(id)
 }
 
-func (e *execution) processTriggers(ctx context.Context, id TaskID) {
+// canRestore returns true if the task succeeded with the same fingerprint in the previous execution and none of its
+// dependencies was executed again.
+func (e *execution) canRestore(id TaskID, node *node) bool {
+	if node.fingerprint == "" || e.changedInputs.Has(id) {
+		return false
+	}
+	fingerprint, ok := e.checkpoint[id]
+	return ok && fingerprint == node.fingerprint
+}
+
+func (e *execution) processTriggers(ctx context.Context, id TaskID, executed bool) {
 	node := e.flow.nodes[id]
 	for target := range node.targetIDs {
+		if executed || e.changedInputs.Has(id) {
+			e.changedInputs.Insert(target)
+		}
+		e.triggeredBy[target] = append(e.triggeredBy[target], id)
 		e.triggerCounts[target]++
 		if e.triggerCounts[target] == e.flow.nodes[target].required {
 			e.runNode(ctx, target)
@@ -280,7 +352,11 @@ func (e *execution) run(ctx context.Context) error {
 		defer e.progressReporter.Stop()
 	}
 
+	ctx, e.flowSpan = e.tracer.Start(ctx, e.flow.name, trace.WithAttributes(AttributeKeyFlow.String(e.flow.name)))
+	defer e.flowSpan.End()
+
 	e.log.Info("Starting")
+	e.loadCheckpoint(ctx)
 	e.reportProgress(ctx)
 
 	var (
@@ -300,7 +376,7 @@ func (e *execution) run(ctx context.Context) error {
 		if result.skipped {
 			e.stats.Skipped.Delete(result.TaskID)
 			if cancelErr = ctx.Err(); cancelErr == nil {
-				e.processTriggers(ctx, result.TaskID)
+				e.processTriggers(ctx, result.TaskID, false)
 			}
 		} else {
 			if result.Error != nil {
@@ -312,7 +388,7 @@ func (e *execution) run(ctx context.Context) error {
 					e.cleanErrors(ctx, result.TaskID)
 				}
 				if cancelErr = ctx.Err(); cancelErr == nil {
-					e.processTriggers(ctx, result.TaskID)
+					e.processTriggers(ctx, result.TaskID, !result.restored)
 				}
 			}
 		}
@@ -320,8 +396,56 @@ func (e *execution) run(ctx context.Context) error {
 		e.reportProgress(ctx)
 	}
 
+	e.saveCheckpoint(ctx, cancelErr)
 	e.log.Info("Finished")
-	return e.result(cancelErr)
+
+	err := e.result(cancelErr)
+	if err != nil {
+		e.flowSpan.SetStatus(codes.Error, err.Error())
+	}
+	return err
+}
+
+func (e *execution) loadCheckpoint(ctx context.Context) {
+	if e.checkpointer == nil {
+		return
+	}
+
+	checkpoint, err := e.checkpointer.Load(ctx)
+	if err != nil {
+		e.log.Error(err, "Failed loading checkpoint, executing all tasks")
+		return
+	}
+	e.checkpoint = checkpoint
+}
+
+// saveCheckpoint persists the fingerprints of the succeeded tasks if the flow did not succeed. Otherwise, it removes the
+// checkpoint so that the next execution starts from the roots again.
+func (e *execution) saveCheckpoint(ctx context.Context, cancelErr error) {
+	if e.checkpointer == nil {
+		return
+	}
+
+	checkpoint := Checkpoint{}
+	if cancelErr != nil || len(e.taskErrors) > 0 {
+		for id := range e.stats.Succeeded {
+			if fingerprint := e.flow.nodes[id].fingerprint; fingerprint != "" {
+				checkpoint[id] = fingerprint
+			}
+		}
+	}
+
+	if len(checkpoint) == 0 && len(e.checkpoint) == 0 {
+		return
+	}
+
+	// The progress should also be persisted if the flow was canceled.
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), checkpointTimeout)
+	defer cancel()
+
+	if err := e.checkpointer.Save(ctx, checkpoint); err != nil {
+		e.log.Error(err, "Failed saving checkpoint")
+	}
 }
 
 func (e *execution) result(cancelErr error) error {
@@ -47,6 +47,20 @@ func (a *AtomicStringList) Values() []string {
 	return out
 }
 
+type memoryCheckpointer struct {
+	checkpoint flow.Checkpoint
+	loadErr    error
+}
+
+func (m *memoryCheckpointer) Load(_ context.Context) (flow.Checkpoint, error) {
+	return m.checkpoint, m.loadErr
+}
+
+func (m *memoryCheckpointer) Save(_ context.Context, checkpoint flow.Checkpoint) error {
+	m.checkpoint = checkpoint
+	return nil
+}
+
 var _ = Describe("Flow", func() {
 	canceledCtx, cancel := context.WithCancel(context.Background())
 	cancel()
@@ -169,6 +183,98 @@ var _ = Describe("Flow", func() {
 			Expect(err).To(HaveOccurred())
 			Expect(flow.WasCanceled(err)).To(BeTrue())
 		})
+
+		Context("with checkpointer", func() {
+			var (
+				list         *AtomicStringList
+				checkpointer *memoryCheckpointer
+				failingTask  string
+
+				mkTask = func(name, fingerprint string, dependencies ...flow.TaskID) flow.Task {
+					return flow.Task{
+						Name: name,
+						Fn: func(_ context.Context) error {
+							list.Append(name)
+							if name == failingTask {
+								return errors.New("fail")
+							}
+							return nil
+						},
+						Fingerprint:  fingerprint,
+						Dependencies: flow.NewTaskIDs(flow.TaskIDSlice(dependencies)),
+					}
+				}
+			)
+
+			BeforeEach(func() {
+				list = NewAtomicStringList()
+				checkpointer = &memoryCheckpointer{}
+				failingTask = ""
+			})
+
+			It("should save the fingerprints of the succeeded tasks if the flow fails", func() {
+				var (
+					g = flow.NewGraph("foo")
+					x = g.Add(mkTask("x", "x-1"))
+					y = g.Add(mkTask("y", "", x))
+					z = g.Add(mkTask("z", "z-1", x))
+					_ = g.Add(mkTask("w", "w-1", y, z))
+					f = g.Compile()
+				)
+				failingTask = "w"
+
+				Expect(f.Run(ctx, flow.Opts{Checkpointer: checkpointer})).To(HaveOccurred())
+				Expect(list.Values()).To(ConsistOf("x", "y", "z", "w"))
+				Expect(checkpointer.checkpoint).To(Equal(flow.Checkpoint{"x": "x-1", "z": "z-1"}))
+			})
+
+			It("should skip the unchanged tasks succeeded in the previous execution", func() {
+				var (
+					g = flow.NewGraph("foo")
+					x = g.Add(mkTask("x", "x-1"))
+					y = g.Add(mkTask("y", "", x))
+					z = g.Add(mkTask("z", "z-1", x))
+					_ = g.Add(mkTask("w", "w-1", y, z))
+					f = g.Compile()
+				)
+				checkpointer.checkpoint = flow.Checkpoint{"x": "x-1", "y": "y-1", "z": "z-1"}
+
+				Expect(f.Run(ctx, flow.Opts{Checkpointer: checkpointer})).To(Succeed())
+				Expect(list.Values()).To(ConsistOf("y", "w"))
+				Expect(checkpointer.checkpoint).To(BeEmpty())
+			})
+
+			It("should execute tasks with changed fingerprints and all tasks depending on them", func() {
+				var (
+					g = flow.NewGraph("foo")
+					x = g.Add(mkTask("x", "x-2"))
+					y = g.Add(mkTask("y", "y-1", x))
+					_ = g.Add(mkTask("z", "z-1", y))
+					v = g.Add(mkTask("v", "v-1"))
+					_ = g.Add(mkTask("w", "w-1", v))
+					f = g.Compile()
+				)
+				checkpointer.checkpoint = flow.Checkpoint{"x": "x-1", "y": "y-1", "z": "z-1", "v": "v-1"}
+				failingTask = "z"
+
+				Expect(f.Run(ctx, flow.Opts{Checkpointer: checkpointer})).To(HaveOccurred())
+				Expect(list.Values()).To(ConsistOf("x", "y", "z", "w"))
+				Expect(checkpointer.checkpoint).To(Equal(flow.Checkpoint{"x": "x-2", "y": "y-1", "v": "v-1", "w": "w-1"}))
+			})
+
+			It("should execute all tasks if the checkpoint cannot be loaded", func() {
+				var (
+					g = flow.NewGraph("foo")
+					_ = g.Add(mkTask("x", "x-1"))
+					f = g.Compile()
+				)
+				checkpointer.checkpoint = flow.Checkpoint{"x": "x-1"}
+				checkpointer.loadErr = errors.New("fake")
+
+				Expect(f.Run(ctx, flow.Opts{Checkpointer: checkpointer})).To(Succeed())
+				Expect(list.Values()).To(ConsistOf("x"))
+			})
+		})
 	})
 
 	Describe("#Sequential", func() {
@@ -15,6 +15,10 @@ type Task struct {
 	Fn           TaskFn
 	SkipIf       bool
 	Dependencies TaskIDs
+	// Fingerprint identifies the inputs of the task. If a Checkpointer is configured for the flow execution and the task
+	// succeeded in the previous execution with the same fingerprint, it is not executed again. Tasks without a
+	// fingerprint are always executed.
+	Fingerprint string
 }
 
 // Spec returns the TaskSpec of a task.
@@ -23,6 +27,7 @@ func (t *Task) Spec() *TaskSpec {
 		t.Fn,
 		t.SkipIf,
 		t.Dependencies.Copy(),
+		t.Fingerprint,
 	}
 }
 
@@ -32,6 +37,7 @@ type TaskSpec struct {
 	Fn           TaskFn
 	Skip         bool
 	Dependencies TaskIDs
+	Fingerprint  string
 }
 
 // Tasks is a mapping from TaskID to TaskSpec.
@@ -86,6 +92,7 @@ func (g *Graph) Compile() *Flow {
 		node := nodes.getOrCreate(taskName)
 		node.fn = taskSpec.Fn
 		node.skip = taskSpec.Skip
+		node.fingerprint = taskSpec.Fingerprint
 		node.required = taskSpec.Dependencies.Len()
 	}
 
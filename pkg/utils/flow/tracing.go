@@ -0,0 +1,47 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package flow
+
+import (
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// TracerName is the name of the tracer used for emitting the traces of flow executions.
+	TracerName = "github.com/gardener/gardener/pkg/utils/flow"
+
+	// AttributeKeyFlow is the key of the span attribute containing the name of the flow.
+	AttributeKeyFlow = attribute.Key("flow.name")
+	// AttributeKeyTask is the key of the span attribute containing the ID of the task.
+	AttributeKeyTask = attribute.Key("flow.task")
+	// AttributeKeyRestored is the key of the span attribute which is set if a task was restored from a checkpoint
+	// instead of being executed.
+	AttributeKeyRestored = attribute.Key("flow.task.restored")
+)
+
+// spanOptions returns the span start options for the given task. The span is parented by the span of the dependency
+// which finished last, i.e., the one which triggered the task, so that the chain of parents reflects the critical path
+// of the flow. The spans of the other dependencies are linked.
+func (e *execution) spanOptions(id TaskID) (trace.SpanContext, []trace.SpanStartOption) {
+	var (
+		parent = e.flowSpan.SpanContext()
+		links  []trace.Link
+	)
+
+	if dependencies := e.triggeredBy[id]; len(dependencies) > 0 {
+		parent = e.spanContexts[dependencies[len(dependencies)-1]]
+		for _, dependency := range dependencies[:len(dependencies)-1] {
+			if spanContext := e.spanContexts[dependency]; spanContext.IsValid() && !spanContext.Equal(parent) {
+				links = append(links, trace.Link{SpanContext: spanContext})
+			}
+		}
+	}
+
+	return parent, []trace.SpanStartOption{
+		trace.WithLinks(links...),
+		trace.WithAttributes(AttributeKeyFlow.String(e.flow.name), AttributeKeyTask.String(string(id))),
+	}
+}
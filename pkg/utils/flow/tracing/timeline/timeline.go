@@ -0,0 +1,111 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package timeline
+
+import (
+	"encoding/json"
+	"io"
+	"slices"
+	"time"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/gardener/gardener/pkg/utils/flow"
+)
+
+type chromeTrace struct {
+	TraceEvents []chromeTraceEvent `json:"traceEvents"`
+}
+
+type chromeTraceEvent struct {
+	Name      string            `json:"name"`
+	Category  string            `json:"cat"`
+	Phase     string            `json:"ph"`
+	Timestamp int64             `json:"ts"`
+	Duration  int64             `json:"dur"`
+	ProcessID int               `json:"pid"`
+	ThreadID  int               `json:"tid"`
+	Args      map[string]string `json:"args,omitempty"`
+}
+
+// Write writes the given spans of flow executions as a timeline in the Chrome trace event format to the given
+// writer. The timeline can be viewed with chrome://tracing or https://ui.perfetto.dev. Every flow is shown as a separate
+// process, and tasks running in parallel are distributed over multiple threads. Spans can be collected with a span
+// recorder of the OpenTelemetry SDK registered at the TracerProvider passed in flow.Opts.
+func Write(w io.Writer, spans []sdktrace.ReadOnlySpan) error {
+	spans = slices.Clone(spans)
+	slices.SortStableFunc(spans, func(a, b sdktrace.ReadOnlySpan) int {
+		return a.StartTime().Compare(b.StartTime())
+	})
+
+	var (
+		out       = chromeTrace{TraceEvents: []chromeTraceEvent{}}
+		processes = make(map[trace.TraceID]int)
+		// threads contains the end times of the last spans per process and thread.
+		threads = make(map[int][]time.Time)
+	)
+
+	for _, span := range spans {
+		var flowName, taskID string
+		for _, attr := range span.Attributes() {
+			switch attr.Key {
+			case flow.AttributeKeyFlow:
+				flowName = attr.Value.AsString()
+			case flow.AttributeKeyTask:
+				taskID = attr.Value.AsString()
+			}
+		}
+		if flowName == "" {
+			continue
+		}
+
+		pid, ok := processes[span.SpanContext().TraceID()]
+		if !ok {
+			pid = len(processes) + 1
+			processes[span.SpanContext().TraceID()] = pid
+		}
+
+		event := chromeTraceEvent{
+			Name:      span.Name(),
+			Category:  "task",
+			Phase:     "X",
+			Timestamp: span.StartTime().UnixMicro(),
+			Duration:  span.EndTime().Sub(span.StartTime()).Microseconds(),
+			ProcessID: pid,
+			Args:      map[string]string{"flow": flowName},
+		}
+
+		if taskID == "" {
+			event.Category = "flow"
+		} else {
+			event.ThreadID = nextFreeThread(threads, pid, span.StartTime(), span.EndTime())
+			event.Args["task"] = taskID
+		}
+		if span.Status().Description != "" {
+			event.Args["error"] = span.Status().Description
+		}
+
+		out.TraceEvents = append(out.TraceEvents, event)
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(out)
+}
+
+// nextFreeThread returns the first thread of the given process which is idle at the given start time and marks it as
+// busy until the given end time. Thread 0 is reserved for the span of the flow itself.
+func nextFreeThread(threads map[int][]time.Time, pid int, start, end time.Time) int {
+	for i, busyUntil := range threads[pid] {
+		if !busyUntil.After(start) {
+			threads[pid][i] = end
+			return i + 1
+		}
+	}
+
+	threads[pid] = append(threads[pid], end)
+	return len(threads[pid])
+}
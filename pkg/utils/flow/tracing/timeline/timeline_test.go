@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package timeline_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/gardener/gardener/pkg/utils/flow"
+	. "github.com/gardener/gardener/pkg/utils/flow/tracing/timeline"
+)
+
+var _ = Describe("Timeline", func() {
+	Describe("#Write", func() {
+		It("should write the spans in the Chrome trace event format", func() {
+			var (
+				start   = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+				traceID = trace.TraceID{1}
+				stub    = func(name string, startOffset, endOffset time.Duration, attrs ...attribute.KeyValue) tracetest.SpanStub {
+					return tracetest.SpanStub{
+						Name:        name,
+						SpanContext: trace.NewSpanContext(trace.SpanContextConfig{TraceID: traceID}),
+						StartTime:   start.Add(startOffset),
+						EndTime:     start.Add(endOffset),
+						Attributes:  append([]attribute.KeyValue{flow.AttributeKeyFlow.String("foo")}, attrs...),
+					}
+				}
+				spans = tracetest.SpanStubs{
+					stub("foo", 0, 4*time.Second),
+					stub("x", 0, 2*time.Second, flow.AttributeKeyTask.String("x")),
+					stub("y", time.Second, 3*time.Second, flow.AttributeKeyTask.String("y")),
+					stub("z", 2*time.Second, 4*time.Second, flow.AttributeKeyTask.String("z")),
+				}
+				buf = &bytes.Buffer{}
+			)
+			spans[3].Status = sdktrace.Status{Code: codes.Error, Description: "fake"}
+
+			Expect(Write(buf, spans.Snapshots())).To(Succeed())
+
+			var timeline struct {
+				TraceEvents []map[string]any `json:"traceEvents"`
+			}
+			Expect(json.Unmarshal(buf.Bytes(), &timeline)).To(Succeed())
+
+			Expect(timeline.TraceEvents).To(ConsistOf(
+				And(HaveKeyWithValue("name", "foo"), HaveKeyWithValue("cat", "flow"), HaveKeyWithValue("tid", BeNumerically("==", 0)), HaveKeyWithValue("dur", BeNumerically("==", 4000000))),
+				And(HaveKeyWithValue("name", "x"), HaveKeyWithValue("ph", "X"), HaveKeyWithValue("tid", BeNumerically("==", 1)), HaveKeyWithValue("ts", BeNumerically("==", start.UnixMicro()))),
+				And(HaveKeyWithValue("name", "y"), HaveKeyWithValue("tid", BeNumerically("==", 2)), HaveKeyWithValue("pid", BeNumerically("==", 1))),
+				And(HaveKeyWithValue("name", "z"), HaveKeyWithValue("tid", BeNumerically("==", 1)), HaveKeyWithValue("args", HaveKeyWithValue("error", "fake"))),
+			))
+		})
+	})
+})
@@ -0,0 +1,101 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package flow_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/gardener/gardener/pkg/utils/flow"
+)
+
+var _ = Describe("Tracing", func() {
+	var (
+		ctx      = context.Background()
+		recorder *tracetest.SpanRecorder
+		provider *sdktrace.TracerProvider
+
+		noop = func(_ context.Context) error { return nil }
+	)
+
+	BeforeEach(func() {
+		recorder = tracetest.NewSpanRecorder()
+		provider = sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	})
+
+	endedSpans := func() map[string]sdktrace.ReadOnlySpan {
+		spans := make(map[string]sdktrace.ReadOnlySpan)
+		for _, span := range recorder.Ended() {
+			spans[span.Name()] = span
+		}
+		return spans
+	}
+
+	Describe("#Run", func() {
+		It("should emit one span per task parented by the dependency which finished last", func() {
+			var (
+				g = flow.NewGraph("foo")
+				x = g.Add(flow.Task{Name: "x", Fn: noop})
+				y = g.Add(flow.Task{Name: "y", Fn: flow.TaskFn(noop).Timeout(time.Second), Dependencies: flow.NewTaskIDs(x)})
+				s = g.Add(flow.Task{Name: "s", Fn: noop, SkipIf: true, Dependencies: flow.NewTaskIDs(x)})
+				_ = g.Add(flow.Task{Name: "z", Fn: noop, Dependencies: flow.NewTaskIDs(x, y)})
+				_ = g.Add(flow.Task{Name: "t", Fn: noop, Dependencies: flow.NewTaskIDs(s)})
+				f = g.Compile()
+			)
+
+			Expect(f.Run(ctx, flow.Opts{TracerProvider: provider})).To(Succeed())
+
+			spans := endedSpans()
+			Expect(spans).To(HaveLen(5))
+			Expect(spans).To(HaveKey("foo"))
+			Expect(spans).NotTo(HaveKey("s"))
+
+			traceID := spans["foo"].SpanContext().TraceID()
+			for _, span := range spans {
+				Expect(span.SpanContext().TraceID()).To(Equal(traceID))
+			}
+
+			Expect(spans["foo"].Parent().IsValid()).To(BeFalse())
+			Expect(spans["x"].Parent().SpanID()).To(Equal(spans["foo"].SpanContext().SpanID()))
+			Expect(spans["y"].Parent().SpanID()).To(Equal(spans["x"].SpanContext().SpanID()))
+			Expect(spans["z"].Parent().SpanID()).To(Equal(spans["y"].SpanContext().SpanID()))
+			Expect(spans["z"].Links()).To(ConsistOf(HaveField("SpanContext.SpanID()", spans["x"].SpanContext().SpanID())))
+			Expect(spans["t"].Parent().SpanID()).To(Equal(spans["x"].SpanContext().SpanID()))
+			Expect(spans["z"].Attributes()).To(ConsistOf(flow.AttributeKeyFlow.String("foo"), flow.AttributeKeyTask.String("z")))
+		})
+
+		It("should record the errors of the failed tasks", func() {
+			var (
+				g = flow.NewGraph("foo")
+				_ = g.Add(flow.Task{Name: "x", Fn: func(_ context.Context) error { return errors.New("fake") }})
+				f = g.Compile()
+			)
+
+			Expect(f.Run(ctx, flow.Opts{TracerProvider: provider})).NotTo(Succeed())
+
+			spans := endedSpans()
+			Expect(spans["x"].Status()).To(Equal(sdktrace.Status{Code: codes.Error, Description: "fake"}))
+			Expect(spans["foo"].Status().Code).To(Equal(codes.Error))
+		})
+
+		It("should mark the tasks restored from a checkpoint", func() {
+			var (
+				g = flow.NewGraph("foo")
+				_ = g.Add(flow.Task{Name: "x", Fn: noop, Fingerprint: "x-1"})
+				f = g.Compile()
+			)
+
+			Expect(f.Run(ctx, flow.Opts{TracerProvider: provider, Checkpointer: &memoryCheckpointer{checkpoint: flow.Checkpoint{"x": "x-1"}}})).To(Succeed())
+			Expect(endedSpans()["x"].Attributes()).To(ContainElement(flow.AttributeKeyRestored.Bool(true)))
+		})
+	})
+})
@@ -0,0 +1,92 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gardener
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
+)
+
+// DataKeySecretsInventory is the key in the data of the secrets inventory ConfigMap of a shoot which contains the
+// JSON-encoded SecretsInventory.
+const DataKeySecretsInventory = "inventory"
+
+// SecretsInventory is a summary of the certificate authorities and certificates managed for the control plane of a
+// shoot. It is published by gardenlet into the `<shoot-name>.secrets-inventory` ConfigMap in the project namespace.
+type SecretsInventory struct {
+	// Certificates is the list of certificate authorities and certificates.
+	Certificates []SecretsInventoryCertificate `json:"certificates,omitempty"`
+}
+
+// SecretsInventoryCertificate contains information about a certificate authority or certificate in a SecretsInventory.
+type SecretsInventoryCertificate struct {
+	secretsmanager.CertificateInfo `json:",inline"`
+	// RotationPhase is the phase of the certificate authorities rotation of the shoot at the time the inventory was
+	// published.
+	RotationPhase gardencorev1beta1.CredentialsRotationPhase `json:"rotationPhase,omitempty"`
+}
+
+// NewSecretsInventory computes the SecretsInventory for the given shoot and certificates.
+func NewSecretsInventory(shoot *gardencorev1beta1.Shoot, certificates []secretsmanager.CertificateInfo) *SecretsInventory {
+	var (
+		inventory     = &SecretsInventory{}
+		rotationPhase = v1beta1helper.GetShootCARotationPhase(shoot.Status.Credentials)
+	)
+
+	for _, certificate := range certificates {
+		inventory.Certificates = append(inventory.Certificates, SecretsInventoryCertificate{
+			CertificateInfo: certificate,
+			RotationPhase:   rotationPhase,
+		})
+	}
+
+	return inventory
+}
+
+// ConfigMapData returns the data for the secrets inventory ConfigMap.
+func (i *SecretsInventory) ConfigMapData() (map[string]string, error) {
+	raw, err := json.Marshal(i)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]string{DataKeySecretsInventory: string(raw)}, nil
+}
+
+// SecretsInventoryFromConfigMap reads the SecretsInventory from the given secrets inventory ConfigMap.
+func SecretsInventoryFromConfigMap(configMap *corev1.ConfigMap) (*SecretsInventory, error) {
+	raw, ok := configMap.Data[DataKeySecretsInventory]
+	if !ok {
+		return nil, fmt.Errorf("ConfigMap %s does not contain data key %q", configMap.Name, DataKeySecretsInventory)
+	}
+
+	inventory := &SecretsInventory{}
+	if err := json.Unmarshal([]byte(raw), inventory); err != nil {
+		return nil, fmt.Errorf("failed decoding secrets inventory from ConfigMap %s: %w", configMap.Name, err)
+	}
+
+	return inventory, nil
+}
+
+// ExpiringCertificates returns the certificates of the inventory which are no longer valid at the given deadline.
+// Certificates without known validity are ignored.
+func (i *SecretsInventory) ExpiringCertificates(deadline time.Time) []SecretsInventoryCertificate {
+	var expiring []SecretsInventoryCertificate
+
+	for _, certificate := range i.Certificates {
+		if certificate.ValidUntil != nil && !certificate.ValidUntil.Time.After(deadline) {
+			expiring = append(expiring, certificate)
+		}
+	}
+
+	return expiring
+}
@@ -0,0 +1,106 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gardener_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	. "github.com/gardener/gardener/pkg/utils/gardener"
+	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
+)
+
+var _ = Describe("SecretsInventory", func() {
+	var (
+		now = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+		ca     = secretsmanager.CertificateInfo{Name: "ca", SecretName: "ca-1234", ManagerIdentity: "gardenlet", CA: true, ValidUntil: &metav1.Time{Time: now.AddDate(10, 0, 0)}}
+		server = secretsmanager.CertificateInfo{Name: "server", SecretName: "server-1234", ManagerIdentity: "gardenlet", SigningCA: "ca-1234", ValidUntil: &metav1.Time{Time: now.AddDate(0, 0, 7)}}
+		client = secretsmanager.CertificateInfo{Name: "client", SecretName: "client-1234", ManagerIdentity: "gardenlet", SigningCA: "ca-1234"}
+
+		shoot *gardencorev1beta1.Shoot
+	)
+
+	BeforeEach(func() {
+		shoot = &gardencorev1beta1.Shoot{
+			Status: gardencorev1beta1.ShootStatus{
+				Credentials: &gardencorev1beta1.ShootCredentials{
+					Rotation: &gardencorev1beta1.ShootCredentialsRotation{
+						CertificateAuthorities: &gardencorev1beta1.CARotation{Phase: gardencorev1beta1.RotationPrepared},
+					},
+				},
+			},
+		}
+	})
+
+	Describe("#NewSecretsInventory", func() {
+		It("should add the CA rotation phase of the shoot to all certificates", func() {
+			Expect(NewSecretsInventory(shoot, []secretsmanager.CertificateInfo{ca, server})).To(Equal(&SecretsInventory{
+				Certificates: []SecretsInventoryCertificate{
+					{CertificateInfo: ca, RotationPhase: gardencorev1beta1.RotationPrepared},
+					{CertificateInfo: server, RotationPhase: gardencorev1beta1.RotationPrepared},
+				},
+			}))
+		})
+
+		It("should not set a rotation phase if the CAs were never rotated", func() {
+			shoot.Status.Credentials = nil
+
+			Expect(NewSecretsInventory(shoot, []secretsmanager.CertificateInfo{ca})).To(Equal(&SecretsInventory{
+				Certificates: []SecretsInventoryCertificate{{CertificateInfo: ca}},
+			}))
+		})
+	})
+
+	Describe("#SecretsInventoryFromConfigMap", func() {
+		It("should read the inventory written by ConfigMapData", func() {
+			inventory := NewSecretsInventory(shoot, []secretsmanager.CertificateInfo{ca, server, client})
+
+			data, err := inventory.ConfigMapData()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(data).To(HaveKey(DataKeySecretsInventory))
+
+			read, err := SecretsInventoryFromConfigMap(&corev1.ConfigMap{Data: data})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(read.Certificates).To(HaveLen(3))
+			Expect(read.Certificates[0].Name).To(Equal("ca"))
+			Expect(read.Certificates[0].CA).To(BeTrue())
+			Expect(read.Certificates[0].RotationPhase).To(Equal(gardencorev1beta1.RotationPrepared))
+			Expect(read.Certificates[1].SigningCA).To(Equal("ca-1234"))
+			Expect(read.Certificates[1].ValidUntil.Time.Equal(server.ValidUntil.Time)).To(BeTrue())
+			Expect(read.Certificates[2].ValidUntil).To(BeNil())
+		})
+
+		It("should fail if the data key is missing", func() {
+			_, err := SecretsInventoryFromConfigMap(&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "foo"}})
+			Expect(err).To(MatchError(ContainSubstring(`does not contain data key "inventory"`)))
+		})
+
+		It("should fail if the inventory cannot be decoded", func() {
+			_, err := SecretsInventoryFromConfigMap(&corev1.ConfigMap{Data: map[string]string{DataKeySecretsInventory: "{"}})
+			Expect(err).To(MatchError(ContainSubstring("failed decoding secrets inventory")))
+		})
+	})
+
+	Describe("#ExpiringCertificates", func() {
+		It("should return the certificates which are no longer valid at the deadline", func() {
+			inventory := NewSecretsInventory(shoot, []secretsmanager.CertificateInfo{ca, server, client})
+
+			Expect(inventory.ExpiringCertificates(now.AddDate(0, 0, 1))).To(BeEmpty())
+			Expect(inventory.ExpiringCertificates(now.AddDate(0, 0, 7))).To(ConsistOf(
+				SecretsInventoryCertificate{CertificateInfo: server, RotationPhase: gardencorev1beta1.RotationPrepared},
+			))
+			Expect(inventory.ExpiringCertificates(now.AddDate(20, 0, 0))).To(ConsistOf(
+				SecretsInventoryCertificate{CertificateInfo: ca, RotationPhase: gardencorev1beta1.RotationPrepared},
+				SecretsInventoryCertificate{CertificateInfo: server, RotationPhase: gardencorev1beta1.RotationPrepared},
+			))
+		})
+	})
+})
@@ -213,6 +213,9 @@ const (
 	ShootProjectSecretSuffixMonitoring = "monitoring"
 	// ShootProjectConfigMapSuffixCACluster is a constant for a shoot project secret with suffix 'ca-cluster'.
 	ShootProjectConfigMapSuffixCACluster = "ca-cluster"
+	// ShootProjectConfigMapSuffixSecretsInventory is a constant for a shoot project config map with suffix
+	// 'secrets-inventory'.
+	ShootProjectConfigMapSuffixSecretsInventory = "secrets-inventory"
 )
 
 // GetShootProjectSecretSuffixes returns the list of shoot-related project secret suffixes.
@@ -237,6 +240,7 @@ func GetShootProjectInternalSecretSuffixes() []string {
 func GetShootProjectConfigMapSuffixes() []string {
 	return []string{
 		ShootProjectConfigMapSuffixCACluster,
+		ShootProjectConfigMapSuffixSecretsInventory,
 	}
 }
 
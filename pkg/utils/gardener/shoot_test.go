@@ -410,6 +410,7 @@ var _ = Describe("Shoot", func() {
 		Entry("unrelated suffix", "foo.bar", "", false),
 		Entry("wrong suffix delimiter", "foo:kubeconfig", "", false),
 		Entry("ca-cluster suffix", "baz.ca-cluster", "baz", true),
+		Entry("secrets-inventory suffix", "baz.secrets-inventory", "baz", true),
 	)
 
 	Describe("#NewShootAccessSecret", func() {
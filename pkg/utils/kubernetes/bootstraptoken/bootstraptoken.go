@@ -6,7 +6,10 @@ package bootstraptoken
 
 import (
 	"context"
+	"fmt"
 	"regexp"
+	"slices"
+	"strings"
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
@@ -48,22 +51,102 @@ func ComputeBootstrapToken(ctx context.Context, c client.Client, tokenID, descri
 		}
 	}
 
+	return createOrUpdate(ctx, c, secret, tokenID, bootstrapTokenSecretKey, description, validity, bootstraptokenapi.KnownTokenUsages)
+}
+
+// Generate generates a random bootstrap token of the form "[a-z0-9]{6}.[a-z0-9]{16}".
+func Generate() (string, error) {
+	return bootstraptokenutil.GenerateBootstrapToken()
+}
+
+// CreateOrUpdate creates or updates the secret for the given bootstrap token of the form "[a-z0-9]{6}.[a-z0-9]{16}"
+// with the given description, validity and usages, and returns it.
+func CreateOrUpdate(ctx context.Context, c client.Client, token, description string, validity time.Duration, usages []string) (*corev1.Secret, error) {
+	tokenID, tokenSecret, err := Parse(token)
+	if err != nil {
+		return nil, err
+	}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      bootstraptokenutil.BootstrapTokenSecretName(tokenID),
+			Namespace: metav1.NamespaceSystem,
+		},
+	}
+
+	return createOrUpdate(ctx, c, secret, tokenID, tokenSecret, description, validity, usages)
+}
+
+func createOrUpdate(ctx context.Context, c client.Client, secret *corev1.Secret, tokenID, tokenSecret, description string, validity time.Duration, usages []string) (*corev1.Secret, error) {
 	data := map[string][]byte{
-		bootstraptokenapi.BootstrapTokenDescriptionKey:      []byte(description),
-		bootstraptokenapi.BootstrapTokenIDKey:               []byte(tokenID),
-		bootstraptokenapi.BootstrapTokenSecretKey:           []byte(bootstrapTokenSecretKey),
-		bootstraptokenapi.BootstrapTokenExpirationKey:       []byte(metav1.Now().Add(validity).Format(time.RFC3339)),
-		bootstraptokenapi.BootstrapTokenUsageAuthentication: []byte("true"),
-		bootstraptokenapi.BootstrapTokenUsageSigningKey:     []byte("true"),
+		bootstraptokenapi.BootstrapTokenDescriptionKey: []byte(description),
+		bootstraptokenapi.BootstrapTokenIDKey:          []byte(tokenID),
+		bootstraptokenapi.BootstrapTokenSecretKey:      []byte(tokenSecret),
+		bootstraptokenapi.BootstrapTokenExpirationKey:  []byte(metav1.Now().Add(validity).Format(time.RFC3339)),
 	}
 
-	_, err2 := controllerutils.GetAndCreateOrMergePatch(ctx, c, secret, func() error {
+	for _, usage := range usages {
+		data[bootstraptokenapi.BootstrapTokenUsagePrefix+usage] = []byte("true")
+	}
+
+	_, err := controllerutils.GetAndCreateOrMergePatch(ctx, c, secret, func() error {
 		secret.Type = bootstraptokenapi.SecretTypeBootstrapToken
 		secret.Data = data
 		return nil
 	})
 
-	return secret, err2
+	return secret, err
+}
+
+// Parse parses the given bootstrap token of the form "[a-z0-9]{6}.[a-z0-9]{16}" and returns its ID and secret.
+func Parse(token string) (tokenID, tokenSecret string, err error) {
+	if !bootstraptokenutil.IsValidBootstrapToken(token) {
+		return "", "", fmt.Errorf("bootstrap token %q does not match the expected format %q", token, bootstraptokenapi.BootstrapTokenPattern)
+	}
+
+	parts := strings.Split(token, ".")
+	return parts[0], parts[1], nil
+}
+
+// List returns all bootstrap token secrets in the kube-system namespace.
+func List(ctx context.Context, c client.Reader) ([]corev1.Secret, error) {
+	secretList := &corev1.SecretList{}
+	if err := c.List(ctx, secretList, client.InNamespace(metav1.NamespaceSystem)); err != nil {
+		return nil, err
+	}
+
+	var secrets []corev1.Secret
+	for _, secret := range secretList.Items {
+		if secret.Type == bootstraptokenapi.SecretTypeBootstrapToken {
+			secrets = append(secrets, secret)
+		}
+	}
+
+	return secrets, nil
+}
+
+// Delete deletes the bootstrap token secret for the given token ID. It does not return an error if the secret does
+// not exist.
+func Delete(ctx context.Context, c client.Client, tokenID string) error {
+	return client.IgnoreNotFound(c.Delete(ctx, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      bootstraptokenutil.BootstrapTokenSecretName(tokenID),
+			Namespace: metav1.NamespaceSystem,
+		},
+	}))
+}
+
+// UsagesFromSecretData returns the usages of the bootstrap token based on the secret data.
+func UsagesFromSecretData(data map[string][]byte) []string {
+	var usages []string
+	for key, value := range data {
+		if usage, ok := strings.CutPrefix(key, bootstraptokenapi.BootstrapTokenUsagePrefix); ok && string(value) == "true" {
+			usages = append(usages, usage)
+		}
+	}
+
+	slices.Sort(usages)
+	return usages
 }
 
 // FromSecretData returns the bootstrap token based on the secret data.
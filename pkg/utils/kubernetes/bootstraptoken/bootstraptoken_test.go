@@ -0,0 +1,125 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package bootstraptoken_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/utils/kubernetes/bootstraptoken"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("BootstrapToken", func() {
+	var (
+		ctx        = context.Background()
+		fakeClient client.Client
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.ShootScheme).Build()
+	})
+
+	Describe("#ComputeBootstrapToken", func() {
+		It("should create a bootstrap token secret and keep the token secret on subsequent calls", func() {
+			secret, err := ComputeBootstrapToken(ctx, fakeClient, "foo123", "some description", time.Hour)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(secret.Type).To(Equal(corev1.SecretType("bootstrap.kubernetes.io/token")))
+			Expect(secret.Data).To(HaveKeyWithValue("token-id", []byte("foo123")))
+			Expect(secret.Data).To(HaveKeyWithValue("usage-bootstrap-authentication", []byte("true")))
+			Expect(secret.Data).To(HaveKeyWithValue("usage-bootstrap-signing", []byte("true")))
+			Expect(secret.Data["token-secret"]).To(MatchRegexp(`^[a-z0-9]{16}$`))
+
+			secret2, err := ComputeBootstrapToken(ctx, fakeClient, "foo123", "some description", time.Hour)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(FromSecretData(secret2.Data)).To(Equal(FromSecretData(secret.Data)))
+		})
+	})
+
+	Describe("#Generate", func() {
+		It("should generate a valid token", func() {
+			token, err := Generate()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(token).To(MatchRegexp(`^[a-z0-9]{6}\.[a-z0-9]{16}$`))
+		})
+	})
+
+	Describe("#Parse", func() {
+		It("should return the token ID and secret", func() {
+			tokenID, tokenSecret, err := Parse("foo123.bar4567890baz123")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(tokenID).To(Equal("foo123"))
+			Expect(tokenSecret).To(Equal("bar4567890baz123"))
+		})
+
+		It("should fail for an invalid token", func() {
+			_, _, err := Parse("foo.bar")
+			Expect(err).To(MatchError(ContainSubstring("does not match the expected format")))
+		})
+	})
+
+	Describe("#CreateOrUpdate", func() {
+		It("should create the secret with the given usages", func() {
+			secret, err := CreateOrUpdate(ctx, fakeClient, "foo123.bar4567890baz123", "some description", time.Hour, []string{"signing"})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: "kube-system", Name: "bootstrap-token-foo123"}, secret)).To(Succeed())
+			Expect(FromSecretData(secret.Data)).To(Equal("foo123.bar4567890baz123"))
+			Expect(UsagesFromSecretData(secret.Data)).To(ConsistOf("signing"))
+			Expect(secret.Data).To(HaveKeyWithValue("description", []byte("some description")))
+		})
+
+		It("should fail for an invalid token", func() {
+			_, err := CreateOrUpdate(ctx, fakeClient, "foo", "", time.Hour, nil)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("#List", func() {
+		It("should only return bootstrap token secrets", func() {
+			_, err := CreateOrUpdate(ctx, fakeClient, "foo123.bar4567890baz123", "", time.Hour, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fakeClient.Create(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "kube-system"}})).To(Succeed())
+
+			secrets, err := List(ctx, fakeClient)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(secrets).To(HaveLen(1))
+			Expect(secrets[0].Name).To(Equal("bootstrap-token-foo123"))
+		})
+	})
+
+	Describe("#Delete", func() {
+		It("should delete the secret", func() {
+			secret, err := CreateOrUpdate(ctx, fakeClient, "foo123.bar4567890baz123", "", time.Hour, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(Delete(ctx, fakeClient, "foo123")).To(Succeed())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(secret), secret)).To(BeNotFoundError())
+		})
+
+		It("should succeed if the secret does not exist", func() {
+			Expect(Delete(ctx, fakeClient, "foo123")).To(Succeed())
+		})
+	})
+
+	Describe("#UsagesFromSecretData", func() {
+		It("should return the sorted usages", func() {
+			Expect(UsagesFromSecretData(map[string][]byte{
+				"usage-bootstrap-signing":        []byte("true"),
+				"usage-bootstrap-authentication": []byte("true"),
+				"usage-bootstrap-foo":            []byte("false"),
+				"token-id":                       []byte("foo123"),
+			})).To(Equal([]string{"authentication", "signing"}))
+		})
+	})
+})
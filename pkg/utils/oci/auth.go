@@ -0,0 +1,65 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package oci
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// dockerConfigKeychain is an authn.Keychain resolving credentials from the content of a `.dockerconfigjson` file.
+type dockerConfigKeychain struct {
+	auths map[string]authn.AuthConfig
+}
+
+// keychainFromSecret returns an authn.Keychain for the credentials contained in the given secret of type
+// `kubernetes.io/dockerconfigjson`.
+func keychainFromSecret(secret *corev1.Secret) (authn.Keychain, error) {
+	if secret.Type != corev1.SecretTypeDockerConfigJson {
+		return nil, fmt.Errorf("pull secret %s must be of type %q but has type %q", secret.Name, corev1.SecretTypeDockerConfigJson, secret.Type)
+	}
+
+	raw, ok := secret.Data[corev1.DockerConfigJsonKey]
+	if !ok {
+		return nil, fmt.Errorf("pull secret %s does not contain data key %q", secret.Name, corev1.DockerConfigJsonKey)
+	}
+
+	config := struct {
+		Auths map[string]authn.AuthConfig `json:"auths"`
+	}{}
+	if err := json.Unmarshal(raw, &config); err != nil {
+		return nil, fmt.Errorf("failed decoding pull secret %s: %w", secret.Name, err)
+	}
+
+	keychain := &dockerConfigKeychain{auths: make(map[string]authn.AuthConfig, len(config.Auths))}
+	for server, auth := range config.Auths {
+		keychain.auths[normalizeRegistry(server)] = auth
+	}
+
+	return keychain, nil
+}
+
+// Resolve implements authn.Keychain.
+func (k *dockerConfigKeychain) Resolve(target authn.Resource) (authn.Authenticator, error) {
+	auth, ok := k.auths[normalizeRegistry(target.RegistryStr())]
+	if !ok {
+		return authn.Anonymous, nil
+	}
+
+	return authn.FromConfig(auth), nil
+}
+
+// normalizeRegistry strips the scheme and path from the server keys used in docker config files, e.g.
+// `https://index.docker.io/v1/` becomes `index.docker.io`.
+func normalizeRegistry(server string) string {
+	server = strings.TrimPrefix(server, "https://")
+	server = strings.TrimPrefix(server, "http://")
+	server, _, _ = strings.Cut(server, "/")
+	return server
+}
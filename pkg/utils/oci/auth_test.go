@@ -0,0 +1,137 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package oci
+
+import (
+	"context"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubernetesscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1 "github.com/gardener/gardener/pkg/apis/core/v1"
+)
+
+var _ = Describe("Auth", func() {
+	var secret *corev1.Secret
+
+	BeforeEach(func() {
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "pull-secret", Namespace: "garden"},
+			Type:       corev1.SecretTypeDockerConfigJson,
+			Data: map[string][]byte{
+				corev1.DockerConfigJsonKey: []byte(`{"auths":{"https://example.com/v1/":{"auth":"Zm9vOmJhcg=="},"registry.local:5000":{"username":"baz","password":"qux"}}}`),
+			},
+		}
+	})
+
+	Describe("#keychainFromSecret", func() {
+		It("should resolve the credentials for the registries in the docker config", func() {
+			keychain, err := keychainFromSecret(secret)
+			Expect(err).NotTo(HaveOccurred())
+
+			for registry, expected := range map[string]authn.AuthConfig{
+				"example.com":         {Username: "foo", Password: "bar"},
+				"registry.local:5000": {Username: "baz", Password: "qux"},
+				"other.com":           {},
+			} {
+				ref, err := name.ParseReference(registry + "/foo:1.0.0")
+				Expect(err).NotTo(HaveOccurred())
+				authenticator, err := keychain.Resolve(ref.Context())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(authenticator.Authorization()).To(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Username": Equal(expected.Username),
+					"Password": Equal(expected.Password),
+				})), registry)
+			}
+		})
+
+		It("should fail if the secret has the wrong type", func() {
+			secret.Type = corev1.SecretTypeOpaque
+
+			_, err := keychainFromSecret(secret)
+			Expect(err).To(MatchError(ContainSubstring(`must be of type "kubernetes.io/dockerconfigjson"`)))
+		})
+
+		It("should fail if the docker config cannot be decoded", func() {
+			secret.Data[corev1.DockerConfigJsonKey] = []byte("{")
+
+			_, err := keychainFromSecret(secret)
+			Expect(err).To(MatchError(ContainSubstring("failed decoding pull secret")))
+		})
+	})
+
+	Describe("#Pull", func() {
+		var (
+			ctx = context.Background()
+			oci *gardencorev1.OCIRepository
+		)
+
+		BeforeEach(func() {
+			oci = &gardencorev1.OCIRepository{
+				Repository:    ptr.To(registryAddress + "/charts/example"),
+				Tag:           ptr.To("0.1.0"),
+				PullSecretRef: &corev1.LocalObjectReference{Name: "pull-secret"},
+			}
+		})
+
+		It("should fail if pull secrets are not configured", func() {
+			hr, err := NewHelmRegistry()
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = hr.Pull(ctx, oci)
+			Expect(err).To(MatchError(ContainSubstring("pull secrets are not supported")))
+		})
+
+		It("should fail if the pull secret does not exist", func() {
+			hr, err := NewHelmRegistry(WithPullSecrets(fakeclient.NewClientBuilder().WithScheme(kubernetesscheme.Scheme).Build(), "garden"))
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = hr.Pull(ctx, oci)
+			Expect(err).To(MatchError(ContainSubstring("failed reading pull secret garden/pull-secret")))
+		})
+
+		It("should fail if the pull secret does not have the required role", func() {
+			hr, err := NewHelmRegistry(
+				WithPullSecrets(fakeclient.NewClientBuilder().WithScheme(kubernetesscheme.Scheme).WithObjects(secret).Build(), "garden"),
+				WithPullSecretRole("helm-pull-secret"),
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = hr.Pull(ctx, oci)
+			Expect(err).To(MatchError("cannot use pull secret garden/pull-secret since it is not labeled with gardener.cloud/role=helm-pull-secret"))
+		})
+
+		It("should pull the chart with a pull secret having the required role", func() {
+			metav1.SetMetaDataLabel(&secret.ObjectMeta, "gardener.cloud/role", "helm-pull-secret")
+
+			hr, err := NewHelmRegistry(
+				WithPullSecrets(fakeclient.NewClientBuilder().WithScheme(kubernetesscheme.Scheme).WithObjects(secret).Build(), "garden"),
+				WithPullSecretRole("helm-pull-secret"),
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			out, err := hr.Pull(ctx, oci)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).To(Equal(rawChart))
+		})
+
+		It("should pull the chart with the pull secret", func() {
+			hr, err := NewHelmRegistry(WithPullSecrets(fakeclient.NewClientBuilder().WithScheme(kubernetesscheme.Scheme).WithObjects(secret).Build(), "garden"))
+			Expect(err).NotTo(HaveOccurred())
+
+			out, err := hr.Pull(ctx, oci)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).To(Equal(rawChart))
+		})
+	})
+})
@@ -4,36 +4,378 @@
 
 package oci
 
-import "sync"
+import (
+	"bytes"
+	"cmp"
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
 
-var defaultCache = newCache()
+	gcrv1 "github.com/google/go-containerregistry/pkg/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// DefaultCacheMaxSize is the default maximum total size of the charts in the cache.
+var DefaultCacheMaxSize = resource.MustParse("256Mi")
+
+var defaultCache cacher = newCache()
 
 type cacher interface {
 	Get(key string) ([]byte, bool)
 	Set(key string, blob []byte)
+	// GetHelmLayerKey returns the key of the cached Helm layer of the artifact with the given manifest digest.
+	GetHelmLayerKey(manifestDigest string) (string, bool)
+	// SetManifest remembers the Helm layer of the artifact with the given manifest digest. The Helm layer must be cached
+	// already.
+	SetManifest(manifestDigest string, rawManifest []byte)
+}
+
+// manifestsDirectory is the name of the subdirectory of the cache directory in which the manifests are persisted.
+const manifestsDirectory = "manifests"
+
+// CacheOptions contains options for the cache of pulled Helm charts.
+type CacheOptions struct {
+	// MaxSize is the maximum total size of the cached charts in bytes. When the size is exceeded, the least recently used
+	// charts are evicted. If zero, DefaultCacheMaxSize is used.
+	MaxSize int64
+	// Directory is the path of a directory in which the cached charts are persisted. If set, charts persisted by a
+	// previous process are loaded into the cache.
+	Directory string
+}
+
+// ConfigureDefaultCache replaces the cache shared by all HelmRegistry instances with a new cache using the given
+// options. It must be called before any HelmRegistry is created.
+func ConfigureDefaultCache(opts CacheOptions) error {
+	c, err := newCacheWithOptions(opts)
+	if err != nil {
+		return err
+	}
+
+	defaultCache = c
+	return nil
 }
 
 func newCache() *cache {
 	return &cache{
-		items: map[string][]byte{},
+		maxSize:   DefaultCacheMaxSize.Value(),
+		items:     map[string]*list.Element{},
+		lru:       list.New(),
+		manifests: map[string]string{},
+	}
+}
+
+func newCacheWithOptions(opts CacheOptions) (*cache, error) {
+	c := newCache()
+	if opts.MaxSize > 0 {
+		c.maxSize = opts.MaxSize
+	}
+
+	if opts.Directory != "" {
+		if err := os.MkdirAll(filepath.Join(opts.Directory, manifestsDirectory), 0700); err != nil {
+			return nil, fmt.Errorf("failed creating cache directory %s: %w", opts.Directory, err)
+		}
+		c.directory = opts.Directory
+
+		if err := c.load(); err != nil {
+			return nil, err
+		}
 	}
+
+	return c, nil
 }
 
-// cache is a basic key-value cache i.e. a map protected by a mutex. Items are never removed from the cache.
+// cache is a content-addressable cache for Helm charts with a maximum total size, i.e., the keys are the SHA256 digests of
+// the cached blobs (`sha256:<hex>`). When the size is exceeded, the least recently used items are evicted. Optionally,
+// the items are persisted in a directory, so that they survive restarts. Persisted items are only loaded if their
+// content matches their digest, so that they cannot be tampered with.
+// Additionally, the cache remembers the Helm layers of the manifests of the pulled artifacts, so that artifacts pinned by
+// digest can be served without any request to the registry. For this purpose, the manifests are persisted as well and
+// only loaded if their content matches their digest.
 type cache struct {
-	mu    sync.RWMutex
-	items map[string][]byte
+	maxSize   int64
+	directory string
+
+	mu    sync.Mutex
+	size  int64
+	items map[string]*list.Element
+	lru   *list.List
+	// manifests maps the entry names of manifests to the entry names of their Helm layers.
+	manifests map[string]string
+}
+
+type cacheEntry struct {
+	// name is the name of the entry, i.e., the hex encoded SHA256 digest of the blob. It is used as file name for
+	// persisting the entry.
+	name string
+	blob []byte
 }
 
 func (c *cache) Get(key string) ([]byte, bool) {
-	c.mu.RLock()
-	blob, found := c.items[key]
-	c.mu.RUnlock()
-	return blob, found
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	name, ok := entryName(key)
+	if !ok {
+		cacheMisses.Inc()
+		return nil, false
+	}
+
+	element, found := c.items[name]
+	if !found {
+		cacheMisses.Inc()
+		return nil, false
+	}
+
+	cacheHits.Inc()
+	c.lru.MoveToFront(element)
+
+	if c.directory != "" {
+		// the modification time determines the order of the entries when they are loaded after a restart
+		now := time.Now()
+		_ = os.Chtimes(filepath.Join(c.directory, element.Value.(*cacheEntry).name), now, now)
+	}
+
+	return element.Value.(*cacheEntry).blob, true
 }
 
 func (c *cache) Set(key string, blob []byte) {
 	c.mu.Lock()
-	c.items[key] = blob
-	c.mu.Unlock()
+	defer c.mu.Unlock()
+
+	name, ok := entryName(key)
+	if !ok || !matchesDigest(name, blob) {
+		return
+	}
+	if _, found := c.items[name]; found || int64(len(blob)) > c.maxSize {
+		return
+	}
+
+	if c.directory != "" {
+		// Persisting is best-effort, the item is still cached in memory if it fails.
+		_ = c.persist(c.directory, name, blob)
+	}
+
+	c.add(&cacheEntry{name: name, blob: blob})
+}
+
+func (c *cache) GetHelmLayerKey(manifestDigest string) (string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	manifestName, ok := entryName(manifestDigest)
+	if !ok {
+		return "", false
+	}
+
+	name, found := c.manifests[manifestName]
+	if !found {
+		return "", false
+	}
+	return "sha256:" + name, true
+}
+
+func (c *cache) SetManifest(manifestDigest string, rawManifest []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	manifestName, ok := entryName(manifestDigest)
+	if !ok || !matchesDigest(manifestName, rawManifest) {
+		return
+	}
+	if _, found := c.manifests[manifestName]; found {
+		return
+	}
+
+	name, ok := helmLayerName(rawManifest)
+	if !ok {
+		return
+	}
+	if _, found := c.items[name]; !found {
+		return
+	}
+
+	if c.directory != "" {
+		// Persisting is best-effort, the manifest is still remembered in memory if it fails.
+		_ = c.persist(filepath.Join(c.directory, manifestsDirectory), manifestName, rawManifest)
+	}
+
+	c.manifests[manifestName] = name
+}
+
+// persist writes the blob to a temporary file first and renames it afterwards, so that no partially written files are
+// loaded after a restart.
+func (c *cache) persist(directory, name string, blob []byte) error {
+	tmpFile, err := os.CreateTemp(directory, ".tmp-"+name)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmpFile.Name())
+
+	if _, err := tmpFile.Write(blob); err != nil {
+		_ = tmpFile.Close()
+		return err
+	}
+	if err := tmpFile.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmpFile.Name(), filepath.Join(directory, name))
+}
+
+// add adds the entry to the front of the LRU list and evicts the least recently used entries until the size fits into
+// the limit. The caller must hold the lock.
+func (c *cache) add(entry *cacheEntry) {
+	c.items[entry.name] = c.lru.PushFront(entry)
+	c.size += int64(len(entry.blob))
+
+	for c.size > c.maxSize {
+		c.evict(c.lru.Back())
+	}
+
+	cacheEntries.Set(float64(c.lru.Len()))
+	cacheSize.Set(float64(c.size))
+}
+
+func (c *cache) evict(element *list.Element) {
+	entry := c.lru.Remove(element).(*cacheEntry)
+	delete(c.items, entry.name)
+	c.size -= int64(len(entry.blob))
+	cacheEvictions.Inc()
+
+	if c.directory != "" {
+		_ = os.Remove(filepath.Join(c.directory, entry.name))
+	}
+
+	// manifests whose Helm layer is not cached anymore are not needed anymore
+	for manifestName, name := range c.manifests {
+		if name != entry.name {
+			continue
+		}
+		delete(c.manifests, manifestName)
+		if c.directory != "" {
+			_ = os.Remove(filepath.Join(c.directory, manifestsDirectory, manifestName))
+		}
+	}
+}
+
+// load adds the items persisted in the directory to the cache. The most recently modified files are considered the most
+// recently used ones.
+func (c *cache) load() error {
+	dirEntries, err := os.ReadDir(c.directory)
+	if err != nil {
+		return fmt.Errorf("failed reading cache directory %s: %w", c.directory, err)
+	}
+
+	type file struct {
+		name    string
+		modTime int64
+	}
+
+	var files []file
+	for _, dirEntry := range dirEntries {
+		// skip temporary files and files not written by the cache
+		if !dirEntry.Type().IsRegular() || !isEntryName(dirEntry.Name()) {
+			continue
+		}
+		info, err := dirEntry.Info()
+		if err != nil {
+			return fmt.Errorf("failed reading cache file %s: %w", dirEntry.Name(), err)
+		}
+		files = append(files, file{name: dirEntry.Name(), modTime: info.ModTime().UnixNano()})
+	}
+
+	// add oldest files first, so that they are evicted first
+	slices.SortFunc(files, func(a, b file) int {
+		return cmp.Compare(a.modTime, b.modTime)
+	})
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, f := range files {
+		blob, err := os.ReadFile(filepath.Join(c.directory, f.name))
+		if err != nil {
+			return fmt.Errorf("failed reading cache file %s: %w", f.name, err)
+		}
+		// Remove files which were tampered with (or written by a previous version of the cache) and files which are too
+		// large.
+		if !matchesDigest(f.name, blob) || int64(len(blob)) > c.maxSize {
+			_ = os.Remove(filepath.Join(c.directory, f.name))
+			continue
+		}
+		c.add(&cacheEntry{name: f.name, blob: blob})
+	}
+
+	return c.loadManifests()
+}
+
+// loadManifests remembers the Helm layers of the manifests persisted in the directory. Manifests whose Helm layer is not
+// cached are removed. The caller must hold the lock.
+func (c *cache) loadManifests() error {
+	directory := filepath.Join(c.directory, manifestsDirectory)
+
+	dirEntries, err := os.ReadDir(directory)
+	if err != nil {
+		return fmt.Errorf("failed reading cache directory %s: %w", directory, err)
+	}
+
+	for _, dirEntry := range dirEntries {
+		manifestName := dirEntry.Name()
+		if !dirEntry.Type().IsRegular() || !isEntryName(manifestName) {
+			continue
+		}
+
+		rawManifest, err := os.ReadFile(filepath.Join(directory, manifestName))
+		if err != nil {
+			return fmt.Errorf("failed reading cache file %s: %w", manifestName, err)
+		}
+
+		name, ok := helmLayerName(rawManifest)
+		if _, found := c.items[name]; !ok || !found || !matchesDigest(manifestName, rawManifest) {
+			_ = os.Remove(filepath.Join(directory, manifestName))
+			continue
+		}
+		c.manifests[manifestName] = name
+	}
+
+	return nil
+}
+
+// helmLayerName returns the entry name of the Helm layer of the given manifest.
+func helmLayerName(rawManifest []byte) (string, bool) {
+	manifest, err := gcrv1.ParseManifest(bytes.NewReader(rawManifest))
+	if err != nil {
+		return "", false
+	}
+
+	for _, layer := range manifest.Layers {
+		if string(layer.MediaType) == mediaTypeHelm {
+			return entryName(layer.Digest.String())
+		}
+	}
+	return "", false
+}
+
+// entryName returns the name of the entry for the given key. It returns false if the key is not a SHA256 digest.
+func entryName(key string) (string, bool) {
+	name, found := strings.CutPrefix(key, "sha256:")
+	return name, found && isEntryName(name)
+}
+
+// matchesDigest returns true if the SHA256 digest of the blob matches the given entry name.
+func matchesDigest(name string, blob []byte) bool {
+	sum := sha256.Sum256(blob)
+	return hex.EncodeToString(sum[:]) == name
+}
+
+func isEntryName(name string) bool {
+	decoded, err := hex.DecodeString(name)
+	return err == nil && len(decoded) == sha256.Size
 }
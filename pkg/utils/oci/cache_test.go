@@ -5,14 +5,21 @@
 package oci
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 )
 
 var _ = Describe("cache", func() {
 	It("should store and retrieve values", func() {
-		key := "foo"
 		data := []byte("bar")
+		key := digestOf("bar")
 		c := newCache()
 
 		_, found := c.Get(key)
@@ -24,4 +31,189 @@ var _ = Describe("cache", func() {
 		Expect(found).To(BeTrue())
 		Expect(out).To(Equal(data))
 	})
+
+	It("should evict the least recently used values when the maximum size is exceeded", func() {
+		c, err := newCacheWithOptions(CacheOptions{MaxSize: 6})
+		Expect(err).NotTo(HaveOccurred())
+
+		c.Set(digestOf("foo"), []byte("foo"))
+		c.Set(digestOf("bar"), []byte("bar"))
+		_, found := c.Get(digestOf("foo"))
+		Expect(found).To(BeTrue())
+
+		c.Set(digestOf("baz"), []byte("baz"))
+
+		_, found = c.Get(digestOf("bar"))
+		Expect(found).To(BeFalse())
+		expectCached(c, "foo")
+		expectCached(c, "baz")
+		Expect(c.size).To(Equal(int64(6)))
+	})
+
+	It("should not cache values not matching their digest", func() {
+		c := newCache()
+
+		c.Set("foo", []byte("foo"))
+		c.Set(digestOf("foo"), []byte("bar"))
+
+		_, found := c.Get("foo")
+		Expect(found).To(BeFalse())
+		_, found = c.Get(digestOf("foo"))
+		Expect(found).To(BeFalse())
+		Expect(c.lru.Len()).To(BeZero())
+	})
+
+	It("should not cache values exceeding the maximum size", func() {
+		c, err := newCacheWithOptions(CacheOptions{MaxSize: 6})
+		Expect(err).NotTo(HaveOccurred())
+
+		c.Set(digestOf("foo"), []byte("foo"))
+		c.Set(digestOf("too large"), []byte("too large"))
+
+		_, found := c.Get(digestOf("too large"))
+		Expect(found).To(BeFalse())
+		expectCached(c, "foo")
+	})
+
+	It("should remember the helm layers of manifests", func() {
+		c := newCache()
+		manifest := manifestOf("foo")
+
+		By("Helm layer is not cached")
+		c.SetManifest(digestOf(string(manifest)), manifest)
+		_, found := c.GetHelmLayerKey(digestOf(string(manifest)))
+		Expect(found).To(BeFalse())
+
+		By("Helm layer is cached")
+		c.Set(digestOf("foo"), []byte("foo"))
+		c.SetManifest(digestOf(string(manifest)), manifest)
+		expectHelmLayerKey(c, manifest, "foo")
+
+		By("Helm layer is evicted")
+		c.evict(c.lru.Back())
+		_, found = c.GetHelmLayerKey(digestOf(string(manifest)))
+		Expect(found).To(BeFalse())
+	})
+
+	It("should not remember manifests not matching their digest", func() {
+		c := newCache()
+		c.Set(digestOf("foo"), []byte("foo"))
+
+		c.SetManifest(digestOf("bar"), manifestOf("foo"))
+
+		_, found := c.GetHelmLayerKey(digestOf("bar"))
+		Expect(found).To(BeFalse())
+	})
+
+	Context("with directory", func() {
+		var directory string
+
+		BeforeEach(func() {
+			directory = GinkgoT().TempDir()
+		})
+
+		It("should load persisted values after a restart", func() {
+			c, err := newCacheWithOptions(CacheOptions{Directory: directory})
+			Expect(err).NotTo(HaveOccurred())
+			c.Set(digestOf("foo"), []byte("foo"))
+			c.Set(digestOf("bar"), []byte("bar"))
+
+			Expect(os.WriteFile(filepath.Join(directory, "unrelated"), []byte("foo"), 0600)).To(Succeed())
+
+			c, err = newCacheWithOptions(CacheOptions{Directory: directory})
+			Expect(err).NotTo(HaveOccurred())
+			expectCached(c, "foo")
+			expectCached(c, "bar")
+			Expect(c.lru.Len()).To(Equal(2))
+		})
+
+		It("should not load persisted values which were tampered with", func() {
+			c, err := newCacheWithOptions(CacheOptions{Directory: directory})
+			Expect(err).NotTo(HaveOccurred())
+			c.Set(digestOf("foo"), []byte("foo"))
+			c.Set(digestOf("bar"), []byte("bar"))
+
+			Expect(os.WriteFile(filepath.Join(directory, fileName("foo")), []byte("evil"), 0600)).To(Succeed())
+
+			c, err = newCacheWithOptions(CacheOptions{Directory: directory})
+			Expect(err).NotTo(HaveOccurred())
+			_, found := c.Get(digestOf("foo"))
+			Expect(found).To(BeFalse())
+			expectCached(c, "bar")
+			Expect(filepath.Join(directory, fileName("foo"))).NotTo(BeAnExistingFile())
+		})
+
+		It("should load persisted manifests after a restart", func() {
+			manifest, otherManifest := manifestOf("foo"), manifestOf("bar")
+
+			c, err := newCacheWithOptions(CacheOptions{Directory: directory})
+			Expect(err).NotTo(HaveOccurred())
+			c.Set(digestOf("foo"), []byte("foo"))
+			c.SetManifest(digestOf(string(manifest)), manifest)
+			c.Set(digestOf("bar"), []byte("bar"))
+			c.SetManifest(digestOf(string(otherManifest)), otherManifest)
+
+			Expect(os.WriteFile(filepath.Join(directory, "manifests", fileName(string(otherManifest))), manifestOf("foo"), 0600)).To(Succeed())
+
+			c, err = newCacheWithOptions(CacheOptions{Directory: directory})
+			Expect(err).NotTo(HaveOccurred())
+			expectHelmLayerKey(c, manifest, "foo")
+			_, found := c.GetHelmLayerKey(digestOf(string(otherManifest)))
+			Expect(found).To(BeFalse())
+			Expect(filepath.Join(directory, "manifests", fileName(string(otherManifest)))).NotTo(BeAnExistingFile())
+		})
+
+		It("should remove evicted values from the directory", func() {
+			c, err := newCacheWithOptions(CacheOptions{Directory: directory, MaxSize: 3})
+			Expect(err).NotTo(HaveOccurred())
+			c.Set(digestOf("foo"), []byte("foo"))
+			c.Set(digestOf("bar"), []byte("bar"))
+
+			Expect(filepath.Join(directory, fileName("foo"))).NotTo(BeAnExistingFile())
+			Expect(filepath.Join(directory, fileName("bar"))).To(BeAnExistingFile())
+		})
+
+		It("should only load the most recently used values if the maximum size was decreased", func() {
+			c, err := newCacheWithOptions(CacheOptions{Directory: directory})
+			Expect(err).NotTo(HaveOccurred())
+			c.Set(digestOf("foo"), []byte("foo"))
+			c.Set(digestOf("bar"), []byte("bar"))
+			Expect(os.Chtimes(filepath.Join(directory, fileName("foo")), time.Now().Add(-time.Hour), time.Now().Add(-time.Hour))).To(Succeed())
+
+			c, err = newCacheWithOptions(CacheOptions{Directory: directory, MaxSize: 3})
+			Expect(err).NotTo(HaveOccurred())
+			_, found := c.Get(digestOf("foo"))
+			Expect(found).To(BeFalse())
+			expectCached(c, "bar")
+		})
+	})
 })
+
+func expectCached(c *cache, value string) {
+	GinkgoHelper()
+
+	out, found := c.Get(digestOf(value))
+	Expect(found).To(BeTrue())
+	Expect(out).To(Equal([]byte(value)))
+}
+
+func expectHelmLayerKey(c *cache, manifest []byte, value string) {
+	GinkgoHelper()
+
+	key, found := c.GetHelmLayerKey(digestOf(string(manifest)))
+	Expect(found).To(BeTrue())
+	Expect(key).To(Equal(digestOf(value)))
+}
+
+func manifestOf(value string) []byte {
+	return []byte(`{"schemaVersion":2,"mediaType":"application/vnd.oci.image.manifest.v1+json","layers":[{"mediaType":"` + mediaTypeHelm + `","digest":"` + digestOf(value) + `","size":` + strconv.Itoa(len(value)) + `}]}`)
+}
+
+func digestOf(value string) string {
+	return "sha256:" + fileName(value)
+}
+
+func fileName(value string) string {
+	sum := sha256.Sum256([]byte(value))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,206 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package oci
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
+)
+
+const (
+	// mediaTypeCosignSimpleSigning is the media type of the layers of a cosign signature artifact.
+	mediaTypeCosignSimpleSigning = "application/vnd.dev.cosign.simplesigning.v1+json"
+	// annotationCosignSignature is the annotation of a cosign signature layer containing the base64-encoded signature
+	// of the layer content.
+	annotationCosignSignature = "dev.cosignproject.cosign/signature"
+)
+
+// ErrSignatureVerificationFailed is returned (wrapped) if an artifact does not carry a valid signature.
+var ErrSignatureVerificationFailed = errors.New("signature verification failed")
+
+// Verifier verifies the signature of an OCI artifact.
+type Verifier interface {
+	// Verify verifies that the artifact with the given digest carries a valid signature. The remote options are used for
+	// fetching the signature from the registry. If the signature is missing or invalid, the returned error wraps
+	// ErrSignatureVerificationFailed.
+	Verify(ctx context.Context, digest name.Digest, opts ...remote.Option) error
+}
+
+// cosignVerifier verifies cosign signatures stored next to the artifact according to the cosign tag scheme
+// (`<repository>:sha256-<hex>.sig`) using a set of public keys.
+type cosignVerifier struct {
+	publicKeys []crypto.PublicKey
+
+	mu       sync.RWMutex
+	verified map[string]struct{}
+}
+
+// cosignPayload is the "simple signing" payload signed by cosign.
+type cosignPayload struct {
+	Critical struct {
+		Image struct {
+			DockerManifestDigest string `json:"docker-manifest-digest"`
+		} `json:"image"`
+		Type string `json:"type"`
+	} `json:"critical"`
+}
+
+// NewCosignVerifier returns a Verifier checking cosign signatures against the given PEM-encoded public keys. An
+// artifact is accepted if at least one of its signatures can be verified with one of the keys. ECDSA, RSA, and Ed25519
+// keys are supported.
+func NewCosignVerifier(publicKeysPEM []string) (Verifier, error) {
+	if len(publicKeysPEM) == 0 {
+		return nil, fmt.Errorf("at least one public key is required")
+	}
+
+	v := &cosignVerifier{verified: make(map[string]struct{})}
+	for i, publicKeyPEM := range publicKeysPEM {
+		publicKey, err := ParsePublicKey([]byte(publicKeyPEM))
+		if err != nil {
+			return nil, fmt.Errorf("failed parsing public key at index %d: %w", i, err)
+		}
+		v.publicKeys = append(v.publicKeys, publicKey)
+	}
+
+	return v, nil
+}
+
+// ParsePublicKey parses a PEM-encoded PKIX public key which can be used for verifying cosign signatures.
+func ParsePublicKey(publicKeyPEM []byte) (crypto.PublicKey, error) {
+	block, _ := pem.Decode(publicKeyPEM)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM block found")
+	}
+
+	publicKey, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	switch publicKey.(type) {
+	case *ecdsa.PublicKey, *rsa.PublicKey, ed25519.PublicKey:
+		return publicKey, nil
+	default:
+		return nil, fmt.Errorf("unsupported public key type %T", publicKey)
+	}
+}
+
+// Verify implements Verifier. Successfully verified digests are remembered, hence the signature is only fetched once
+// per digest.
+func (v *cosignVerifier) Verify(_ context.Context, digest name.Digest, opts ...remote.Option) error {
+	v.mu.RLock()
+	_, ok := v.verified[digest.Name()]
+	v.mu.RUnlock()
+	if ok {
+		return nil
+	}
+
+	signatureTag := digest.Context().Tag(strings.Replace(digest.DigestStr(), ":", "-", 1) + ".sig")
+
+	signatureImage, err := remote.Image(signatureTag, opts...)
+	if err != nil {
+		var transportErr *transport.Error
+		if errors.As(err, &transportErr) && transportErr.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("%w: no cosign signature found for %s", ErrSignatureVerificationFailed, digest)
+		}
+		return fmt.Errorf("failed fetching cosign signature %s: %w", signatureTag, err)
+	}
+
+	manifest, err := signatureImage.Manifest()
+	if err != nil {
+		return fmt.Errorf("failed reading manifest of cosign signature %s: %w", signatureTag, err)
+	}
+
+	for _, layer := range manifest.Layers {
+		if string(layer.MediaType) != mediaTypeCosignSimpleSigning {
+			continue
+		}
+
+		signature, err := base64.StdEncoding.DecodeString(layer.Annotations[annotationCosignSignature])
+		if err != nil || len(signature) == 0 {
+			continue
+		}
+
+		l, err := signatureImage.LayerByDigest(layer.Digest)
+		if err != nil {
+			return fmt.Errorf("failed reading layer %s of cosign signature %s: %w", layer.Digest, signatureTag, err)
+		}
+		payload, err := readLayer(l.Compressed)
+		if err != nil {
+			return fmt.Errorf("failed reading layer %s of cosign signature %s: %w", layer.Digest, signatureTag, err)
+		}
+
+		if !v.verifySignature(payload, signature) || !payloadMatchesDigest(payload, digest) {
+			continue
+		}
+
+		v.mu.Lock()
+		v.verified[digest.Name()] = struct{}{}
+		v.mu.Unlock()
+		return nil
+	}
+
+	return fmt.Errorf("%w: no valid cosign signature found for %s", ErrSignatureVerificationFailed, digest)
+}
+
+func (v *cosignVerifier) verifySignature(payload, signature []byte) bool {
+	hash := sha256.Sum256(payload)
+
+	for _, publicKey := range v.publicKeys {
+		switch key := publicKey.(type) {
+		case *ecdsa.PublicKey:
+			if ecdsa.VerifyASN1(key, hash[:], signature) {
+				return true
+			}
+		case *rsa.PublicKey:
+			if rsa.VerifyPKCS1v15(key, crypto.SHA256, hash[:], signature) == nil {
+				return true
+			}
+		case ed25519.PublicKey:
+			if ed25519.Verify(key, payload, signature) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func payloadMatchesDigest(payload []byte, digest name.Digest) bool {
+	p := &cosignPayload{}
+	if err := json.Unmarshal(payload, p); err != nil {
+		return false
+	}
+
+	return p.Critical.Image.DockerManifestDigest == digest.DigestStr()
+}
+
+func readLayer(open func() (io.ReadCloser, error)) ([]byte, error) {
+	rc, err := open()
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	return io.ReadAll(rc)
+}
@@ -0,0 +1,179 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package oci
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/static"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	helmregistry "helm.sh/helm/v3/pkg/registry"
+	"k8s.io/utils/ptr"
+
+	gardencorev1 "github.com/gardener/gardener/pkg/apis/core/v1"
+)
+
+var _ = Describe("Cosign", func() {
+	var (
+		ctx = context.Background()
+
+		signingKey *ecdsa.PrivateKey
+		verifier   Verifier
+	)
+
+	BeforeEach(func() {
+		var err error
+		signingKey, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		Expect(err).NotTo(HaveOccurred())
+
+		verifier, err = NewCosignVerifier([]string{publicKeyPEM(signingKey.Public())})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	Describe("#NewCosignVerifier", func() {
+		It("should fail if no public key is given", func() {
+			_, err := NewCosignVerifier(nil)
+			Expect(err).To(MatchError("at least one public key is required"))
+		})
+
+		It("should fail if a public key cannot be parsed", func() {
+			_, err := NewCosignVerifier([]string{"foo"})
+			Expect(err).To(MatchError(ContainSubstring("failed parsing public key at index 0")))
+		})
+	})
+
+	Describe("#Verify", func() {
+		var (
+			repository string
+			digest     name.Digest
+		)
+
+		BeforeEach(func() {
+			repository, digest = pushChart()
+		})
+
+		It("should accept an artifact signed with an ECDSA key", func() {
+			pushSignature(digest, digest.DigestStr(), signingKey)
+
+			Expect(verifier.Verify(ctx, digest)).To(Succeed())
+		})
+
+		It("should accept an artifact signed with an Ed25519 key", func() {
+			publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+			Expect(err).NotTo(HaveOccurred())
+			verifier, err = NewCosignVerifier([]string{publicKeyPEM(signingKey.Public()), publicKeyPEM(publicKey)})
+			Expect(err).NotTo(HaveOccurred())
+
+			pushSignature(digest, digest.DigestStr(), privateKey)
+
+			Expect(verifier.Verify(ctx, digest)).To(Succeed())
+		})
+
+		It("should reject an unsigned artifact", func() {
+			Expect(verifier.Verify(ctx, digest)).To(And(
+				MatchError(ErrSignatureVerificationFailed),
+				MatchError(ContainSubstring("no cosign signature found")),
+			))
+		})
+
+		It("should reject an artifact signed with an unknown key", func() {
+			otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			Expect(err).NotTo(HaveOccurred())
+			pushSignature(digest, digest.DigestStr(), otherKey)
+
+			Expect(verifier.Verify(ctx, digest)).To(And(
+				MatchError(ErrSignatureVerificationFailed),
+				MatchError(ContainSubstring("no valid cosign signature found")),
+			))
+		})
+
+		It("should reject a signature for a different artifact", func() {
+			pushSignature(digest, "sha256:7a855a6d69033dd3240d9648e8bd46a67a528059158e098c7794ac9227735b4a", signingKey)
+
+			Expect(verifier.Verify(ctx, digest)).To(MatchError(ErrSignatureVerificationFailed))
+		})
+
+		It("should be used by the HelmRegistry", func() {
+			hr := &HelmRegistry{cache: newCache(), verifier: verifier}
+			oci := &gardencorev1.OCIRepository{Repository: ptr.To(repository), Tag: ptr.To("0.1.0")}
+
+			_, err := hr.Pull(ctx, oci)
+			Expect(err).To(MatchError(ErrSignatureVerificationFailed))
+
+			pushSignature(digest, digest.DigestStr(), signingKey)
+
+			out, err := hr.Pull(ctx, oci)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).To(Equal(rawChart))
+		})
+	})
+})
+
+var pushedCharts int
+
+// pushChart pushes the example chart to a new repository and returns the repository and the digest of the chart.
+func pushChart() (string, name.Digest) {
+	c, err := helmregistry.NewClient()
+	Expect(err).NotTo(HaveOccurred())
+
+	pushedCharts++
+	repository := fmt.Sprintf("%s/cosign-%d/example", registryAddress, pushedCharts)
+	res, err := c.Push(rawChart, repository+":0.1.0")
+	Expect(err).NotTo(HaveOccurred())
+
+	digest, err := name.NewDigest(repository + "@" + res.Manifest.Digest)
+	Expect(err).NotTo(HaveOccurred())
+
+	return repository, digest
+}
+
+// pushSignature pushes a cosign signature of the given payload digest for the artifact with the given digest.
+func pushSignature(digest name.Digest, payloadDigest string, signer crypto.Signer) {
+	payload := []byte(fmt.Sprintf(`{"critical":{"identity":{"docker-reference":%q},"image":{"docker-manifest-digest":%q},"type":"cosign container image signature"},"optional":null}`, digest.Context().Name(), payloadDigest))
+
+	var (
+		signature []byte
+		err       error
+	)
+	if _, ok := signer.(ed25519.PrivateKey); ok {
+		signature, err = signer.Sign(rand.Reader, payload, crypto.Hash(0))
+	} else {
+		hash := sha256.Sum256(payload)
+		signature, err = signer.Sign(rand.Reader, hash[:], crypto.SHA256)
+	}
+	Expect(err).NotTo(HaveOccurred())
+
+	image, err := mutate.Append(empty.Image, mutate.Addendum{
+		Layer:       static.NewLayer(payload, types.MediaType(mediaTypeCosignSimpleSigning)),
+		Annotations: map[string]string{annotationCosignSignature: base64.StdEncoding.EncodeToString(signature)},
+	})
+	Expect(err).NotTo(HaveOccurred())
+
+	Expect(remote.Write(digest.Context().Tag(strings.Replace(digest.DigestStr(), ":", "-", 1)+".sig"), image)).To(Succeed())
+}
+
+func publicKeyPEM(publicKey crypto.PublicKey) string {
+	der, err := x509.MarshalPKIXPublicKey(publicKey)
+	Expect(err).NotTo(HaveOccurred())
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+}
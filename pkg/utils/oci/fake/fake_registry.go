@@ -21,17 +21,25 @@ var _ oci.Interface = &Registry{}
 type Registry struct {
 	mu        sync.Mutex
 	artifacts map[string][]byte
+	errors    map[string]error
 }
 
 // NewRegistry returns a new registry
 func NewRegistry() *Registry {
 	return &Registry{
 		artifacts: make(map[string][]byte),
+		errors:    make(map[string]error),
 	}
 }
 
 // Pull implements oci.Interface
 func (r *Registry) Pull(_ context.Context, oci *gardencorev1.OCIRepository) ([]byte, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err, ok := r.errors[artifactKey(oci)]; ok {
+		return nil, err
+	}
 	data, ok := r.artifacts[artifactKey(oci)]
 	if !ok {
 		return nil, fmt.Errorf("not found")
@@ -46,6 +54,13 @@ func (r *Registry) AddArtifact(oci *gardencorev1.OCIRepository, data []byte) {
 	r.artifacts[artifactKey(oci)] = data
 }
 
+// AddError makes the fake registry return the given error when pulling the given artifact.
+func (r *Registry) AddError(oci *gardencorev1.OCIRepository, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.errors[artifactKey(oci)] = err
+}
+
 func artifactKey(oci *gardencorev1.OCIRepository) string {
 	if oci.Ref != nil {
 		return *oci.Ref
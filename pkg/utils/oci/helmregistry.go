@@ -8,14 +8,16 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"io"
 	"strings"
 
 	"github.com/google/go-containerregistry/pkg/name"
 	gcrv1 "github.com/google/go-containerregistry/pkg/v1"
 	"github.com/google/go-containerregistry/pkg/v1/remote"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	gardencorev1 "github.com/gardener/gardener/pkg/apis/core/v1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 )
 
 const (
@@ -33,13 +35,52 @@ type Interface interface {
 // HelmRegistry can pull OCI Helm Charts.
 type HelmRegistry struct {
 	cache cacher
+
+	secretReader    client.Reader
+	secretNamespace string
+	secretRole      string
+	verifier        Verifier
+}
+
+// HelmRegistryOption is an option for creating a HelmRegistry.
+type HelmRegistryOption func(*HelmRegistry)
+
+// WithPullSecrets configures the HelmRegistry to read the pull secrets referenced in OCIRepository.PullSecretRef from
+// the given namespace using the given reader.
+func WithPullSecrets(reader client.Reader, namespace string) HelmRegistryOption {
+	return func(r *HelmRegistry) {
+		r.secretReader = reader
+		r.secretNamespace = namespace
+	}
+}
+
+// WithPullSecretRole configures the HelmRegistry to only use pull secrets which are labeled with the given
+// `gardener.cloud/role`.
+func WithPullSecretRole(role string) HelmRegistryOption {
+	return func(r *HelmRegistry) {
+		r.secretRole = role
+	}
+}
+
+// WithVerifier configures the HelmRegistry to only return artifacts whose signatures were successfully verified by the
+// given Verifier.
+func WithVerifier(verifier Verifier) HelmRegistryOption {
+	return func(r *HelmRegistry) {
+		r.verifier = verifier
+	}
 }
 
 // NewHelmRegistry creates a new HelmRegistry.
-func NewHelmRegistry() (*HelmRegistry, error) {
-	return &HelmRegistry{
+func NewHelmRegistry(opts ...HelmRegistryOption) (*HelmRegistry, error) {
+	r := &HelmRegistry{
 		cache: defaultCache,
-	}, nil
+	}
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return r, nil
 }
 
 // Pull from the repository and return the compressed archive.
@@ -48,40 +89,96 @@ func (r *HelmRegistry) Pull(ctx context.Context, oci *gardencorev1.OCIRepository
 	if err != nil {
 		return nil, err
 	}
-	remoteOpts := []remote.Option{
-		remote.WithContext(ctx),
+	remoteOpts, err := r.remoteOptions(ctx, oci)
+	if err != nil {
+		return nil, err
 	}
 
-	key, err := cacheKeyFromRef(ref, remoteOpts...)
+	digest, err := digestFromRef(ref, remoteOpts...)
 	if err != nil {
 		return nil, err
 	}
-	if key != "" {
+
+	// The signature is verified before the cache is consulted since the cache is shared between all HelmRegistry
+	// instances, some of which might not verify signatures.
+	if r.verifier != nil {
+		if err := r.verifier.Verify(ctx, digest, remoteOpts...); err != nil {
+			return nil, err
+		}
+	}
+
+	// Artifacts which were pulled before are looked up by their manifest digest, so that no requests to the registry are
+	// needed for artifacts pinned by digest.
+	if key, found := r.cache.GetHelmLayerKey(digest.DigestStr()); found {
 		if blob, found := r.cache.Get(key); found {
 			return blob, nil
 		}
 	}
 
-	img, err := remote.Image(ref, remoteOpts...)
+	// pull by digest to make sure that the pulled artifact is the one that was verified
+	img, err := remote.Image(digest, remoteOpts...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to pull artifact %s: %w", ref, err)
 	}
-	blob, err := extractHelmLayer(img)
+	layer, err := helmLayer(img)
 	if err != nil {
 		return nil, err
 	}
 
-	// construct cache key based on digest of the pulled artifact
-	digest, err := img.Digest()
+	// The cache key is the digest of the Helm layer as referenced in the manifest of the artifact, so that the cache can
+	// verify the integrity of the cached blobs.
+	layerDigest, err := layer.Digest()
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("failed to determine digest of helm layer: %w", err)
+	}
+	key := layerDigest.String()
+	blob, found := r.cache.Get(key)
+	if !found {
+		blob, err = readLayer(layer.Compressed)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read content of helm layer: %w", err)
+		}
+		r.cache.Set(key, blob)
+	}
+
+	// The manifest was already fetched by remote.Image, hence, this does not send another request to the registry.
+	if rawManifest, err := img.RawManifest(); err == nil {
+		r.cache.SetManifest(digest.DigestStr(), rawManifest)
 	}
-	key = ref.Context().Digest(digest.String()).Name()
-	r.cache.Set(key, blob)
 
 	return blob, nil
 }
 
+func (r *HelmRegistry) remoteOptions(ctx context.Context, oci *gardencorev1.OCIRepository) ([]remote.Option, error) {
+	remoteOpts := []remote.Option{
+		remote.WithContext(ctx),
+	}
+
+	if oci.PullSecretRef == nil {
+		return remoteOpts, nil
+	}
+
+	if r.secretReader == nil {
+		return nil, fmt.Errorf("cannot use pull secret %q since pull secrets are not supported by this Helm registry", oci.PullSecretRef.Name)
+	}
+
+	secret := &corev1.Secret{}
+	if err := r.secretReader.Get(ctx, client.ObjectKey{Namespace: r.secretNamespace, Name: oci.PullSecretRef.Name}, secret); err != nil {
+		return nil, fmt.Errorf("failed reading pull secret %s/%s: %w", r.secretNamespace, oci.PullSecretRef.Name, err)
+	}
+
+	if r.secretRole != "" && secret.Labels[v1beta1constants.GardenRole] != r.secretRole {
+		return nil, fmt.Errorf("cannot use pull secret %s/%s since it is not labeled with %s=%s", r.secretNamespace, oci.PullSecretRef.Name, v1beta1constants.GardenRole, r.secretRole)
+	}
+
+	keychain, err := keychainFromSecret(secret)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(remoteOpts, remote.WithAuthFromKeychain(keychain)), nil
+}
+
 func buildRef(oci *gardencorev1.OCIRepository) (name.Reference, error) {
 	ref := oci.GetURL()
 
@@ -97,11 +194,11 @@ func buildRef(oci *gardencorev1.OCIRepository) (name.Reference, error) {
 	return name.ParseReference(ref, opts...)
 }
 
-// cacheKeyFromRef returns "repo@sha256:digest". If the ref is not a digest, the remote repository is queried to
+// digestFromRef returns "repo@sha256:digest". If the ref is not a digest, the remote repository is queried to
 // retrieve the digest pointed to by the ref.
-func cacheKeyFromRef(ref name.Reference, opts ...remote.Option) (string, error) {
+func digestFromRef(ref name.Reference, opts ...remote.Option) (name.Digest, error) {
 	if ref, ok := ref.(name.Digest); ok {
-		return ref.Name(), nil
+		return ref, nil
 	}
 
 	var digest gcrv1.Hash
@@ -111,14 +208,14 @@ func cacheKeyFromRef(ref name.Reference, opts ...remote.Option) (string, error)
 	} else {
 		rd, gErr := remote.Get(ref, opts...)
 		if gErr != nil {
-			return "", fmt.Errorf("failed get manifest from remote trying to determine digest: %w", errors.Join(gErr, hErr))
+			return name.Digest{}, fmt.Errorf("failed get manifest from remote trying to determine digest: %w", errors.Join(gErr, hErr))
 		}
 		digest = rd.Descriptor.Digest
 	}
-	return ref.Context().Digest(digest.String()).Name(), nil
+	return ref.Context().Digest(digest.String()), nil
 }
 
-func extractHelmLayer(image gcrv1.Image) ([]byte, error) {
+func helmLayer(image gcrv1.Image) (gcrv1.Layer, error) {
 	layers, err := image.Layers()
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse layers: %w", err)
@@ -142,13 +239,5 @@ func extractHelmLayer(image gcrv1.Image) ([]byte, error) {
 	if layer == nil {
 		return nil, fmt.Errorf("no helm layer found in artifact")
 	}
-	blob, err := layer.Compressed()
-	if err != nil {
-		return nil, fmt.Errorf("failed to extract layer from artifact: %w", err)
-	}
-	raw, err := io.ReadAll(blob)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read content of helm layer: %w", err)
-	}
-	return raw, nil
+	return layer, nil
 }
@@ -101,6 +101,23 @@ var _ = Describe("helmregistry", func() {
 		Expect(err).NotTo(HaveOccurred())
 		Expect(rc.cacheHits).To(Equal(1))
 	})
+
+	It("should not send requests to the registry for cached artifacts pinned by digest", func() {
+		_, err := hr.Pull(ctx, &gardencorev1.OCIRepository{
+			Repository: ptr.To(registryAddress + "/charts/example"),
+			Digest:     ptr.To(exampleChartDigest),
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		// the registry is not reachable under this address
+		out, err := hr.Pull(ctx, &gardencorev1.OCIRepository{
+			Repository: ptr.To("127.0.0.1:1/charts/example"),
+			Digest:     ptr.To(exampleChartDigest),
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out).NotTo(BeEmpty())
+		Expect(rc.cacheHits).To(Equal(1))
+	})
 })
 
 type recordingCache struct {
@@ -120,6 +137,14 @@ func (rc *recordingCache) Set(k string, blob []byte) {
 	rc.cache.Set(k, blob)
 }
 
+func (rc *recordingCache) GetHelmLayerKey(manifestDigest string) (string, bool) {
+	return rc.cache.GetHelmLayerKey(manifestDigest)
+}
+
+func (rc *recordingCache) SetManifest(manifestDigest string, rawManifest []byte) {
+	rc.cache.SetManifest(manifestDigest, rawManifest)
+}
+
 var _ = Describe("buildRef", func() {
 	const digest = "sha256:7a855a6d69033dd3240d9648e8bd46a67a528059158e098c7794ac9227735b4a"
 
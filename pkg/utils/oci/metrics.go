@@ -0,0 +1,51 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package oci
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	runtimemetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	metricsNamespace = "gardener"
+	metricsSubsystem = "oci_chart_cache"
+)
+
+var (
+	factory = promauto.With(runtimemetrics.Registry)
+
+	cacheHits = factory.NewCounter(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "hits_total",
+		Help:      "Total number of lookups of Helm charts which were found in the cache.",
+	})
+	cacheMisses = factory.NewCounter(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "misses_total",
+		Help:      "Total number of lookups of Helm charts which were not found in the cache.",
+	})
+	cacheEvictions = factory.NewCounter(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "evictions_total",
+		Help:      "Total number of Helm charts evicted from the cache because its maximum size was exceeded.",
+	})
+	cacheEntries = factory.NewGauge(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "entries",
+		Help:      "Number of Helm charts in the cache.",
+	})
+	cacheSize = factory.NewGauge(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "size_bytes",
+		Help:      "Total size of the Helm charts in the cache in bytes.",
+	})
+)
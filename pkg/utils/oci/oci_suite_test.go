@@ -43,9 +43,12 @@ var _ = BeforeSuite(func() {
 	Expect(err).NotTo(HaveOccurred())
 	rawChart, err = os.ReadFile("./testdata/example-0.1.0.tgz")
 	Expect(err).NotTo(HaveOccurred())
-	res, err := c.Push(rawChart, fmt.Sprintf("%s/charts/example:0.1.0", registryAddress))
-	Expect(err).NotTo(HaveOccurred())
-	exampleChartDigest = res.Manifest.Digest
+	// the registry is started asynchronously, hence retry until it accepts connections
+	Eventually(func(g Gomega) {
+		res, err := c.Push(rawChart, fmt.Sprintf("%s/charts/example:0.1.0", registryAddress))
+		g.Expect(err).NotTo(HaveOccurred())
+		exampleChartDigest = res.Manifest.Digest
+	}).Should(Succeed())
 })
 
 func startTestRegistry(ctx context.Context) (string, error) {
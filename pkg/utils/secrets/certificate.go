@@ -5,10 +5,17 @@
 package secrets
 
 import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/x509"
 	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
 	"math/big"
 	"net"
 	"os"
@@ -41,6 +48,22 @@ const (
 	DataKeyPrivateKeyCA = "ca.key"
 )
 
+// KeyAlgorithm is a string alias for the algorithms of the private keys of certificates.
+type KeyAlgorithm string
+
+const (
+	// KeyAlgorithmRSA3072 indicates that the certificate should have a 3072-bit RSA private key. This is the default.
+	KeyAlgorithmRSA3072 KeyAlgorithm = "RSA-3072"
+	// KeyAlgorithmRSA4096 indicates that the certificate should have a 4096-bit RSA private key.
+	KeyAlgorithmRSA4096 KeyAlgorithm = "RSA-4096"
+	// KeyAlgorithmECDSAP256 indicates that the certificate should have an ECDSA private key on the P-256 curve.
+	KeyAlgorithmECDSAP256 KeyAlgorithm = "ECDSA-P256"
+	// KeyAlgorithmECDSAP384 indicates that the certificate should have an ECDSA private key on the P-384 curve.
+	KeyAlgorithmECDSAP384 KeyAlgorithm = "ECDSA-P384"
+	// KeyAlgorithmEd25519 indicates that the certificate should have an Ed25519 private key.
+	KeyAlgorithmEd25519 KeyAlgorithm = "Ed25519"
+)
+
 const (
 	// PKCS1 certificate format
 	PKCS1 = iota
@@ -54,7 +77,7 @@ const (
 )
 
 // CertificateSecretConfig contains the specification a to-be-generated CA, server, or client certificate.
-// It always contains a 3072-bit RSA private key.
+// It contains a 3072-bit RSA private key unless another KeyAlgorithm is specified.
 type CertificateSecretConfig struct {
 	Name string
 
@@ -66,6 +89,12 @@ type CertificateSecretConfig struct {
 	CertType  CertType
 	SigningCA *Certificate
 	PKCS      int
+	// KeyAlgorithm is the algorithm of the private key. Defaults to KeyAlgorithmRSA3072. With PKCS1, ECDSA keys are
+	// encoded in the SEC1 format and Ed25519 keys in the PKCS8 format.
+	KeyAlgorithm KeyAlgorithm
+	// Issuer issues the certificate by an external PKI instead of signing it locally. It must not be set together with
+	// SigningCA. CA certificates are issued as intermediate CAs, i.e., they can still sign other certificates locally.
+	Issuer Issuer `hash:"ignore"`
 
 	Validity                          *time.Duration
 	SkipPublishingCACertificate       bool
@@ -82,7 +111,7 @@ type Certificate struct {
 	SkipPublishingCACertificate       bool
 	IncludeCACertificateInServerChain bool
 
-	PrivateKey    *rsa.PrivateKey
+	PrivateKey    crypto.Signer
 	PrivateKeyPEM []byte
 
 	Certificate    *x509.Certificate
@@ -99,8 +128,17 @@ func (s *CertificateSecretConfig) Generate() (DataInterface, error) {
 	return s.GenerateCertificate()
 }
 
+// GenerateWithContext implements ContextConfigInterface.
+func (s *CertificateSecretConfig) GenerateWithContext(ctx context.Context) (DataInterface, error) {
+	return s.generateCertificate(ctx)
+}
+
 // GenerateCertificate is the same as Generate but returns a *Certificate instead of the DataInterface.
 func (s *CertificateSecretConfig) GenerateCertificate() (*Certificate, error) {
+	return s.generateCertificate(context.Background())
+}
+
+func (s *CertificateSecretConfig) generateCertificate(ctx context.Context) (*Certificate, error) {
 	certificateObj := &Certificate{
 		Name:                              s.Name,
 		CA:                                s.SigningCA,
@@ -109,13 +147,21 @@ func (s *CertificateSecretConfig) GenerateCertificate() (*Certificate, error) {
 		IncludeCACertificateInServerChain: s.IncludeCACertificateInServerChain,
 	}
 
+	if s.Issuer != nil && s.SigningCA != nil {
+		return nil, fmt.Errorf("certificate %q cannot be both issued by issuer %q and signed by CA %q", s.Name, s.Issuer.Name(), s.SigningCA.Name)
+	}
+
 	// If no cert type is given then we only return a certificate object that contains the CA.
 	if s.CertType != "" {
-		privateKey, err := GenerateKey(rand.Reader, 3072)
+		privateKey, err := s.generateKey()
 		if err != nil {
 			return nil, err
 		}
 
+		if s.Issuer != nil {
+			return s.generateIssuedCertificate(ctx, certificateObj, privateKey)
+		}
+
 		var (
 			certificate       = s.generateCertificateTemplate()
 			certificateSigner = certificate
@@ -127,20 +173,14 @@ func (s *CertificateSecretConfig) GenerateCertificate() (*Certificate, error) {
 			privateKeySigner = s.SigningCA.PrivateKey
 		}
 
-		certificatePEM, err := signCertificate(certificate, privateKey, certificateSigner, privateKeySigner)
+		certificatePEM, err := signCertificate(certificate, privateKey.Public(), certificateSigner, privateKeySigner)
 		if err != nil {
 			return nil, err
 		}
 
-		var pk []byte
-		if s.PKCS == PKCS1 {
-			pk = utils.EncodePrivateKey(privateKey)
-		} else if s.PKCS == PKCS8 {
-			pk, err = utils.EncodePrivateKeyInPKCS8(privateKey)
-
-			if err != nil {
-				return nil, err
-			}
+		pk, err := encodePrivateKey(privateKey, s.PKCS)
+		if err != nil {
+			return nil, err
 		}
 
 		certificateObj.PrivateKey = privateKey
@@ -152,6 +192,81 @@ func (s *CertificateSecretConfig) GenerateCertificate() (*Certificate, error) {
 	return certificateObj, nil
 }
 
+// generateIssuedCertificate completes the given certificate object with a certificate issued by the configured issuer.
+// For non-CA certificates, the certificate of the issuing CA is used as CA certificate.
+func (s *CertificateSecretConfig) generateIssuedCertificate(ctx context.Context, certificateObj *Certificate, privateKey crypto.Signer) (*Certificate, error) {
+	ctx, cancel := context.WithTimeout(ctx, issueTimeout)
+	defer cancel()
+
+	issued, certificate, err := s.issueCertificate(ctx, privateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	pk, err := encodePrivateKey(privateKey, s.PKCS)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.CertType != CACert {
+		certificateObj.CA = &Certificate{Name: s.Issuer.Name(), CertificatePEM: issued.IssuingCAPEM}
+	}
+
+	certificateObj.PrivateKey = privateKey
+	certificateObj.PrivateKeyPEM = pk
+	certificateObj.Certificate = certificate
+	certificateObj.CertificatePEM = issued.CertificatePEM
+
+	return certificateObj, nil
+}
+
+// generateKey generates a private key with the configured algorithm.
+func (s *CertificateSecretConfig) generateKey() (crypto.Signer, error) {
+	switch s.KeyAlgorithm {
+	case "", KeyAlgorithmRSA3072:
+		return GenerateKey(rand.Reader, 3072)
+	case KeyAlgorithmRSA4096:
+		return GenerateKey(rand.Reader, 4096)
+	case KeyAlgorithmECDSAP256:
+		return ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	case KeyAlgorithmECDSAP384:
+		return ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	case KeyAlgorithmEd25519:
+		_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+		return privateKey, err
+	}
+
+	return nil, fmt.Errorf("unsupported key algorithm %q", s.KeyAlgorithm)
+}
+
+// encodePrivateKey encodes the given private key to the PEM format. RSA keys are encoded in the requested PKCS format.
+// For other keys, PKCS1 does not exist, hence ECDSA keys are encoded in the SEC1 format and Ed25519 keys in the PKCS8
+// format instead.
+func encodePrivateKey(privateKey crypto.Signer, pkcs int) ([]byte, error) {
+	switch key := privateKey.(type) {
+	case *rsa.PrivateKey:
+		if pkcs == PKCS8 {
+			return utils.EncodePrivateKeyInPKCS8(key)
+		}
+		return utils.EncodePrivateKey(key), nil
+
+	case *ecdsa.PrivateKey:
+		if pkcs == PKCS1 {
+			bytes, err := x509.MarshalECPrivateKey(key)
+			if err != nil {
+				return nil, err
+			}
+			return pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: bytes}), nil
+		}
+	}
+
+	bytes, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	if err != nil {
+		return nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: bytes}), nil
+}
+
 // SecretData computes the data map which can be used in a Kubernetes secret.
 func (c *Certificate) SecretData() map[string][]byte {
 	data := map[string][]byte{}
@@ -182,9 +297,10 @@ func (c *Certificate) SecretData() map[string][]byte {
 }
 
 // LoadCertificate takes a byte slice representation of a certificate and the corresponding private key, and returns its de-serialized private
-// key, certificate template and PEM certificate which can be used to sign other x509 certificates.
+// key, certificate template and PEM certificate which can be used to sign other x509 certificates. The private key may
+// be of any of the supported key algorithms.
 func LoadCertificate(name string, privateKeyPEM, certificatePEM []byte) (*Certificate, error) {
-	privateKey, err := utils.DecodePrivateKey(privateKeyPEM)
+	privateKey, err := utils.DecodeAnyPrivateKey(privateKeyPEM)
 	if err != nil {
 		return nil, err
 	}
@@ -226,7 +342,7 @@ func (s *CertificateSecretConfig) generateCertificateTemplate() *x509.Certificat
 			SerialNumber:          serialNumber,
 			NotBefore:             AdjustToClockSkew(now),
 			NotAfter:              expiration,
-			KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+			KeyUsage:              x509.KeyUsageDigitalSignature,
 			Subject: pkix.Name{
 				CommonName:   s.CommonName,
 				Organization: s.Organization,
@@ -236,6 +352,12 @@ func (s *CertificateSecretConfig) generateCertificateTemplate() *x509.Certificat
 		}
 	)
 
+	// Key encipherment is only possible with RSA keys.
+	switch s.KeyAlgorithm {
+	case "", KeyAlgorithmRSA3072, KeyAlgorithmRSA4096:
+		template.KeyUsage |= x509.KeyUsageKeyEncipherment
+	}
+
 	switch s.CertType {
 	case CACert:
 		template.KeyUsage |= x509.KeyUsageCertSign | x509.KeyUsageCRLSign
@@ -251,10 +373,10 @@ func (s *CertificateSecretConfig) generateCertificateTemplate() *x509.Certificat
 }
 
 // SignCertificate takes a <certificateTemplate> and a <certificateTemplateSigner> which is used to sign
-// the first. It also requires the corresponding private keys of both certificates. The created certificate
-// is returned as byte slice.
-func signCertificate(certificateTemplate *x509.Certificate, privateKey *rsa.PrivateKey, certificateTemplateSigner *x509.Certificate, privateKeySigner *rsa.PrivateKey) ([]byte, error) {
-	certificate, err := x509.CreateCertificate(rand.Reader, certificateTemplate, certificateTemplateSigner, &privateKey.PublicKey, privateKeySigner)
+// the first. It also requires the public key of the first and the private key of the signing certificate, which
+// may be of different key algorithms. The created certificate is returned as byte slice.
+func signCertificate(certificateTemplate *x509.Certificate, publicKey crypto.PublicKey, certificateTemplateSigner *x509.Certificate, privateKeySigner crypto.Signer) ([]byte, error) {
+	certificate, err := x509.CreateCertificate(rand.Reader, certificateTemplate, certificateTemplateSigner, publicKey, privateKeySigner)
 	if err != nil {
 		return nil, err
 	}
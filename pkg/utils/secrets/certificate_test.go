@@ -5,9 +5,16 @@
 package secrets_test
 
 import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	"github.com/gardener/gardener/pkg/utils"
 	. "github.com/gardener/gardener/pkg/utils/secrets"
 )
 
@@ -37,6 +44,79 @@ var _ = Describe("Certificate Secrets", func() {
 				Expect(certificate.Certificate).NotTo(BeNil())
 				Expect(certificate.CA).To(BeNil())
 			})
+
+			It("should fail for an unsupported key algorithm", func() {
+				certificateConfig.KeyAlgorithm = "DSA"
+
+				_, err := certificateConfig.Generate()
+				Expect(err).To(MatchError(`unsupported key algorithm "DSA"`))
+			})
+		})
+
+		Describe("#GenerateCertificate", func() {
+			DescribeTable("should generate certificates with the configured key algorithm",
+				func(keyAlgorithm KeyAlgorithm, pkcs int, keyType any, pemType string, keyEncipherment bool) {
+					certificateConfig.KeyAlgorithm = keyAlgorithm
+					certificateConfig.PKCS = pkcs
+
+					certificate, err := certificateConfig.GenerateCertificate()
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(certificate.PrivateKey).To(BeAssignableToTypeOf(keyType))
+					Expect(string(certificate.PrivateKeyPEM)).To(HavePrefix("-----BEGIN " + pemType + "-----"))
+					Expect(certificate.Certificate.KeyUsage&x509.KeyUsageKeyEncipherment != 0).To(Equal(keyEncipherment))
+
+					loaded, err := LoadCertificate("ca", certificate.PrivateKeyPEM, certificate.CertificatePEM)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(loaded.PrivateKey).To(Equal(certificate.PrivateKey))
+				},
+
+				Entry("default", KeyAlgorithm(""), PKCS1, &rsa.PrivateKey{}, "RSA PRIVATE KEY", true),
+				Entry("RSA-4096 in PKCS8", KeyAlgorithmRSA4096, PKCS8, &rsa.PrivateKey{}, "RSA PRIVATE KEY", true),
+				Entry("ECDSA-P256", KeyAlgorithmECDSAP256, PKCS1, &ecdsa.PrivateKey{}, "EC PRIVATE KEY", false),
+				Entry("ECDSA-P384 in PKCS8", KeyAlgorithmECDSAP384, PKCS8, &ecdsa.PrivateKey{}, "PRIVATE KEY", false),
+				Entry("Ed25519", KeyAlgorithmEd25519, PKCS1, ed25519.PrivateKey{}, "PRIVATE KEY", false),
+			)
+
+			DescribeTable("should sign certificates with CAs of other key algorithms",
+				func(caKeyAlgorithm, keyAlgorithm KeyAlgorithm) {
+					certificateConfig.KeyAlgorithm = caKeyAlgorithm
+					ca, err := certificateConfig.GenerateCertificate()
+					Expect(err).NotTo(HaveOccurred())
+
+					// Simulate that the CA was read from an existing secret.
+					ca, err = LoadCertificate("ca", ca.PrivateKeyPEM, ca.CertificatePEM)
+					Expect(err).NotTo(HaveOccurred())
+
+					certificate, err := (&CertificateSecretConfig{
+						Name:         "server",
+						CommonName:   "server",
+						DNSNames:     []string{"server.example.com"},
+						CertType:     ServerCert,
+						SigningCA:    ca,
+						KeyAlgorithm: keyAlgorithm,
+					}).GenerateCertificate()
+					Expect(err).NotTo(HaveOccurred())
+
+					cert, err := utils.DecodeCertificate(certificate.CertificatePEM)
+					Expect(err).NotTo(HaveOccurred())
+
+					roots := x509.NewCertPool()
+					roots.AddCert(ca.Certificate)
+					_, err = cert.Verify(x509.VerifyOptions{
+						DNSName:     "server.example.com",
+						Roots:       roots,
+						KeyUsages:   []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+						CurrentTime: cert.NotBefore.Add(time.Hour),
+					})
+					Expect(err).NotTo(HaveOccurred())
+				},
+
+				Entry("RSA CA, ECDSA certificate", KeyAlgorithmRSA3072, KeyAlgorithmECDSAP256),
+				Entry("ECDSA CA, RSA certificate", KeyAlgorithmECDSAP384, KeyAlgorithmRSA3072),
+				Entry("Ed25519 CA, ECDSA certificate", KeyAlgorithmEd25519, KeyAlgorithmECDSAP256),
+				Entry("ECDSA CA, Ed25519 certificate", KeyAlgorithmECDSAP256, KeyAlgorithmEd25519),
+			)
 		})
 	})
 
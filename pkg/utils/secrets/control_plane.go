@@ -5,6 +5,7 @@
 package secrets
 
 import (
+	"context"
 	"fmt"
 
 	"k8s.io/apimachinery/pkg/runtime"
@@ -57,12 +58,17 @@ func (s *ControlPlaneSecretConfig) GetName() string {
 
 // Generate implements ConfigInterface.
 func (s *ControlPlaneSecretConfig) Generate() (DataInterface, error) {
+	return s.GenerateWithContext(context.Background())
+}
+
+// GenerateWithContext implements ContextConfigInterface.
+func (s *ControlPlaneSecretConfig) GenerateWithContext(ctx context.Context) (DataInterface, error) {
 	var certificate *Certificate
 
 	if s.CertificateSecretConfig != nil {
 		s.CertificateSecretConfig.Name = s.Name
 
-		certData, err := s.CertificateSecretConfig.GenerateCertificate()
+		certData, err := s.CertificateSecretConfig.generateCertificate(ctx)
 		if err != nil {
 			return nil, err
 		}
@@ -0,0 +1,112 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package secrets
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/gardener/gardener/pkg/utils"
+)
+
+// IssueRequest is a request for issuing a certificate by an Issuer.
+type IssueRequest struct {
+	// CSRPEM is the PEM-encoded certificate signing request containing the subject, the subject alternative names and
+	// the public key of the requested certificate.
+	CSRPEM []byte
+	// CommonName is the common name of the requested certificate.
+	CommonName string
+	// DNSNames are the DNS subject alternative names of the requested certificate.
+	DNSNames []string
+	// IPAddresses are the IP subject alternative names of the requested certificate.
+	IPAddresses []net.IP
+	// CertType is the type of the requested certificate. For CACert, an intermediate CA certificate is requested.
+	CertType CertType
+	// Validity is the requested validity of the certificate. The issuer may shorten it.
+	Validity time.Duration
+}
+
+// IssuedCertificate is a certificate issued by an Issuer.
+type IssuedCertificate struct {
+	// CertificatePEM is the PEM-encoded issued certificate.
+	CertificatePEM []byte
+	// IssuingCAPEM is the PEM-encoded certificate of the CA which issued the certificate.
+	IssuingCAPEM []byte
+}
+
+// Issuer issues certificates by an external PKI instead of signing them locally.
+type Issuer interface {
+	// Name returns a unique name of the issuer. Changing it leads to new certificates.
+	Name() string
+	// Issue issues a certificate for the given request.
+	Issue(ctx context.Context, request *IssueRequest) (*IssuedCertificate, error)
+}
+
+// issueTimeout is the timeout for issuing a certificate by an Issuer.
+const issueTimeout = time.Minute
+
+// issueCertificate requests a certificate for the given private key from the configured issuer.
+func (s *CertificateSecretConfig) issueCertificate(ctx context.Context, privateKey crypto.Signer) (*IssuedCertificate, *x509.Certificate, error) {
+	csr, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject: pkix.Name{
+			CommonName:   s.CommonName,
+			Organization: s.Organization,
+		},
+		DNSNames:    s.DNSNames,
+		IPAddresses: s.IPAddresses,
+	}, privateKey)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed creating certificate signing request: %w", err)
+	}
+
+	// Like for locally signed certificates, the validity defaults to 10 years.
+	now := Clock.Now()
+	validity := now.AddDate(10, 0, 0).Sub(now)
+	if s.Validity != nil {
+		validity = *s.Validity
+	}
+
+	issued, err := s.Issuer.Issue(ctx, &IssueRequest{
+		CSRPEM:      pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csr}),
+		CommonName:  s.CommonName,
+		DNSNames:    s.DNSNames,
+		IPAddresses: s.IPAddresses,
+		CertType:    s.CertType,
+		Validity:    validity,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed issuing certificate by issuer %q: %w", s.Issuer.Name(), err)
+	}
+
+	// PEM blocks are concatenated when building certificate chains or bundles, hence they must end with a newline.
+	issued.CertificatePEM = withTrailingNewline(issued.CertificatePEM)
+	issued.IssuingCAPEM = withTrailingNewline(issued.IssuingCAPEM)
+
+	certificate, err := utils.DecodeCertificate(issued.CertificatePEM)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed decoding certificate issued by issuer %q: %w", s.Issuer.Name(), err)
+	}
+
+	if publicKey, ok := privateKey.Public().(interface{ Equal(crypto.PublicKey) bool }); ok && !publicKey.Equal(certificate.PublicKey) {
+		return nil, nil, fmt.Errorf("certificate issued by issuer %q does not match the private key", s.Issuer.Name())
+	}
+
+	return issued, certificate, nil
+}
+
+func withTrailingNewline(data []byte) []byte {
+	if len(data) == 0 || bytes.HasSuffix(data, []byte("\n")) {
+		return data
+	}
+	return append(data, '\n')
+}
@@ -0,0 +1,135 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package secrets
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultVaultPKIMount is the default path at which the PKI secrets engine is mounted in Vault.
+	DefaultVaultPKIMount = "pki"
+
+	vaultTokenHeader = "X-Vault-Token"
+)
+
+// VaultIssuer is an Issuer using the HTTP API of a Vault-compatible PKI secrets engine. CA certificates are issued as
+// intermediate CAs via the 'root/sign-intermediate' endpoint, other certificates via the 'sign/<role>' endpoint.
+type VaultIssuer struct {
+	// Address is the address of the Vault server, e.g. https://vault.example.com:8200.
+	Address string
+	// Mount is the path at which the PKI secrets engine is mounted. Defaults to DefaultVaultPKIMount.
+	Mount string
+	// Role is the name of the role used for issuing non-CA certificates.
+	Role string
+	// Token is the token used for authenticating against Vault.
+	Token string
+	// Client is the HTTP client used for the requests. Defaults to http.DefaultClient.
+	Client *http.Client
+}
+
+var _ Issuer = &VaultIssuer{}
+
+type vaultSignRequest struct {
+	CSR          string `json:"csr"`
+	CommonName   string `json:"common_name"`
+	AltNames     string `json:"alt_names,omitempty"`
+	IPSANs       string `json:"ip_sans,omitempty"`
+	TTL          string `json:"ttl"`
+	Format       string `json:"format"`
+	UseCSRValues bool   `json:"use_csr_values,omitempty"`
+}
+
+type vaultSignResponse struct {
+	Data struct {
+		Certificate string `json:"certificate"`
+		IssuingCA   string `json:"issuing_ca"`
+	} `json:"data"`
+	Errors []string `json:"errors"`
+}
+
+// Name implements Issuer.
+func (v *VaultIssuer) Name() string {
+	return fmt.Sprintf("vault:%s/%s/%s", strings.TrimSuffix(v.Address, "/"), v.mount(), v.Role)
+}
+
+// Issue implements Issuer.
+func (v *VaultIssuer) Issue(ctx context.Context, request *IssueRequest) (*IssuedCertificate, error) {
+	signRequest := vaultSignRequest{
+		CSR:        string(request.CSRPEM),
+		CommonName: request.CommonName,
+		AltNames:   strings.Join(request.DNSNames, ","),
+		TTL:        fmt.Sprintf("%ds", int64(request.Validity/time.Second)),
+		Format:     "pem",
+	}
+
+	ipSANs := make([]string, 0, len(request.IPAddresses))
+	for _, ip := range request.IPAddresses {
+		ipSANs = append(ipSANs, ip.String())
+	}
+	signRequest.IPSANs = strings.Join(ipSANs, ",")
+
+	path := "sign/" + v.Role
+	if request.CertType == CACert {
+		path = "root/sign-intermediate"
+		signRequest.UseCSRValues = true
+	}
+
+	body, err := json.Marshal(signRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/v1/%s/%s", strings.TrimSuffix(v.Address, "/"), v.mount(), path), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Set(vaultTokenHeader, v.Token)
+	httpRequest.Header.Set("Content-Type", "application/json")
+
+	client := v.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	httpResponse, err := client.Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer httpResponse.Body.Close()
+
+	data, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed reading response: %w", err)
+	}
+
+	response := &vaultSignResponse{}
+	if err := json.Unmarshal(data, response); err != nil {
+		return nil, fmt.Errorf("failed decoding response with status code %d: %w", httpResponse.StatusCode, err)
+	}
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d: %s", httpResponse.StatusCode, strings.Join(response.Errors, ", "))
+	}
+
+	return &IssuedCertificate{
+		CertificatePEM: []byte(response.Data.Certificate),
+		IssuingCAPEM:   []byte(response.Data.IssuingCA),
+	}, nil
+}
+
+func (v *VaultIssuer) mount() string {
+	if v.Mount == "" {
+		return DefaultVaultPKIMount
+	}
+	return strings.Trim(v.Mount, "/")
+}
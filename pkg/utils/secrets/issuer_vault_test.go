@@ -0,0 +1,218 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package secrets_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/json"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/gardener/pkg/utils"
+	. "github.com/gardener/gardener/pkg/utils/secrets"
+)
+
+var _ = Describe("VaultIssuer", func() {
+	var (
+		rootCA   *Certificate
+		server   *httptest.Server
+		requests []map[string]any
+		issuer   *VaultIssuer
+	)
+
+	BeforeEach(func() {
+		var err error
+		rootCA, err = (&CertificateSecretConfig{Name: "root", CommonName: "corporate-root", CertType: CACert}).GenerateCertificate()
+		Expect(err).NotTo(HaveOccurred())
+		rootCA, err = LoadCertificate("root", rootCA.PrivateKeyPEM, rootCA.CertificatePEM)
+		Expect(err).NotTo(HaveOccurred())
+
+		requests = nil
+		server = httptest.NewServer(newFakeVaultPKI(rootCA, &requests))
+		DeferCleanup(server.Close)
+
+		issuer = &VaultIssuer{
+			Address: server.URL,
+			Role:    "shoot",
+			Token:   "token",
+			Client:  server.Client(),
+		}
+	})
+
+	verify := func(certificatePEM []byte, roots, intermediates []*x509.Certificate) error {
+		certificate, err := utils.DecodeCertificate(certificatePEM)
+		Expect(err).NotTo(HaveOccurred())
+
+		options := x509.VerifyOptions{
+			Roots:         x509.NewCertPool(),
+			Intermediates: x509.NewCertPool(),
+			KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+			CurrentTime:   certificate.NotBefore.Add(time.Hour),
+		}
+		for _, cert := range roots {
+			options.Roots.AddCert(cert)
+		}
+		for _, cert := range intermediates {
+			options.Intermediates.AddCert(cert)
+		}
+
+		_, err = certificate.Verify(options)
+		return err
+	}
+
+	It("should issue intermediate CA certificates which can sign certificates locally", func() {
+		ca, err := (&CertificateSecretConfig{
+			Name:       "ca",
+			CommonName: "ca",
+			CertType:   CACert,
+			Issuer:     issuer,
+		}).GenerateCertificate()
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(requests).To(ConsistOf(And(
+			HaveKeyWithValue("path", "/v1/pki/root/sign-intermediate"),
+			HaveKeyWithValue("common_name", "ca"),
+			HaveKeyWithValue("ttl", "315532800s"),
+			HaveKeyWithValue("use_csr_values", true),
+		)))
+		Expect(ca.CA).To(BeNil())
+		Expect(ca.Certificate.IsCA).To(BeTrue())
+		Expect(ca.SecretData()).To(Equal(map[string][]byte{
+			DataKeyCertificateCA: ca.CertificatePEM,
+			DataKeyPrivateKeyCA:  ca.PrivateKeyPEM,
+		}))
+		Expect(verify(ca.CertificatePEM, []*x509.Certificate{rootCA.Certificate}, nil)).To(Succeed())
+
+		ca, err = LoadCertificate("ca", ca.PrivateKeyPEM, ca.CertificatePEM)
+		Expect(err).NotTo(HaveOccurred())
+
+		certificate, err := (&CertificateSecretConfig{
+			Name:       "client",
+			CommonName: "client",
+			CertType:   ClientCert,
+			SigningCA:  ca,
+		}).GenerateCertificate()
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(verify(certificate.CertificatePEM, []*x509.Certificate{ca.Certificate}, nil)).To(Succeed())
+		Expect(verify(certificate.CertificatePEM, []*x509.Certificate{rootCA.Certificate}, []*x509.Certificate{ca.Certificate})).To(Succeed())
+	})
+
+	It("should issue server certificates and publish the issuing CA", func() {
+		validity := time.Hour
+
+		certificate, err := (&CertificateSecretConfig{
+			Name:                              "server",
+			CommonName:                        "server",
+			DNSNames:                          []string{"server.example.com", "server"},
+			IPAddresses:                       []net.IP{net.ParseIP("10.0.0.1")},
+			CertType:                          ServerCert,
+			Validity:                          &validity,
+			KeyAlgorithm:                      KeyAlgorithmECDSAP256,
+			IncludeCACertificateInServerChain: true,
+			Issuer:                            issuer,
+		}).GenerateCertificate()
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(requests).To(ConsistOf(And(
+			HaveKeyWithValue("path", "/v1/pki/sign/shoot"),
+			HaveKeyWithValue("alt_names", "server.example.com,server"),
+			HaveKeyWithValue("ip_sans", "10.0.0.1"),
+			HaveKeyWithValue("ttl", "3600s"),
+		)))
+		Expect(certificate.Certificate.DNSNames).To(ConsistOf("server.example.com", "server"))
+		Expect(certificate.CA.CertificatePEM).To(Equal(rootCA.CertificatePEM))
+		Expect(verify(certificate.CertificatePEM, []*x509.Certificate{rootCA.Certificate}, nil)).To(Succeed())
+
+		data := certificate.SecretData()
+		Expect(data).To(HaveKeyWithValue(DataKeyCertificateCA, rootCA.CertificatePEM))
+		Expect(data).To(HaveKeyWithValue(DataKeyPrivateKey, certificate.PrivateKeyPEM))
+		Expect(string(data[DataKeyCertificate])).To(Equal(string(certificate.CertificatePEM) + string(rootCA.CertificatePEM)))
+	})
+
+	It("should return the errors reported by Vault", func() {
+		issuer.Token = "wrong"
+
+		_, err := (&CertificateSecretConfig{Name: "ca", CommonName: "ca", CertType: CACert, Issuer: issuer}).GenerateCertificate()
+		Expect(err).To(MatchError(ContainSubstring("unexpected status code 403: permission denied")))
+	})
+
+	It("should fail if the context is cancelled", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err := (&CertificateSecretConfig{Name: "ca", CommonName: "ca", CertType: CACert, Issuer: issuer}).GenerateWithContext(ctx)
+		Expect(err).To(MatchError(context.Canceled))
+		Expect(requests).To(BeEmpty())
+	})
+
+	It("should fail if the certificate should also be signed by a CA", func() {
+		_, err := (&CertificateSecretConfig{Name: "server", CommonName: "server", CertType: ServerCert, Issuer: issuer, SigningCA: rootCA}).GenerateCertificate()
+		Expect(err).To(MatchError(ContainSubstring("cannot be both issued by issuer")))
+	})
+})
+
+// newFakeVaultPKI returns a handler serving a minimal fake of the Vault PKI secrets engine API which signs the
+// certificates with the given CA.
+func newFakeVaultPKI(ca *Certificate, requests *[]map[string]any) http.Handler {
+	writeResponse := func(w http.ResponseWriter, status int, response any) {
+		w.WriteHeader(status)
+		Expect(json.NewEncoder(w).Encode(response)).To(Succeed())
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer GinkgoRecover()
+
+		if r.Header.Get("X-Vault-Token") != "token" {
+			writeResponse(w, http.StatusForbidden, map[string]any{"errors": []string{"permission denied"}})
+			return
+		}
+
+		request := map[string]any{}
+		Expect(json.NewDecoder(r.Body).Decode(&request)).To(Succeed())
+		request["path"] = r.URL.Path
+		*requests = append(*requests, request)
+
+		csr, err := utils.DecodeCertificateRequest([]byte(request["csr"].(string)))
+		Expect(err).NotTo(HaveOccurred())
+		ttl, err := time.ParseDuration(request["ttl"].(string))
+		Expect(err).NotTo(HaveOccurred())
+
+		template := &x509.Certificate{
+			SerialNumber:          big.NewInt(time.Now().UnixNano()),
+			Subject:               csr.Subject,
+			DNSNames:              csr.DNSNames,
+			IPAddresses:           csr.IPAddresses,
+			NotBefore:             Clock.Now(),
+			NotAfter:              Clock.Now().Add(ttl),
+			BasicConstraintsValid: true,
+			KeyUsage:              x509.KeyUsageDigitalSignature,
+			ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		}
+		if strings.HasSuffix(r.URL.Path, "/root/sign-intermediate") {
+			template.IsCA = true
+			template.KeyUsage |= x509.KeyUsageCertSign
+			template.ExtKeyUsage = nil
+		}
+
+		certificate, err := x509.CreateCertificate(rand.Reader, template, ca.Certificate, csr.PublicKey, ca.PrivateKey)
+		Expect(err).NotTo(HaveOccurred())
+
+		// Vault returns the PEM blocks without trailing newlines.
+		writeResponse(w, http.StatusOK, map[string]any{"data": map[string]any{
+			"certificate": strings.TrimSpace(string(utils.EncodeCertificate(certificate))),
+			"issuing_ca":  strings.TrimSpace(string(ca.CertificatePEM)),
+		}})
+	})
+}
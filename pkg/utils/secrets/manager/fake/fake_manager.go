@@ -74,7 +74,7 @@ func (m *fakeManager) Generate(ctx context.Context, config secretsutils.ConfigIn
 		return nil, err
 	}
 
-	data, err := config.Generate()
+	data, err := secretsutils.GenerateWithContext(ctx, config)
 	if err != nil {
 		return nil, err
 	}
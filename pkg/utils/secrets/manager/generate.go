@@ -24,6 +24,10 @@ import (
 
 func (m *manager) Generate(ctx context.Context, config secretsutils.ConfigInterface, opts ...GenerateOption) (*corev1.Secret, error) {
 	options := &GenerateOptions{}
+	if certConfig := certificateSecretConfig(config); m.caIssuer != nil && certConfig != nil && certConfig.CertType == secretsutils.CACert {
+		// The CA issuer is applied first so that it can be overwritten by an explicit IssuedBy option.
+		opts = append([]GenerateOption{IssuedBy(m.caIssuer)}, opts...)
+	}
 	if err := options.ApplyOptions(m, config, opts); err != nil {
 		return nil, fmt.Errorf("failed applying generate options for config %s: %w", config.GetName(), err)
 	}
@@ -95,7 +99,7 @@ func (m *manager) generateAndCreate(ctx context.Context, config secretsutils.Con
 		certConfig.CommonName = objectMeta.Name
 	}
 
-	data, err := config.Generate()
+	data, err := secretsutils.GenerateWithContext(ctx, config)
 	if err != nil {
 		return nil, fmt.Errorf("failed generating data: %w", err)
 	}
@@ -491,6 +495,27 @@ func SignedByCA(name string, opts ...SignedByCAOption) GenerateOption {
 	}
 }
 
+// IssuedBy returns a function which sets the 'Issuer' field in case the ConfigInterface provided to the Generate request
+// is a CertificateSecretConfig, i.e., the certificate is issued by the given external issuer instead of being signed
+// locally. Additionally, in such case it stores a checksum of the issuer name in the options (like for the signing CA)
+// so that the certificate is regenerated when the issuer changes.
+func IssuedBy(issuer secretsutils.Issuer) GenerateOption {
+	return func(m Interface, config secretsutils.ConfigInterface, options *GenerateOptions) error {
+		if _, ok := m.(*manager); !ok {
+			return nil
+		}
+
+		certificateConfig := certificateSecretConfig(config)
+		if certificateConfig == nil {
+			return fmt.Errorf("could not apply option to %T, expected *secrets.CertificateSecretConfig", config)
+		}
+
+		certificateConfig.Issuer = issuer
+		options.signingCAChecksum = ptr.To(kubernetesutils.TruncateLabelValue(utils.ComputeSHA256Hex([]byte(issuer.Name()))))
+		return nil
+	}
+}
+
 // Persist returns a function which sets the 'Persist' field to true.
 func Persist() GenerateOption {
 	return func(_ Interface, _ secretsutils.ConfigInterface, options *GenerateOptions) error {
@@ -6,7 +6,10 @@ package manager
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/x509"
 	"fmt"
+	"math/big"
 	"sort"
 	"strconv"
 	"time"
@@ -23,6 +26,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	"github.com/gardener/gardener/pkg/utils"
 	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
 	"github.com/gardener/gardener/pkg/utils/test"
 )
@@ -447,6 +451,68 @@ var _ = Describe("Generate", func() {
 				Expect(secret.Name).To(Equal(name))
 			})
 
+			It("should generate a new CA secret with a static name when the CA issuer changes", func() {
+				By("Generate new secret")
+				secret, err := m.Generate(ctx, config, IgnoreConfigChecksumForCASecretName())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(secret.Name).To(Equal(name))
+
+				By("Generate secret with an issuer")
+				issuer := newFakeIssuer("issuer")
+				config.CommonName = commonName
+				issuedSecret, err := m.Generate(ctx, config, IgnoreConfigChecksumForCASecretName(), IssuedBy(issuer))
+				Expect(err).NotTo(HaveOccurred())
+				expectSecretWasCreated(ctx, fakeClient, issuedSecret)
+				Expect(issuedSecret.Name).To(Equal(name + "-" + utils.ComputeSHA256Hex([]byte(utils.ComputeSHA256Hex([]byte("issuer"))[:63]))[:8]))
+				Expect(issuer.requests).To(Equal(1))
+
+				By("Generate secret with another issuer")
+				otherIssuer := newFakeIssuer("other-issuer")
+				config.CommonName = commonName
+				otherIssuedSecret, err := m.Generate(ctx, config, IgnoreConfigChecksumForCASecretName(), IssuedBy(otherIssuer))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(otherIssuedSecret.Name).NotTo(Or(Equal(name), Equal(issuedSecret.Name)))
+				Expect(otherIssuer.requests).To(Equal(1))
+			})
+
+			It("should issue the CA secret by the configured CA issuer", func() {
+				issuer := newFakeIssuer("issuer")
+				mgr, err := New(ctx, logr.Discard(), fakeClock, fakeClient, namespace, identity, Config{CAIssuer: issuer})
+				Expect(err).NotTo(HaveOccurred())
+				m = mgr.(*manager)
+
+				By("Generate new secret")
+				secret, err := m.Generate(ctx, config)
+				Expect(err).NotTo(HaveOccurred())
+				expectSecretWasCreated(ctx, fakeClient, secret)
+				Expect(issuer.requests).To(Equal(1))
+				Expect(secret.Name).NotTo(Equal(name + "-54620669"))
+				Expect(secret.Labels).To(HaveKeyWithValue("checksum-of-signing-ca", utils.ComputeSHA256Hex([]byte("issuer"))[:63]))
+
+				By("Verify CA is issued by the issuer")
+				cert, err := secretsutils.LoadCertificate("", secret.Data["ca.key"], secret.Data["ca.crt"])
+				Expect(err).NotTo(HaveOccurred())
+				Expect(cert.Certificate.IsCA).To(BeTrue())
+				Expect(issuer.verify(cert.Certificate)).To(Succeed())
+
+				By("Get secret again")
+				// Generate overwrites the common name of CA certificates with the secret name.
+				config.CommonName = commonName
+				existingSecret, err := m.Generate(ctx, config)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(existingSecret.Name).To(Equal(secret.Name))
+				Expect(issuer.requests).To(Equal(1))
+
+				By("Generate secret with another issuer")
+				otherIssuer := newFakeIssuer("other-issuer")
+				config.CommonName = commonName
+				newSecret, err := m.Generate(ctx, config, IssuedBy(otherIssuer))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(newSecret.Name).NotTo(Equal(secret.Name))
+				Expect(issuer.requests).To(Equal(1))
+				Expect(otherIssuer.requests).To(Equal(1))
+			})
+
 			It("should rotate a CA secret and add old and new to the corresponding bundle", func() {
 				By("Generate new secret")
 				secret, err := m.Generate(ctx, config)
@@ -758,6 +824,31 @@ var _ = Describe("Generate", func() {
 				Expect(newClientSecret).NotTo(Equal(clientSecret))
 			})
 
+			It("should sign certificates locally with a CA issued by the CA issuer", func() {
+				issuer := newFakeIssuer("issuer")
+				mgr, err := New(ctx, logr.Discard(), fakeClock, fakeClient, namespace, identity, Config{CAIssuer: issuer})
+				Expect(err).NotTo(HaveOccurred())
+				m = mgr.(*manager)
+
+				By("Generate new CA secret")
+				caSecret, err := m.Generate(ctx, caConfig)
+				Expect(err).NotTo(HaveOccurred())
+				expectSecretWasCreated(ctx, fakeClient, caSecret)
+
+				By("Generate new server secret")
+				serverSecret, err := m.Generate(ctx, serverConfig, SignedByCA(caName))
+				Expect(err).NotTo(HaveOccurred())
+				expectSecretWasCreated(ctx, fakeClient, serverSecret)
+				Expect(issuer.requests).To(Equal(1))
+
+				By("Verify server certificate chains up to the issuer")
+				ca, err := secretsutils.LoadCertificate("", caSecret.Data["ca.key"], caSecret.Data["ca.crt"])
+				Expect(err).NotTo(HaveOccurred())
+				server, err := secretsutils.LoadCertificate("", serverSecret.Data["tls.key"], serverSecret.Data["tls.crt"])
+				Expect(err).NotTo(HaveOccurred())
+				Expect(issuer.verify(server.Certificate, ca.Certificate)).To(Succeed())
+			})
+
 			It("should also accept ControlPlaneSecretConfigs", func() {
 				DeferCleanup(test.WithVar(&secretsutils.Clock, fakeClock))
 
@@ -952,3 +1043,70 @@ func expectSecretWasCreated(ctx context.Context, fakeClient client.Client, secre
 
 	Expect(foundSecret).To(Equal(secret))
 }
+
+// fakeIssuer is an issuer signing the requested certificates with a locally generated root CA.
+type fakeIssuer struct {
+	name     string
+	ca       *secretsutils.Certificate
+	requests int
+}
+
+func newFakeIssuer(name string) *fakeIssuer {
+	ca, err := (&secretsutils.CertificateSecretConfig{Name: name, CommonName: name, CertType: secretsutils.CACert}).GenerateCertificate()
+	Expect(err).NotTo(HaveOccurred())
+	ca, err = secretsutils.LoadCertificate(name, ca.PrivateKeyPEM, ca.CertificatePEM)
+	Expect(err).NotTo(HaveOccurred())
+
+	return &fakeIssuer{name: name, ca: ca}
+}
+
+func (f *fakeIssuer) Name() string {
+	return f.name
+}
+
+func (f *fakeIssuer) Issue(_ context.Context, request *secretsutils.IssueRequest) (*secretsutils.IssuedCertificate, error) {
+	f.requests++
+
+	csr, err := utils.DecodeCertificateRequest(request.CSRPEM)
+	if err != nil {
+		return nil, err
+	}
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(int64(f.requests)),
+		Subject:               csr.Subject,
+		DNSNames:              csr.DNSNames,
+		IPAddresses:           csr.IPAddresses,
+		NotBefore:             f.ca.Certificate.NotBefore,
+		NotAfter:              f.ca.Certificate.NotBefore.Add(request.Validity),
+		BasicConstraintsValid: true,
+		IsCA:                  request.CertType == secretsutils.CACert,
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+	}
+
+	certificate, err := x509.CreateCertificate(rand.Reader, template, f.ca.Certificate, csr.PublicKey, f.ca.PrivateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &secretsutils.IssuedCertificate{
+		CertificatePEM: utils.EncodeCertificate(certificate),
+		IssuingCAPEM:   f.ca.CertificatePEM,
+	}, nil
+}
+
+func (f *fakeIssuer) verify(certificate *x509.Certificate, intermediates ...*x509.Certificate) error {
+	options := x509.VerifyOptions{
+		Roots:         x509.NewCertPool(),
+		Intermediates: x509.NewCertPool(),
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+		CurrentTime:   certificate.NotBefore.Add(time.Hour),
+	}
+	options.Roots.AddCert(f.ca.Certificate)
+	for _, intermediate := range intermediates {
+		options.Intermediates.AddCert(intermediate)
+	}
+
+	_, err := certificate.Verify(options)
+	return err
+}
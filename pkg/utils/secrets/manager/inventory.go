@@ -0,0 +1,115 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package manager
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener/pkg/utils"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+)
+
+// CertificateInfo contains information about a CA, server, or client certificate managed by a SecretsManager.
+type CertificateInfo struct {
+	// Name is the name of the secret config the certificate was generated for.
+	Name string `json:"name"`
+	// SecretName is the name of the secret containing the certificate.
+	SecretName string `json:"secretName"`
+	// ManagerIdentity is the identity of the SecretsManager managing the certificate.
+	ManagerIdentity string `json:"managerIdentity"`
+	// CA specifies whether the certificate is a certificate authority.
+	CA bool `json:"ca,omitempty"`
+	// SigningCA is the name of the secret containing the CA which signed the certificate. It is empty for self-signed
+	// CAs and for certificates whose signing CA is not managed in the same namespace.
+	SigningCA string `json:"signingCA,omitempty"`
+	// IssuedAt is the time when the certificate was issued.
+	IssuedAt *metav1.Time `json:"issuedAt,omitempty"`
+	// ValidUntil is the time until when the certificate is valid.
+	ValidUntil *metav1.Time `json:"validUntil,omitempty"`
+}
+
+// ListCertificates returns information about all CA, server, and client certificates managed by any SecretsManager in
+// the given namespace. The information is computed based on the labels maintained by the SecretsManager, hence the
+// secret data is only read for computing the checksums of CAs. The result is sorted by the secret names.
+func ListCertificates(ctx context.Context, c client.Reader, namespace string) ([]CertificateInfo, error) {
+	secretList := &corev1.SecretList{}
+	if err := c.List(ctx, secretList, client.InNamespace(namespace), client.MatchingLabels{LabelKeyManagedBy: LabelValueSecretsManager}); err != nil {
+		return nil, fmt.Errorf("failed listing secrets: %w", err)
+	}
+
+	// SignedByCA labels certificates with the (truncated) checksum of the data of the signing CA secret, see
+	// GenerateOptions.signingCAChecksum.
+	caSecretNamesByChecksum := make(map[string]string)
+	for _, secret := range secretList.Items {
+		if isCASecret(secret.Data) {
+			caSecretNamesByChecksum[kubernetesutils.TruncateLabelValue(utils.ComputeSecretChecksum(secret.Data))] = secret.Name
+		}
+	}
+
+	var certificates []CertificateInfo
+	for _, secret := range secretList.Items {
+		isCA := isCASecret(secret.Data)
+		if !isCA && !isCertificateSecret(secret) {
+			continue
+		}
+
+		info := CertificateInfo{
+			Name:            secret.Labels[LabelKeyName],
+			SecretName:      secret.Name,
+			ManagerIdentity: secret.Labels[LabelKeyManagerIdentity],
+			CA:              isCA,
+			SigningCA:       caSecretNamesByChecksum[secret.Labels[LabelKeyChecksumSigningCA]],
+		}
+
+		var err error
+		if info.IssuedAt, err = timeFromUnixLabel(secret.Labels, LabelKeyIssuedAtTime); err != nil {
+			return nil, fmt.Errorf("failed parsing label %q of secret %s: %w", LabelKeyIssuedAtTime, secret.Name, err)
+		}
+		if info.ValidUntil, err = timeFromUnixLabel(secret.Labels, LabelKeyValidUntilTime); err != nil {
+			return nil, fmt.Errorf("failed parsing label %q of secret %s: %w", LabelKeyValidUntilTime, secret.Name, err)
+		}
+
+		certificates = append(certificates, info)
+	}
+
+	slices.SortFunc(certificates, func(a, b CertificateInfo) int {
+		return strings.Compare(a.SecretName, b.SecretName)
+	})
+
+	return certificates, nil
+}
+
+func isCertificateSecret(secret corev1.Secret) bool {
+	if secret.Data[secretsutils.DataKeyCertificate] != nil && secret.Data[secretsutils.DataKeyPrivateKey] != nil {
+		return true
+	}
+
+	name := secret.Labels[LabelKeyName]
+	return secret.Data[secretsutils.ControlPlaneSecretDataKeyCertificatePEM(name)] != nil && secret.Data[secretsutils.ControlPlaneSecretDataKeyPrivateKey(name)] != nil
+}
+
+func timeFromUnixLabel(labels map[string]string, key string) (*metav1.Time, error) {
+	value, ok := labels[key]
+	if !ok {
+		return nil, nil
+	}
+
+	unix, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	return &metav1.Time{Time: time.Unix(unix, 0).UTC()}, nil
+}
@@ -0,0 +1,94 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package manager
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubernetesscheme "k8s.io/client-go/kubernetes/scheme"
+	testclock "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+	"github.com/gardener/gardener/pkg/utils/test"
+)
+
+var _ = Describe("Inventory", func() {
+	var (
+		ctx       = context.TODO()
+		namespace = "shoot--foo--bar"
+
+		fakeClient client.Client
+		fakeClock  = testclock.NewFakeClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetesscheme.Scheme).Build()
+		DeferCleanup(test.WithVar(&secretsutils.Clock, fakeClock))
+	})
+
+	Describe("#ListCertificates", func() {
+		It("should list all certificates managed by secrets managers", func() {
+			m, err := New(ctx, logr.Discard(), fakeClock, fakeClient, namespace, "test", Config{})
+			Expect(err).NotTo(HaveOccurred())
+
+			caSecret, err := m.Generate(ctx, &secretsutils.CertificateSecretConfig{Name: "ca", CommonName: "ca", CertType: secretsutils.CACert})
+			Expect(err).NotTo(HaveOccurred())
+			serverSecret, err := m.Generate(ctx, &secretsutils.CertificateSecretConfig{Name: "server", CommonName: "server", CertType: secretsutils.ServerCert}, SignedByCA("ca"))
+			Expect(err).NotTo(HaveOccurred())
+			controlPlaneSecret, err := m.Generate(ctx, &secretsutils.ControlPlaneSecretConfig{
+				Name:                    "control-plane",
+				CertificateSecretConfig: &secretsutils.CertificateSecretConfig{CommonName: "control-plane", CertType: secretsutils.ClientCert},
+			}, SignedByCA("ca"))
+			Expect(err).NotTo(HaveOccurred())
+			_, err = m.Generate(ctx, &secretsutils.BasicAuthSecretConfig{Name: "basic-auth", Format: secretsutils.BasicAuthFormatNormal, Username: "foo", PasswordLength: 3})
+			Expect(err).NotTo(HaveOccurred())
+
+			otherManager, err := New(ctx, logr.Discard(), fakeClock, fakeClient, namespace, "other", Config{})
+			Expect(err).NotTo(HaveOccurred())
+			otherCASecret, err := otherManager.Generate(ctx, &secretsutils.CertificateSecretConfig{Name: "other-ca", CommonName: "other-ca", CertType: secretsutils.CACert})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeClient.Create(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "unmanaged", Namespace: namespace}, Data: caSecret.Data})).To(Succeed())
+
+			var (
+				issuedAt   = &metav1.Time{Time: secretsutils.AdjustToClockSkew(fakeClock.Now()).UTC()}
+				validUntil = &metav1.Time{Time: fakeClock.Now().AddDate(10, 0, 0).UTC()}
+			)
+
+			certificates, err := ListCertificates(ctx, fakeClient, namespace)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(certificates).To(Equal([]CertificateInfo{
+				{Name: "ca", SecretName: caSecret.Name, ManagerIdentity: "test", CA: true, IssuedAt: issuedAt, ValidUntil: validUntil},
+				{Name: "control-plane", SecretName: controlPlaneSecret.Name, ManagerIdentity: "test", SigningCA: caSecret.Name, IssuedAt: issuedAt, ValidUntil: validUntil},
+				{Name: "other-ca", SecretName: otherCASecret.Name, ManagerIdentity: "other", CA: true, IssuedAt: issuedAt, ValidUntil: validUntil},
+				{Name: "server", SecretName: serverSecret.Name, ManagerIdentity: "test", SigningCA: caSecret.Name, IssuedAt: issuedAt, ValidUntil: validUntil},
+			}))
+		})
+
+		It("should fail if a lifetime label cannot be parsed", func() {
+			m, err := New(ctx, logr.Discard(), fakeClock, fakeClient, namespace, "test", Config{})
+			Expect(err).NotTo(HaveOccurred())
+
+			caSecret, err := m.Generate(ctx, &secretsutils.CertificateSecretConfig{Name: "ca", CommonName: "ca", CertType: secretsutils.CACert})
+			Expect(err).NotTo(HaveOccurred())
+
+			patch := client.MergeFrom(caSecret.DeepCopy())
+			caSecret.Labels["valid-until-time"] = "foo"
+			Expect(fakeClient.Patch(ctx, caSecret, patch)).To(Succeed())
+
+			certificates, err := ListCertificates(ctx, fakeClient, namespace)
+			Expect(err).To(MatchError(ContainSubstring(`failed parsing label "valid-until-time"`)))
+			Expect(certificates).To(BeNil())
+		})
+	})
+})
@@ -77,6 +77,7 @@ type (
 		namespace                   string
 		identity                    string
 		lastRotationInitiationTimes nameToUnixTime
+		caIssuer                    secretsutils.Issuer
 	}
 
 	nameToUnixTime map[string]string
@@ -100,6 +101,10 @@ type (
 		// SecretNamesToTimes is a map whose keys are secret names and whose values are the last rotation initiation
 		// times.
 		SecretNamesToTimes map[string]time.Time
+		// CAIssuer is an external issuer which issues all CA certificates as intermediate CAs instead of self-signing
+		// them. Certificates signed by these CAs are still signed locally. It can be overwritten per Generate request
+		// with the IssuedBy option.
+		CAIssuer secretsutils.Issuer
 	}
 )
 
@@ -134,6 +139,7 @@ func New(
 		namespace:                   namespace,
 		identity:                    identity,
 		lastRotationInitiationTimes: make(nameToUnixTime),
+		caIssuer:                    rotation.CAIssuer,
 	}
 
 	if err := m.initialize(ctx, rotation); err != nil {
@@ -339,6 +345,10 @@ func computeSecretName(config secretsutils.ConfigInterface, labels map[string]st
 		if infix := labels[LabelKeyChecksumConfig] + labels[LabelKeyChecksumSigningCA]; len(infix) > 0 {
 			name += "-" + utils.ComputeSHA256Hex([]byte(infix))[:8]
 		}
+	} else if infix := labels[LabelKeyChecksumSigningCA]; len(infix) > 0 {
+		// CAs with static names still get a new name when they are issued by an (other) external issuer, so that the
+		// issuer takes effect without waiting for the next rotation.
+		name += "-" + utils.ComputeSHA256Hex([]byte(infix))[:8]
 	}
 
 	if suffix := labels[LabelKeyLastRotationInitiationTime]; len(suffix) > 0 {
@@ -315,6 +315,15 @@ var _ = Describe("Manager", func() {
 			Entry("config checksum considered, rotation", false, configName+"-fd0a3f24-76711", lastRotationInitiationTime),
 		)
 
+		It("should consider the signing CA checksum for CAs whose config checksum is ignored", func() {
+			config := &secretsutils.CertificateSecretConfig{Name: configName}
+
+			meta, err := ObjectMeta(namespace, "test", config, true, "", ptr.To("checksum"), nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(meta.Name).To(Equal(configName + "-" + utils.ComputeSHA256Hex([]byte("checksum"))[:8]))
+			Expect(meta.Labels).To(HaveKeyWithValue("checksum-of-signing-ca", "checksum"))
+		})
+
 		DescribeTable("check different label options",
 			func(nameInfix string, signingCAChecksum *string, persist *bool, bundleFor *string, extraLabels map[string]string) {
 				config := &secretsutils.CertificateSecretConfig{
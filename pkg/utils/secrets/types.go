@@ -4,6 +4,10 @@
 
 package secrets
 
+import (
+	"context"
+)
+
 // ConfigInterface define functions needed for generating a specific secret.
 type ConfigInterface interface {
 	// GetName returns the name of the configuration.
@@ -12,6 +16,23 @@ type ConfigInterface interface {
 	Generate() (DataInterface, error)
 }
 
+// ContextConfigInterface is implemented by configurations whose generation might send requests to external systems,
+// e.g. to an Issuer.
+type ContextConfigInterface interface {
+	ConfigInterface
+	// GenerateWithContext is the same as Generate but binds requests to external systems to the given context.
+	GenerateWithContext(ctx context.Context) (DataInterface, error)
+}
+
+// GenerateWithContext generates the data of the given configuration. If the configuration implements
+// ContextConfigInterface, the given context is passed to it.
+func GenerateWithContext(ctx context.Context, config ConfigInterface) (DataInterface, error) {
+	if c, ok := config.(ContextConfigInterface); ok {
+		return c.GenerateWithContext(ctx)
+	}
+	return config.Generate()
+}
+
 // DataInterface defines functions needed for defining the data map of a Kubernetes secret.
 type DataInterface interface {
 	// SecretData computes the data map which can be used in a Kubernetes secret.
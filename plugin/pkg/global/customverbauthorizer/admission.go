@@ -32,6 +32,9 @@ const (
 	// CustomVerbProjectManageMembers is a constant for the custom verb that allows to manage human users or
 	// groups subjects in the `.spec.members` field in `Project` resources.
 	CustomVerbProjectManageMembers = "manage-members"
+	// CustomVerbModifyProjectBudget is a constant for the custom verb that allows modifying the `.spec.budget` field
+	// in `Project` resources.
+	CustomVerbModifyProjectBudget = "modify-spec-budget"
 
 	// CustomVerbNamespacedCloudProfileModifyKubernetes is a constant for the custom verb that allows modifying the
 	// `.spec.kubernetes` field in `NamespacedCloudProfile` resources.
@@ -112,6 +115,14 @@ func (c *CustomVerbAuthorizer) admitProjects(ctx context.Context, a admission.At
 		}
 	}
 
+	// The budget is checked first since the members of a project must not be able to raise their own budget even if
+	// they are allowed to perform other privileged modifications.
+	if !apiequality.Semantic.DeepEqual(oldObj.Spec.Budget, obj.Spec.Budget) {
+		if err := c.authorize(ctx, a, CustomVerbModifyProjectBudget, "modify .spec.budget"); err != nil {
+			return err
+		}
+	}
+
 	if mustCheckProjectTolerationsWhitelist(oldObj.Spec.Tolerations, obj.Spec.Tolerations) {
 		return c.authorize(ctx, a, CustomVerbModifyProjectTolerationsWhitelist, "modify .spec.tolerations.whitelist")
 	}
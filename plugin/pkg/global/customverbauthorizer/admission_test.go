@@ -12,6 +12,7 @@ import (
 	. "github.com/onsi/gomega"
 	"go.uber.org/mock/gomock"
 	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apiserver/pkg/admission"
@@ -151,6 +152,79 @@ var _ = Describe("customverbauthorizer", func() {
 				})
 			})
 
+			Context("modify-spec-budget verb", func() {
+				BeforeEach(func() {
+					authorizeAttributes.Verb = CustomVerbModifyProjectBudget
+				})
+
+				It("should always allow creating a project without budget", func() {
+					attrs = admission.NewAttributesRecord(project, nil, core.Kind("Project").WithVersion("version"), project.Namespace, project.Name, core.Resource("projects").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+					Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(Succeed())
+				})
+
+				It("should always allow updating a project without changing the budget", func() {
+					project.Spec.Budget = &core.ProjectBudget{MachineHours: ptr.To(resource.MustParse("100"))}
+					oldProject := project.DeepCopy()
+					project.Spec.Purpose = ptr.To("foo")
+
+					attrs = admission.NewAttributesRecord(project, oldProject, core.Kind("Project").WithVersion("version"), project.Namespace, project.Name, core.Resource("projects").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, userInfo)
+					Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(Succeed())
+				})
+
+				Describe("permissions granted", func() {
+					BeforeEach(func() {
+						auth.EXPECT().Authorize(ctx, authorizeAttributes).Return(authorizer.DecisionAllow, "", nil)
+					})
+
+					It("should allow creating a project with budget", func() {
+						project.Spec.Budget = &core.ProjectBudget{MachineHours: ptr.To(resource.MustParse("100"))}
+
+						attrs = admission.NewAttributesRecord(project, nil, core.Kind("Project").WithVersion("version"), project.Namespace, project.Name, core.Resource("projects").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+						Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(Succeed())
+					})
+
+					It("should allow updating a project's budget", func() {
+						project.Spec.Budget = &core.ProjectBudget{MachineHours: ptr.To(resource.MustParse("100"))}
+						oldProject := project.DeepCopy()
+						project.Spec.Budget.MachineHours = ptr.To(resource.MustParse("200"))
+
+						attrs = admission.NewAttributesRecord(project, oldProject, core.Kind("Project").WithVersion("version"), project.Namespace, project.Name, core.Resource("projects").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, userInfo)
+						Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(Succeed())
+					})
+				})
+
+				Describe("permissions not granted", func() {
+					BeforeEach(func() {
+						auth.EXPECT().Authorize(ctx, authorizeAttributes).Return(authorizer.DecisionDeny, "", nil)
+					})
+
+					It("should forbid creating a project with budget", func() {
+						project.Spec.Budget = &core.ProjectBudget{MachineHours: ptr.To(resource.MustParse("100"))}
+
+						attrs = admission.NewAttributesRecord(project, nil, core.Kind("Project").WithVersion("version"), project.Namespace, project.Name, core.Resource("projects").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+						Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).NotTo(Succeed())
+					})
+
+					It("should forbid updating a project's budget", func() {
+						project.Spec.Budget = &core.ProjectBudget{MachineHours: ptr.To(resource.MustParse("100"))}
+						oldProject := project.DeepCopy()
+						project.Spec.Budget.MachineHours = ptr.To(resource.MustParse("200"))
+
+						attrs = admission.NewAttributesRecord(project, oldProject, core.Kind("Project").WithVersion("version"), project.Namespace, project.Name, core.Resource("projects").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, userInfo)
+						Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).NotTo(Succeed())
+					})
+
+					It("should forbid removing a project's budget", func() {
+						project.Spec.Budget = &core.ProjectBudget{MachineHours: ptr.To(resource.MustParse("100"))}
+						oldProject := project.DeepCopy()
+						project.Spec.Budget = nil
+
+						attrs = admission.NewAttributesRecord(project, oldProject, core.Kind("Project").WithVersion("version"), project.Namespace, project.Name, core.Resource("projects").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, userInfo)
+						Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).NotTo(Succeed())
+					})
+				})
+			})
+
 			Context("manage-members verb", func() {
 				BeforeEach(func() {
 					authorizeAttributes.Verb = CustomVerbProjectManageMembers
@@ -9,15 +9,19 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"slices"
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
+	"k8s.io/apiserver/pkg/warning"
 	"k8s.io/utils/ptr"
 
 	"github.com/gardener/gardener/pkg/apis/core"
@@ -44,6 +48,10 @@ var (
 		core.QuotaMetricLoadbalancer}
 )
 
+// CustomVerbWakeUpExceedingBudget is a constant for the custom verb that allows waking up hibernated Shoots although
+// the budget of their project is exceeded.
+const CustomVerbWakeUpExceedingBudget = "wake-up-exceeding-budget"
+
 // Register registers a plugin.
 func Register(plugins *admission.Plugins) {
 	plugins.Register(plugin.PluginNameShootQuotaValidator, func(_ io.Reader) (admission.Interface, error) {
@@ -55,11 +63,13 @@ func Register(plugins *admission.Plugins) {
 type QuotaValidator struct {
 	*admission.Handler
 	shootLister                  gardencorev1beta1listers.ShootLister
+	projectLister                gardencorev1beta1listers.ProjectLister
 	cloudProfileLister           gardencorev1beta1listers.CloudProfileLister
 	namespacedCloudProfileLister gardencorev1beta1listers.NamespacedCloudProfileLister
 	secretBindingLister          gardencorev1beta1listers.SecretBindingLister
 	credentialsBindingLister     securityv1alpha1listers.CredentialsBindingLister
 	quotaLister                  gardencorev1beta1listers.QuotaLister
+	authorizer                   authorizer.Authorizer
 	readyFunc                    admission.ReadyFunc
 	time                         timeutils.Ops
 }
@@ -67,6 +77,7 @@ type QuotaValidator struct {
 var (
 	_ = admissioninitializer.WantsCoreInformerFactory(&QuotaValidator{})
 	_ = admissioninitializer.WantsSecurityInformerFactory(&QuotaValidator{})
+	_ = admissioninitializer.WantsAuthorizer(&QuotaValidator{})
 
 	readyFuncs []admission.ReadyFunc
 )
@@ -90,6 +101,9 @@ func (q *QuotaValidator) SetCoreInformerFactory(f gardencoreinformers.SharedInfo
 	shootInformer := f.Core().V1beta1().Shoots()
 	q.shootLister = shootInformer.Lister()
 
+	projectInformer := f.Core().V1beta1().Projects()
+	q.projectLister = projectInformer.Lister()
+
 	cloudProfileInformer := f.Core().V1beta1().CloudProfiles()
 	q.cloudProfileLister = cloudProfileInformer.Lister()
 
@@ -102,7 +116,7 @@ func (q *QuotaValidator) SetCoreInformerFactory(f gardencoreinformers.SharedInfo
 	quotaInformer := f.Core().V1beta1().Quotas()
 	q.quotaLister = quotaInformer.Lister()
 
-	readyFuncs = append(readyFuncs, shootInformer.Informer().HasSynced, cloudProfileInformer.Informer().HasSynced, secretBindingInformer.Informer().HasSynced, quotaInformer.Informer().HasSynced)
+	readyFuncs = append(readyFuncs, shootInformer.Informer().HasSynced, projectInformer.Informer().HasSynced, cloudProfileInformer.Informer().HasSynced, secretBindingInformer.Informer().HasSynced, quotaInformer.Informer().HasSynced)
 }
 
 // SetSecurityInformerFactory gets Lister from SharedInformerFactory.
@@ -113,11 +127,19 @@ func (q *QuotaValidator) SetSecurityInformerFactory(f securityinformers.SharedIn
 	readyFuncs = append(readyFuncs, credentialsBindingInformer.Informer().HasSynced)
 }
 
+// SetAuthorizer gets the authorizer.
+func (q *QuotaValidator) SetAuthorizer(authorizer authorizer.Authorizer) {
+	q.authorizer = authorizer
+}
+
 // ValidateInitialization checks whether the plugin was correctly initialized.
 func (q *QuotaValidator) ValidateInitialization() error {
 	if q.shootLister == nil {
 		return errors.New("missing shoot lister")
 	}
+	if q.projectLister == nil {
+		return errors.New("missing project lister")
+	}
 	if q.cloudProfileLister == nil {
 		return errors.New("missing cloudProfile lister")
 	}
@@ -130,6 +152,9 @@ func (q *QuotaValidator) ValidateInitialization() error {
 	if q.quotaLister == nil {
 		return errors.New("missing quota lister")
 	}
+	if q.authorizer == nil {
+		return errors.New("missing authorizer")
+	}
 	if q.credentialsBindingLister == nil {
 		return errors.New("missing credentials binding lister")
 	}
@@ -138,8 +163,8 @@ func (q *QuotaValidator) ValidateInitialization() error {
 
 var _ admission.ValidationInterface = &QuotaValidator{}
 
-// Validate checks that the requested Shoot resources do not exceed the quota limits.
-func (q *QuotaValidator) Validate(_ context.Context, a admission.Attributes, _ admission.ObjectInterfaces) error {
+// Validate checks that the requested Shoot resources do not exceed the quota limits and the budget of the project.
+func (q *QuotaValidator) Validate(ctx context.Context, a admission.Attributes, _ admission.ObjectInterfaces) error {
 	// Wait until the caches have been synced
 	if q.readyFunc == nil {
 		q.AssignReadyFunc(func() bool {
@@ -178,10 +203,12 @@ func (q *QuotaValidator) Validate(_ context.Context, a admission.Attributes, _ a
 		maxShootLifetime *int32
 		checkLifetime    = false
 		checkQuota       = false
+		checkBudget      = false
 	)
 
 	if a.GetOperation() == admission.Create {
 		checkQuota = true
+		checkBudget = true
 	}
 
 	if a.GetOperation() == admission.Update {
@@ -192,6 +219,24 @@ func (q *QuotaValidator) Validate(_ context.Context, a admission.Attributes, _ a
 
 		checkQuota = quotaVerificationNeeded(*shoot, *oldShoot)
 		checkLifetime = lifetimeVerificationNeeded(*shoot, *oldShoot)
+		checkBudget = budgetVerificationNeeded(*shoot, *oldShoot)
+
+		// Shoots are also woken up by system components, e.g., according to their hibernation schedules. Rejecting such
+		// wake-ups would let these components retry forever, hence they are allowed to bypass the budget. Instead, the
+		// wake-ups are checked when they are scheduled, i.e., when awake overrides or wake-up schedules are added.
+		if checkBudget && !workersScaledUp(*shoot, *oldShoot) {
+			allowed, err := q.authorizedToWakeUpExceedingBudget(ctx, a)
+			if err != nil {
+				return apierrors.NewInternalError(err)
+			}
+			checkBudget = !allowed
+		}
+	}
+
+	if checkBudget {
+		if err := q.validateProjectBudget(ctx, a, shoot); err != nil {
+			return err
+		}
 	}
 
 	var quotas []corev1.ObjectReference
@@ -263,6 +308,30 @@ func (q *QuotaValidator) Validate(_ context.Context, a admission.Attributes, _ a
 	return nil
 }
 
+func (q *QuotaValidator) validateProjectBudget(ctx context.Context, a admission.Attributes, shoot *core.Shoot) error {
+	project, err := utils.ProjectForNamespaceFromLister(q.projectLister, shoot.Namespace)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return apierrors.NewInternalError(err)
+	}
+
+	if project.Spec.Budget == nil || project.Status.Usage == nil {
+		return nil
+	}
+
+	switch project.Status.Usage.BudgetState {
+	case gardencorev1beta1.ProjectBudgetStateExceeded:
+		return admission.NewForbidden(a, fmt.Errorf("budget of project %q is exceeded for the current period. Unable to create shoots, wake them up or scale up worker pools", project.Name))
+	case gardencorev1beta1.ProjectBudgetStateWarning:
+		// The warning threshold is defaulted by the API.
+		warning.AddWarning(ctx, "", fmt.Sprintf("usage of project %q reached %d%% of its budget for the current period", project.Name, *project.Spec.Budget.WarningThresholdPercentage))
+	}
+
+	return nil
+}
+
 func (q *QuotaValidator) isQuotaExceeded(shoot core.Shoot, quota gardencorev1beta1.Quota) (*[]corev1.ResourceName, error) {
 	allocatedResources, err := q.determineAllocatedResources(quota, shoot)
 	if err != nil {
@@ -546,6 +615,93 @@ func quotaVerificationNeeded(new, old core.Shoot) bool {
 	return false
 }
 
+func (q *QuotaValidator) authorizedToWakeUpExceedingBudget(ctx context.Context, a admission.Attributes) (bool, error) {
+	resource := a.GetResource()
+
+	decision, _, err := q.authorizer.Authorize(ctx, authorizer.AttributesRecord{
+		User:            a.GetUserInfo(),
+		APIGroup:        resource.Group,
+		Resource:        resource.Resource,
+		Namespace:       a.GetNamespace(),
+		Name:            a.GetName(),
+		Verb:            CustomVerbWakeUpExceedingBudget,
+		ResourceRequest: true,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return decision == authorizer.DecisionAllow, nil
+}
+
+func budgetVerificationNeeded(new, old core.Shoot) bool {
+	// Waking up a hibernated Shoot creates machines again
+	if helper.HibernationIsEnabled(&old) && !helper.HibernationIsEnabled(&new) {
+		return true
+	}
+
+	return wakeUpScheduled(new, old) || workersScaledUp(new, old)
+}
+
+// wakeUpScheduled returns true if the new Shoot is kept awake for a longer time than the old one or contains wake-up
+// schedules which the old one does not contain. The Shoot is then woken up by the system components without further
+// checks.
+func wakeUpScheduled(new, old core.Shoot) bool {
+	if new.Spec.Hibernation == nil {
+		return false
+	}
+
+	var (
+		oldAwakeUntil *metav1.Time
+		oldSchedules  []core.HibernationSchedule
+	)
+
+	if old.Spec.Hibernation != nil {
+		oldAwakeUntil = old.Spec.Hibernation.AwakeUntil
+		oldSchedules = old.Spec.Hibernation.Schedules
+	}
+
+	if newAwakeUntil := new.Spec.Hibernation.AwakeUntil; newAwakeUntil != nil && (oldAwakeUntil == nil || newAwakeUntil.After(oldAwakeUntil.Time)) {
+		return true
+	}
+
+	for _, schedule := range new.Spec.Hibernation.Schedules {
+		if schedule.End == nil {
+			continue
+		}
+
+		if !slices.ContainsFunc(oldSchedules, func(oldSchedule core.HibernationSchedule) bool {
+			return apiequality.Semantic.DeepEqual(schedule, oldSchedule)
+		}) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func workersScaledUp(new, old core.Shoot) bool {
+	for _, worker := range new.Spec.Provider.Workers {
+		oldHasWorker := false
+
+		for _, oldWorker := range old.Spec.Provider.Workers {
+			if worker.Name == oldWorker.Name {
+				oldHasWorker = true
+
+				if worker.Machine.Type != oldWorker.Machine.Type || worker.Minimum > oldWorker.Minimum || worker.Maximum > oldWorker.Maximum {
+					return true
+				}
+			}
+		}
+
+		if !oldHasWorker {
+			return true
+		}
+	}
+
+	return false
+}
+
 func hasSufficientQuota(limit, required resource.Quantity) bool {
 	compareCode := limit.Cmp(required)
 	return compareCode != -1
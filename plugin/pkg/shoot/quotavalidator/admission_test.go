@@ -15,6 +15,9 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
+	"k8s.io/apiserver/pkg/warning"
 	"k8s.io/utils/ptr"
 
 	"github.com/gardener/gardener/pkg/apis/core"
@@ -23,8 +26,10 @@ import (
 	securityv1alpha1 "github.com/gardener/gardener/pkg/apis/security/v1alpha1"
 	gardencoreinformers "github.com/gardener/gardener/pkg/client/core/informers/externalversions"
 	securityinformers "github.com/gardener/gardener/pkg/client/security/informers/externalversions"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
 	mocktime "github.com/gardener/gardener/pkg/utils/time/mock"
 	. "github.com/gardener/gardener/plugin/pkg/shoot/quotavalidator"
+	mockauthorizer "github.com/gardener/gardener/third_party/mock/apiserver/authorization/authorizer"
 )
 
 var _ = Describe("quotavalidator", func() {
@@ -36,6 +41,7 @@ var _ = Describe("quotavalidator", func() {
 			coreInformerFactory     gardencoreinformers.SharedInformerFactory
 			securityInformerFactory securityinformers.SharedInformerFactory
 			timeOps                 *mocktime.MockOps
+			auth                    *mockauthorizer.MockAuthorizer
 			shoot                   core.Shoot
 			oldShoot                core.Shoot
 			secretBinding           gardencorev1beta1.SecretBinding
@@ -274,8 +280,11 @@ var _ = Describe("quotavalidator", func() {
 			ctrl = gomock.NewController(GinkgoT())
 			timeOps = mocktime.NewMockOps(ctrl)
 
+			auth = mockauthorizer.NewMockAuthorizer(ctrl)
+
 			admissionHandler, _ = New(timeOps)
 			admissionHandler.AssignReadyFunc(func() bool { return true })
+			admissionHandler.SetAuthorizer(auth)
 			coreInformerFactory = gardencoreinformers.NewSharedInformerFactory(nil, 0)
 			admissionHandler.SetCoreInformerFactory(coreInformerFactory)
 			Expect(coreInformerFactory.Core().V1beta1().CloudProfiles().Informer().GetStore().Add(&cloudProfile)).To(Succeed())
@@ -381,6 +390,198 @@ var _ = Describe("quotavalidator", func() {
 			})
 		})
 
+		Context("tests for project budgets", func() {
+			var project *gardencorev1beta1.Project
+
+			BeforeEach(func() {
+				quotaProject.Spec.Metrics = nil
+				quotaSecret.Spec.Metrics = nil
+
+				project = &gardencorev1beta1.Project{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-project"},
+					Spec: gardencorev1beta1.ProjectSpec{
+						Namespace: ptr.To(namespace),
+						Budget: &gardencorev1beta1.ProjectBudget{
+							MachineHours:               ptr.To(resource.MustParse("100")),
+							WarningThresholdPercentage: ptr.To[int32](80),
+						},
+					},
+					Status: gardencorev1beta1.ProjectStatus{
+						Usage: &gardencorev1beta1.ProjectUsage{
+							BudgetState: gardencorev1beta1.ProjectBudgetStateExceeded,
+						},
+					},
+				}
+				Expect(coreInformerFactory.Core().V1beta1().Projects().Informer().GetStore().Add(project)).To(Succeed())
+			})
+
+			It("should fail to create a shoot because the budget is exceeded", func() {
+				attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+
+				err := admissionHandler.Validate(context.TODO(), attrs, nil)
+				Expect(err).To(BeForbiddenError())
+				Expect(err.Error()).To(ContainSubstring("budget of project \"test-project\" is exceeded"))
+			})
+
+			It("should pass with a warning because the budget reached the warning threshold", func() {
+				project.Status.Usage.BudgetState = gardencorev1beta1.ProjectBudgetStateWarning
+				recorder := &fakeWarningRecorder{}
+				attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+
+				Expect(admissionHandler.Validate(warning.WithWarningRecorder(context.TODO(), recorder), attrs, nil)).To(Succeed())
+				Expect(recorder.warnings).To(ConsistOf(ContainSubstring("reached 80% of its budget")))
+			})
+
+			It("should pass because the project has no budget", func() {
+				project.Spec.Budget = nil
+				attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+
+				Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(Succeed())
+			})
+
+			It("should fail to scale up a worker pool because the budget is exceeded", func() {
+				oldShoot = *shoot.DeepCopy()
+				shoot.Spec.Provider.Workers[0].Maximum = 2
+				attrs := admission.NewAttributesRecord(&shoot, &oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, nil)
+
+				Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(BeForbiddenError())
+			})
+
+			Context("waking up a shoot", func() {
+				var (
+					userInfo            = &user.DefaultInfo{Name: "foo"}
+					authorizeAttributes authorizer.AttributesRecord
+					attrs               admission.Attributes
+				)
+
+				BeforeEach(func() {
+					oldShoot = *shoot.DeepCopy()
+					oldShoot.Spec.Hibernation = &core.Hibernation{Enabled: ptr.To(true)}
+					attrs = admission.NewAttributesRecord(&shoot, &oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, userInfo)
+
+					authorizeAttributes = authorizer.AttributesRecord{
+						User:            userInfo,
+						APIGroup:        core.GroupName,
+						Resource:        "shoots",
+						Namespace:       shoot.Namespace,
+						Name:            shoot.Name,
+						Verb:            "wake-up-exceeding-budget",
+						ResourceRequest: true,
+					}
+				})
+
+				It("should fail because the budget is exceeded", func() {
+					auth.EXPECT().Authorize(gomock.Any(), authorizeAttributes).Return(authorizer.DecisionDeny, "", nil)
+
+					Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(BeForbiddenError())
+				})
+
+				It("should pass although the budget is exceeded because the user is allowed to bypass the budget", func() {
+					auth.EXPECT().Authorize(gomock.Any(), authorizeAttributes).Return(authorizer.DecisionAllow, "", nil)
+
+					Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(Succeed())
+				})
+
+				It("should fail if workers are scaled up at the same time, even if the user is allowed to bypass the budget", func() {
+					shoot.Spec.Provider.Workers[0].Maximum++
+
+					Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(BeForbiddenError())
+				})
+			})
+
+			Context("scheduling a wake-up of a shoot", func() {
+				var (
+					userInfo            = &user.DefaultInfo{Name: "foo"}
+					authorizeAttributes authorizer.AttributesRecord
+					attrs               admission.Attributes
+					now                 = metav1.Now()
+				)
+
+				BeforeEach(func() {
+					shoot.Spec.Hibernation = &core.Hibernation{
+						Enabled:    ptr.To(true),
+						AwakeUntil: &metav1.Time{Time: now.Add(time.Hour)},
+						Schedules:  []core.HibernationSchedule{{Start: ptr.To("00 17 * * 1,2,3,4,5"), End: ptr.To("00 08 * * 1,2,3,4,5")}},
+					}
+					oldShoot = *shoot.DeepCopy()
+					attrs = admission.NewAttributesRecord(&shoot, &oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, userInfo)
+
+					authorizeAttributes = authorizer.AttributesRecord{
+						User:            userInfo,
+						APIGroup:        core.GroupName,
+						Resource:        "shoots",
+						Namespace:       shoot.Namespace,
+						Name:            shoot.Name,
+						Verb:            "wake-up-exceeding-budget",
+						ResourceRequest: true,
+					}
+				})
+
+				It("should fail to add an awake override because the budget is exceeded", func() {
+					oldShoot.Spec.Hibernation.AwakeUntil = nil
+					auth.EXPECT().Authorize(gomock.Any(), authorizeAttributes).Return(authorizer.DecisionDeny, "", nil)
+
+					Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(BeForbiddenError())
+				})
+
+				It("should fail to extend an awake override because the budget is exceeded", func() {
+					shoot.Spec.Hibernation.AwakeUntil = &metav1.Time{Time: now.Add(2 * time.Hour)}
+					auth.EXPECT().Authorize(gomock.Any(), authorizeAttributes).Return(authorizer.DecisionDeny, "", nil)
+
+					Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(BeForbiddenError())
+				})
+
+				It("should fail to add a wake-up schedule because the budget is exceeded", func() {
+					shoot.Spec.Hibernation.Schedules = append(shoot.Spec.Hibernation.Schedules, core.HibernationSchedule{End: ptr.To("00 08 * * 6")})
+					auth.EXPECT().Authorize(gomock.Any(), authorizeAttributes).Return(authorizer.DecisionDeny, "", nil)
+
+					Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(BeForbiddenError())
+				})
+
+				It("should fail to change a wake-up schedule because the budget is exceeded", func() {
+					shoot.Spec.Hibernation.Schedules[0].End = ptr.To("00 06 * * 1,2,3,4,5")
+					auth.EXPECT().Authorize(gomock.Any(), authorizeAttributes).Return(authorizer.DecisionDeny, "", nil)
+
+					Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(BeForbiddenError())
+				})
+
+				It("should pass although the budget is exceeded because the user is allowed to bypass the budget", func() {
+					oldShoot.Spec.Hibernation = nil
+					auth.EXPECT().Authorize(gomock.Any(), authorizeAttributes).Return(authorizer.DecisionAllow, "", nil)
+
+					Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(Succeed())
+				})
+
+				It("should pass to shorten an awake override although the budget is exceeded", func() {
+					shoot.Spec.Hibernation.AwakeUntil = &metav1.Time{Time: now.Add(30 * time.Minute)}
+
+					Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(Succeed())
+				})
+
+				It("should pass to add a hibernation schedule without wake-up although the budget is exceeded", func() {
+					shoot.Spec.Hibernation.Schedules = append(shoot.Spec.Hibernation.Schedules, core.HibernationSchedule{Start: ptr.To("00 12 * * 6")})
+
+					Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(Succeed())
+				})
+			})
+
+			It("should pass to scale down a worker pool although the budget is exceeded", func() {
+				oldShoot = *shoot.DeepCopy()
+				oldShoot.Spec.Provider.Workers[0].Maximum = 2
+				attrs := admission.NewAttributesRecord(&shoot, &oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, nil)
+
+				Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(Succeed())
+			})
+
+			It("should pass to hibernate a shoot although the budget is exceeded", func() {
+				oldShoot = *shoot.DeepCopy()
+				shoot.Spec.Hibernation = &core.Hibernation{Enabled: ptr.To(true)}
+				attrs := admission.NewAttributesRecord(&shoot, &oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, nil)
+
+				Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(Succeed())
+			})
+		})
+
 		Context("tests for Quota validation corner cases", func() {
 			It("should pass because shoot is intended to get deleted", func() {
 				var now metav1.Time
@@ -489,3 +690,11 @@ var _ = Describe("quotavalidator", func() {
 		})
 	})
 })
+
+type fakeWarningRecorder struct {
+	warnings []string
+}
+
+func (f *fakeWarningRecorder) AddWarning(_, text string) {
+	f.warnings = append(f.warnings, text)
+}
@@ -6,6 +6,7 @@ package controllerinstallation_test
 
 import (
 	"encoding/base64"
+	"fmt"
 	"strings"
 	"time"
 
@@ -28,6 +29,7 @@ import (
 	"github.com/gardener/gardener/pkg/features"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/controllerinstallation/controllerinstallation"
 	gardenletfeatures "github.com/gardener/gardener/pkg/gardenlet/features"
+	ociutils "github.com/gardener/gardener/pkg/utils/oci"
 	"github.com/gardener/gardener/pkg/utils/test"
 	. "github.com/gardener/gardener/pkg/utils/test/matchers"
 )
@@ -186,6 +188,26 @@ var _ = Describe("ControllerInstallation controller tests", func() {
 		})
 	})
 
+	Context("responsible with OCI and invalid signature", func() {
+		BeforeEach(func() {
+			oci := &gardencorev1.OCIRepository{
+				Repository: ptr.To("test-unsigned"),
+				Tag:        ptr.To("0.1.0"),
+			}
+			controllerDeployment.Helm = &gardencorev1.HelmControllerDeployment{
+				OCIRepository: oci,
+			}
+			fakeRegistry.AddError(oci, fmt.Errorf("%w: no cosign signature found", ociutils.ErrSignatureVerificationFailed))
+		})
+
+		It("should report the invalid signature in the Valid condition", func() {
+			Eventually(func(g Gomega) []gardencorev1beta1.Condition {
+				g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(controllerInstallation), controllerInstallation)).To(Succeed())
+				return controllerInstallation.Status.Conditions
+			}).Should(ContainCondition(OfType(gardencorev1beta1.ControllerInstallationValid), WithStatus(gardencorev1beta1.ConditionFalse), WithReason("OCIChartSignatureInvalid")))
+		})
+	})
+
 	Context("responsible", func() {
 		BeforeEach(func() {
 			DeferCleanup(test.WithVar(&controllerinstallation.RequeueDurationWhenResourceDeletionStillPresent, 500*time.Millisecond))
@@ -125,10 +125,11 @@ var _ = BeforeSuite(func() {
 			SyncPeriod:          &metav1.Duration{Duration: time.Minute},
 			ManagedByLabelValue: ptr.To("gardener"),
 		},
-		Clock:                         fakeClock,
-		ClassFilter:                   filter,
-		RequeueAfterOnDeletionPending: ptr.To(50 * time.Millisecond),
-		GarbageCollectorActivated:     true,
+		Clock:                           fakeClock,
+		ClassFilter:                     filter,
+		RequeueAfterOnDeletionPending:   ptr.To(50 * time.Millisecond),
+		RequeueAfterOnApplyPhasePending: ptr.To(50 * time.Millisecond),
+		GarbageCollectorActivated:       true,
 	}).AddToManager(ctx, mgr, mgr, mgr)).To(Succeed())
 
 	By("Start manager")
@@ -1056,6 +1056,152 @@ var _ = Describe("ManagedResource controller tests", func() {
 		})
 	})
 
+	Describe("Apply phases", func() {
+		var deployment *appsv1.Deployment
+
+		BeforeEach(func() {
+			deployment = &appsv1.Deployment{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: appsv1.SchemeGroupVersion.String(),
+					Kind:       "Deployment",
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      resourceName,
+					Namespace: testNamespace.Name,
+				},
+				Spec: appsv1.DeploymentSpec{
+					Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"foo": "bar"}},
+					Replicas: ptr.To[int32](1),
+					Template: corev1.PodTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"foo": "bar"}},
+						Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "foo-container", Image: "foo"}}},
+					},
+				},
+			}
+
+			configMap.SetAnnotations(map[string]string{resourcesv1alpha1.ApplyPhase: "1"})
+			secretForManagedResource.Data = map[string][]byte{
+				"deployment.yaml": jsonDataForObject(deployment),
+				dataKey:           jsonDataForObject(configMap),
+			}
+		})
+
+		AfterEach(func() {
+			By("Delete ManagedResource")
+			Expect(testClient.Delete(ctx, managedResource)).To(Or(Succeed(), BeNotFoundError()))
+
+			// resource-manager deletes Deployments with foreground deletion, which causes API server to add the
+			// foregroundDeletion finalizer. It is removed by kube-controller-manager's garbage collector, which is not
+			// running in envtest, so we me might need to remove it ourselves.
+			Eventually(func(g Gomega) bool {
+				err := testClient.Get(ctx, client.ObjectKeyFromObject(deployment), deployment)
+				if apierrors.IsNotFound(err) {
+					return true
+				}
+				g.Expect(err).To(Succeed())
+				g.Expect(controllerutils.RemoveFinalizers(ctx, testClient, deployment, metav1.FinalizerDeleteDependents)).To(Succeed())
+				return false
+			}).Should(BeTrue())
+		})
+
+		It("should apply the next phase only once the objects of the previous phase are healthy", func() {
+			Eventually(func(g Gomega) []gardencorev1beta1.Condition {
+				g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+				return managedResource.Status.Conditions
+			}).Should(
+				ContainCondition(OfType(resourcesv1alpha1.ResourcesApplied), WithStatus(gardencorev1beta1.ConditionProgressing), WithReason(resourcesv1alpha1.ConditionApplyPhasePending)),
+			)
+
+			Expect(testClient.Get(ctx, client.ObjectKeyFromObject(deployment), deployment)).To(Succeed())
+			Consistently(func() error {
+				return testClient.Get(ctx, client.ObjectKeyFromObject(configMap), configMap)
+			}).Should(BeNotFoundError())
+			Expect(managedResource.Status.Resources).To(ConsistOf(HaveField("Name", deployment.Name)))
+
+			By("Mark Deployment as healthy")
+			deployment.Status = appsv1.DeploymentStatus{
+				ObservedGeneration: deployment.Generation,
+				Replicas:           1,
+				UpdatedReplicas:    1,
+				AvailableReplicas:  1,
+				Conditions: []appsv1.DeploymentCondition{
+					{Type: appsv1.DeploymentAvailable, Status: corev1.ConditionTrue, LastUpdateTime: metav1.Now(), LastTransitionTime: metav1.Now()},
+					{Type: appsv1.DeploymentProgressing, Status: corev1.ConditionTrue, LastUpdateTime: metav1.Now(), LastTransitionTime: metav1.Now()},
+				},
+			}
+			Expect(testClient.Status().Update(ctx, deployment)).To(Succeed())
+
+			Eventually(func() error {
+				return testClient.Get(ctx, client.ObjectKeyFromObject(configMap), configMap)
+			}).Should(Succeed())
+
+			Eventually(func(g Gomega) []gardencorev1beta1.Condition {
+				g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+				return managedResource.Status.Conditions
+			}).Should(
+				ContainCondition(OfType(resourcesv1alpha1.ResourcesApplied), WithStatus(gardencorev1beta1.ConditionTrue), WithReason(resourcesv1alpha1.ConditionApplySucceeded)),
+			)
+		})
+	})
+
+	Describe("Server-side apply", func() {
+		BeforeEach(func() {
+			managedResource.Spec.ApplyMode = ptr.To(resourcesv1alpha1.ApplyModeServerSideApply)
+		})
+
+		JustBeforeEach(func() {
+			Eventually(func(g Gomega) []gardencorev1beta1.Condition {
+				g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+				return managedResource.Status.Conditions
+			}).Should(
+				ContainCondition(OfType(resourcesv1alpha1.ResourcesApplied), WithStatus(gardencorev1beta1.ConditionTrue), WithReason(resourcesv1alpha1.ConditionApplySucceeded)),
+			)
+		})
+
+		It("should apply the resources with the resource manager's field manager", func() {
+			Expect(testClient.Get(ctx, client.ObjectKeyFromObject(configMap), configMap)).To(Succeed())
+			Expect(configMap.ManagedFields).To(ContainElement(And(
+				HaveField("Manager", "gardener-resource-manager"),
+				HaveField("Operation", metav1.ManagedFieldsOperationApply),
+			)))
+		})
+
+		It("should preserve fields owned by other field managers", func() {
+			patch := client.MergeFrom(configMap.DeepCopy())
+			configMap.Data = map[string]string{"abc": "xyz", "foo": "bar"}
+			Expect(testClient.Patch(ctx, configMap, patch)).To(Succeed())
+
+			patch = client.MergeFrom(managedResource.DeepCopy())
+			metav1.SetMetaDataAnnotation(&managedResource.ObjectMeta, "gardener.cloud/operation", "reconcile")
+			Expect(testClient.Patch(ctx, managedResource, patch)).To(Succeed())
+
+			Consistently(func(g Gomega) map[string]string {
+				g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(configMap), configMap)).To(Succeed())
+				return configMap.Data
+			}).Should(Equal(map[string]string{"abc": "xyz", "foo": "bar"}))
+		})
+
+		It("should report conflicts with other field managers", func() {
+			patch := client.MergeFrom(configMap.DeepCopy())
+			configMap.Data = map[string]string{"abc": "changed"}
+			Expect(testClient.Patch(ctx, configMap, patch)).To(Succeed())
+
+			patch = client.MergeFrom(managedResource.DeepCopy())
+			metav1.SetMetaDataAnnotation(&managedResource.ObjectMeta, "gardener.cloud/operation", "reconcile")
+			Expect(testClient.Patch(ctx, managedResource, patch)).To(Succeed())
+
+			Eventually(func(g Gomega) []gardencorev1beta1.Condition {
+				g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+				return managedResource.Status.Conditions
+			}).Should(
+				ContainCondition(OfType(resourcesv1alpha1.ResourcesApplied), WithStatus(gardencorev1beta1.ConditionFalse), WithReason(resourcesv1alpha1.ConditionApplyConflict), WithMessageSubstrings(".data.abc")),
+			)
+
+			Expect(testClient.Get(ctx, client.ObjectKeyFromObject(configMap), configMap)).To(Succeed())
+			Expect(configMap.Data).To(HaveKeyWithValue("abc", "changed"))
+		})
+	})
+
 	Describe("Immutable resources", func() {
 		BeforeEach(func() {
 			configMap.Immutable = ptr.To(true)